@@ -9,16 +9,29 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"keeper/docs"
 	"keeper/internal/app"
+	"keeper/internal/audit"
+	"keeper/internal/authz"
 	"keeper/internal/db"
+	"keeper/internal/key"
+	"keeper/internal/mail"
+	"keeper/internal/oauth"
 	platformhttp "keeper/internal/platform/http"
+	"keeper/internal/role"
+	"keeper/internal/scim"
+	"keeper/internal/token"
 	"keeper/internal/user"
+	"keeper/internal/webhook"
 	"keeper/pkg/auth"
+	"keeper/pkg/breach"
 	"keeper/pkg/config"
+	"keeper/pkg/mailer"
+	"keeper/pkg/password"
 )
 
 // @title Keeper API
@@ -73,19 +86,210 @@ func main() {
 		}
 	}()
 
+	// Record changes to users, apps and lockouts in the audit trail.
+	auditRepo := audit.NewAuditRepository(client)
+	auditRecorder := audit.NewRecorder(auditRepo)
+	audit.Register(client, auditRecorder)
+	auditHandler := audit.NewAuditHandler(audit.NewAuditService(auditRepo))
+
+	// Queue webhook events with the changes to users and apps.
+	webhookGuard, err := webhook.NewAddressGuard(cfg.Webhook.AllowedNetworks)
+	if err != nil {
+		slog.Error("invalid webhook allowed networks", "error", err)
+		os.Exit(1)
+	}
+	webhookRepo := webhook.NewWebhookRepository(client)
+	dispatcher := webhook.NewDispatcher(webhookRepo,
+		webhook.WithMaxAttempts(cfg.Webhook.MaxAttempts),
+		webhook.WithTimeout(cfg.Webhook.Timeout),
+		webhook.WithAddressGuard(webhookGuard),
+	)
+	webhook.Register(client, dispatcher)
+	webhookHandler := webhook.NewWebhookHandler(webhook.NewWebhookService(webhookRepo, webhookGuard))
+
 	// Auth setup
-	jwtManager := auth.NewJWTManager(cfg.Auth.JWTSecret, cfg.Auth.JWTExpiry)
+	bootstrapSigner := auth.NewHMACSigner(cfg.Auth.SigningKeyID, cfg.Auth.JWTSecret)
+	if cfg.Auth.SigningKeyFile != "" {
+		bootstrapSigner, err = auth.LoadSignerFromFile(cfg.Auth.SigningKeyID, cfg.Auth.SigningKeyFile)
+		if err != nil {
+			slog.Error("failed to load signing key", "path", cfg.Auth.SigningKeyFile, "error", err)
+			os.Exit(1)
+		}
+		slog.Info("using asymmetric signing key", "kid", bootstrapSigner.KeyID(), "alg", bootstrapSigner.Method().Alg())
+	}
+	keyring := auth.NewKeyring(cfg.Auth.JWTExpiry)
+	jwtManager := auth.NewJWTManagerWithKeyring(keyring, cfg.Auth.JWTExpiry)
+	jwtManager.SetIssuer(strings.TrimSuffix(cfg.Auth.Issuer, "/"))
+
+	keyRepo := key.NewKeyRepository(client)
+	keySvc := key.NewKeyService(keyRepo, keyring, auth.Key{Signer: bootstrapSigner})
+	keyHandler := key.NewKeyHandler(keySvc)
+	if err := keySvc.Reload(context.Background()); err != nil {
+		slog.Error("failed to load signing keys", "error", err)
+		os.Exit(1)
+	}
+
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go func() {
+		ticker := time.NewTicker(cfg.Auth.KeyReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				if err := keySvc.Reload(bgCtx); err != nil {
+					slog.Error("failed to reload signing keys", "error", err)
+				}
+			}
+		}
+	}()
+
+	var denylist auth.Denylist
+	switch cfg.Auth.DenylistStore {
+	case "memory":
+		denylist = auth.NewMemoryDenylist()
+	case "database":
+		denylist = token.NewDenylistRepository(client)
+	default:
+		slog.Error("unknown denylist store", "store", cfg.Auth.DenylistStore)
+		os.Exit(1)
+	}
+	jwtManager.SetDenylist(denylist)
+	go auth.RunPurger(bgCtx, denylist, cfg.Auth.DenylistPurgeInterval)
+
+	var mailTransport mailer.Mailer
+	switch cfg.Mail.Driver {
+	case "log":
+		mailTransport = mailer.LogMailer{}
+	case "file":
+		mailTransport = mailer.FileMailer{Dir: cfg.Mail.Dir}
+	case "smtp":
+		mailTransport = mailer.NewSMTPMailer(mailer.SMTPConfig{
+			Host:        cfg.Mail.SMTPHost,
+			Port:        cfg.Mail.SMTPPort,
+			Username:    cfg.Mail.SMTPUsername,
+			Password:    cfg.Mail.SMTPPassword,
+			ImplicitTLS: cfg.Mail.SMTPImplicitTLS,
+		})
+	default:
+		slog.Error("unknown mail driver", "driver", cfg.Mail.Driver)
+		os.Exit(1)
+	}
+	mailRepo := mail.NewMailRepository(client)
+	outbox := mail.NewOutbox(mailRepo, mailTransport, cfg.Mail.From, mail.WithMaxAttempts(cfg.Mail.MaxAttempts))
+	go outbox.Run(bgCtx, cfg.Mail.OutboxInterval)
+	go dispatcher.Run(bgCtx, cfg.Webhook.DeliveryInterval)
+	mailHandler := mail.NewMailHandler(mail.NewMailService(mailRepo))
+
+	hasher, err := password.NewHasher(password.HashParams{
+		Algorithm:     cfg.Auth.PasswordHashAlgorithm,
+		Argon2Memory:  cfg.Auth.Argon2Memory,
+		Argon2Time:    cfg.Auth.Argon2Time,
+		Argon2Threads: cfg.Auth.Argon2Threads,
+		ScryptLogN:    cfg.Auth.ScryptLogN,
+		ScryptR:       cfg.Auth.ScryptR,
+		ScryptP:       cfg.Auth.ScryptP,
+		BcryptCost:    cfg.Auth.BcryptCost,
+	})
+	if err != nil {
+		slog.Error("invalid password hashing configuration", "error", err)
+		os.Exit(1)
+	}
+
+	userOpts := []user.Option{
+		user.WithRefreshExpiry(cfg.Auth.RefreshExpiry),
+		user.WithPasswordResetExpiry(cfg.Auth.PasswordResetExpiry),
+		user.WithEmailVerificationExpiry(cfg.Auth.EmailVerificationExpiry),
+		user.WithMFAChallengeExpiry(cfg.Auth.MFAChallengeExpiry),
+		user.WithWebAuthnChallengeExpiry(cfg.Auth.WebAuthnChallengeExpiry),
+		user.WithMagicLinkExpiry(cfg.Auth.MagicLinkExpiry),
+		user.WithMagicLinkURL(jwtManager.Issuer() + "/users/auth/magic-link/verify"),
+		user.WithPasswordHasher(hasher),
+		user.WithNotifier(mail.NewNotifier(outbox)),
+		user.WithAuditor(auditRecorder),
+		user.WithAuditor(dispatcher),
+	}
+	if cfg.Auth.BreachedPasswordsFile != "" {
+		screener, err := breach.NewScreener(cfg.Auth.BreachedPasswordsFile)
+		if err != nil {
+			slog.Error("failed to open breached password index", "path", cfg.Auth.BreachedPasswordsFile, "error", err)
+			os.Exit(1)
+		}
+		defer func() {
+			if err := screener.Close(); err != nil {
+				slog.Error("failed to close breached password index", "error", err)
+			}
+		}()
+		go func() {
+			ticker := time.NewTicker(cfg.Auth.BreachedPasswordsReloadInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-bgCtx.Done():
+					return
+				case <-ticker.C:
+					if err := screener.Reload(); err != nil {
+						slog.Error("failed to reload breached password index", "error", err)
+					}
+				}
+			}
+		}()
+		userOpts = append(userOpts, user.WithBreachedPasswords(screener))
+	}
 
 	// Initialize components
 	userRepo := user.NewUserRepository(client)
-	userSvc := user.NewUserService(userRepo, jwtManager)
+	jwtManager.SetUserStatusChecker(user.NewStatusChecker(userRepo))
+	userSvc := user.NewUserService(userRepo, jwtManager, userOpts...)
 	userHandler := user.NewUserHandler(userSvc)
 
 	appRepo := app.NewAppRepository(client)
 	appSvc := app.NewAppService(appRepo)
 	appHandler := app.NewAppHandler(appSvc)
 
-	router := platformhttp.NewRouter(userHandler, appHandler, jwtManager, cfg)
+	roleRepo := role.NewRoleRepository(client)
+	roleSvc := role.NewRoleService(roleRepo)
+	roleHandler := role.NewRoleHandler(roleSvc)
+	// Make every permission enforced by the routes available to roles.
+	err = roleSvc.SyncPermissions(context.Background(), []string{
+		user.PermissionRead, user.PermissionWrite,
+		app.PermissionRead, app.PermissionWrite,
+		role.PermissionRead, role.PermissionWrite,
+		authz.PermissionCheck,
+		mail.PermissionRead, mail.PermissionWrite,
+		audit.PermissionRead,
+		webhook.PermissionRead, webhook.PermissionWrite,
+	})
+	if err != nil {
+		slog.Error("failed to sync permissions", "error", err)
+		os.Exit(1)
+	}
+
+	authzSvc := authz.NewAuthzService(authz.NewAuthzRepository(client))
+	authzHandler := authz.NewAuthzHandler(authzSvc)
+
+	oauthRepo := oauth.NewOAuthRepository(client)
+	oauthSvc := oauth.NewOAuthService(oauthRepo, userSvc, jwtManager)
+	oauthHandler := oauth.NewOAuthHandler(oauthSvc)
+	jwtManager.SetClientStatusChecker(oauth.NewClientStatusChecker(oauthRepo))
+
+	scimSvc := scim.NewSCIMService(scim.NewSCIMRepository(client), userSvc, roleSvc, jwtManager.Issuer()+"/scim/v2")
+	scimHandler := scim.NewSCIMHandler(scimSvc)
+
+	router := platformhttp.NewRouter(platformhttp.Handlers{
+		User:    userHandler,
+		App:     appHandler,
+		Role:    roleHandler,
+		Authz:   authzHandler,
+		Key:     keyHandler,
+		Mail:    mailHandler,
+		Audit:   auditHandler,
+		Webhook: webhookHandler,
+		OAuth:   oauthHandler,
+		SCIM:    scimHandler,
+	}, jwtManager, cfg)
 
 	srv := &http.Server{
 		Addr:         cfg.Server.Addr,
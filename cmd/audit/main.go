@@ -0,0 +1,93 @@
+// Command audit checks the audit trail of the configured database.
+//
+//	audit verify [-head HASH]
+//
+// verify checks that every entry matches its hash and the hash of the entry
+// before it, and prints the number of entries and the hash of the last one.
+// Given the head printed by an earlier run, it also checks that the entry
+// with that hash is still in the chain, which detects the removal of the
+// newest entries. It exits with status 1 when the trail was tampered with.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"keeper/ent/auditlog"
+	"keeper/ent/privacy"
+	"keeper/internal/audit"
+	"keeper/internal/db"
+	"keeper/pkg/config"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch os.Args[1] {
+	case "verify":
+		err = verify(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "audit %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage:")
+	fmt.Fprintln(os.Stderr, "  audit verify [-head HASH]")
+	os.Exit(2)
+}
+
+func verify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	head := fs.String("head", "", "hash of the last entry seen by an earlier run")
+	_ = fs.Parse(args)
+	if fs.NArg() != 0 {
+		usage()
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	client, err := db.NewSQLiteClient(cfg.DB.Path)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	res, err := audit.NewAuditService(audit.NewAuditRepository(client)).Verify(ctx)
+	if err != nil {
+		return err
+	}
+	if !res.Valid() {
+		return fmt.Errorf("chain broken at entry %d after %d valid entries: %s", *res.BrokenAt, res.Entries, res.Reason)
+	}
+	if *head != "" && *head != res.Head {
+		n, err := client.AuditLog.Query().
+			Where(auditlog.HashEQ(*head)).
+			Count(privacy.DecisionContext(ctx, privacy.Allow))
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errors.New("entry with the given head hash is missing")
+		}
+	}
+
+	fmt.Printf("ok: %d entries, head %s\n", res.Entries, res.Head)
+	return nil
+}
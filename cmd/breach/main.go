@@ -0,0 +1,229 @@
+// Command breach builds and inspects the breached password index Keeper
+// screens new passwords against.
+//
+//	breach build [-kind sha1|ntlm] -o INDEX CORPUS...
+//	breach info INDEX
+//	breach check INDEX < passwords
+//
+// A corpus is a text file of hex hashes, one per line and sorted, as in the
+// Pwned Passwords downloads, or a directory of range files named after the
+// five hex characters every hash in them starts with. Several corpora are
+// merged. The index is written next to INDEX and renamed over it once
+// complete, so a running server picks it up on its next reload.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"keeper/pkg/breach"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch os.Args[1] {
+	case "build":
+		err = build(os.Args[2:])
+	case "info":
+		err = info(os.Args[2:])
+	case "check":
+		err = check(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "breach %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage:")
+	fmt.Fprintln(os.Stderr, "  breach build [-kind sha1|ntlm] -o INDEX CORPUS...")
+	fmt.Fprintln(os.Stderr, "  breach info INDEX")
+	fmt.Fprintln(os.Stderr, "  breach check INDEX < passwords")
+	os.Exit(2)
+}
+
+func build(args []string) error {
+	fs := flag.NewFlagSet("build", flag.ExitOnError)
+	kindName := fs.String("kind", "sha1", "hash function of the corpus: sha1 or ntlm")
+	out := fs.String("o", "", "path of the index to write")
+	_ = fs.Parse(args)
+	if *out == "" || fs.NArg() == 0 {
+		usage()
+	}
+	kind, err := breach.ParseKind(*kindName)
+	if err != nil {
+		return err
+	}
+
+	sources := make([]breach.Source, fs.NArg())
+	for i, path := range fs.Args() {
+		src, err := openCorpus(path, kind)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		sources[i] = src
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(*out), filepath.Base(*out)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := breach.Build(tmp, kind, sources...)
+	if err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), *out); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %d %s hashes to %s\n", n, kind, *out)
+	return nil
+}
+
+func info(args []string) error {
+	if len(args) != 1 {
+		usage()
+	}
+	idx, err := breach.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+	fmt.Printf("%s: %d %s hashes\n", args[0], idx.Len(), idx.Kind())
+	return nil
+}
+
+func check(args []string) error {
+	if len(args) != 1 {
+		usage()
+	}
+	idx, err := breach.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+
+	s := bufio.NewScanner(os.Stdin)
+	for s.Scan() {
+		found, err := idx.Contains(s.Text())
+		if err != nil {
+			return err
+		}
+		if found {
+			fmt.Println("breached")
+		} else {
+			fmt.Println("not found")
+		}
+	}
+	return s.Err()
+}
+
+// rangeFile matches the names of Pwned Passwords range files.
+var rangeFile = regexp.MustCompile(`^[0-9A-Fa-f]{5}$`)
+
+// corpus is a breach.Source over a corpus file or a directory of range
+// files, read one file at a time.
+type corpus struct {
+	kind  breach.Kind
+	files []string
+	f     *os.File
+	r     *breach.Reader
+}
+
+func openCorpus(path string, kind breach.Kind) (*corpus, error) {
+	st, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !st.IsDir() {
+		return &corpus{kind: kind, files: []string{path}}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &corpus{kind: kind}
+	for _, e := range entries {
+		if !e.IsDir() && rangeFile.MatchString(rangePrefix(e.Name())) {
+			c.files = append(c.files, filepath.Join(path, e.Name()))
+		}
+	}
+	if len(c.files) == 0 {
+		return nil, fmt.Errorf("%s: no range files", path)
+	}
+	// Range files hold disjoint prefixes, so reading them in prefix order
+	// yields every hash in order.
+	slices.SortFunc(c.files, func(a, b string) int {
+		return strings.Compare(strings.ToUpper(filepath.Base(a)), strings.ToUpper(filepath.Base(b)))
+	})
+	return c, nil
+}
+
+// rangePrefix returns the name of a file without its extension.
+func rangePrefix(name string) string {
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+func (c *corpus) Next() ([]byte, error) {
+	for {
+		if c.r == nil {
+			if len(c.files) == 0 {
+				return nil, io.EOF
+			}
+			path := c.files[0]
+			c.files = c.files[1:]
+			f, err := os.Open(path)
+			if err != nil {
+				return nil, err
+			}
+			prefix := ""
+			if p := rangePrefix(filepath.Base(path)); rangeFile.MatchString(p) {
+				prefix = p
+			}
+			c.f, c.r = f, breach.NewReader(f, c.kind, prefix)
+		}
+		h, err := c.r.Next()
+		if errors.Is(err, io.EOF) {
+			_ = c.f.Close()
+			c.f, c.r = nil, nil
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.f.Name(), err)
+		}
+		return h, nil
+	}
+}
+
+func (c *corpus) Close() {
+	if c.f != nil {
+		_ = c.f.Close()
+	}
+}
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"keeper/ent/app"
+	"keeper/pkg/password"
 	"strings"
 	"time"
 
@@ -19,6 +21,36 @@ type App struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// RedirectUris holds the value of the "redirect_uris" field.
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// ClientSecretHash holds the value of the "client_secret_hash" field.
+	ClientSecretHash *string `json:"-"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// ScimTokenHash holds the value of the "scim_token_hash" field.
+	ScimTokenHash *string `json:"-"`
+	// RequireVerifiedEmail holds the value of the "require_verified_email" field.
+	RequireVerifiedEmail bool `json:"require_verified_email,omitempty"`
+	// RequireMfa holds the value of the "require_mfa" field.
+	RequireMfa bool `json:"require_mfa,omitempty"`
+	// MagicLinkEnabled holds the value of the "magic_link_enabled" field.
+	MagicLinkEnabled bool `json:"magic_link_enabled,omitempty"`
+	// WebauthnRpID holds the value of the "webauthn_rp_id" field.
+	WebauthnRpID string `json:"webauthn_rp_id,omitempty"`
+	// WebauthnOrigins holds the value of the "webauthn_origins" field.
+	WebauthnOrigins []string `json:"webauthn_origins,omitempty"`
+	// MaxLoginAttempts holds the value of the "max_login_attempts" field.
+	MaxLoginAttempts int `json:"max_login_attempts,omitempty"`
+	// LockoutDuration holds the value of the "lockout_duration" field.
+	LockoutDuration int `json:"lockout_duration,omitempty"`
+	// LoginDelay holds the value of the "login_delay" field.
+	LoginDelay int `json:"login_delay,omitempty"`
+	// MaxIPLoginAttempts holds the value of the "max_ip_login_attempts" field.
+	MaxIPLoginAttempts int `json:"max_ip_login_attempts,omitempty"`
+	// PasswordPolicy holds the value of the "password_policy" field.
+	PasswordPolicy *password.Policy `json:"password_policy,omitempty"`
 	// Status holds the value of the "status" field.
 	Status int8 `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -35,9 +67,21 @@ type App struct {
 type AppEdges struct {
 	// Users holds the value of the users edge.
 	Users []*User `json:"users,omitempty"`
+	// AuthorizationCodes holds the value of the authorization_codes edge.
+	AuthorizationCodes []*AuthorizationCode `json:"authorization_codes,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
+	// EmailTemplates holds the value of the email_templates edge.
+	EmailTemplates []*EmailTemplate `json:"email_templates,omitempty"`
+	// WebauthnChallenges holds the value of the webauthn_challenges edge.
+	WebauthnChallenges []*WebAuthnChallenge `json:"webauthn_challenges,omitempty"`
+	// Lockouts holds the value of the lockouts edge.
+	Lockouts []*Lockout `json:"lockouts,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -49,14 +93,72 @@ func (e AppEdges) UsersOrErr() ([]*User, error) {
 	return nil, &NotLoadedError{edge: "users"}
 }
 
+// AuthorizationCodesOrErr returns the AuthorizationCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) AuthorizationCodesOrErr() ([]*AuthorizationCode, error) {
+	if e.loadedTypes[1] {
+		return e.AuthorizationCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "authorization_codes"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[2] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// EmailTemplatesOrErr returns the EmailTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) EmailTemplatesOrErr() ([]*EmailTemplate, error) {
+	if e.loadedTypes[3] {
+		return e.EmailTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "email_templates"}
+}
+
+// WebauthnChallengesOrErr returns the WebauthnChallenges value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) WebauthnChallengesOrErr() ([]*WebAuthnChallenge, error) {
+	if e.loadedTypes[4] {
+		return e.WebauthnChallenges, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_challenges"}
+}
+
+// LockoutsOrErr returns the Lockouts value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) LockoutsOrErr() ([]*Lockout, error) {
+	if e.loadedTypes[5] {
+		return e.Lockouts, nil
+	}
+	return nil, &NotLoadedError{edge: "lockouts"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[6] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*App) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case app.FieldID, app.FieldStatus:
+		case app.FieldRedirectUris, app.FieldScopes, app.FieldWebauthnOrigins, app.FieldPasswordPolicy:
+			values[i] = new([]byte)
+		case app.FieldRequireVerifiedEmail, app.FieldRequireMfa, app.FieldMagicLinkEnabled:
+			values[i] = new(sql.NullBool)
+		case app.FieldID, app.FieldMaxLoginAttempts, app.FieldLockoutDuration, app.FieldLoginDelay, app.FieldMaxIPLoginAttempts, app.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case app.FieldName:
+		case app.FieldName, app.FieldClientID, app.FieldClientSecretHash, app.FieldScimTokenHash, app.FieldWebauthnRpID:
 			values[i] = new(sql.NullString)
 		case app.FieldCreatedAt, app.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -87,6 +189,106 @@ func (_m *App) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case app.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				_m.ClientID = value.String
+			}
+		case app.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case app.FieldClientSecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_secret_hash", values[i])
+			} else if value.Valid {
+				_m.ClientSecretHash = new(string)
+				*_m.ClientSecretHash = value.String
+			}
+		case app.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case app.FieldScimTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scim_token_hash", values[i])
+			} else if value.Valid {
+				_m.ScimTokenHash = new(string)
+				*_m.ScimTokenHash = value.String
+			}
+		case app.FieldRequireVerifiedEmail:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_verified_email", values[i])
+			} else if value.Valid {
+				_m.RequireVerifiedEmail = value.Bool
+			}
+		case app.FieldRequireMfa:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_mfa", values[i])
+			} else if value.Valid {
+				_m.RequireMfa = value.Bool
+			}
+		case app.FieldMagicLinkEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field magic_link_enabled", values[i])
+			} else if value.Valid {
+				_m.MagicLinkEnabled = value.Bool
+			}
+		case app.FieldWebauthnRpID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webauthn_rp_id", values[i])
+			} else if value.Valid {
+				_m.WebauthnRpID = value.String
+			}
+		case app.FieldWebauthnOrigins:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field webauthn_origins", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.WebauthnOrigins); err != nil {
+					return fmt.Errorf("unmarshal field webauthn_origins: %w", err)
+				}
+			}
+		case app.FieldMaxLoginAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_login_attempts", values[i])
+			} else if value.Valid {
+				_m.MaxLoginAttempts = int(value.Int64)
+			}
+		case app.FieldLockoutDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lockout_duration", values[i])
+			} else if value.Valid {
+				_m.LockoutDuration = int(value.Int64)
+			}
+		case app.FieldLoginDelay:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field login_delay", values[i])
+			} else if value.Valid {
+				_m.LoginDelay = int(value.Int64)
+			}
+		case app.FieldMaxIPLoginAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_ip_login_attempts", values[i])
+			} else if value.Valid {
+				_m.MaxIPLoginAttempts = int(value.Int64)
+			}
+		case app.FieldPasswordPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field password_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PasswordPolicy); err != nil {
+					return fmt.Errorf("unmarshal field password_policy: %w", err)
+				}
+			}
 		case app.FieldStatus:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -123,6 +325,36 @@ func (_m *App) QueryUsers() *UserQuery {
 	return NewAppClient(_m.config).QueryUsers(_m)
 }
 
+// QueryAuthorizationCodes queries the "authorization_codes" edge of the App entity.
+func (_m *App) QueryAuthorizationCodes() *AuthorizationCodeQuery {
+	return NewAppClient(_m.config).QueryAuthorizationCodes(_m)
+}
+
+// QueryRoles queries the "roles" edge of the App entity.
+func (_m *App) QueryRoles() *RoleQuery {
+	return NewAppClient(_m.config).QueryRoles(_m)
+}
+
+// QueryEmailTemplates queries the "email_templates" edge of the App entity.
+func (_m *App) QueryEmailTemplates() *EmailTemplateQuery {
+	return NewAppClient(_m.config).QueryEmailTemplates(_m)
+}
+
+// QueryWebauthnChallenges queries the "webauthn_challenges" edge of the App entity.
+func (_m *App) QueryWebauthnChallenges() *WebAuthnChallengeQuery {
+	return NewAppClient(_m.config).QueryWebauthnChallenges(_m)
+}
+
+// QueryLockouts queries the "lockouts" edge of the App entity.
+func (_m *App) QueryLockouts() *LockoutQuery {
+	return NewAppClient(_m.config).QueryLockouts(_m)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the App entity.
+func (_m *App) QueryWebhooks() *WebhookQuery {
+	return NewAppClient(_m.config).QueryWebhooks(_m)
+}
+
 // Update returns a builder for updating this App.
 // Note that you need to call App.Unwrap() before calling this method if this App
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -149,6 +381,49 @@ func (_m *App) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(_m.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("client_secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("scim_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("require_verified_email=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireVerifiedEmail))
+	builder.WriteString(", ")
+	builder.WriteString("require_mfa=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireMfa))
+	builder.WriteString(", ")
+	builder.WriteString("magic_link_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MagicLinkEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("webauthn_rp_id=")
+	builder.WriteString(_m.WebauthnRpID)
+	builder.WriteString(", ")
+	builder.WriteString("webauthn_origins=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WebauthnOrigins))
+	builder.WriteString(", ")
+	builder.WriteString("max_login_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxLoginAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("lockout_duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LockoutDuration))
+	builder.WriteString(", ")
+	builder.WriteString("login_delay=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LoginDelay))
+	builder.WriteString(", ")
+	builder.WriteString("max_ip_login_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxIPLoginAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("password_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PasswordPolicy))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
@@ -5,6 +5,7 @@ package app
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,6 +17,36 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldRedirectUris holds the string denoting the redirect_uris field in the database.
+	FieldRedirectUris = "redirect_uris"
+	// FieldClientSecretHash holds the string denoting the client_secret_hash field in the database.
+	FieldClientSecretHash = "client_secret_hash"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldScimTokenHash holds the string denoting the scim_token_hash field in the database.
+	FieldScimTokenHash = "scim_token_hash"
+	// FieldRequireVerifiedEmail holds the string denoting the require_verified_email field in the database.
+	FieldRequireVerifiedEmail = "require_verified_email"
+	// FieldRequireMfa holds the string denoting the require_mfa field in the database.
+	FieldRequireMfa = "require_mfa"
+	// FieldMagicLinkEnabled holds the string denoting the magic_link_enabled field in the database.
+	FieldMagicLinkEnabled = "magic_link_enabled"
+	// FieldWebauthnRpID holds the string denoting the webauthn_rp_id field in the database.
+	FieldWebauthnRpID = "webauthn_rp_id"
+	// FieldWebauthnOrigins holds the string denoting the webauthn_origins field in the database.
+	FieldWebauthnOrigins = "webauthn_origins"
+	// FieldMaxLoginAttempts holds the string denoting the max_login_attempts field in the database.
+	FieldMaxLoginAttempts = "max_login_attempts"
+	// FieldLockoutDuration holds the string denoting the lockout_duration field in the database.
+	FieldLockoutDuration = "lockout_duration"
+	// FieldLoginDelay holds the string denoting the login_delay field in the database.
+	FieldLoginDelay = "login_delay"
+	// FieldMaxIPLoginAttempts holds the string denoting the max_ip_login_attempts field in the database.
+	FieldMaxIPLoginAttempts = "max_ip_login_attempts"
+	// FieldPasswordPolicy holds the string denoting the password_policy field in the database.
+	FieldPasswordPolicy = "password_policy"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -24,6 +55,18 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
+	// EdgeAuthorizationCodes holds the string denoting the authorization_codes edge name in mutations.
+	EdgeAuthorizationCodes = "authorization_codes"
+	// EdgeRoles holds the string denoting the roles edge name in mutations.
+	EdgeRoles = "roles"
+	// EdgeEmailTemplates holds the string denoting the email_templates edge name in mutations.
+	EdgeEmailTemplates = "email_templates"
+	// EdgeWebauthnChallenges holds the string denoting the webauthn_challenges edge name in mutations.
+	EdgeWebauthnChallenges = "webauthn_challenges"
+	// EdgeLockouts holds the string denoting the lockouts edge name in mutations.
+	EdgeLockouts = "lockouts"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
 	// Table holds the table name of the app in the database.
 	Table = "kpr_app"
 	// UsersTable is the table that holds the users relation/edge.
@@ -33,12 +76,69 @@ const (
 	UsersInverseTable = "kpr_user"
 	// UsersColumn is the table column denoting the users relation/edge.
 	UsersColumn = "app_id"
+	// AuthorizationCodesTable is the table that holds the authorization_codes relation/edge.
+	AuthorizationCodesTable = "kpr_authorization_code"
+	// AuthorizationCodesInverseTable is the table name for the AuthorizationCode entity.
+	// It exists in this package in order to avoid circular dependency with the "authorizationcode" package.
+	AuthorizationCodesInverseTable = "kpr_authorization_code"
+	// AuthorizationCodesColumn is the table column denoting the authorization_codes relation/edge.
+	AuthorizationCodesColumn = "app_id"
+	// RolesTable is the table that holds the roles relation/edge.
+	RolesTable = "kpr_role"
+	// RolesInverseTable is the table name for the Role entity.
+	// It exists in this package in order to avoid circular dependency with the "role" package.
+	RolesInverseTable = "kpr_role"
+	// RolesColumn is the table column denoting the roles relation/edge.
+	RolesColumn = "app_id"
+	// EmailTemplatesTable is the table that holds the email_templates relation/edge.
+	EmailTemplatesTable = "kpr_email_template"
+	// EmailTemplatesInverseTable is the table name for the EmailTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "emailtemplate" package.
+	EmailTemplatesInverseTable = "kpr_email_template"
+	// EmailTemplatesColumn is the table column denoting the email_templates relation/edge.
+	EmailTemplatesColumn = "app_id"
+	// WebauthnChallengesTable is the table that holds the webauthn_challenges relation/edge.
+	WebauthnChallengesTable = "kpr_webauthn_challenge"
+	// WebauthnChallengesInverseTable is the table name for the WebAuthnChallenge entity.
+	// It exists in this package in order to avoid circular dependency with the "webauthnchallenge" package.
+	WebauthnChallengesInverseTable = "kpr_webauthn_challenge"
+	// WebauthnChallengesColumn is the table column denoting the webauthn_challenges relation/edge.
+	WebauthnChallengesColumn = "app_id"
+	// LockoutsTable is the table that holds the lockouts relation/edge.
+	LockoutsTable = "kpr_lockout"
+	// LockoutsInverseTable is the table name for the Lockout entity.
+	// It exists in this package in order to avoid circular dependency with the "lockout" package.
+	LockoutsInverseTable = "kpr_lockout"
+	// LockoutsColumn is the table column denoting the lockouts relation/edge.
+	LockoutsColumn = "app_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "kpr_webhook"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "kpr_webhook"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "app_id"
 )
 
 // Columns holds all SQL columns for app fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldClientID,
+	FieldRedirectUris,
+	FieldClientSecretHash,
+	FieldScopes,
+	FieldScimTokenHash,
+	FieldRequireVerifiedEmail,
+	FieldRequireMfa,
+	FieldMagicLinkEnabled,
+	FieldWebauthnRpID,
+	FieldWebauthnOrigins,
+	FieldMaxLoginAttempts,
+	FieldLockoutDuration,
+	FieldLoginDelay,
+	FieldMaxIPLoginAttempts,
+	FieldPasswordPolicy,
 	FieldStatus,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -54,7 +154,38 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "keeper/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultClientID holds the default value on creation for the "client_id" field.
+	DefaultClientID func() string
+	// DefaultRequireVerifiedEmail holds the default value on creation for the "require_verified_email" field.
+	DefaultRequireVerifiedEmail bool
+	// DefaultRequireMfa holds the default value on creation for the "require_mfa" field.
+	DefaultRequireMfa bool
+	// DefaultMagicLinkEnabled holds the default value on creation for the "magic_link_enabled" field.
+	DefaultMagicLinkEnabled bool
+	// DefaultMaxLoginAttempts holds the default value on creation for the "max_login_attempts" field.
+	DefaultMaxLoginAttempts int
+	// MaxLoginAttemptsValidator is a validator for the "max_login_attempts" field. It is called by the builders before save.
+	MaxLoginAttemptsValidator func(int) error
+	// DefaultLockoutDuration holds the default value on creation for the "lockout_duration" field.
+	DefaultLockoutDuration int
+	// LockoutDurationValidator is a validator for the "lockout_duration" field. It is called by the builders before save.
+	LockoutDurationValidator func(int) error
+	// DefaultLoginDelay holds the default value on creation for the "login_delay" field.
+	DefaultLoginDelay int
+	// LoginDelayValidator is a validator for the "login_delay" field. It is called by the builders before save.
+	LoginDelayValidator func(int) error
+	// DefaultMaxIPLoginAttempts holds the default value on creation for the "max_ip_login_attempts" field.
+	DefaultMaxIPLoginAttempts int
+	// MaxIPLoginAttemptsValidator is a validator for the "max_ip_login_attempts" field. It is called by the builders before save.
+	MaxIPLoginAttemptsValidator func(int) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus int8
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -78,6 +209,61 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByClientSecretHash orders the results by the client_secret_hash field.
+func ByClientSecretHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientSecretHash, opts...).ToFunc()
+}
+
+// ByScimTokenHash orders the results by the scim_token_hash field.
+func ByScimTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScimTokenHash, opts...).ToFunc()
+}
+
+// ByRequireVerifiedEmail orders the results by the require_verified_email field.
+func ByRequireVerifiedEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireVerifiedEmail, opts...).ToFunc()
+}
+
+// ByRequireMfa orders the results by the require_mfa field.
+func ByRequireMfa(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireMfa, opts...).ToFunc()
+}
+
+// ByMagicLinkEnabled orders the results by the magic_link_enabled field.
+func ByMagicLinkEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMagicLinkEnabled, opts...).ToFunc()
+}
+
+// ByWebauthnRpID orders the results by the webauthn_rp_id field.
+func ByWebauthnRpID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebauthnRpID, opts...).ToFunc()
+}
+
+// ByMaxLoginAttempts orders the results by the max_login_attempts field.
+func ByMaxLoginAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLoginAttempts, opts...).ToFunc()
+}
+
+// ByLockoutDuration orders the results by the lockout_duration field.
+func ByLockoutDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockoutDuration, opts...).ToFunc()
+}
+
+// ByLoginDelay orders the results by the login_delay field.
+func ByLoginDelay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLoginDelay, opts...).ToFunc()
+}
+
+// ByMaxIPLoginAttempts orders the results by the max_ip_login_attempts field.
+func ByMaxIPLoginAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxIPLoginAttempts, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -106,6 +292,90 @@ func ByUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAuthorizationCodesCount orders the results by authorization_codes count.
+func ByAuthorizationCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuthorizationCodesStep(), opts...)
+	}
+}
+
+// ByAuthorizationCodes orders the results by authorization_codes terms.
+func ByAuthorizationCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuthorizationCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRolesCount orders the results by roles count.
+func ByRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRolesStep(), opts...)
+	}
+}
+
+// ByRoles orders the results by roles terms.
+func ByRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailTemplatesCount orders the results by email_templates count.
+func ByEmailTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailTemplatesStep(), opts...)
+	}
+}
+
+// ByEmailTemplates orders the results by email_templates terms.
+func ByEmailTemplates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailTemplatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebauthnChallengesCount orders the results by webauthn_challenges count.
+func ByWebauthnChallengesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebauthnChallengesStep(), opts...)
+	}
+}
+
+// ByWebauthnChallenges orders the results by webauthn_challenges terms.
+func ByWebauthnChallenges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebauthnChallengesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLockoutsCount orders the results by lockouts count.
+func ByLockoutsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLockoutsStep(), opts...)
+	}
+}
+
+// ByLockouts orders the results by lockouts terms.
+func ByLockouts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLockoutsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -113,3 +383,45 @@ func newUsersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UsersTable, UsersColumn),
 	)
 }
+func newAuthorizationCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuthorizationCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuthorizationCodesTable, AuthorizationCodesColumn),
+	)
+}
+func newRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RolesTable, RolesColumn),
+	)
+}
+func newEmailTemplatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailTemplatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailTemplatesTable, EmailTemplatesColumn),
+	)
+}
+func newWebauthnChallengesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebauthnChallengesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebauthnChallengesTable, WebauthnChallengesColumn),
+	)
+}
+func newLockoutsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LockoutsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LockoutsTable, LockoutsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
@@ -60,6 +60,61 @@ func Name(v string) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldName, v))
 }
 
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecretHash applies equality check predicate on the "client_secret_hash" field. It's identical to ClientSecretHashEQ.
+func ClientSecretHash(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
+// ScimTokenHash applies equality check predicate on the "scim_token_hash" field. It's identical to ScimTokenHashEQ.
+func ScimTokenHash(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldScimTokenHash, v))
+}
+
+// RequireVerifiedEmail applies equality check predicate on the "require_verified_email" field. It's identical to RequireVerifiedEmailEQ.
+func RequireVerifiedEmail(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRequireVerifiedEmail, v))
+}
+
+// RequireMfa applies equality check predicate on the "require_mfa" field. It's identical to RequireMfaEQ.
+func RequireMfa(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRequireMfa, v))
+}
+
+// MagicLinkEnabled applies equality check predicate on the "magic_link_enabled" field. It's identical to MagicLinkEnabledEQ.
+func MagicLinkEnabled(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMagicLinkEnabled, v))
+}
+
+// WebauthnRpID applies equality check predicate on the "webauthn_rp_id" field. It's identical to WebauthnRpIDEQ.
+func WebauthnRpID(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldWebauthnRpID, v))
+}
+
+// MaxLoginAttempts applies equality check predicate on the "max_login_attempts" field. It's identical to MaxLoginAttemptsEQ.
+func MaxLoginAttempts(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMaxLoginAttempts, v))
+}
+
+// LockoutDuration applies equality check predicate on the "lockout_duration" field. It's identical to LockoutDurationEQ.
+func LockoutDuration(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldLockoutDuration, v))
+}
+
+// LoginDelay applies equality check predicate on the "login_delay" field. It's identical to LoginDelayEQ.
+func LoginDelay(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldLoginDelay, v))
+}
+
+// MaxIPLoginAttempts applies equality check predicate on the "max_ip_login_attempts" field. It's identical to MaxIPLoginAttemptsEQ.
+func MaxIPLoginAttempts(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMaxIPLoginAttempts, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v int8) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldStatus, v))
@@ -140,6 +195,526 @@ func NameContainsFold(v string) predicate.App {
 	return predicate.App(sql.FieldContainsFold(FieldName, v))
 }
 
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// RedirectUrisIsNil applies the IsNil predicate on the "redirect_uris" field.
+func RedirectUrisIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldRedirectUris))
+}
+
+// RedirectUrisNotNil applies the NotNil predicate on the "redirect_uris" field.
+func RedirectUrisNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldRedirectUris))
+}
+
+// ClientSecretHashEQ applies the EQ predicate on the "client_secret_hash" field.
+func ClientSecretHashEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashNEQ applies the NEQ predicate on the "client_secret_hash" field.
+func ClientSecretHashNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIn applies the In predicate on the "client_secret_hash" field.
+func ClientSecretHashIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashNotIn applies the NotIn predicate on the "client_secret_hash" field.
+func ClientSecretHashNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldClientSecretHash, vs...))
+}
+
+// ClientSecretHashGT applies the GT predicate on the "client_secret_hash" field.
+func ClientSecretHashGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashGTE applies the GTE predicate on the "client_secret_hash" field.
+func ClientSecretHashGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLT applies the LT predicate on the "client_secret_hash" field.
+func ClientSecretHashLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashLTE applies the LTE predicate on the "client_secret_hash" field.
+func ClientSecretHashLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContains applies the Contains predicate on the "client_secret_hash" field.
+func ClientSecretHashContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasPrefix applies the HasPrefix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashHasSuffix applies the HasSuffix predicate on the "client_secret_hash" field.
+func ClientSecretHashHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashIsNil applies the IsNil predicate on the "client_secret_hash" field.
+func ClientSecretHashIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashNotNil applies the NotNil predicate on the "client_secret_hash" field.
+func ClientSecretHashNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldClientSecretHash))
+}
+
+// ClientSecretHashEqualFold applies the EqualFold predicate on the "client_secret_hash" field.
+func ClientSecretHashEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldClientSecretHash, v))
+}
+
+// ClientSecretHashContainsFold applies the ContainsFold predicate on the "client_secret_hash" field.
+func ClientSecretHashContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldClientSecretHash, v))
+}
+
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldScopes))
+}
+
+// ScimTokenHashEQ applies the EQ predicate on the "scim_token_hash" field.
+func ScimTokenHashEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashNEQ applies the NEQ predicate on the "scim_token_hash" field.
+func ScimTokenHashNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashIn applies the In predicate on the "scim_token_hash" field.
+func ScimTokenHashIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldScimTokenHash, vs...))
+}
+
+// ScimTokenHashNotIn applies the NotIn predicate on the "scim_token_hash" field.
+func ScimTokenHashNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldScimTokenHash, vs...))
+}
+
+// ScimTokenHashGT applies the GT predicate on the "scim_token_hash" field.
+func ScimTokenHashGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashGTE applies the GTE predicate on the "scim_token_hash" field.
+func ScimTokenHashGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashLT applies the LT predicate on the "scim_token_hash" field.
+func ScimTokenHashLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashLTE applies the LTE predicate on the "scim_token_hash" field.
+func ScimTokenHashLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashContains applies the Contains predicate on the "scim_token_hash" field.
+func ScimTokenHashContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashHasPrefix applies the HasPrefix predicate on the "scim_token_hash" field.
+func ScimTokenHashHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashHasSuffix applies the HasSuffix predicate on the "scim_token_hash" field.
+func ScimTokenHashHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashIsNil applies the IsNil predicate on the "scim_token_hash" field.
+func ScimTokenHashIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldScimTokenHash))
+}
+
+// ScimTokenHashNotNil applies the NotNil predicate on the "scim_token_hash" field.
+func ScimTokenHashNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldScimTokenHash))
+}
+
+// ScimTokenHashEqualFold applies the EqualFold predicate on the "scim_token_hash" field.
+func ScimTokenHashEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldScimTokenHash, v))
+}
+
+// ScimTokenHashContainsFold applies the ContainsFold predicate on the "scim_token_hash" field.
+func ScimTokenHashContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldScimTokenHash, v))
+}
+
+// RequireVerifiedEmailEQ applies the EQ predicate on the "require_verified_email" field.
+func RequireVerifiedEmailEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRequireVerifiedEmail, v))
+}
+
+// RequireVerifiedEmailNEQ applies the NEQ predicate on the "require_verified_email" field.
+func RequireVerifiedEmailNEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldRequireVerifiedEmail, v))
+}
+
+// RequireMfaEQ applies the EQ predicate on the "require_mfa" field.
+func RequireMfaEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRequireMfa, v))
+}
+
+// RequireMfaNEQ applies the NEQ predicate on the "require_mfa" field.
+func RequireMfaNEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldRequireMfa, v))
+}
+
+// MagicLinkEnabledEQ applies the EQ predicate on the "magic_link_enabled" field.
+func MagicLinkEnabledEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMagicLinkEnabled, v))
+}
+
+// MagicLinkEnabledNEQ applies the NEQ predicate on the "magic_link_enabled" field.
+func MagicLinkEnabledNEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldMagicLinkEnabled, v))
+}
+
+// WebauthnRpIDEQ applies the EQ predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDNEQ applies the NEQ predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDIn applies the In predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldWebauthnRpID, vs...))
+}
+
+// WebauthnRpIDNotIn applies the NotIn predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldWebauthnRpID, vs...))
+}
+
+// WebauthnRpIDGT applies the GT predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDGTE applies the GTE predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDLT applies the LT predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDLTE applies the LTE predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDContains applies the Contains predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDHasPrefix applies the HasPrefix predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDHasSuffix applies the HasSuffix predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDIsNil applies the IsNil predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldWebauthnRpID))
+}
+
+// WebauthnRpIDNotNil applies the NotNil predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldWebauthnRpID))
+}
+
+// WebauthnRpIDEqualFold applies the EqualFold predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldWebauthnRpID, v))
+}
+
+// WebauthnRpIDContainsFold applies the ContainsFold predicate on the "webauthn_rp_id" field.
+func WebauthnRpIDContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldWebauthnRpID, v))
+}
+
+// WebauthnOriginsIsNil applies the IsNil predicate on the "webauthn_origins" field.
+func WebauthnOriginsIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldWebauthnOrigins))
+}
+
+// WebauthnOriginsNotNil applies the NotNil predicate on the "webauthn_origins" field.
+func WebauthnOriginsNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldWebauthnOrigins))
+}
+
+// MaxLoginAttemptsEQ applies the EQ predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMaxLoginAttempts, v))
+}
+
+// MaxLoginAttemptsNEQ applies the NEQ predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldMaxLoginAttempts, v))
+}
+
+// MaxLoginAttemptsIn applies the In predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldMaxLoginAttempts, vs...))
+}
+
+// MaxLoginAttemptsNotIn applies the NotIn predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldMaxLoginAttempts, vs...))
+}
+
+// MaxLoginAttemptsGT applies the GT predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldMaxLoginAttempts, v))
+}
+
+// MaxLoginAttemptsGTE applies the GTE predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldMaxLoginAttempts, v))
+}
+
+// MaxLoginAttemptsLT applies the LT predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldMaxLoginAttempts, v))
+}
+
+// MaxLoginAttemptsLTE applies the LTE predicate on the "max_login_attempts" field.
+func MaxLoginAttemptsLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldMaxLoginAttempts, v))
+}
+
+// LockoutDurationEQ applies the EQ predicate on the "lockout_duration" field.
+func LockoutDurationEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldLockoutDuration, v))
+}
+
+// LockoutDurationNEQ applies the NEQ predicate on the "lockout_duration" field.
+func LockoutDurationNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldLockoutDuration, v))
+}
+
+// LockoutDurationIn applies the In predicate on the "lockout_duration" field.
+func LockoutDurationIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldLockoutDuration, vs...))
+}
+
+// LockoutDurationNotIn applies the NotIn predicate on the "lockout_duration" field.
+func LockoutDurationNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldLockoutDuration, vs...))
+}
+
+// LockoutDurationGT applies the GT predicate on the "lockout_duration" field.
+func LockoutDurationGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldLockoutDuration, v))
+}
+
+// LockoutDurationGTE applies the GTE predicate on the "lockout_duration" field.
+func LockoutDurationGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldLockoutDuration, v))
+}
+
+// LockoutDurationLT applies the LT predicate on the "lockout_duration" field.
+func LockoutDurationLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldLockoutDuration, v))
+}
+
+// LockoutDurationLTE applies the LTE predicate on the "lockout_duration" field.
+func LockoutDurationLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldLockoutDuration, v))
+}
+
+// LoginDelayEQ applies the EQ predicate on the "login_delay" field.
+func LoginDelayEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldLoginDelay, v))
+}
+
+// LoginDelayNEQ applies the NEQ predicate on the "login_delay" field.
+func LoginDelayNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldLoginDelay, v))
+}
+
+// LoginDelayIn applies the In predicate on the "login_delay" field.
+func LoginDelayIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldLoginDelay, vs...))
+}
+
+// LoginDelayNotIn applies the NotIn predicate on the "login_delay" field.
+func LoginDelayNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldLoginDelay, vs...))
+}
+
+// LoginDelayGT applies the GT predicate on the "login_delay" field.
+func LoginDelayGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldLoginDelay, v))
+}
+
+// LoginDelayGTE applies the GTE predicate on the "login_delay" field.
+func LoginDelayGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldLoginDelay, v))
+}
+
+// LoginDelayLT applies the LT predicate on the "login_delay" field.
+func LoginDelayLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldLoginDelay, v))
+}
+
+// LoginDelayLTE applies the LTE predicate on the "login_delay" field.
+func LoginDelayLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldLoginDelay, v))
+}
+
+// MaxIPLoginAttemptsEQ applies the EQ predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMaxIPLoginAttempts, v))
+}
+
+// MaxIPLoginAttemptsNEQ applies the NEQ predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldMaxIPLoginAttempts, v))
+}
+
+// MaxIPLoginAttemptsIn applies the In predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldMaxIPLoginAttempts, vs...))
+}
+
+// MaxIPLoginAttemptsNotIn applies the NotIn predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldMaxIPLoginAttempts, vs...))
+}
+
+// MaxIPLoginAttemptsGT applies the GT predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldMaxIPLoginAttempts, v))
+}
+
+// MaxIPLoginAttemptsGTE applies the GTE predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldMaxIPLoginAttempts, v))
+}
+
+// MaxIPLoginAttemptsLT applies the LT predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldMaxIPLoginAttempts, v))
+}
+
+// MaxIPLoginAttemptsLTE applies the LTE predicate on the "max_ip_login_attempts" field.
+func MaxIPLoginAttemptsLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldMaxIPLoginAttempts, v))
+}
+
+// PasswordPolicyIsNil applies the IsNil predicate on the "password_policy" field.
+func PasswordPolicyIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldPasswordPolicy))
+}
+
+// PasswordPolicyNotNil applies the NotNil predicate on the "password_policy" field.
+func PasswordPolicyNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldPasswordPolicy))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v int8) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldStatus, v))
@@ -283,6 +858,144 @@ func HasUsersWith(preds ...predicate.User) predicate.App {
 	})
 }
 
+// HasAuthorizationCodes applies the HasEdge predicate on the "authorization_codes" edge.
+func HasAuthorizationCodes() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuthorizationCodesTable, AuthorizationCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthorizationCodesWith applies the HasEdge predicate on the "authorization_codes" edge with a given conditions (other predicates).
+func HasAuthorizationCodesWith(preds ...predicate.AuthorizationCode) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newAuthorizationCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRoles applies the HasEdge predicate on the "roles" edge.
+func HasRoles() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RolesTable, RolesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolesWith applies the HasEdge predicate on the "roles" edge with a given conditions (other predicates).
+func HasRolesWith(preds ...predicate.Role) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmailTemplates applies the HasEdge predicate on the "email_templates" edge.
+func HasEmailTemplates() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmailTemplatesTable, EmailTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmailTemplatesWith applies the HasEdge predicate on the "email_templates" edge with a given conditions (other predicates).
+func HasEmailTemplatesWith(preds ...predicate.EmailTemplate) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newEmailTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebauthnChallenges applies the HasEdge predicate on the "webauthn_challenges" edge.
+func HasWebauthnChallenges() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebauthnChallengesTable, WebauthnChallengesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebauthnChallengesWith applies the HasEdge predicate on the "webauthn_challenges" edge with a given conditions (other predicates).
+func HasWebauthnChallengesWith(preds ...predicate.WebAuthnChallenge) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newWebauthnChallengesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLockouts applies the HasEdge predicate on the "lockouts" edge.
+func HasLockouts() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LockoutsTable, LockoutsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLockoutsWith applies the HasEdge predicate on the "lockouts" edge with a given conditions (other predicates).
+func HasLockoutsWith(preds ...predicate.Lockout) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newLockoutsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.App) predicate.App {
 	return predicate.App(sql.AndPredicates(predicates...))
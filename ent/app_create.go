@@ -7,7 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"keeper/ent/app"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/emailtemplate"
+	"keeper/ent/lockout"
+	"keeper/ent/role"
 	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"keeper/ent/webhook"
+	"keeper/pkg/password"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -27,6 +34,184 @@ func (_c *AppCreate) SetName(v string) *AppCreate {
 	return _c
 }
 
+// SetClientID sets the "client_id" field.
+func (_c *AppCreate) SetClientID(v string) *AppCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_c *AppCreate) SetNillableClientID(v *string) *AppCreate {
+	if v != nil {
+		_c.SetClientID(*v)
+	}
+	return _c
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_c *AppCreate) SetRedirectUris(v []string) *AppCreate {
+	_c.mutation.SetRedirectUris(v)
+	return _c
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_c *AppCreate) SetClientSecretHash(v string) *AppCreate {
+	_c.mutation.SetClientSecretHash(v)
+	return _c
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_c *AppCreate) SetNillableClientSecretHash(v *string) *AppCreate {
+	if v != nil {
+		_c.SetClientSecretHash(*v)
+	}
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *AppCreate) SetScopes(v []string) *AppCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_c *AppCreate) SetScimTokenHash(v string) *AppCreate {
+	_c.mutation.SetScimTokenHash(v)
+	return _c
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_c *AppCreate) SetNillableScimTokenHash(v *string) *AppCreate {
+	if v != nil {
+		_c.SetScimTokenHash(*v)
+	}
+	return _c
+}
+
+// SetRequireVerifiedEmail sets the "require_verified_email" field.
+func (_c *AppCreate) SetRequireVerifiedEmail(v bool) *AppCreate {
+	_c.mutation.SetRequireVerifiedEmail(v)
+	return _c
+}
+
+// SetNillableRequireVerifiedEmail sets the "require_verified_email" field if the given value is not nil.
+func (_c *AppCreate) SetNillableRequireVerifiedEmail(v *bool) *AppCreate {
+	if v != nil {
+		_c.SetRequireVerifiedEmail(*v)
+	}
+	return _c
+}
+
+// SetRequireMfa sets the "require_mfa" field.
+func (_c *AppCreate) SetRequireMfa(v bool) *AppCreate {
+	_c.mutation.SetRequireMfa(v)
+	return _c
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (_c *AppCreate) SetNillableRequireMfa(v *bool) *AppCreate {
+	if v != nil {
+		_c.SetRequireMfa(*v)
+	}
+	return _c
+}
+
+// SetMagicLinkEnabled sets the "magic_link_enabled" field.
+func (_c *AppCreate) SetMagicLinkEnabled(v bool) *AppCreate {
+	_c.mutation.SetMagicLinkEnabled(v)
+	return _c
+}
+
+// SetNillableMagicLinkEnabled sets the "magic_link_enabled" field if the given value is not nil.
+func (_c *AppCreate) SetNillableMagicLinkEnabled(v *bool) *AppCreate {
+	if v != nil {
+		_c.SetMagicLinkEnabled(*v)
+	}
+	return _c
+}
+
+// SetWebauthnRpID sets the "webauthn_rp_id" field.
+func (_c *AppCreate) SetWebauthnRpID(v string) *AppCreate {
+	_c.mutation.SetWebauthnRpID(v)
+	return _c
+}
+
+// SetNillableWebauthnRpID sets the "webauthn_rp_id" field if the given value is not nil.
+func (_c *AppCreate) SetNillableWebauthnRpID(v *string) *AppCreate {
+	if v != nil {
+		_c.SetWebauthnRpID(*v)
+	}
+	return _c
+}
+
+// SetWebauthnOrigins sets the "webauthn_origins" field.
+func (_c *AppCreate) SetWebauthnOrigins(v []string) *AppCreate {
+	_c.mutation.SetWebauthnOrigins(v)
+	return _c
+}
+
+// SetMaxLoginAttempts sets the "max_login_attempts" field.
+func (_c *AppCreate) SetMaxLoginAttempts(v int) *AppCreate {
+	_c.mutation.SetMaxLoginAttempts(v)
+	return _c
+}
+
+// SetNillableMaxLoginAttempts sets the "max_login_attempts" field if the given value is not nil.
+func (_c *AppCreate) SetNillableMaxLoginAttempts(v *int) *AppCreate {
+	if v != nil {
+		_c.SetMaxLoginAttempts(*v)
+	}
+	return _c
+}
+
+// SetLockoutDuration sets the "lockout_duration" field.
+func (_c *AppCreate) SetLockoutDuration(v int) *AppCreate {
+	_c.mutation.SetLockoutDuration(v)
+	return _c
+}
+
+// SetNillableLockoutDuration sets the "lockout_duration" field if the given value is not nil.
+func (_c *AppCreate) SetNillableLockoutDuration(v *int) *AppCreate {
+	if v != nil {
+		_c.SetLockoutDuration(*v)
+	}
+	return _c
+}
+
+// SetLoginDelay sets the "login_delay" field.
+func (_c *AppCreate) SetLoginDelay(v int) *AppCreate {
+	_c.mutation.SetLoginDelay(v)
+	return _c
+}
+
+// SetNillableLoginDelay sets the "login_delay" field if the given value is not nil.
+func (_c *AppCreate) SetNillableLoginDelay(v *int) *AppCreate {
+	if v != nil {
+		_c.SetLoginDelay(*v)
+	}
+	return _c
+}
+
+// SetMaxIPLoginAttempts sets the "max_ip_login_attempts" field.
+func (_c *AppCreate) SetMaxIPLoginAttempts(v int) *AppCreate {
+	_c.mutation.SetMaxIPLoginAttempts(v)
+	return _c
+}
+
+// SetNillableMaxIPLoginAttempts sets the "max_ip_login_attempts" field if the given value is not nil.
+func (_c *AppCreate) SetNillableMaxIPLoginAttempts(v *int) *AppCreate {
+	if v != nil {
+		_c.SetMaxIPLoginAttempts(*v)
+	}
+	return _c
+}
+
+// SetPasswordPolicy sets the "password_policy" field.
+func (_c *AppCreate) SetPasswordPolicy(v *password.Policy) *AppCreate {
+	_c.mutation.SetPasswordPolicy(v)
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *AppCreate) SetStatus(v int8) *AppCreate {
 	_c.mutation.SetStatus(v)
@@ -84,6 +269,96 @@ func (_c *AppCreate) AddUsers(v ...*User) *AppCreate {
 	return _c.AddUserIDs(ids...)
 }
 
+// AddAuthorizationCodeIDs adds the "authorization_codes" edge to the AuthorizationCode entity by IDs.
+func (_c *AppCreate) AddAuthorizationCodeIDs(ids ...int) *AppCreate {
+	_c.mutation.AddAuthorizationCodeIDs(ids...)
+	return _c
+}
+
+// AddAuthorizationCodes adds the "authorization_codes" edges to the AuthorizationCode entity.
+func (_c *AppCreate) AddAuthorizationCodes(v ...*AuthorizationCode) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuthorizationCodeIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_c *AppCreate) AddRoleIDs(ids ...int) *AppCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_c *AppCreate) AddRoles(v ...*Role) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
+// AddEmailTemplateIDs adds the "email_templates" edge to the EmailTemplate entity by IDs.
+func (_c *AppCreate) AddEmailTemplateIDs(ids ...int) *AppCreate {
+	_c.mutation.AddEmailTemplateIDs(ids...)
+	return _c
+}
+
+// AddEmailTemplates adds the "email_templates" edges to the EmailTemplate entity.
+func (_c *AppCreate) AddEmailTemplates(v ...*EmailTemplate) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEmailTemplateIDs(ids...)
+}
+
+// AddWebauthnChallengeIDs adds the "webauthn_challenges" edge to the WebAuthnChallenge entity by IDs.
+func (_c *AppCreate) AddWebauthnChallengeIDs(ids ...int) *AppCreate {
+	_c.mutation.AddWebauthnChallengeIDs(ids...)
+	return _c
+}
+
+// AddWebauthnChallenges adds the "webauthn_challenges" edges to the WebAuthnChallenge entity.
+func (_c *AppCreate) AddWebauthnChallenges(v ...*WebAuthnChallenge) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebauthnChallengeIDs(ids...)
+}
+
+// AddLockoutIDs adds the "lockouts" edge to the Lockout entity by IDs.
+func (_c *AppCreate) AddLockoutIDs(ids ...int) *AppCreate {
+	_c.mutation.AddLockoutIDs(ids...)
+	return _c
+}
+
+// AddLockouts adds the "lockouts" edges to the Lockout entity.
+func (_c *AppCreate) AddLockouts(v ...*Lockout) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLockoutIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_c *AppCreate) AddWebhookIDs(ids ...int) *AppCreate {
+	_c.mutation.AddWebhookIDs(ids...)
+	return _c
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_c *AppCreate) AddWebhooks(v ...*Webhook) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookIDs(ids...)
+}
+
 // Mutation returns the AppMutation object of the builder.
 func (_c *AppCreate) Mutation() *AppMutation {
 	return _c.mutation
@@ -91,7 +366,9 @@ func (_c *AppCreate) Mutation() *AppMutation {
 
 // Save creates the App in the database.
 func (_c *AppCreate) Save(ctx context.Context) (*App, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -118,19 +395,61 @@ func (_c *AppCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *AppCreate) defaults() {
+func (_c *AppCreate) defaults() error {
+	if _, ok := _c.mutation.ClientID(); !ok {
+		if app.DefaultClientID == nil {
+			return fmt.Errorf("ent: uninitialized app.DefaultClientID (forgotten import ent/runtime?)")
+		}
+		v := app.DefaultClientID()
+		_c.mutation.SetClientID(v)
+	}
+	if _, ok := _c.mutation.RequireVerifiedEmail(); !ok {
+		v := app.DefaultRequireVerifiedEmail
+		_c.mutation.SetRequireVerifiedEmail(v)
+	}
+	if _, ok := _c.mutation.RequireMfa(); !ok {
+		v := app.DefaultRequireMfa
+		_c.mutation.SetRequireMfa(v)
+	}
+	if _, ok := _c.mutation.MagicLinkEnabled(); !ok {
+		v := app.DefaultMagicLinkEnabled
+		_c.mutation.SetMagicLinkEnabled(v)
+	}
+	if _, ok := _c.mutation.MaxLoginAttempts(); !ok {
+		v := app.DefaultMaxLoginAttempts
+		_c.mutation.SetMaxLoginAttempts(v)
+	}
+	if _, ok := _c.mutation.LockoutDuration(); !ok {
+		v := app.DefaultLockoutDuration
+		_c.mutation.SetLockoutDuration(v)
+	}
+	if _, ok := _c.mutation.LoginDelay(); !ok {
+		v := app.DefaultLoginDelay
+		_c.mutation.SetLoginDelay(v)
+	}
+	if _, ok := _c.mutation.MaxIPLoginAttempts(); !ok {
+		v := app.DefaultMaxIPLoginAttempts
+		_c.mutation.SetMaxIPLoginAttempts(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := app.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if app.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized app.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := app.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if app.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized app.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := app.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -138,6 +457,50 @@ func (_c *AppCreate) check() error {
 	if _, ok := _c.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "App.name"`)}
 	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "App.client_id"`)}
+	}
+	if _, ok := _c.mutation.RequireVerifiedEmail(); !ok {
+		return &ValidationError{Name: "require_verified_email", err: errors.New(`ent: missing required field "App.require_verified_email"`)}
+	}
+	if _, ok := _c.mutation.RequireMfa(); !ok {
+		return &ValidationError{Name: "require_mfa", err: errors.New(`ent: missing required field "App.require_mfa"`)}
+	}
+	if _, ok := _c.mutation.MagicLinkEnabled(); !ok {
+		return &ValidationError{Name: "magic_link_enabled", err: errors.New(`ent: missing required field "App.magic_link_enabled"`)}
+	}
+	if _, ok := _c.mutation.MaxLoginAttempts(); !ok {
+		return &ValidationError{Name: "max_login_attempts", err: errors.New(`ent: missing required field "App.max_login_attempts"`)}
+	}
+	if v, ok := _c.mutation.MaxLoginAttempts(); ok {
+		if err := app.MaxLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_login_attempts", err: fmt.Errorf(`ent: validator failed for field "App.max_login_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LockoutDuration(); !ok {
+		return &ValidationError{Name: "lockout_duration", err: errors.New(`ent: missing required field "App.lockout_duration"`)}
+	}
+	if v, ok := _c.mutation.LockoutDuration(); ok {
+		if err := app.LockoutDurationValidator(v); err != nil {
+			return &ValidationError{Name: "lockout_duration", err: fmt.Errorf(`ent: validator failed for field "App.lockout_duration": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LoginDelay(); !ok {
+		return &ValidationError{Name: "login_delay", err: errors.New(`ent: missing required field "App.login_delay"`)}
+	}
+	if v, ok := _c.mutation.LoginDelay(); ok {
+		if err := app.LoginDelayValidator(v); err != nil {
+			return &ValidationError{Name: "login_delay", err: fmt.Errorf(`ent: validator failed for field "App.login_delay": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxIPLoginAttempts(); !ok {
+		return &ValidationError{Name: "max_ip_login_attempts", err: errors.New(`ent: missing required field "App.max_ip_login_attempts"`)}
+	}
+	if v, ok := _c.mutation.MaxIPLoginAttempts(); ok {
+		if err := app.MaxIPLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_ip_login_attempts", err: fmt.Errorf(`ent: validator failed for field "App.max_ip_login_attempts": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "App.status"`)}
 	}
@@ -177,6 +540,66 @@ func (_c *AppCreate) createSpec() (*App, *sqlgraph.CreateSpec) {
 		_spec.SetField(app.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(app.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.RedirectUris(); ok {
+		_spec.SetField(app.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := _c.mutation.ClientSecretHash(); ok {
+		_spec.SetField(app.FieldClientSecretHash, field.TypeString, value)
+		_node.ClientSecretHash = &value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(app.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.ScimTokenHash(); ok {
+		_spec.SetField(app.FieldScimTokenHash, field.TypeString, value)
+		_node.ScimTokenHash = &value
+	}
+	if value, ok := _c.mutation.RequireVerifiedEmail(); ok {
+		_spec.SetField(app.FieldRequireVerifiedEmail, field.TypeBool, value)
+		_node.RequireVerifiedEmail = value
+	}
+	if value, ok := _c.mutation.RequireMfa(); ok {
+		_spec.SetField(app.FieldRequireMfa, field.TypeBool, value)
+		_node.RequireMfa = value
+	}
+	if value, ok := _c.mutation.MagicLinkEnabled(); ok {
+		_spec.SetField(app.FieldMagicLinkEnabled, field.TypeBool, value)
+		_node.MagicLinkEnabled = value
+	}
+	if value, ok := _c.mutation.WebauthnRpID(); ok {
+		_spec.SetField(app.FieldWebauthnRpID, field.TypeString, value)
+		_node.WebauthnRpID = value
+	}
+	if value, ok := _c.mutation.WebauthnOrigins(); ok {
+		_spec.SetField(app.FieldWebauthnOrigins, field.TypeJSON, value)
+		_node.WebauthnOrigins = value
+	}
+	if value, ok := _c.mutation.MaxLoginAttempts(); ok {
+		_spec.SetField(app.FieldMaxLoginAttempts, field.TypeInt, value)
+		_node.MaxLoginAttempts = value
+	}
+	if value, ok := _c.mutation.LockoutDuration(); ok {
+		_spec.SetField(app.FieldLockoutDuration, field.TypeInt, value)
+		_node.LockoutDuration = value
+	}
+	if value, ok := _c.mutation.LoginDelay(); ok {
+		_spec.SetField(app.FieldLoginDelay, field.TypeInt, value)
+		_node.LoginDelay = value
+	}
+	if value, ok := _c.mutation.MaxIPLoginAttempts(); ok {
+		_spec.SetField(app.FieldMaxIPLoginAttempts, field.TypeInt, value)
+		_node.MaxIPLoginAttempts = value
+	}
+	if value, ok := _c.mutation.PasswordPolicy(); ok {
+		_spec.SetField(app.FieldPasswordPolicy, field.TypeJSON, value)
+		_node.PasswordPolicy = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(app.FieldStatus, field.TypeInt8, value)
 		_node.Status = value
@@ -205,6 +628,102 @@ func (_c *AppCreate) createSpec() (*App, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EmailTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebauthnChallengesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LockoutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -7,12 +7,20 @@ import (
 	"errors"
 	"fmt"
 	"keeper/ent/app"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/emailtemplate"
+	"keeper/ent/lockout"
 	"keeper/ent/predicate"
+	"keeper/ent/role"
 	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"keeper/ent/webhook"
+	"keeper/pkg/password"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -43,15 +51,1350 @@ func (_u *AppUpdate) SetNillableName(v *string) *AppUpdate {
 	return _u
 }
 
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *AppUpdate) SetRedirectUris(v []string) *AppUpdate {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *AppUpdate) AppendRedirectUris(v []string) *AppUpdate {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *AppUpdate) ClearRedirectUris() *AppUpdate {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *AppUpdate) SetClientSecretHash(v string) *AppUpdate {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableClientSecretHash(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *AppUpdate) ClearClientSecretHash() *AppUpdate {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *AppUpdate) SetScopes(v []string) *AppUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *AppUpdate) AppendScopes(v []string) *AppUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *AppUpdate) ClearScopes() *AppUpdate {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_u *AppUpdate) SetScimTokenHash(v string) *AppUpdate {
+	_u.mutation.SetScimTokenHash(v)
+	return _u
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableScimTokenHash(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetScimTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearScimTokenHash clears the value of the "scim_token_hash" field.
+func (_u *AppUpdate) ClearScimTokenHash() *AppUpdate {
+	_u.mutation.ClearScimTokenHash()
+	return _u
+}
+
+// SetRequireVerifiedEmail sets the "require_verified_email" field.
+func (_u *AppUpdate) SetRequireVerifiedEmail(v bool) *AppUpdate {
+	_u.mutation.SetRequireVerifiedEmail(v)
+	return _u
+}
+
+// SetNillableRequireVerifiedEmail sets the "require_verified_email" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableRequireVerifiedEmail(v *bool) *AppUpdate {
+	if v != nil {
+		_u.SetRequireVerifiedEmail(*v)
+	}
+	return _u
+}
+
+// SetRequireMfa sets the "require_mfa" field.
+func (_u *AppUpdate) SetRequireMfa(v bool) *AppUpdate {
+	_u.mutation.SetRequireMfa(v)
+	return _u
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableRequireMfa(v *bool) *AppUpdate {
+	if v != nil {
+		_u.SetRequireMfa(*v)
+	}
+	return _u
+}
+
+// SetMagicLinkEnabled sets the "magic_link_enabled" field.
+func (_u *AppUpdate) SetMagicLinkEnabled(v bool) *AppUpdate {
+	_u.mutation.SetMagicLinkEnabled(v)
+	return _u
+}
+
+// SetNillableMagicLinkEnabled sets the "magic_link_enabled" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableMagicLinkEnabled(v *bool) *AppUpdate {
+	if v != nil {
+		_u.SetMagicLinkEnabled(*v)
+	}
+	return _u
+}
+
+// SetWebauthnRpID sets the "webauthn_rp_id" field.
+func (_u *AppUpdate) SetWebauthnRpID(v string) *AppUpdate {
+	_u.mutation.SetWebauthnRpID(v)
+	return _u
+}
+
+// SetNillableWebauthnRpID sets the "webauthn_rp_id" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableWebauthnRpID(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetWebauthnRpID(*v)
+	}
+	return _u
+}
+
+// ClearWebauthnRpID clears the value of the "webauthn_rp_id" field.
+func (_u *AppUpdate) ClearWebauthnRpID() *AppUpdate {
+	_u.mutation.ClearWebauthnRpID()
+	return _u
+}
+
+// SetWebauthnOrigins sets the "webauthn_origins" field.
+func (_u *AppUpdate) SetWebauthnOrigins(v []string) *AppUpdate {
+	_u.mutation.SetWebauthnOrigins(v)
+	return _u
+}
+
+// AppendWebauthnOrigins appends value to the "webauthn_origins" field.
+func (_u *AppUpdate) AppendWebauthnOrigins(v []string) *AppUpdate {
+	_u.mutation.AppendWebauthnOrigins(v)
+	return _u
+}
+
+// ClearWebauthnOrigins clears the value of the "webauthn_origins" field.
+func (_u *AppUpdate) ClearWebauthnOrigins() *AppUpdate {
+	_u.mutation.ClearWebauthnOrigins()
+	return _u
+}
+
+// SetMaxLoginAttempts sets the "max_login_attempts" field.
+func (_u *AppUpdate) SetMaxLoginAttempts(v int) *AppUpdate {
+	_u.mutation.ResetMaxLoginAttempts()
+	_u.mutation.SetMaxLoginAttempts(v)
+	return _u
+}
+
+// SetNillableMaxLoginAttempts sets the "max_login_attempts" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableMaxLoginAttempts(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetMaxLoginAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxLoginAttempts adds value to the "max_login_attempts" field.
+func (_u *AppUpdate) AddMaxLoginAttempts(v int) *AppUpdate {
+	_u.mutation.AddMaxLoginAttempts(v)
+	return _u
+}
+
+// SetLockoutDuration sets the "lockout_duration" field.
+func (_u *AppUpdate) SetLockoutDuration(v int) *AppUpdate {
+	_u.mutation.ResetLockoutDuration()
+	_u.mutation.SetLockoutDuration(v)
+	return _u
+}
+
+// SetNillableLockoutDuration sets the "lockout_duration" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableLockoutDuration(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetLockoutDuration(*v)
+	}
+	return _u
+}
+
+// AddLockoutDuration adds value to the "lockout_duration" field.
+func (_u *AppUpdate) AddLockoutDuration(v int) *AppUpdate {
+	_u.mutation.AddLockoutDuration(v)
+	return _u
+}
+
+// SetLoginDelay sets the "login_delay" field.
+func (_u *AppUpdate) SetLoginDelay(v int) *AppUpdate {
+	_u.mutation.ResetLoginDelay()
+	_u.mutation.SetLoginDelay(v)
+	return _u
+}
+
+// SetNillableLoginDelay sets the "login_delay" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableLoginDelay(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetLoginDelay(*v)
+	}
+	return _u
+}
+
+// AddLoginDelay adds value to the "login_delay" field.
+func (_u *AppUpdate) AddLoginDelay(v int) *AppUpdate {
+	_u.mutation.AddLoginDelay(v)
+	return _u
+}
+
+// SetMaxIPLoginAttempts sets the "max_ip_login_attempts" field.
+func (_u *AppUpdate) SetMaxIPLoginAttempts(v int) *AppUpdate {
+	_u.mutation.ResetMaxIPLoginAttempts()
+	_u.mutation.SetMaxIPLoginAttempts(v)
+	return _u
+}
+
+// SetNillableMaxIPLoginAttempts sets the "max_ip_login_attempts" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableMaxIPLoginAttempts(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetMaxIPLoginAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxIPLoginAttempts adds value to the "max_ip_login_attempts" field.
+func (_u *AppUpdate) AddMaxIPLoginAttempts(v int) *AppUpdate {
+	_u.mutation.AddMaxIPLoginAttempts(v)
+	return _u
+}
+
+// SetPasswordPolicy sets the "password_policy" field.
+func (_u *AppUpdate) SetPasswordPolicy(v *password.Policy) *AppUpdate {
+	_u.mutation.SetPasswordPolicy(v)
+	return _u
+}
+
+// ClearPasswordPolicy clears the value of the "password_policy" field.
+func (_u *AppUpdate) ClearPasswordPolicy() *AppUpdate {
+	_u.mutation.ClearPasswordPolicy()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AppUpdate) SetStatus(v int8) *AppUpdate {
+	_u.mutation.ResetStatus()
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableStatus(v *int8) *AppUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// AddStatus adds value to the "status" field.
+func (_u *AppUpdate) AddStatus(v int8) *AppUpdate {
+	_u.mutation.AddStatus(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AppUpdate) SetCreatedAt(v time.Time) *AppUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableCreatedAt(v *time.Time) *AppUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AppUpdate) SetUpdatedAt(v time.Time) *AppUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddUserIDs adds the "users" edge to the User entity by IDs.
+func (_u *AppUpdate) AddUserIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddUserIDs(ids...)
+	return _u
+}
+
+// AddUsers adds the "users" edges to the User entity.
+func (_u *AppUpdate) AddUsers(v ...*User) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUserIDs(ids...)
+}
+
+// AddAuthorizationCodeIDs adds the "authorization_codes" edge to the AuthorizationCode entity by IDs.
+func (_u *AppUpdate) AddAuthorizationCodeIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// AddAuthorizationCodes adds the "authorization_codes" edges to the AuthorizationCode entity.
+func (_u *AppUpdate) AddAuthorizationCodes(v ...*AuthorizationCode) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthorizationCodeIDs(ids...)
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *AppUpdate) AddRoleIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddRoleIDs(ids...)
+	return _u
+}
+
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *AppUpdate) AddRoles(v ...*Role) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRoleIDs(ids...)
+}
+
+// AddEmailTemplateIDs adds the "email_templates" edge to the EmailTemplate entity by IDs.
+func (_u *AppUpdate) AddEmailTemplateIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddEmailTemplateIDs(ids...)
+	return _u
+}
+
+// AddEmailTemplates adds the "email_templates" edges to the EmailTemplate entity.
+func (_u *AppUpdate) AddEmailTemplates(v ...*EmailTemplate) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmailTemplateIDs(ids...)
+}
+
+// AddWebauthnChallengeIDs adds the "webauthn_challenges" edge to the WebAuthnChallenge entity by IDs.
+func (_u *AppUpdate) AddWebauthnChallengeIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddWebauthnChallengeIDs(ids...)
+	return _u
+}
+
+// AddWebauthnChallenges adds the "webauthn_challenges" edges to the WebAuthnChallenge entity.
+func (_u *AppUpdate) AddWebauthnChallenges(v ...*WebAuthnChallenge) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebauthnChallengeIDs(ids...)
+}
+
+// AddLockoutIDs adds the "lockouts" edge to the Lockout entity by IDs.
+func (_u *AppUpdate) AddLockoutIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddLockoutIDs(ids...)
+	return _u
+}
+
+// AddLockouts adds the "lockouts" edges to the Lockout entity.
+func (_u *AppUpdate) AddLockouts(v ...*Lockout) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLockoutIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *AppUpdate) AddWebhookIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *AppUpdate) AddWebhooks(v ...*Webhook) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookIDs(ids...)
+}
+
+// Mutation returns the AppMutation object of the builder.
+func (_u *AppUpdate) Mutation() *AppMutation {
+	return _u.mutation
+}
+
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *AppUpdate) ClearUsers() *AppUpdate {
+	_u.mutation.ClearUsers()
+	return _u
+}
+
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *AppUpdate) RemoveUserIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *AppUpdate) RemoveUsers(v ...*User) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearAuthorizationCodes clears all "authorization_codes" edges to the AuthorizationCode entity.
+func (_u *AppUpdate) ClearAuthorizationCodes() *AppUpdate {
+	_u.mutation.ClearAuthorizationCodes()
+	return _u
+}
+
+// RemoveAuthorizationCodeIDs removes the "authorization_codes" edge to AuthorizationCode entities by IDs.
+func (_u *AppUpdate) RemoveAuthorizationCodeIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// RemoveAuthorizationCodes removes "authorization_codes" edges to AuthorizationCode entities.
+func (_u *AppUpdate) RemoveAuthorizationCodes(v ...*AuthorizationCode) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthorizationCodeIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *AppUpdate) ClearRoles() *AppUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *AppUpdate) RemoveRoleIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveRoleIDs(ids...)
+	return _u
+}
+
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *AppUpdate) RemoveRoles(v ...*Role) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearEmailTemplates clears all "email_templates" edges to the EmailTemplate entity.
+func (_u *AppUpdate) ClearEmailTemplates() *AppUpdate {
+	_u.mutation.ClearEmailTemplates()
+	return _u
+}
+
+// RemoveEmailTemplateIDs removes the "email_templates" edge to EmailTemplate entities by IDs.
+func (_u *AppUpdate) RemoveEmailTemplateIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveEmailTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveEmailTemplates removes "email_templates" edges to EmailTemplate entities.
+func (_u *AppUpdate) RemoveEmailTemplates(v ...*EmailTemplate) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmailTemplateIDs(ids...)
+}
+
+// ClearWebauthnChallenges clears all "webauthn_challenges" edges to the WebAuthnChallenge entity.
+func (_u *AppUpdate) ClearWebauthnChallenges() *AppUpdate {
+	_u.mutation.ClearWebauthnChallenges()
+	return _u
+}
+
+// RemoveWebauthnChallengeIDs removes the "webauthn_challenges" edge to WebAuthnChallenge entities by IDs.
+func (_u *AppUpdate) RemoveWebauthnChallengeIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveWebauthnChallengeIDs(ids...)
+	return _u
+}
+
+// RemoveWebauthnChallenges removes "webauthn_challenges" edges to WebAuthnChallenge entities.
+func (_u *AppUpdate) RemoveWebauthnChallenges(v ...*WebAuthnChallenge) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebauthnChallengeIDs(ids...)
+}
+
+// ClearLockouts clears all "lockouts" edges to the Lockout entity.
+func (_u *AppUpdate) ClearLockouts() *AppUpdate {
+	_u.mutation.ClearLockouts()
+	return _u
+}
+
+// RemoveLockoutIDs removes the "lockouts" edge to Lockout entities by IDs.
+func (_u *AppUpdate) RemoveLockoutIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveLockoutIDs(ids...)
+	return _u
+}
+
+// RemoveLockouts removes "lockouts" edges to Lockout entities.
+func (_u *AppUpdate) RemoveLockouts(v ...*Lockout) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLockoutIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *AppUpdate) ClearWebhooks() *AppUpdate {
+	_u.mutation.ClearWebhooks()
+	return _u
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *AppUpdate) RemoveWebhookIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveWebhookIDs(ids...)
+	return _u
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *AppUpdate) RemoveWebhooks(v ...*Webhook) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AppUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AppUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AppUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if app.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized app.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := app.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppUpdate) check() error {
+	if v, ok := _u.mutation.MaxLoginAttempts(); ok {
+		if err := app.MaxLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_login_attempts", err: fmt.Errorf(`ent: validator failed for field "App.max_login_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LockoutDuration(); ok {
+		if err := app.LockoutDurationValidator(v); err != nil {
+			return &ValidationError{Name: "lockout_duration", err: fmt.Errorf(`ent: validator failed for field "App.lockout_duration": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LoginDelay(); ok {
+		if err := app.LoginDelayValidator(v); err != nil {
+			return &ValidationError{Name: "login_delay", err: fmt.Errorf(`ent: validator failed for field "App.login_delay": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxIPLoginAttempts(); ok {
+		if err := app.MaxIPLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_ip_login_attempts", err: fmt.Errorf(`ent: validator failed for field "App.max_ip_login_attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(app.Table, app.Columns, sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(app.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(app.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(app.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(app.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(app.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(app.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(app.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScimTokenHash(); ok {
+		_spec.SetField(app.FieldScimTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ScimTokenHashCleared() {
+		_spec.ClearField(app.FieldScimTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequireVerifiedEmail(); ok {
+		_spec.SetField(app.FieldRequireVerifiedEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequireMfa(); ok {
+		_spec.SetField(app.FieldRequireMfa, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MagicLinkEnabled(); ok {
+		_spec.SetField(app.FieldMagicLinkEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebauthnRpID(); ok {
+		_spec.SetField(app.FieldWebauthnRpID, field.TypeString, value)
+	}
+	if _u.mutation.WebauthnRpIDCleared() {
+		_spec.ClearField(app.FieldWebauthnRpID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WebauthnOrigins(); ok {
+		_spec.SetField(app.FieldWebauthnOrigins, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWebauthnOrigins(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldWebauthnOrigins, value)
+		})
+	}
+	if _u.mutation.WebauthnOriginsCleared() {
+		_spec.ClearField(app.FieldWebauthnOrigins, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxLoginAttempts(); ok {
+		_spec.SetField(app.FieldMaxLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLoginAttempts(); ok {
+		_spec.AddField(app.FieldMaxLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockoutDuration(); ok {
+		_spec.SetField(app.FieldLockoutDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLockoutDuration(); ok {
+		_spec.AddField(app.FieldLockoutDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LoginDelay(); ok {
+		_spec.SetField(app.FieldLoginDelay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLoginDelay(); ok {
+		_spec.AddField(app.FieldLoginDelay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxIPLoginAttempts(); ok {
+		_spec.SetField(app.FieldMaxIPLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxIPLoginAttempts(); ok {
+		_spec.AddField(app.FieldMaxIPLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PasswordPolicy(); ok {
+		_spec.SetField(app.FieldPasswordPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.PasswordPolicyCleared() {
+		_spec.ClearField(app.FieldPasswordPolicy, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(app.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.AddedStatus(); ok {
+		_spec.AddField(app.FieldStatus, field.TypeInt8, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(app.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(app.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.UsersTable,
+			Columns: []string{app.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.UsersTable,
+			Columns: []string{app.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.UsersTable,
+			Columns: []string{app.UsersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthorizationCodesIDs(); len(nodes) > 0 && !_u.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmailTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmailTemplatesIDs(); len(nodes) > 0 && !_u.mutation.EmailTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmailTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebauthnChallengesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebauthnChallengesIDs(); len(nodes) > 0 && !_u.mutation.WebauthnChallengesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebauthnChallengesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LockoutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLockoutsIDs(); len(nodes) > 0 && !_u.mutation.LockoutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LockoutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{app.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AppUpdateOne is the builder for updating a single App entity.
+type AppUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AppMutation
+}
+
+// SetName sets the "name" field.
+func (_u *AppUpdateOne) SetName(v string) *AppUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableName(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (_u *AppUpdateOne) SetRedirectUris(v []string) *AppUpdateOne {
+	_u.mutation.SetRedirectUris(v)
+	return _u
+}
+
+// AppendRedirectUris appends value to the "redirect_uris" field.
+func (_u *AppUpdateOne) AppendRedirectUris(v []string) *AppUpdateOne {
+	_u.mutation.AppendRedirectUris(v)
+	return _u
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (_u *AppUpdateOne) ClearRedirectUris() *AppUpdateOne {
+	_u.mutation.ClearRedirectUris()
+	return _u
+}
+
+// SetClientSecretHash sets the "client_secret_hash" field.
+func (_u *AppUpdateOne) SetClientSecretHash(v string) *AppUpdateOne {
+	_u.mutation.SetClientSecretHash(v)
+	return _u
+}
+
+// SetNillableClientSecretHash sets the "client_secret_hash" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableClientSecretHash(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetClientSecretHash(*v)
+	}
+	return _u
+}
+
+// ClearClientSecretHash clears the value of the "client_secret_hash" field.
+func (_u *AppUpdateOne) ClearClientSecretHash() *AppUpdateOne {
+	_u.mutation.ClearClientSecretHash()
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *AppUpdateOne) SetScopes(v []string) *AppUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *AppUpdateOne) AppendScopes(v []string) *AppUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (_u *AppUpdateOne) ClearScopes() *AppUpdateOne {
+	_u.mutation.ClearScopes()
+	return _u
+}
+
+// SetScimTokenHash sets the "scim_token_hash" field.
+func (_u *AppUpdateOne) SetScimTokenHash(v string) *AppUpdateOne {
+	_u.mutation.SetScimTokenHash(v)
+	return _u
+}
+
+// SetNillableScimTokenHash sets the "scim_token_hash" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableScimTokenHash(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetScimTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearScimTokenHash clears the value of the "scim_token_hash" field.
+func (_u *AppUpdateOne) ClearScimTokenHash() *AppUpdateOne {
+	_u.mutation.ClearScimTokenHash()
+	return _u
+}
+
+// SetRequireVerifiedEmail sets the "require_verified_email" field.
+func (_u *AppUpdateOne) SetRequireVerifiedEmail(v bool) *AppUpdateOne {
+	_u.mutation.SetRequireVerifiedEmail(v)
+	return _u
+}
+
+// SetNillableRequireVerifiedEmail sets the "require_verified_email" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableRequireVerifiedEmail(v *bool) *AppUpdateOne {
+	if v != nil {
+		_u.SetRequireVerifiedEmail(*v)
+	}
+	return _u
+}
+
+// SetRequireMfa sets the "require_mfa" field.
+func (_u *AppUpdateOne) SetRequireMfa(v bool) *AppUpdateOne {
+	_u.mutation.SetRequireMfa(v)
+	return _u
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableRequireMfa(v *bool) *AppUpdateOne {
+	if v != nil {
+		_u.SetRequireMfa(*v)
+	}
+	return _u
+}
+
+// SetMagicLinkEnabled sets the "magic_link_enabled" field.
+func (_u *AppUpdateOne) SetMagicLinkEnabled(v bool) *AppUpdateOne {
+	_u.mutation.SetMagicLinkEnabled(v)
+	return _u
+}
+
+// SetNillableMagicLinkEnabled sets the "magic_link_enabled" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableMagicLinkEnabled(v *bool) *AppUpdateOne {
+	if v != nil {
+		_u.SetMagicLinkEnabled(*v)
+	}
+	return _u
+}
+
+// SetWebauthnRpID sets the "webauthn_rp_id" field.
+func (_u *AppUpdateOne) SetWebauthnRpID(v string) *AppUpdateOne {
+	_u.mutation.SetWebauthnRpID(v)
+	return _u
+}
+
+// SetNillableWebauthnRpID sets the "webauthn_rp_id" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableWebauthnRpID(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetWebauthnRpID(*v)
+	}
+	return _u
+}
+
+// ClearWebauthnRpID clears the value of the "webauthn_rp_id" field.
+func (_u *AppUpdateOne) ClearWebauthnRpID() *AppUpdateOne {
+	_u.mutation.ClearWebauthnRpID()
+	return _u
+}
+
+// SetWebauthnOrigins sets the "webauthn_origins" field.
+func (_u *AppUpdateOne) SetWebauthnOrigins(v []string) *AppUpdateOne {
+	_u.mutation.SetWebauthnOrigins(v)
+	return _u
+}
+
+// AppendWebauthnOrigins appends value to the "webauthn_origins" field.
+func (_u *AppUpdateOne) AppendWebauthnOrigins(v []string) *AppUpdateOne {
+	_u.mutation.AppendWebauthnOrigins(v)
+	return _u
+}
+
+// ClearWebauthnOrigins clears the value of the "webauthn_origins" field.
+func (_u *AppUpdateOne) ClearWebauthnOrigins() *AppUpdateOne {
+	_u.mutation.ClearWebauthnOrigins()
+	return _u
+}
+
+// SetMaxLoginAttempts sets the "max_login_attempts" field.
+func (_u *AppUpdateOne) SetMaxLoginAttempts(v int) *AppUpdateOne {
+	_u.mutation.ResetMaxLoginAttempts()
+	_u.mutation.SetMaxLoginAttempts(v)
+	return _u
+}
+
+// SetNillableMaxLoginAttempts sets the "max_login_attempts" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableMaxLoginAttempts(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetMaxLoginAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxLoginAttempts adds value to the "max_login_attempts" field.
+func (_u *AppUpdateOne) AddMaxLoginAttempts(v int) *AppUpdateOne {
+	_u.mutation.AddMaxLoginAttempts(v)
+	return _u
+}
+
+// SetLockoutDuration sets the "lockout_duration" field.
+func (_u *AppUpdateOne) SetLockoutDuration(v int) *AppUpdateOne {
+	_u.mutation.ResetLockoutDuration()
+	_u.mutation.SetLockoutDuration(v)
+	return _u
+}
+
+// SetNillableLockoutDuration sets the "lockout_duration" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableLockoutDuration(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetLockoutDuration(*v)
+	}
+	return _u
+}
+
+// AddLockoutDuration adds value to the "lockout_duration" field.
+func (_u *AppUpdateOne) AddLockoutDuration(v int) *AppUpdateOne {
+	_u.mutation.AddLockoutDuration(v)
+	return _u
+}
+
+// SetLoginDelay sets the "login_delay" field.
+func (_u *AppUpdateOne) SetLoginDelay(v int) *AppUpdateOne {
+	_u.mutation.ResetLoginDelay()
+	_u.mutation.SetLoginDelay(v)
+	return _u
+}
+
+// SetNillableLoginDelay sets the "login_delay" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableLoginDelay(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetLoginDelay(*v)
+	}
+	return _u
+}
+
+// AddLoginDelay adds value to the "login_delay" field.
+func (_u *AppUpdateOne) AddLoginDelay(v int) *AppUpdateOne {
+	_u.mutation.AddLoginDelay(v)
+	return _u
+}
+
+// SetMaxIPLoginAttempts sets the "max_ip_login_attempts" field.
+func (_u *AppUpdateOne) SetMaxIPLoginAttempts(v int) *AppUpdateOne {
+	_u.mutation.ResetMaxIPLoginAttempts()
+	_u.mutation.SetMaxIPLoginAttempts(v)
+	return _u
+}
+
+// SetNillableMaxIPLoginAttempts sets the "max_ip_login_attempts" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableMaxIPLoginAttempts(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetMaxIPLoginAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxIPLoginAttempts adds value to the "max_ip_login_attempts" field.
+func (_u *AppUpdateOne) AddMaxIPLoginAttempts(v int) *AppUpdateOne {
+	_u.mutation.AddMaxIPLoginAttempts(v)
+	return _u
+}
+
+// SetPasswordPolicy sets the "password_policy" field.
+func (_u *AppUpdateOne) SetPasswordPolicy(v *password.Policy) *AppUpdateOne {
+	_u.mutation.SetPasswordPolicy(v)
+	return _u
+}
+
+// ClearPasswordPolicy clears the value of the "password_policy" field.
+func (_u *AppUpdateOne) ClearPasswordPolicy() *AppUpdateOne {
+	_u.mutation.ClearPasswordPolicy()
+	return _u
+}
+
 // SetStatus sets the "status" field.
-func (_u *AppUpdate) SetStatus(v int8) *AppUpdate {
+func (_u *AppUpdateOne) SetStatus(v int8) *AppUpdateOne {
 	_u.mutation.ResetStatus()
 	_u.mutation.SetStatus(v)
 	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *AppUpdate) SetNillableStatus(v *int8) *AppUpdate {
+func (_u *AppUpdateOne) SetNillableStatus(v *int8) *AppUpdateOne {
 	if v != nil {
 		_u.SetStatus(*v)
 	}
@@ -59,19 +1402,19 @@ func (_u *AppUpdate) SetNillableStatus(v *int8) *AppUpdate {
 }
 
 // AddStatus adds value to the "status" field.
-func (_u *AppUpdate) AddStatus(v int8) *AppUpdate {
+func (_u *AppUpdateOne) AddStatus(v int8) *AppUpdateOne {
 	_u.mutation.AddStatus(v)
 	return _u
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (_u *AppUpdate) SetCreatedAt(v time.Time) *AppUpdate {
+func (_u *AppUpdateOne) SetCreatedAt(v time.Time) *AppUpdateOne {
 	_u.mutation.SetCreatedAt(v)
 	return _u
 }
 
 // SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *AppUpdate) SetNillableCreatedAt(v *time.Time) *AppUpdate {
+func (_u *AppUpdateOne) SetNillableCreatedAt(v *time.Time) *AppUpdateOne {
 	if v != nil {
 		_u.SetCreatedAt(*v)
 	}
@@ -79,19 +1422,19 @@ func (_u *AppUpdate) SetNillableCreatedAt(v *time.Time) *AppUpdate {
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (_u *AppUpdate) SetUpdatedAt(v time.Time) *AppUpdate {
+func (_u *AppUpdateOne) SetUpdatedAt(v time.Time) *AppUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
 // AddUserIDs adds the "users" edge to the User entity by IDs.
-func (_u *AppUpdate) AddUserIDs(ids ...int) *AppUpdate {
+func (_u *AppUpdateOne) AddUserIDs(ids ...int) *AppUpdateOne {
 	_u.mutation.AddUserIDs(ids...)
 	return _u
 }
 
 // AddUsers adds the "users" edges to the User entity.
-func (_u *AppUpdate) AddUsers(v ...*User) *AppUpdate {
+func (_u *AppUpdateOne) AddUsers(v ...*User) *AppUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
@@ -99,251 +1442,246 @@ func (_u *AppUpdate) AddUsers(v ...*User) *AppUpdate {
 	return _u.AddUserIDs(ids...)
 }
 
-// Mutation returns the AppMutation object of the builder.
-func (_u *AppUpdate) Mutation() *AppMutation {
-	return _u.mutation
+// AddAuthorizationCodeIDs adds the "authorization_codes" edge to the AuthorizationCode entity by IDs.
+func (_u *AppUpdateOne) AddAuthorizationCodeIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddAuthorizationCodeIDs(ids...)
+	return _u
 }
 
-// ClearUsers clears all "users" edges to the User entity.
-func (_u *AppUpdate) ClearUsers() *AppUpdate {
-	_u.mutation.ClearUsers()
-	return _u
+// AddAuthorizationCodes adds the "authorization_codes" edges to the AuthorizationCode entity.
+func (_u *AppUpdateOne) AddAuthorizationCodes(v ...*AuthorizationCode) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthorizationCodeIDs(ids...)
 }
 
-// RemoveUserIDs removes the "users" edge to User entities by IDs.
-func (_u *AppUpdate) RemoveUserIDs(ids ...int) *AppUpdate {
-	_u.mutation.RemoveUserIDs(ids...)
+// AddRoleIDs adds the "roles" edge to the Role entity by IDs.
+func (_u *AppUpdateOne) AddRoleIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddRoleIDs(ids...)
 	return _u
 }
 
-// RemoveUsers removes "users" edges to User entities.
-func (_u *AppUpdate) RemoveUsers(v ...*User) *AppUpdate {
+// AddRoles adds the "roles" edges to the Role entity.
+func (_u *AppUpdateOne) AddRoles(v ...*Role) *AppUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUserIDs(ids...)
+	return _u.AddRoleIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *AppUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// AddEmailTemplateIDs adds the "email_templates" edge to the EmailTemplate entity by IDs.
+func (_u *AppUpdateOne) AddEmailTemplateIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddEmailTemplateIDs(ids...)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *AppUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddEmailTemplates adds the "email_templates" edges to the EmailTemplate entity.
+func (_u *AppUpdateOne) AddEmailTemplates(v ...*EmailTemplate) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.AddEmailTemplateIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *AppUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// AddWebauthnChallengeIDs adds the "webauthn_challenges" edge to the WebAuthnChallenge entity by IDs.
+func (_u *AppUpdateOne) AddWebauthnChallengeIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddWebauthnChallengeIDs(ids...)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *AppUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// AddWebauthnChallenges adds the "webauthn_challenges" edges to the WebAuthnChallenge entity.
+func (_u *AppUpdateOne) AddWebauthnChallenges(v ...*WebAuthnChallenge) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddWebauthnChallengeIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *AppUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := app.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
-	}
+// AddLockoutIDs adds the "lockouts" edge to the Lockout entity by IDs.
+func (_u *AppUpdateOne) AddLockoutIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddLockoutIDs(ids...)
+	return _u
 }
 
-func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(app.Table, app.Columns, sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Name(); ok {
-		_spec.SetField(app.FieldName, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(app.FieldStatus, field.TypeInt8, value)
-	}
-	if value, ok := _u.mutation.AddedStatus(); ok {
-		_spec.AddField(app.FieldStatus, field.TypeInt8, value)
-	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(app.FieldCreatedAt, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(app.FieldUpdatedAt, field.TypeTime, value)
-	}
-	if _u.mutation.UsersCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   app.UsersTable,
-			Columns: []string{app.UsersColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedUsersIDs(); len(nodes) > 0 && !_u.mutation.UsersCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   app.UsersTable,
-			Columns: []string{app.UsersColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.UsersIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   app.UsersTable,
-			Columns: []string{app.UsersColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+// AddLockouts adds the "lockouts" edges to the Lockout entity.
+func (_u *AppUpdateOne) AddLockouts(v ...*Lockout) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{app.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u.AddLockoutIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (_u *AppUpdateOne) AddWebhookIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddWebhookIDs(ids...)
+	return _u
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (_u *AppUpdateOne) AddWebhooks(v ...*Webhook) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u.AddWebhookIDs(ids...)
 }
 
-// AppUpdateOne is the builder for updating a single App entity.
-type AppUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *AppMutation
+// Mutation returns the AppMutation object of the builder.
+func (_u *AppUpdateOne) Mutation() *AppMutation {
+	return _u.mutation
 }
 
-// SetName sets the "name" field.
-func (_u *AppUpdateOne) SetName(v string) *AppUpdateOne {
-	_u.mutation.SetName(v)
+// ClearUsers clears all "users" edges to the User entity.
+func (_u *AppUpdateOne) ClearUsers() *AppUpdateOne {
+	_u.mutation.ClearUsers()
 	return _u
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *AppUpdateOne) SetNillableName(v *string) *AppUpdateOne {
-	if v != nil {
-		_u.SetName(*v)
+// RemoveUserIDs removes the "users" edge to User entities by IDs.
+func (_u *AppUpdateOne) RemoveUserIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveUserIDs(ids...)
+	return _u
+}
+
+// RemoveUsers removes "users" edges to User entities.
+func (_u *AppUpdateOne) RemoveUsers(v ...*User) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveUserIDs(ids...)
+}
+
+// ClearAuthorizationCodes clears all "authorization_codes" edges to the AuthorizationCode entity.
+func (_u *AppUpdateOne) ClearAuthorizationCodes() *AppUpdateOne {
+	_u.mutation.ClearAuthorizationCodes()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *AppUpdateOne) SetStatus(v int8) *AppUpdateOne {
-	_u.mutation.ResetStatus()
-	_u.mutation.SetStatus(v)
+// RemoveAuthorizationCodeIDs removes the "authorization_codes" edge to AuthorizationCode entities by IDs.
+func (_u *AppUpdateOne) RemoveAuthorizationCodeIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveAuthorizationCodeIDs(ids...)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *AppUpdateOne) SetNillableStatus(v *int8) *AppUpdateOne {
-	if v != nil {
-		_u.SetStatus(*v)
+// RemoveAuthorizationCodes removes "authorization_codes" edges to AuthorizationCode entities.
+func (_u *AppUpdateOne) RemoveAuthorizationCodes(v ...*AuthorizationCode) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveAuthorizationCodeIDs(ids...)
+}
+
+// ClearRoles clears all "roles" edges to the Role entity.
+func (_u *AppUpdateOne) ClearRoles() *AppUpdateOne {
+	_u.mutation.ClearRoles()
 	return _u
 }
 
-// AddStatus adds value to the "status" field.
-func (_u *AppUpdateOne) AddStatus(v int8) *AppUpdateOne {
-	_u.mutation.AddStatus(v)
+// RemoveRoleIDs removes the "roles" edge to Role entities by IDs.
+func (_u *AppUpdateOne) RemoveRoleIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveRoleIDs(ids...)
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *AppUpdateOne) SetCreatedAt(v time.Time) *AppUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// RemoveRoles removes "roles" edges to Role entities.
+func (_u *AppUpdateOne) RemoveRoles(v ...*Role) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRoleIDs(ids...)
+}
+
+// ClearEmailTemplates clears all "email_templates" edges to the EmailTemplate entity.
+func (_u *AppUpdateOne) ClearEmailTemplates() *AppUpdateOne {
+	_u.mutation.ClearEmailTemplates()
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *AppUpdateOne) SetNillableCreatedAt(v *time.Time) *AppUpdateOne {
-	if v != nil {
-		_u.SetCreatedAt(*v)
+// RemoveEmailTemplateIDs removes the "email_templates" edge to EmailTemplate entities by IDs.
+func (_u *AppUpdateOne) RemoveEmailTemplateIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveEmailTemplateIDs(ids...)
+	return _u
+}
+
+// RemoveEmailTemplates removes "email_templates" edges to EmailTemplate entities.
+func (_u *AppUpdateOne) RemoveEmailTemplates(v ...*EmailTemplate) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveEmailTemplateIDs(ids...)
+}
+
+// ClearWebauthnChallenges clears all "webauthn_challenges" edges to the WebAuthnChallenge entity.
+func (_u *AppUpdateOne) ClearWebauthnChallenges() *AppUpdateOne {
+	_u.mutation.ClearWebauthnChallenges()
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *AppUpdateOne) SetUpdatedAt(v time.Time) *AppUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// RemoveWebauthnChallengeIDs removes the "webauthn_challenges" edge to WebAuthnChallenge entities by IDs.
+func (_u *AppUpdateOne) RemoveWebauthnChallengeIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveWebauthnChallengeIDs(ids...)
 	return _u
 }
 
-// AddUserIDs adds the "users" edge to the User entity by IDs.
-func (_u *AppUpdateOne) AddUserIDs(ids ...int) *AppUpdateOne {
-	_u.mutation.AddUserIDs(ids...)
+// RemoveWebauthnChallenges removes "webauthn_challenges" edges to WebAuthnChallenge entities.
+func (_u *AppUpdateOne) RemoveWebauthnChallenges(v ...*WebAuthnChallenge) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebauthnChallengeIDs(ids...)
+}
+
+// ClearLockouts clears all "lockouts" edges to the Lockout entity.
+func (_u *AppUpdateOne) ClearLockouts() *AppUpdateOne {
+	_u.mutation.ClearLockouts()
 	return _u
 }
 
-// AddUsers adds the "users" edges to the User entity.
-func (_u *AppUpdateOne) AddUsers(v ...*User) *AppUpdateOne {
+// RemoveLockoutIDs removes the "lockouts" edge to Lockout entities by IDs.
+func (_u *AppUpdateOne) RemoveLockoutIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveLockoutIDs(ids...)
+	return _u
+}
+
+// RemoveLockouts removes "lockouts" edges to Lockout entities.
+func (_u *AppUpdateOne) RemoveLockouts(v ...*Lockout) *AppUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddUserIDs(ids...)
-}
-
-// Mutation returns the AppMutation object of the builder.
-func (_u *AppUpdateOne) Mutation() *AppMutation {
-	return _u.mutation
+	return _u.RemoveLockoutIDs(ids...)
 }
 
-// ClearUsers clears all "users" edges to the User entity.
-func (_u *AppUpdateOne) ClearUsers() *AppUpdateOne {
-	_u.mutation.ClearUsers()
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (_u *AppUpdateOne) ClearWebhooks() *AppUpdateOne {
+	_u.mutation.ClearWebhooks()
 	return _u
 }
 
-// RemoveUserIDs removes the "users" edge to User entities by IDs.
-func (_u *AppUpdateOne) RemoveUserIDs(ids ...int) *AppUpdateOne {
-	_u.mutation.RemoveUserIDs(ids...)
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (_u *AppUpdateOne) RemoveWebhookIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveWebhookIDs(ids...)
 	return _u
 }
 
-// RemoveUsers removes "users" edges to User entities.
-func (_u *AppUpdateOne) RemoveUsers(v ...*User) *AppUpdateOne {
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (_u *AppUpdateOne) RemoveWebhooks(v ...*Webhook) *AppUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveUserIDs(ids...)
+	return _u.RemoveWebhookIDs(ids...)
 }
 
 // Where appends a list predicates to the AppUpdate builder.
@@ -361,7 +1699,9 @@ func (_u *AppUpdateOne) Select(field string, fields ...string) *AppUpdateOne {
 
 // Save executes the query and returns the updated App entity.
 func (_u *AppUpdateOne) Save(ctx context.Context) (*App, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -388,14 +1728,46 @@ func (_u *AppUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *AppUpdateOne) defaults() {
+func (_u *AppUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if app.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized app.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := app.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AppUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxLoginAttempts(); ok {
+		if err := app.MaxLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_login_attempts", err: fmt.Errorf(`ent: validator failed for field "App.max_login_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LockoutDuration(); ok {
+		if err := app.LockoutDurationValidator(v); err != nil {
+			return &ValidationError{Name: "lockout_duration", err: fmt.Errorf(`ent: validator failed for field "App.lockout_duration": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LoginDelay(); ok {
+		if err := app.LoginDelayValidator(v); err != nil {
+			return &ValidationError{Name: "login_delay", err: fmt.Errorf(`ent: validator failed for field "App.login_delay": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxIPLoginAttempts(); ok {
+		if err := app.MaxIPLoginAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_ip_login_attempts", err: fmt.Errorf(`ent: validator failed for field "App.max_ip_login_attempts": %w`, err)}
+		}
+	}
+	return nil
 }
 
 func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(app.Table, app.Columns, sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -424,6 +1796,96 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(app.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.RedirectUris(); ok {
+		_spec.SetField(app.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldRedirectUris, value)
+		})
+	}
+	if _u.mutation.RedirectUrisCleared() {
+		_spec.ClearField(app.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClientSecretHash(); ok {
+		_spec.SetField(app.FieldClientSecretHash, field.TypeString, value)
+	}
+	if _u.mutation.ClientSecretHashCleared() {
+		_spec.ClearField(app.FieldClientSecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(app.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldScopes, value)
+		})
+	}
+	if _u.mutation.ScopesCleared() {
+		_spec.ClearField(app.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScimTokenHash(); ok {
+		_spec.SetField(app.FieldScimTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.ScimTokenHashCleared() {
+		_spec.ClearField(app.FieldScimTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequireVerifiedEmail(); ok {
+		_spec.SetField(app.FieldRequireVerifiedEmail, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequireMfa(); ok {
+		_spec.SetField(app.FieldRequireMfa, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MagicLinkEnabled(); ok {
+		_spec.SetField(app.FieldMagicLinkEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WebauthnRpID(); ok {
+		_spec.SetField(app.FieldWebauthnRpID, field.TypeString, value)
+	}
+	if _u.mutation.WebauthnRpIDCleared() {
+		_spec.ClearField(app.FieldWebauthnRpID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WebauthnOrigins(); ok {
+		_spec.SetField(app.FieldWebauthnOrigins, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWebauthnOrigins(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldWebauthnOrigins, value)
+		})
+	}
+	if _u.mutation.WebauthnOriginsCleared() {
+		_spec.ClearField(app.FieldWebauthnOrigins, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxLoginAttempts(); ok {
+		_spec.SetField(app.FieldMaxLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLoginAttempts(); ok {
+		_spec.AddField(app.FieldMaxLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockoutDuration(); ok {
+		_spec.SetField(app.FieldLockoutDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLockoutDuration(); ok {
+		_spec.AddField(app.FieldLockoutDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LoginDelay(); ok {
+		_spec.SetField(app.FieldLoginDelay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLoginDelay(); ok {
+		_spec.AddField(app.FieldLoginDelay, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxIPLoginAttempts(); ok {
+		_spec.SetField(app.FieldMaxIPLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxIPLoginAttempts(); ok {
+		_spec.AddField(app.FieldMaxIPLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PasswordPolicy(); ok {
+		_spec.SetField(app.FieldPasswordPolicy, field.TypeJSON, value)
+	}
+	if _u.mutation.PasswordPolicyCleared() {
+		_spec.ClearField(app.FieldPasswordPolicy, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(app.FieldStatus, field.TypeInt8, value)
 	}
@@ -481,6 +1943,276 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthorizationCodesIDs(); len(nodes) > 0 && !_u.mutation.AuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolesIDs(); len(nodes) > 0 && !_u.mutation.RolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(role.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmailTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtemplate.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmailTemplatesIDs(); len(nodes) > 0 && !_u.mutation.EmailTemplatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmailTemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(emailtemplate.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebauthnChallengesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebauthnChallengesIDs(); len(nodes) > 0 && !_u.mutation.WebauthnChallengesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebauthnChallengesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LockoutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLockoutsIDs(); len(nodes) > 0 && !_u.mutation.LockoutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LockoutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !_u.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &App{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,52 @@
+// Code generated by ent, DO NOT EDIT.
+
+package audithead
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the audithead type in the database.
+	Label = "audit_head"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAppended holds the string denoting the appended field in the database.
+	FieldAppended = "appended"
+	// Table holds the table name of the audithead in the database.
+	Table = "kpr_audit_head"
+)
+
+// Columns holds all SQL columns for audithead fields.
+var Columns = []string{
+	FieldID,
+	FieldAppended,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAppended holds the default value on creation for the "appended" field.
+	DefaultAppended int
+)
+
+// OrderOption defines the ordering options for the AuditHead queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAppended orders the results by the appended field.
+func ByAppended(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppended, opts...).ToFunc()
+}
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package audithead
+
+import (
+	"keeper/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldLTE(FieldID, id))
+}
+
+// Appended applies equality check predicate on the "appended" field. It's identical to AppendedEQ.
+func Appended(v int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldEQ(FieldAppended, v))
+}
+
+// AppendedEQ applies the EQ predicate on the "appended" field.
+func AppendedEQ(v int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldEQ(FieldAppended, v))
+}
+
+// AppendedNEQ applies the NEQ predicate on the "appended" field.
+func AppendedNEQ(v int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldNEQ(FieldAppended, v))
+}
+
+// AppendedIn applies the In predicate on the "appended" field.
+func AppendedIn(vs ...int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldIn(FieldAppended, vs...))
+}
+
+// AppendedNotIn applies the NotIn predicate on the "appended" field.
+func AppendedNotIn(vs ...int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldNotIn(FieldAppended, vs...))
+}
+
+// AppendedGT applies the GT predicate on the "appended" field.
+func AppendedGT(v int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldGT(FieldAppended, v))
+}
+
+// AppendedGTE applies the GTE predicate on the "appended" field.
+func AppendedGTE(v int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldGTE(FieldAppended, v))
+}
+
+// AppendedLT applies the LT predicate on the "appended" field.
+func AppendedLT(v int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldLT(FieldAppended, v))
+}
+
+// AppendedLTE applies the LTE predicate on the "appended" field.
+func AppendedLTE(v int) predicate.AuditHead {
+	return predicate.AuditHead(sql.FieldLTE(FieldAppended, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditHead) predicate.AuditHead {
+	return predicate.AuditHead(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditHead) predicate.AuditHead {
+	return predicate.AuditHead(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditHead) predicate.AuditHead {
+	return predicate.AuditHead(sql.NotPredicates(p))
+}
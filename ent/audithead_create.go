@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/audithead"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditHeadCreate is the builder for creating a AuditHead entity.
+type AuditHeadCreate struct {
+	config
+	mutation *AuditHeadMutation
+	hooks    []Hook
+}
+
+// SetAppended sets the "appended" field.
+func (_c *AuditHeadCreate) SetAppended(v int) *AuditHeadCreate {
+	_c.mutation.SetAppended(v)
+	return _c
+}
+
+// SetNillableAppended sets the "appended" field if the given value is not nil.
+func (_c *AuditHeadCreate) SetNillableAppended(v *int) *AuditHeadCreate {
+	if v != nil {
+		_c.SetAppended(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AuditHeadMutation object of the builder.
+func (_c *AuditHeadCreate) Mutation() *AuditHeadMutation {
+	return _c.mutation
+}
+
+// Save creates the AuditHead in the database.
+func (_c *AuditHeadCreate) Save(ctx context.Context) (*AuditHead, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuditHeadCreate) SaveX(ctx context.Context) *AuditHead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditHeadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditHeadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuditHeadCreate) defaults() {
+	if _, ok := _c.mutation.Appended(); !ok {
+		v := audithead.DefaultAppended
+		_c.mutation.SetAppended(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuditHeadCreate) check() error {
+	if _, ok := _c.mutation.Appended(); !ok {
+		return &ValidationError{Name: "appended", err: errors.New(`ent: missing required field "AuditHead.appended"`)}
+	}
+	return nil
+}
+
+func (_c *AuditHeadCreate) sqlSave(ctx context.Context) (*AuditHead, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuditHeadCreate) createSpec() (*AuditHead, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditHead{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(audithead.Table, sqlgraph.NewFieldSpec(audithead.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Appended(); ok {
+		_spec.SetField(audithead.FieldAppended, field.TypeInt, value)
+		_node.Appended = value
+	}
+	return _node, _spec
+}
+
+// AuditHeadCreateBulk is the builder for creating many AuditHead entities in bulk.
+type AuditHeadCreateBulk struct {
+	config
+	err      error
+	builders []*AuditHeadCreate
+}
+
+// Save creates the AuditHead entities in the database.
+func (_c *AuditHeadCreateBulk) Save(ctx context.Context) ([]*AuditHead, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuditHead, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditHeadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuditHeadCreateBulk) SaveX(ctx context.Context) []*AuditHead {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuditHeadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuditHeadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
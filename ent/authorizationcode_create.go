@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthorizationCodeCreate is the builder for creating a AuthorizationCode entity.
+type AuthorizationCodeCreate struct {
+	config
+	mutation *AuthorizationCodeMutation
+	hooks    []Hook
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *AuthorizationCodeCreate) SetCodeHash(v string) *AuthorizationCodeCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetAppID sets the "app_id" field.
+func (_c *AuthorizationCodeCreate) SetAppID(v int) *AuthorizationCodeCreate {
+	_c.mutation.SetAppID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *AuthorizationCodeCreate) SetUserID(v int) *AuthorizationCodeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_c *AuthorizationCodeCreate) SetRedirectURI(v string) *AuthorizationCodeCreate {
+	_c.mutation.SetRedirectURI(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *AuthorizationCodeCreate) SetScope(v string) *AuthorizationCodeCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *AuthorizationCodeCreate) SetNonce(v string) *AuthorizationCodeCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_c *AuthorizationCodeCreate) SetNillableNonce(v *string) *AuthorizationCodeCreate {
+	if v != nil {
+		_c.SetNonce(*v)
+	}
+	return _c
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_c *AuthorizationCodeCreate) SetCodeChallenge(v string) *AuthorizationCodeCreate {
+	_c.mutation.SetCodeChallenge(v)
+	return _c
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_c *AuthorizationCodeCreate) SetCodeChallengeMethod(v string) *AuthorizationCodeCreate {
+	_c.mutation.SetCodeChallengeMethod(v)
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *AuthorizationCodeCreate) SetAuthTime(v time.Time) *AuthorizationCodeCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AuthorizationCodeCreate) SetExpiresAt(v time.Time) *AuthorizationCodeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *AuthorizationCodeCreate) SetUsedAt(v time.Time) *AuthorizationCodeCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *AuthorizationCodeCreate) SetNillableUsedAt(v *time.Time) *AuthorizationCodeCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AuthorizationCodeCreate) SetCreatedAt(v time.Time) *AuthorizationCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AuthorizationCodeCreate) SetNillableCreatedAt(v *time.Time) *AuthorizationCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_c *AuthorizationCodeCreate) SetApp(v *App) *AuthorizationCodeCreate {
+	return _c.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AuthorizationCodeCreate) SetUser(v *User) *AuthorizationCodeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the AuthorizationCodeMutation object of the builder.
+func (_c *AuthorizationCodeCreate) Mutation() *AuthorizationCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the AuthorizationCode in the database.
+func (_c *AuthorizationCodeCreate) Save(ctx context.Context) (*AuthorizationCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AuthorizationCodeCreate) SaveX(ctx context.Context) *AuthorizationCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthorizationCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthorizationCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AuthorizationCodeCreate) defaults() {
+	if _, ok := _c.mutation.Nonce(); !ok {
+		v := authorizationcode.DefaultNonce
+		_c.mutation.SetNonce(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := authorizationcode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AuthorizationCodeCreate) check() error {
+	if _, ok := _c.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "AuthorizationCode.code_hash"`)}
+	}
+	if _, ok := _c.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "AuthorizationCode.app_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AuthorizationCode.user_id"`)}
+	}
+	if _, ok := _c.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "AuthorizationCode.redirect_uri"`)}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "AuthorizationCode.scope"`)}
+	}
+	if _, ok := _c.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "AuthorizationCode.nonce"`)}
+	}
+	if _, ok := _c.mutation.CodeChallenge(); !ok {
+		return &ValidationError{Name: "code_challenge", err: errors.New(`ent: missing required field "AuthorizationCode.code_challenge"`)}
+	}
+	if _, ok := _c.mutation.CodeChallengeMethod(); !ok {
+		return &ValidationError{Name: "code_challenge_method", err: errors.New(`ent: missing required field "AuthorizationCode.code_challenge_method"`)}
+	}
+	if _, ok := _c.mutation.AuthTime(); !ok {
+		return &ValidationError{Name: "auth_time", err: errors.New(`ent: missing required field "AuthorizationCode.auth_time"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AuthorizationCode.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthorizationCode.created_at"`)}
+	}
+	if len(_c.mutation.AppIDs()) == 0 {
+		return &ValidationError{Name: "app", err: errors.New(`ent: missing required edge "AuthorizationCode.app"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AuthorizationCode.user"`)}
+	}
+	return nil
+}
+
+func (_c *AuthorizationCodeCreate) sqlSave(ctx context.Context) (*AuthorizationCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AuthorizationCodeCreate) createSpec() (*AuthorizationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthorizationCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(authorizationcode.Table, sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(authorizationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.RedirectURI(); ok {
+		_spec.SetField(authorizationcode.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(authorizationcode.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(authorizationcode.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.CodeChallenge(); ok {
+		_spec.SetField(authorizationcode.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := _c.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(authorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+		_node.CodeChallengeMethod = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(authorizationcode.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(authorizationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(authorizationcode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(authorizationcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.AppTable,
+			Columns: []string{authorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AppID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.UserTable,
+			Columns: []string{authorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AuthorizationCodeCreateBulk is the builder for creating many AuthorizationCode entities in bulk.
+type AuthorizationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*AuthorizationCodeCreate
+}
+
+// Save creates the AuthorizationCode entities in the database.
+func (_c *AuthorizationCodeCreateBulk) Save(ctx context.Context) ([]*AuthorizationCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AuthorizationCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthorizationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AuthorizationCodeCreateBulk) SaveX(ctx context.Context) []*AuthorizationCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AuthorizationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AuthorizationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
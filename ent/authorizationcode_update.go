@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/predicate"
+	"keeper/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthorizationCodeUpdate is the builder for updating AuthorizationCode entities.
+type AuthorizationCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthorizationCodeMutation
+}
+
+// Where appends a list predicates to the AuthorizationCodeUpdate builder.
+func (_u *AuthorizationCodeUpdate) Where(ps ...predicate.AuthorizationCode) *AuthorizationCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *AuthorizationCodeUpdate) SetCodeHash(v string) *AuthorizationCodeUpdate {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableCodeHash(v *string) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *AuthorizationCodeUpdate) SetAppID(v int) *AuthorizationCodeUpdate {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableAppID(v *int) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuthorizationCodeUpdate) SetUserID(v int) *AuthorizationCodeUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableUserID(v *int) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *AuthorizationCodeUpdate) SetRedirectURI(v string) *AuthorizationCodeUpdate {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableRedirectURI(v *string) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *AuthorizationCodeUpdate) SetScope(v string) *AuthorizationCodeUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableScope(v *string) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *AuthorizationCodeUpdate) SetNonce(v string) *AuthorizationCodeUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableNonce(v *string) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *AuthorizationCodeUpdate) SetCodeChallenge(v string) *AuthorizationCodeUpdate {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableCodeChallenge(v *string) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *AuthorizationCodeUpdate) SetCodeChallengeMethod(v string) *AuthorizationCodeUpdate {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableCodeChallengeMethod(v *string) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthorizationCodeUpdate) SetAuthTime(v time.Time) *AuthorizationCodeUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableAuthTime(v *time.Time) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthorizationCodeUpdate) SetExpiresAt(v time.Time) *AuthorizationCodeUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableExpiresAt(v *time.Time) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *AuthorizationCodeUpdate) SetUsedAt(v time.Time) *AuthorizationCodeUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableUsedAt(v *time.Time) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *AuthorizationCodeUpdate) ClearUsedAt() *AuthorizationCodeUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthorizationCodeUpdate) SetCreatedAt(v time.Time) *AuthorizationCodeUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdate) SetNillableCreatedAt(v *time.Time) *AuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *AuthorizationCodeUpdate) SetApp(v *App) *AuthorizationCodeUpdate {
+	return _u.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AuthorizationCodeUpdate) SetUser(v *User) *AuthorizationCodeUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AuthorizationCodeMutation object of the builder.
+func (_u *AuthorizationCodeUpdate) Mutation() *AuthorizationCodeMutation {
+	return _u.mutation
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *AuthorizationCodeUpdate) ClearApp() *AuthorizationCodeUpdate {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AuthorizationCodeUpdate) ClearUser() *AuthorizationCodeUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AuthorizationCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthorizationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AuthorizationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthorizationCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuthorizationCodeUpdate) check() error {
+	if _u.mutation.AppCleared() && len(_u.mutation.AppIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AuthorizationCode.app"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AuthorizationCode.user"`)
+	}
+	return nil
+}
+
+func (_u *AuthorizationCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authorizationcode.Table, authorizationcode.Columns, sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(authorizationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(authorizationcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(authorizationcode.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(authorizationcode.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(authorizationcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(authorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authorizationcode.FieldAuthTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authorizationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(authorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(authorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authorizationcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.AppTable,
+			Columns: []string{authorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.AppTable,
+			Columns: []string{authorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.UserTable,
+			Columns: []string{authorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.UserTable,
+			Columns: []string{authorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AuthorizationCodeUpdateOne is the builder for updating a single AuthorizationCode entity.
+type AuthorizationCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthorizationCodeMutation
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *AuthorizationCodeUpdateOne) SetCodeHash(v string) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableCodeHash(v *string) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *AuthorizationCodeUpdateOne) SetAppID(v int) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableAppID(v *int) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *AuthorizationCodeUpdateOne) SetUserID(v int) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableUserID(v *int) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *AuthorizationCodeUpdateOne) SetRedirectURI(v string) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableRedirectURI(v *string) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *AuthorizationCodeUpdateOne) SetScope(v string) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableScope(v *string) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *AuthorizationCodeUpdateOne) SetNonce(v string) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableNonce(v *string) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *AuthorizationCodeUpdateOne) SetCodeChallenge(v string) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableCodeChallenge(v *string) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *AuthorizationCodeUpdateOne) SetCodeChallengeMethod(v string) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableCodeChallengeMethod(v *string) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *AuthorizationCodeUpdateOne) SetAuthTime(v time.Time) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableAuthTime(v *time.Time) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *AuthorizationCodeUpdateOne) SetExpiresAt(v time.Time) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableExpiresAt(v *time.Time) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *AuthorizationCodeUpdateOne) SetUsedAt(v time.Time) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableUsedAt(v *time.Time) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *AuthorizationCodeUpdateOne) ClearUsedAt() *AuthorizationCodeUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *AuthorizationCodeUpdateOne) SetCreatedAt(v time.Time) *AuthorizationCodeUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *AuthorizationCodeUpdateOne) SetNillableCreatedAt(v *time.Time) *AuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *AuthorizationCodeUpdateOne) SetApp(v *App) *AuthorizationCodeUpdateOne {
+	return _u.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AuthorizationCodeUpdateOne) SetUser(v *User) *AuthorizationCodeUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the AuthorizationCodeMutation object of the builder.
+func (_u *AuthorizationCodeUpdateOne) Mutation() *AuthorizationCodeMutation {
+	return _u.mutation
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *AuthorizationCodeUpdateOne) ClearApp() *AuthorizationCodeUpdateOne {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AuthorizationCodeUpdateOne) ClearUser() *AuthorizationCodeUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the AuthorizationCodeUpdate builder.
+func (_u *AuthorizationCodeUpdateOne) Where(ps ...predicate.AuthorizationCode) *AuthorizationCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AuthorizationCodeUpdateOne) Select(field string, fields ...string) *AuthorizationCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AuthorizationCode entity.
+func (_u *AuthorizationCodeUpdateOne) Save(ctx context.Context) (*AuthorizationCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AuthorizationCodeUpdateOne) SaveX(ctx context.Context) *AuthorizationCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AuthorizationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AuthorizationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AuthorizationCodeUpdateOne) check() error {
+	if _u.mutation.AppCleared() && len(_u.mutation.AppIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AuthorizationCode.app"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AuthorizationCode.user"`)
+	}
+	return nil
+}
+
+func (_u *AuthorizationCodeUpdateOne) sqlSave(ctx context.Context) (_node *AuthorizationCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authorizationcode.Table, authorizationcode.Columns, sqlgraph.NewFieldSpec(authorizationcode.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthorizationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authorizationcode.FieldID)
+		for _, f := range fields {
+			if !authorizationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authorizationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(authorizationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(authorizationcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(authorizationcode.FieldScope, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(authorizationcode.FieldNonce, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(authorizationcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(authorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(authorizationcode.FieldAuthTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(authorizationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(authorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(authorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(authorizationcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.AppTable,
+			Columns: []string{authorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.AppTable,
+			Columns: []string{authorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.UserTable,
+			Columns: []string{authorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.UserTable,
+			Columns: []string{authorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AuthorizationCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
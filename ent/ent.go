@@ -7,7 +7,29 @@ import (
 	"errors"
 	"fmt"
 	"keeper/ent/app"
+	"keeper/ent/audithead"
+	"keeper/ent/auditlog"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/emailtemplate"
+	"keeper/ent/emailverificationtoken"
+	"keeper/ent/lockout"
+	"keeper/ent/loginthrottle"
+	"keeper/ent/magiclinktoken"
+	"keeper/ent/mfachallenge"
+	"keeper/ent/mfarecoverycode"
+	"keeper/ent/outboundemail"
+	"keeper/ent/passwordhistory"
+	"keeper/ent/passwordresettoken"
+	"keeper/ent/permission"
+	"keeper/ent/refreshtoken"
+	"keeper/ent/revokedtoken"
+	"keeper/ent/role"
+	"keeper/ent/signingkey"
 	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"keeper/ent/webauthncredential"
+	"keeper/ent/webhook"
+	"keeper/ent/webhookdelivery"
 	"reflect"
 	"sync"
 
@@ -74,8 +96,30 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			app.Table:  app.ValidColumn,
-			user.Table: user.ValidColumn,
+			app.Table:                    app.ValidColumn,
+			audithead.Table:              audithead.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			authorizationcode.Table:      authorizationcode.ValidColumn,
+			emailtemplate.Table:          emailtemplate.ValidColumn,
+			emailverificationtoken.Table: emailverificationtoken.ValidColumn,
+			lockout.Table:                lockout.ValidColumn,
+			loginthrottle.Table:          loginthrottle.ValidColumn,
+			mfachallenge.Table:           mfachallenge.ValidColumn,
+			mfarecoverycode.Table:        mfarecoverycode.ValidColumn,
+			magiclinktoken.Table:         magiclinktoken.ValidColumn,
+			outboundemail.Table:          outboundemail.ValidColumn,
+			passwordhistory.Table:        passwordhistory.ValidColumn,
+			passwordresettoken.Table:     passwordresettoken.ValidColumn,
+			permission.Table:             permission.ValidColumn,
+			refreshtoken.Table:           refreshtoken.ValidColumn,
+			revokedtoken.Table:           revokedtoken.ValidColumn,
+			role.Table:                   role.ValidColumn,
+			signingkey.Table:             signingkey.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			webauthnchallenge.Table:      webauthnchallenge.ValidColumn,
+			webauthncredential.Table:     webauthncredential.ValidColumn,
+			webhook.Table:                webhook.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
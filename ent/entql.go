@@ -0,0 +1,3522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"keeper/ent/app"
+	"keeper/ent/audithead"
+	"keeper/ent/auditlog"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/emailtemplate"
+	"keeper/ent/emailverificationtoken"
+	"keeper/ent/lockout"
+	"keeper/ent/loginthrottle"
+	"keeper/ent/magiclinktoken"
+	"keeper/ent/mfachallenge"
+	"keeper/ent/mfarecoverycode"
+	"keeper/ent/outboundemail"
+	"keeper/ent/passwordhistory"
+	"keeper/ent/passwordresettoken"
+	"keeper/ent/permission"
+	"keeper/ent/predicate"
+	"keeper/ent/refreshtoken"
+	"keeper/ent/revokedtoken"
+	"keeper/ent/role"
+	"keeper/ent/signingkey"
+	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"keeper/ent/webauthncredential"
+	"keeper/ent/webhook"
+	"keeper/ent/webhookdelivery"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entql"
+	"entgo.io/ent/schema/field"
+)
+
+// schemaGraph holds a representation of ent/schema at runtime.
+var schemaGraph = func() *sqlgraph.Schema {
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 24)}
+	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   app.Table,
+			Columns: app.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: app.FieldID,
+			},
+		},
+		Type: "App",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			app.FieldName:                 {Type: field.TypeString, Column: app.FieldName},
+			app.FieldClientID:             {Type: field.TypeString, Column: app.FieldClientID},
+			app.FieldRedirectUris:         {Type: field.TypeJSON, Column: app.FieldRedirectUris},
+			app.FieldClientSecretHash:     {Type: field.TypeString, Column: app.FieldClientSecretHash},
+			app.FieldScopes:               {Type: field.TypeJSON, Column: app.FieldScopes},
+			app.FieldScimTokenHash:        {Type: field.TypeString, Column: app.FieldScimTokenHash},
+			app.FieldRequireVerifiedEmail: {Type: field.TypeBool, Column: app.FieldRequireVerifiedEmail},
+			app.FieldRequireMfa:           {Type: field.TypeBool, Column: app.FieldRequireMfa},
+			app.FieldMagicLinkEnabled:     {Type: field.TypeBool, Column: app.FieldMagicLinkEnabled},
+			app.FieldWebauthnRpID:         {Type: field.TypeString, Column: app.FieldWebauthnRpID},
+			app.FieldWebauthnOrigins:      {Type: field.TypeJSON, Column: app.FieldWebauthnOrigins},
+			app.FieldMaxLoginAttempts:     {Type: field.TypeInt, Column: app.FieldMaxLoginAttempts},
+			app.FieldLockoutDuration:      {Type: field.TypeInt, Column: app.FieldLockoutDuration},
+			app.FieldLoginDelay:           {Type: field.TypeInt, Column: app.FieldLoginDelay},
+			app.FieldMaxIPLoginAttempts:   {Type: field.TypeInt, Column: app.FieldMaxIPLoginAttempts},
+			app.FieldPasswordPolicy:       {Type: field.TypeJSON, Column: app.FieldPasswordPolicy},
+			app.FieldStatus:               {Type: field.TypeInt8, Column: app.FieldStatus},
+			app.FieldCreatedAt:            {Type: field.TypeTime, Column: app.FieldCreatedAt},
+			app.FieldUpdatedAt:            {Type: field.TypeTime, Column: app.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   audithead.Table,
+			Columns: audithead.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: audithead.FieldID,
+			},
+		},
+		Type: "AuditHead",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			audithead.FieldAppended: {Type: field.TypeInt, Column: audithead.FieldAppended},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+		Type: "AuditLog",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditlog.FieldAppID:      {Type: field.TypeInt, Column: auditlog.FieldAppID},
+			auditlog.FieldActorType:  {Type: field.TypeEnum, Column: auditlog.FieldActorType},
+			auditlog.FieldActorID:    {Type: field.TypeInt, Column: auditlog.FieldActorID},
+			auditlog.FieldAction:     {Type: field.TypeString, Column: auditlog.FieldAction},
+			auditlog.FieldTargetType: {Type: field.TypeString, Column: auditlog.FieldTargetType},
+			auditlog.FieldTargetID:   {Type: field.TypeInt, Column: auditlog.FieldTargetID},
+			auditlog.FieldChanges:    {Type: field.TypeString, Column: auditlog.FieldChanges},
+			auditlog.FieldMetadata:   {Type: field.TypeString, Column: auditlog.FieldMetadata},
+			auditlog.FieldIP:         {Type: field.TypeString, Column: auditlog.FieldIP},
+			auditlog.FieldRequestID:  {Type: field.TypeString, Column: auditlog.FieldRequestID},
+			auditlog.FieldPrevHash:   {Type: field.TypeString, Column: auditlog.FieldPrevHash},
+			auditlog.FieldHash:       {Type: field.TypeString, Column: auditlog.FieldHash},
+			auditlog.FieldCreatedAt:  {Type: field.TypeTime, Column: auditlog.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   authorizationcode.Table,
+			Columns: authorizationcode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: authorizationcode.FieldID,
+			},
+		},
+		Type: "AuthorizationCode",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			authorizationcode.FieldCodeHash:            {Type: field.TypeString, Column: authorizationcode.FieldCodeHash},
+			authorizationcode.FieldAppID:               {Type: field.TypeInt, Column: authorizationcode.FieldAppID},
+			authorizationcode.FieldUserID:              {Type: field.TypeInt, Column: authorizationcode.FieldUserID},
+			authorizationcode.FieldRedirectURI:         {Type: field.TypeString, Column: authorizationcode.FieldRedirectURI},
+			authorizationcode.FieldScope:               {Type: field.TypeString, Column: authorizationcode.FieldScope},
+			authorizationcode.FieldNonce:               {Type: field.TypeString, Column: authorizationcode.FieldNonce},
+			authorizationcode.FieldCodeChallenge:       {Type: field.TypeString, Column: authorizationcode.FieldCodeChallenge},
+			authorizationcode.FieldCodeChallengeMethod: {Type: field.TypeString, Column: authorizationcode.FieldCodeChallengeMethod},
+			authorizationcode.FieldAuthTime:            {Type: field.TypeTime, Column: authorizationcode.FieldAuthTime},
+			authorizationcode.FieldExpiresAt:           {Type: field.TypeTime, Column: authorizationcode.FieldExpiresAt},
+			authorizationcode.FieldUsedAt:              {Type: field.TypeTime, Column: authorizationcode.FieldUsedAt},
+			authorizationcode.FieldCreatedAt:           {Type: field.TypeTime, Column: authorizationcode.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   emailtemplate.Table,
+			Columns: emailtemplate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: emailtemplate.FieldID,
+			},
+		},
+		Type: "EmailTemplate",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			emailtemplate.FieldAppID:     {Type: field.TypeInt, Column: emailtemplate.FieldAppID},
+			emailtemplate.FieldName:      {Type: field.TypeString, Column: emailtemplate.FieldName},
+			emailtemplate.FieldSubject:   {Type: field.TypeString, Column: emailtemplate.FieldSubject},
+			emailtemplate.FieldHTML:      {Type: field.TypeString, Column: emailtemplate.FieldHTML},
+			emailtemplate.FieldText:      {Type: field.TypeString, Column: emailtemplate.FieldText},
+			emailtemplate.FieldCreatedAt: {Type: field.TypeTime, Column: emailtemplate.FieldCreatedAt},
+			emailtemplate.FieldUpdatedAt: {Type: field.TypeTime, Column: emailtemplate.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   emailverificationtoken.Table,
+			Columns: emailverificationtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: emailverificationtoken.FieldID,
+			},
+		},
+		Type: "EmailVerificationToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			emailverificationtoken.FieldUserID:    {Type: field.TypeInt, Column: emailverificationtoken.FieldUserID},
+			emailverificationtoken.FieldTokenHash: {Type: field.TypeString, Column: emailverificationtoken.FieldTokenHash},
+			emailverificationtoken.FieldEmail:     {Type: field.TypeString, Column: emailverificationtoken.FieldEmail},
+			emailverificationtoken.FieldExpiresAt: {Type: field.TypeTime, Column: emailverificationtoken.FieldExpiresAt},
+			emailverificationtoken.FieldUsedAt:    {Type: field.TypeTime, Column: emailverificationtoken.FieldUsedAt},
+			emailverificationtoken.FieldCreatedAt: {Type: field.TypeTime, Column: emailverificationtoken.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   lockout.Table,
+			Columns: lockout.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: lockout.FieldID,
+			},
+		},
+		Type: "Lockout",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			lockout.FieldKind:        {Type: field.TypeEnum, Column: lockout.FieldKind},
+			lockout.FieldAppID:       {Type: field.TypeInt, Column: lockout.FieldAppID},
+			lockout.FieldUserID:      {Type: field.TypeInt, Column: lockout.FieldUserID},
+			lockout.FieldIP:          {Type: field.TypeString, Column: lockout.FieldIP},
+			lockout.FieldFailures:    {Type: field.TypeInt, Column: lockout.FieldFailures},
+			lockout.FieldLockedUntil: {Type: field.TypeTime, Column: lockout.FieldLockedUntil},
+			lockout.FieldUnlockedAt:  {Type: field.TypeTime, Column: lockout.FieldUnlockedAt},
+			lockout.FieldCreatedAt:   {Type: field.TypeTime, Column: lockout.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   loginthrottle.Table,
+			Columns: loginthrottle.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: loginthrottle.FieldID,
+			},
+		},
+		Type: "LoginThrottle",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			loginthrottle.FieldIP:            {Type: field.TypeString, Column: loginthrottle.FieldIP},
+			loginthrottle.FieldFailures:      {Type: field.TypeInt, Column: loginthrottle.FieldFailures},
+			loginthrottle.FieldLastFailureAt: {Type: field.TypeTime, Column: loginthrottle.FieldLastFailureAt},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   mfachallenge.Table,
+			Columns: mfachallenge.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mfachallenge.FieldID,
+			},
+		},
+		Type: "MFAChallenge",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			mfachallenge.FieldUserID:    {Type: field.TypeInt, Column: mfachallenge.FieldUserID},
+			mfachallenge.FieldTokenHash: {Type: field.TypeString, Column: mfachallenge.FieldTokenHash},
+			mfachallenge.FieldAttempts:  {Type: field.TypeInt, Column: mfachallenge.FieldAttempts},
+			mfachallenge.FieldExpiresAt: {Type: field.TypeTime, Column: mfachallenge.FieldExpiresAt},
+			mfachallenge.FieldUsedAt:    {Type: field.TypeTime, Column: mfachallenge.FieldUsedAt},
+			mfachallenge.FieldCreatedAt: {Type: field.TypeTime, Column: mfachallenge.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   mfarecoverycode.Table,
+			Columns: mfarecoverycode.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: mfarecoverycode.FieldID,
+			},
+		},
+		Type: "MFARecoveryCode",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			mfarecoverycode.FieldUserID:    {Type: field.TypeInt, Column: mfarecoverycode.FieldUserID},
+			mfarecoverycode.FieldCodeHash:  {Type: field.TypeString, Column: mfarecoverycode.FieldCodeHash},
+			mfarecoverycode.FieldUsedAt:    {Type: field.TypeTime, Column: mfarecoverycode.FieldUsedAt},
+			mfarecoverycode.FieldCreatedAt: {Type: field.TypeTime, Column: mfarecoverycode.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[10] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   magiclinktoken.Table,
+			Columns: magiclinktoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: magiclinktoken.FieldID,
+			},
+		},
+		Type: "MagicLinkToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			magiclinktoken.FieldUserID:    {Type: field.TypeInt, Column: magiclinktoken.FieldUserID},
+			magiclinktoken.FieldTokenHash: {Type: field.TypeString, Column: magiclinktoken.FieldTokenHash},
+			magiclinktoken.FieldNonceHash: {Type: field.TypeString, Column: magiclinktoken.FieldNonceHash},
+			magiclinktoken.FieldExpiresAt: {Type: field.TypeTime, Column: magiclinktoken.FieldExpiresAt},
+			magiclinktoken.FieldUsedAt:    {Type: field.TypeTime, Column: magiclinktoken.FieldUsedAt},
+			magiclinktoken.FieldCreatedAt: {Type: field.TypeTime, Column: magiclinktoken.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   outboundemail.Table,
+			Columns: outboundemail.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: outboundemail.FieldID,
+			},
+		},
+		Type: "OutboundEmail",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			outboundemail.FieldAppID:         {Type: field.TypeInt, Column: outboundemail.FieldAppID},
+			outboundemail.FieldTemplate:      {Type: field.TypeString, Column: outboundemail.FieldTemplate},
+			outboundemail.FieldRecipient:     {Type: field.TypeString, Column: outboundemail.FieldRecipient},
+			outboundemail.FieldSubject:       {Type: field.TypeString, Column: outboundemail.FieldSubject},
+			outboundemail.FieldHTML:          {Type: field.TypeString, Column: outboundemail.FieldHTML},
+			outboundemail.FieldText:          {Type: field.TypeString, Column: outboundemail.FieldText},
+			outboundemail.FieldStatus:        {Type: field.TypeEnum, Column: outboundemail.FieldStatus},
+			outboundemail.FieldAttempts:      {Type: field.TypeInt, Column: outboundemail.FieldAttempts},
+			outboundemail.FieldNextAttemptAt: {Type: field.TypeTime, Column: outboundemail.FieldNextAttemptAt},
+			outboundemail.FieldLastError:     {Type: field.TypeString, Column: outboundemail.FieldLastError},
+			outboundemail.FieldSentAt:        {Type: field.TypeTime, Column: outboundemail.FieldSentAt},
+			outboundemail.FieldCreatedAt:     {Type: field.TypeTime, Column: outboundemail.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   passwordhistory.Table,
+			Columns: passwordhistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: passwordhistory.FieldID,
+			},
+		},
+		Type: "PasswordHistory",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			passwordhistory.FieldUserID:       {Type: field.TypeInt, Column: passwordhistory.FieldUserID},
+			passwordhistory.FieldPasswordHash: {Type: field.TypeString, Column: passwordhistory.FieldPasswordHash},
+			passwordhistory.FieldCreatedAt:    {Type: field.TypeTime, Column: passwordhistory.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   passwordresettoken.Table,
+			Columns: passwordresettoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: passwordresettoken.FieldID,
+			},
+		},
+		Type: "PasswordResetToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			passwordresettoken.FieldUserID:    {Type: field.TypeInt, Column: passwordresettoken.FieldUserID},
+			passwordresettoken.FieldTokenHash: {Type: field.TypeString, Column: passwordresettoken.FieldTokenHash},
+			passwordresettoken.FieldExpiresAt: {Type: field.TypeTime, Column: passwordresettoken.FieldExpiresAt},
+			passwordresettoken.FieldUsedAt:    {Type: field.TypeTime, Column: passwordresettoken.FieldUsedAt},
+			passwordresettoken.FieldCreatedAt: {Type: field.TypeTime, Column: passwordresettoken.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[14] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   permission.Table,
+			Columns: permission.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: permission.FieldID,
+			},
+		},
+		Type: "Permission",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			permission.FieldName:      {Type: field.TypeString, Column: permission.FieldName},
+			permission.FieldCreatedAt: {Type: field.TypeTime, Column: permission.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[15] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   refreshtoken.Table,
+			Columns: refreshtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: refreshtoken.FieldID,
+			},
+		},
+		Type: "RefreshToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			refreshtoken.FieldUserID:    {Type: field.TypeInt, Column: refreshtoken.FieldUserID},
+			refreshtoken.FieldTokenHash: {Type: field.TypeString, Column: refreshtoken.FieldTokenHash},
+			refreshtoken.FieldFamilyID:  {Type: field.TypeString, Column: refreshtoken.FieldFamilyID},
+			refreshtoken.FieldScope:     {Type: field.TypeString, Column: refreshtoken.FieldScope},
+			refreshtoken.FieldExpiresAt: {Type: field.TypeTime, Column: refreshtoken.FieldExpiresAt},
+			refreshtoken.FieldUsedAt:    {Type: field.TypeTime, Column: refreshtoken.FieldUsedAt},
+			refreshtoken.FieldRevokedAt: {Type: field.TypeTime, Column: refreshtoken.FieldRevokedAt},
+			refreshtoken.FieldCreatedAt: {Type: field.TypeTime, Column: refreshtoken.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[16] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   revokedtoken.Table,
+			Columns: revokedtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: revokedtoken.FieldID,
+			},
+		},
+		Type: "RevokedToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			revokedtoken.FieldJti:       {Type: field.TypeString, Column: revokedtoken.FieldJti},
+			revokedtoken.FieldExpiresAt: {Type: field.TypeTime, Column: revokedtoken.FieldExpiresAt},
+			revokedtoken.FieldCreatedAt: {Type: field.TypeTime, Column: revokedtoken.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[17] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   role.Table,
+			Columns: role.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: role.FieldID,
+			},
+		},
+		Type: "Role",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			role.FieldAppID:       {Type: field.TypeInt, Column: role.FieldAppID},
+			role.FieldName:        {Type: field.TypeString, Column: role.FieldName},
+			role.FieldDescription: {Type: field.TypeString, Column: role.FieldDescription},
+			role.FieldCreatedAt:   {Type: field.TypeTime, Column: role.FieldCreatedAt},
+			role.FieldUpdatedAt:   {Type: field.TypeTime, Column: role.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[18] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   signingkey.Table,
+			Columns: signingkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: signingkey.FieldID,
+			},
+		},
+		Type: "SigningKey",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			signingkey.FieldKid:         {Type: field.TypeString, Column: signingkey.FieldKid},
+			signingkey.FieldAlgorithm:   {Type: field.TypeString, Column: signingkey.FieldAlgorithm},
+			signingkey.FieldPrivateKey:  {Type: field.TypeString, Column: signingkey.FieldPrivateKey},
+			signingkey.FieldActivatesAt: {Type: field.TypeTime, Column: signingkey.FieldActivatesAt},
+			signingkey.FieldRetiredAt:   {Type: field.TypeTime, Column: signingkey.FieldRetiredAt},
+			signingkey.FieldCreatedAt:   {Type: field.TypeTime, Column: signingkey.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[19] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   user.Table,
+			Columns: user.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: user.FieldID,
+			},
+		},
+		Type: "User",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			user.FieldAppID:             {Type: field.TypeInt, Column: user.FieldAppID},
+			user.FieldFirstname:         {Type: field.TypeString, Column: user.FieldFirstname},
+			user.FieldLastname:          {Type: field.TypeString, Column: user.FieldLastname},
+			user.FieldEmail:             {Type: field.TypeString, Column: user.FieldEmail},
+			user.FieldPassword:          {Type: field.TypeString, Column: user.FieldPassword},
+			user.FieldPasswordChangedAt: {Type: field.TypeTime, Column: user.FieldPasswordChangedAt},
+			user.FieldStatus:            {Type: field.TypeInt8, Column: user.FieldStatus},
+			user.FieldPlatformAdmin:     {Type: field.TypeBool, Column: user.FieldPlatformAdmin},
+			user.FieldTokensValidAfter:  {Type: field.TypeTime, Column: user.FieldTokensValidAfter},
+			user.FieldEmailVerifiedAt:   {Type: field.TypeTime, Column: user.FieldEmailVerifiedAt},
+			user.FieldTotpSecret:        {Type: field.TypeString, Column: user.FieldTotpSecret},
+			user.FieldTotpConfirmedAt:   {Type: field.TypeTime, Column: user.FieldTotpConfirmedAt},
+			user.FieldTotpLastStep:      {Type: field.TypeInt64, Column: user.FieldTotpLastStep},
+			user.FieldFailedLogins:      {Type: field.TypeInt, Column: user.FieldFailedLogins},
+			user.FieldLastFailedLoginAt: {Type: field.TypeTime, Column: user.FieldLastFailedLoginAt},
+			user.FieldLockedUntil:       {Type: field.TypeTime, Column: user.FieldLockedUntil},
+			user.FieldCreatedAt:         {Type: field.TypeTime, Column: user.FieldCreatedAt},
+			user.FieldUpdatedAt:         {Type: field.TypeTime, Column: user.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[20] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webauthnchallenge.Table,
+			Columns: webauthnchallenge.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webauthnchallenge.FieldID,
+			},
+		},
+		Type: "WebAuthnChallenge",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webauthnchallenge.FieldChallengeHash: {Type: field.TypeString, Column: webauthnchallenge.FieldChallengeHash},
+			webauthnchallenge.FieldCeremony:      {Type: field.TypeEnum, Column: webauthnchallenge.FieldCeremony},
+			webauthnchallenge.FieldAppID:         {Type: field.TypeInt, Column: webauthnchallenge.FieldAppID},
+			webauthnchallenge.FieldUserID:        {Type: field.TypeInt, Column: webauthnchallenge.FieldUserID},
+			webauthnchallenge.FieldExpiresAt:     {Type: field.TypeTime, Column: webauthnchallenge.FieldExpiresAt},
+			webauthnchallenge.FieldUsedAt:        {Type: field.TypeTime, Column: webauthnchallenge.FieldUsedAt},
+			webauthnchallenge.FieldCreatedAt:     {Type: field.TypeTime, Column: webauthnchallenge.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[21] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webauthncredential.Table,
+			Columns: webauthncredential.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webauthncredential.FieldID,
+			},
+		},
+		Type: "WebAuthnCredential",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webauthncredential.FieldUserID:         {Type: field.TypeInt, Column: webauthncredential.FieldUserID},
+			webauthncredential.FieldCredentialID:   {Type: field.TypeBytes, Column: webauthncredential.FieldCredentialID},
+			webauthncredential.FieldPublicKey:      {Type: field.TypeBytes, Column: webauthncredential.FieldPublicKey},
+			webauthncredential.FieldSignCount:      {Type: field.TypeUint32, Column: webauthncredential.FieldSignCount},
+			webauthncredential.FieldTransports:     {Type: field.TypeJSON, Column: webauthncredential.FieldTransports},
+			webauthncredential.FieldAaguid:         {Type: field.TypeBytes, Column: webauthncredential.FieldAaguid},
+			webauthncredential.FieldBackupEligible: {Type: field.TypeBool, Column: webauthncredential.FieldBackupEligible},
+			webauthncredential.FieldBackedUp:       {Type: field.TypeBool, Column: webauthncredential.FieldBackedUp},
+			webauthncredential.FieldName:           {Type: field.TypeString, Column: webauthncredential.FieldName},
+			webauthncredential.FieldLastUsedAt:     {Type: field.TypeTime, Column: webauthncredential.FieldLastUsedAt},
+			webauthncredential.FieldCreatedAt:      {Type: field.TypeTime, Column: webauthncredential.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[22] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhook.FieldID,
+			},
+		},
+		Type: "Webhook",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhook.FieldAppID:       {Type: field.TypeInt, Column: webhook.FieldAppID},
+			webhook.FieldURL:         {Type: field.TypeString, Column: webhook.FieldURL},
+			webhook.FieldSecret:      {Type: field.TypeString, Column: webhook.FieldSecret},
+			webhook.FieldEvents:      {Type: field.TypeJSON, Column: webhook.FieldEvents},
+			webhook.FieldEnabled:     {Type: field.TypeBool, Column: webhook.FieldEnabled},
+			webhook.FieldDescription: {Type: field.TypeString, Column: webhook.FieldDescription},
+			webhook.FieldCreatedAt:   {Type: field.TypeTime, Column: webhook.FieldCreatedAt},
+			webhook.FieldUpdatedAt:   {Type: field.TypeTime, Column: webhook.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[23] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+		Type: "WebhookDelivery",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhookdelivery.FieldWebhookID:      {Type: field.TypeInt, Column: webhookdelivery.FieldWebhookID},
+			webhookdelivery.FieldAppID:          {Type: field.TypeInt, Column: webhookdelivery.FieldAppID},
+			webhookdelivery.FieldEventID:        {Type: field.TypeString, Column: webhookdelivery.FieldEventID},
+			webhookdelivery.FieldEvent:          {Type: field.TypeString, Column: webhookdelivery.FieldEvent},
+			webhookdelivery.FieldPayload:        {Type: field.TypeString, Column: webhookdelivery.FieldPayload},
+			webhookdelivery.FieldStatus:         {Type: field.TypeEnum, Column: webhookdelivery.FieldStatus},
+			webhookdelivery.FieldAttempts:       {Type: field.TypeInt, Column: webhookdelivery.FieldAttempts},
+			webhookdelivery.FieldNextAttemptAt:  {Type: field.TypeTime, Column: webhookdelivery.FieldNextAttemptAt},
+			webhookdelivery.FieldLastError:      {Type: field.TypeString, Column: webhookdelivery.FieldLastError},
+			webhookdelivery.FieldResponseStatus: {Type: field.TypeInt, Column: webhookdelivery.FieldResponseStatus},
+			webhookdelivery.FieldDeliveredAt:    {Type: field.TypeTime, Column: webhookdelivery.FieldDeliveredAt},
+			webhookdelivery.FieldCreatedAt:      {Type: field.TypeTime, Column: webhookdelivery.FieldCreatedAt},
+		},
+	}
+	graph.MustAddE(
+		"users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.UsersTable,
+			Columns: []string{app.UsersColumn},
+			Bidi:    false,
+		},
+		"App",
+		"User",
+	)
+	graph.MustAddE(
+		"authorization_codes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthorizationCodesTable,
+			Columns: []string{app.AuthorizationCodesColumn},
+			Bidi:    false,
+		},
+		"App",
+		"AuthorizationCode",
+	)
+	graph.MustAddE(
+		"roles",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.RolesTable,
+			Columns: []string{app.RolesColumn},
+			Bidi:    false,
+		},
+		"App",
+		"Role",
+	)
+	graph.MustAddE(
+		"email_templates",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.EmailTemplatesTable,
+			Columns: []string{app.EmailTemplatesColumn},
+			Bidi:    false,
+		},
+		"App",
+		"EmailTemplate",
+	)
+	graph.MustAddE(
+		"webauthn_challenges",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebauthnChallengesTable,
+			Columns: []string{app.WebauthnChallengesColumn},
+			Bidi:    false,
+		},
+		"App",
+		"WebAuthnChallenge",
+	)
+	graph.MustAddE(
+		"lockouts",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.LockoutsTable,
+			Columns: []string{app.LockoutsColumn},
+			Bidi:    false,
+		},
+		"App",
+		"Lockout",
+	)
+	graph.MustAddE(
+		"webhooks",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhooksTable,
+			Columns: []string{app.WebhooksColumn},
+			Bidi:    false,
+		},
+		"App",
+		"Webhook",
+	)
+	graph.MustAddE(
+		"app",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.AppTable,
+			Columns: []string{authorizationcode.AppColumn},
+			Bidi:    false,
+		},
+		"AuthorizationCode",
+		"App",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   authorizationcode.UserTable,
+			Columns: []string{authorizationcode.UserColumn},
+			Bidi:    false,
+		},
+		"AuthorizationCode",
+		"User",
+	)
+	graph.MustAddE(
+		"app",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailtemplate.AppTable,
+			Columns: []string{emailtemplate.AppColumn},
+			Bidi:    false,
+		},
+		"EmailTemplate",
+		"App",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   emailverificationtoken.UserTable,
+			Columns: []string{emailverificationtoken.UserColumn},
+			Bidi:    false,
+		},
+		"EmailVerificationToken",
+		"User",
+	)
+	graph.MustAddE(
+		"app",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.AppTable,
+			Columns: []string{lockout.AppColumn},
+			Bidi:    false,
+		},
+		"Lockout",
+		"App",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.UserTable,
+			Columns: []string{lockout.UserColumn},
+			Bidi:    false,
+		},
+		"Lockout",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfachallenge.UserTable,
+			Columns: []string{mfachallenge.UserColumn},
+			Bidi:    false,
+		},
+		"MFAChallenge",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfarecoverycode.UserTable,
+			Columns: []string{mfarecoverycode.UserColumn},
+			Bidi:    false,
+		},
+		"MFARecoveryCode",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclinktoken.UserTable,
+			Columns: []string{magiclinktoken.UserColumn},
+			Bidi:    false,
+		},
+		"MagicLinkToken",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordhistory.UserTable,
+			Columns: []string{passwordhistory.UserColumn},
+			Bidi:    false,
+		},
+		"PasswordHistory",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordresettoken.UserTable,
+			Columns: []string{passwordresettoken.UserColumn},
+			Bidi:    false,
+		},
+		"PasswordResetToken",
+		"User",
+	)
+	graph.MustAddE(
+		"roles",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   permission.RolesTable,
+			Columns: permission.RolesPrimaryKey,
+			Bidi:    false,
+		},
+		"Permission",
+		"Role",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+		},
+		"RefreshToken",
+		"User",
+	)
+	graph.MustAddE(
+		"app",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   role.AppTable,
+			Columns: []string{role.AppColumn},
+			Bidi:    false,
+		},
+		"Role",
+		"App",
+	)
+	graph.MustAddE(
+		"permissions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   role.PermissionsTable,
+			Columns: role.PermissionsPrimaryKey,
+			Bidi:    false,
+		},
+		"Role",
+		"Permission",
+	)
+	graph.MustAddE(
+		"users",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   role.UsersTable,
+			Columns: role.UsersPrimaryKey,
+			Bidi:    false,
+		},
+		"Role",
+		"User",
+	)
+	graph.MustAddE(
+		"app",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.AppTable,
+			Columns: []string{user.AppColumn},
+			Bidi:    false,
+		},
+		"User",
+		"App",
+	)
+	graph.MustAddE(
+		"refresh_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"RefreshToken",
+	)
+	graph.MustAddE(
+		"authorization_codes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthorizationCodesTable,
+			Columns: []string{user.AuthorizationCodesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"AuthorizationCode",
+	)
+	graph.MustAddE(
+		"password_reset_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordResetTokensTable,
+			Columns: []string{user.PasswordResetTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"PasswordResetToken",
+	)
+	graph.MustAddE(
+		"email_verification_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailVerificationTokensTable,
+			Columns: []string{user.EmailVerificationTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"EmailVerificationToken",
+	)
+	graph.MustAddE(
+		"mfa_recovery_codes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MfaRecoveryCodesTable,
+			Columns: []string{user.MfaRecoveryCodesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"MFARecoveryCode",
+	)
+	graph.MustAddE(
+		"mfa_challenges",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MfaChallengesTable,
+			Columns: []string{user.MfaChallengesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"MFAChallenge",
+	)
+	graph.MustAddE(
+		"webauthn_credentials",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnCredentialsTable,
+			Columns: []string{user.WebauthnCredentialsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"WebAuthnCredential",
+	)
+	graph.MustAddE(
+		"webauthn_challenges",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebauthnChallengesTable,
+			Columns: []string{user.WebauthnChallengesColumn},
+			Bidi:    false,
+		},
+		"User",
+		"WebAuthnChallenge",
+	)
+	graph.MustAddE(
+		"lockouts",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LockoutsTable,
+			Columns: []string{user.LockoutsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Lockout",
+	)
+	graph.MustAddE(
+		"password_history",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordHistoryTable,
+			Columns: []string{user.PasswordHistoryColumn},
+			Bidi:    false,
+		},
+		"User",
+		"PasswordHistory",
+	)
+	graph.MustAddE(
+		"magic_link_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MagicLinkTokensTable,
+			Columns: []string{user.MagicLinkTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"MagicLinkToken",
+	)
+	graph.MustAddE(
+		"roles",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   user.RolesTable,
+			Columns: user.RolesPrimaryKey,
+			Bidi:    false,
+		},
+		"User",
+		"Role",
+	)
+	graph.MustAddE(
+		"app",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.AppTable,
+			Columns: []string{webauthnchallenge.AppColumn},
+			Bidi:    false,
+		},
+		"WebAuthnChallenge",
+		"App",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.UserTable,
+			Columns: []string{webauthnchallenge.UserColumn},
+			Bidi:    false,
+		},
+		"WebAuthnChallenge",
+		"User",
+	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthncredential.UserTable,
+			Columns: []string{webauthncredential.UserColumn},
+			Bidi:    false,
+		},
+		"WebAuthnCredential",
+		"User",
+	)
+	graph.MustAddE(
+		"app",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.AppTable,
+			Columns: []string{webhook.AppColumn},
+			Bidi:    false,
+		},
+		"Webhook",
+		"App",
+	)
+	graph.MustAddE(
+		"deliveries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+		},
+		"Webhook",
+		"WebhookDelivery",
+	)
+	graph.MustAddE(
+		"webhook",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+		},
+		"WebhookDelivery",
+		"Webhook",
+	)
+	return graph
+}()
+
+// predicateAdder wraps the addPredicate method.
+// All update, update-one and query builders implement this interface.
+type predicateAdder interface {
+	addPredicate(func(s *sql.Selector))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *AppQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AppQuery builder.
+func (_q *AppQuery) Filter() *AppFilter {
+	return &AppFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AppMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AppMutation builder.
+func (m *AppMutation) Filter() *AppFilter {
+	return &AppFilter{config: m.config, predicateAdder: m}
+}
+
+// AppFilter provides a generic filtering capability at runtime for AppQuery.
+type AppFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AppFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AppFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(app.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *AppFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(app.FieldName))
+}
+
+// WhereClientID applies the entql string predicate on the client_id field.
+func (f *AppFilter) WhereClientID(p entql.StringP) {
+	f.Where(p.Field(app.FieldClientID))
+}
+
+// WhereRedirectUris applies the entql json.RawMessage predicate on the redirect_uris field.
+func (f *AppFilter) WhereRedirectUris(p entql.BytesP) {
+	f.Where(p.Field(app.FieldRedirectUris))
+}
+
+// WhereClientSecretHash applies the entql string predicate on the client_secret_hash field.
+func (f *AppFilter) WhereClientSecretHash(p entql.StringP) {
+	f.Where(p.Field(app.FieldClientSecretHash))
+}
+
+// WhereScopes applies the entql json.RawMessage predicate on the scopes field.
+func (f *AppFilter) WhereScopes(p entql.BytesP) {
+	f.Where(p.Field(app.FieldScopes))
+}
+
+// WhereScimTokenHash applies the entql string predicate on the scim_token_hash field.
+func (f *AppFilter) WhereScimTokenHash(p entql.StringP) {
+	f.Where(p.Field(app.FieldScimTokenHash))
+}
+
+// WhereRequireVerifiedEmail applies the entql bool predicate on the require_verified_email field.
+func (f *AppFilter) WhereRequireVerifiedEmail(p entql.BoolP) {
+	f.Where(p.Field(app.FieldRequireVerifiedEmail))
+}
+
+// WhereRequireMfa applies the entql bool predicate on the require_mfa field.
+func (f *AppFilter) WhereRequireMfa(p entql.BoolP) {
+	f.Where(p.Field(app.FieldRequireMfa))
+}
+
+// WhereMagicLinkEnabled applies the entql bool predicate on the magic_link_enabled field.
+func (f *AppFilter) WhereMagicLinkEnabled(p entql.BoolP) {
+	f.Where(p.Field(app.FieldMagicLinkEnabled))
+}
+
+// WhereWebauthnRpID applies the entql string predicate on the webauthn_rp_id field.
+func (f *AppFilter) WhereWebauthnRpID(p entql.StringP) {
+	f.Where(p.Field(app.FieldWebauthnRpID))
+}
+
+// WhereWebauthnOrigins applies the entql json.RawMessage predicate on the webauthn_origins field.
+func (f *AppFilter) WhereWebauthnOrigins(p entql.BytesP) {
+	f.Where(p.Field(app.FieldWebauthnOrigins))
+}
+
+// WhereMaxLoginAttempts applies the entql int predicate on the max_login_attempts field.
+func (f *AppFilter) WhereMaxLoginAttempts(p entql.IntP) {
+	f.Where(p.Field(app.FieldMaxLoginAttempts))
+}
+
+// WhereLockoutDuration applies the entql int predicate on the lockout_duration field.
+func (f *AppFilter) WhereLockoutDuration(p entql.IntP) {
+	f.Where(p.Field(app.FieldLockoutDuration))
+}
+
+// WhereLoginDelay applies the entql int predicate on the login_delay field.
+func (f *AppFilter) WhereLoginDelay(p entql.IntP) {
+	f.Where(p.Field(app.FieldLoginDelay))
+}
+
+// WhereMaxIPLoginAttempts applies the entql int predicate on the max_ip_login_attempts field.
+func (f *AppFilter) WhereMaxIPLoginAttempts(p entql.IntP) {
+	f.Where(p.Field(app.FieldMaxIPLoginAttempts))
+}
+
+// WherePasswordPolicy applies the entql json.RawMessage predicate on the password_policy field.
+func (f *AppFilter) WherePasswordPolicy(p entql.BytesP) {
+	f.Where(p.Field(app.FieldPasswordPolicy))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *AppFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(app.FieldStatus))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AppFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(app.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *AppFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(app.FieldUpdatedAt))
+}
+
+// WhereHasUsers applies a predicate to check if query has an edge users.
+func (f *AppFilter) WhereHasUsers() {
+	f.Where(entql.HasEdge("users"))
+}
+
+// WhereHasUsersWith applies a predicate to check if query has an edge users with a given conditions (other predicates).
+func (f *AppFilter) WhereHasUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAuthorizationCodes applies a predicate to check if query has an edge authorization_codes.
+func (f *AppFilter) WhereHasAuthorizationCodes() {
+	f.Where(entql.HasEdge("authorization_codes"))
+}
+
+// WhereHasAuthorizationCodesWith applies a predicate to check if query has an edge authorization_codes with a given conditions (other predicates).
+func (f *AppFilter) WhereHasAuthorizationCodesWith(preds ...predicate.AuthorizationCode) {
+	f.Where(entql.HasEdgeWith("authorization_codes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRoles applies a predicate to check if query has an edge roles.
+func (f *AppFilter) WhereHasRoles() {
+	f.Where(entql.HasEdge("roles"))
+}
+
+// WhereHasRolesWith applies a predicate to check if query has an edge roles with a given conditions (other predicates).
+func (f *AppFilter) WhereHasRolesWith(preds ...predicate.Role) {
+	f.Where(entql.HasEdgeWith("roles", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasEmailTemplates applies a predicate to check if query has an edge email_templates.
+func (f *AppFilter) WhereHasEmailTemplates() {
+	f.Where(entql.HasEdge("email_templates"))
+}
+
+// WhereHasEmailTemplatesWith applies a predicate to check if query has an edge email_templates with a given conditions (other predicates).
+func (f *AppFilter) WhereHasEmailTemplatesWith(preds ...predicate.EmailTemplate) {
+	f.Where(entql.HasEdgeWith("email_templates", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWebauthnChallenges applies a predicate to check if query has an edge webauthn_challenges.
+func (f *AppFilter) WhereHasWebauthnChallenges() {
+	f.Where(entql.HasEdge("webauthn_challenges"))
+}
+
+// WhereHasWebauthnChallengesWith applies a predicate to check if query has an edge webauthn_challenges with a given conditions (other predicates).
+func (f *AppFilter) WhereHasWebauthnChallengesWith(preds ...predicate.WebAuthnChallenge) {
+	f.Where(entql.HasEdgeWith("webauthn_challenges", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasLockouts applies a predicate to check if query has an edge lockouts.
+func (f *AppFilter) WhereHasLockouts() {
+	f.Where(entql.HasEdge("lockouts"))
+}
+
+// WhereHasLockoutsWith applies a predicate to check if query has an edge lockouts with a given conditions (other predicates).
+func (f *AppFilter) WhereHasLockoutsWith(preds ...predicate.Lockout) {
+	f.Where(entql.HasEdgeWith("lockouts", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWebhooks applies a predicate to check if query has an edge webhooks.
+func (f *AppFilter) WhereHasWebhooks() {
+	f.Where(entql.HasEdge("webhooks"))
+}
+
+// WhereHasWebhooksWith applies a predicate to check if query has an edge webhooks with a given conditions (other predicates).
+func (f *AppFilter) WhereHasWebhooksWith(preds ...predicate.Webhook) {
+	f.Where(entql.HasEdgeWith("webhooks", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *AuditHeadQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuditHeadQuery builder.
+func (_q *AuditHeadQuery) Filter() *AuditHeadFilter {
+	return &AuditHeadFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuditHeadMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuditHeadMutation builder.
+func (m *AuditHeadMutation) Filter() *AuditHeadFilter {
+	return &AuditHeadFilter{config: m.config, predicateAdder: m}
+}
+
+// AuditHeadFilter provides a generic filtering capability at runtime for AuditHeadQuery.
+type AuditHeadFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuditHeadFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuditHeadFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(audithead.FieldID))
+}
+
+// WhereAppended applies the entql int predicate on the appended field.
+func (f *AuditHeadFilter) WhereAppended(p entql.IntP) {
+	f.Where(p.Field(audithead.FieldAppended))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *AuditLogQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuditLogQuery builder.
+func (_q *AuditLogQuery) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuditLogMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuditLogMutation builder.
+func (m *AuditLogMutation) Filter() *AuditLogFilter {
+	return &AuditLogFilter{config: m.config, predicateAdder: m}
+}
+
+// AuditLogFilter provides a generic filtering capability at runtime for AuditLogQuery.
+type AuditLogFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuditLogFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuditLogFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldID))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *AuditLogFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldAppID))
+}
+
+// WhereActorType applies the entql string predicate on the actor_type field.
+func (f *AuditLogFilter) WhereActorType(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldActorType))
+}
+
+// WhereActorID applies the entql int predicate on the actor_id field.
+func (f *AuditLogFilter) WhereActorID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldActorID))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *AuditLogFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldAction))
+}
+
+// WhereTargetType applies the entql string predicate on the target_type field.
+func (f *AuditLogFilter) WhereTargetType(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldTargetType))
+}
+
+// WhereTargetID applies the entql int predicate on the target_id field.
+func (f *AuditLogFilter) WhereTargetID(p entql.IntP) {
+	f.Where(p.Field(auditlog.FieldTargetID))
+}
+
+// WhereChanges applies the entql string predicate on the changes field.
+func (f *AuditLogFilter) WhereChanges(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldChanges))
+}
+
+// WhereMetadata applies the entql string predicate on the metadata field.
+func (f *AuditLogFilter) WhereMetadata(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldMetadata))
+}
+
+// WhereIP applies the entql string predicate on the ip field.
+func (f *AuditLogFilter) WhereIP(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldIP))
+}
+
+// WhereRequestID applies the entql string predicate on the request_id field.
+func (f *AuditLogFilter) WhereRequestID(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldRequestID))
+}
+
+// WherePrevHash applies the entql string predicate on the prev_hash field.
+func (f *AuditLogFilter) WherePrevHash(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldPrevHash))
+}
+
+// WhereHash applies the entql string predicate on the hash field.
+func (f *AuditLogFilter) WhereHash(p entql.StringP) {
+	f.Where(p.Field(auditlog.FieldHash))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AuditLogFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(auditlog.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *AuthorizationCodeQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuthorizationCodeQuery builder.
+func (_q *AuthorizationCodeQuery) Filter() *AuthorizationCodeFilter {
+	return &AuthorizationCodeFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuthorizationCodeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuthorizationCodeMutation builder.
+func (m *AuthorizationCodeMutation) Filter() *AuthorizationCodeFilter {
+	return &AuthorizationCodeFilter{config: m.config, predicateAdder: m}
+}
+
+// AuthorizationCodeFilter provides a generic filtering capability at runtime for AuthorizationCodeQuery.
+type AuthorizationCodeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuthorizationCodeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuthorizationCodeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(authorizationcode.FieldID))
+}
+
+// WhereCodeHash applies the entql string predicate on the code_hash field.
+func (f *AuthorizationCodeFilter) WhereCodeHash(p entql.StringP) {
+	f.Where(p.Field(authorizationcode.FieldCodeHash))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *AuthorizationCodeFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(authorizationcode.FieldAppID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *AuthorizationCodeFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(authorizationcode.FieldUserID))
+}
+
+// WhereRedirectURI applies the entql string predicate on the redirect_uri field.
+func (f *AuthorizationCodeFilter) WhereRedirectURI(p entql.StringP) {
+	f.Where(p.Field(authorizationcode.FieldRedirectURI))
+}
+
+// WhereScope applies the entql string predicate on the scope field.
+func (f *AuthorizationCodeFilter) WhereScope(p entql.StringP) {
+	f.Where(p.Field(authorizationcode.FieldScope))
+}
+
+// WhereNonce applies the entql string predicate on the nonce field.
+func (f *AuthorizationCodeFilter) WhereNonce(p entql.StringP) {
+	f.Where(p.Field(authorizationcode.FieldNonce))
+}
+
+// WhereCodeChallenge applies the entql string predicate on the code_challenge field.
+func (f *AuthorizationCodeFilter) WhereCodeChallenge(p entql.StringP) {
+	f.Where(p.Field(authorizationcode.FieldCodeChallenge))
+}
+
+// WhereCodeChallengeMethod applies the entql string predicate on the code_challenge_method field.
+func (f *AuthorizationCodeFilter) WhereCodeChallengeMethod(p entql.StringP) {
+	f.Where(p.Field(authorizationcode.FieldCodeChallengeMethod))
+}
+
+// WhereAuthTime applies the entql time.Time predicate on the auth_time field.
+func (f *AuthorizationCodeFilter) WhereAuthTime(p entql.TimeP) {
+	f.Where(p.Field(authorizationcode.FieldAuthTime))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *AuthorizationCodeFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(authorizationcode.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *AuthorizationCodeFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(authorizationcode.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *AuthorizationCodeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(authorizationcode.FieldCreatedAt))
+}
+
+// WhereHasApp applies a predicate to check if query has an edge app.
+func (f *AuthorizationCodeFilter) WhereHasApp() {
+	f.Where(entql.HasEdge("app"))
+}
+
+// WhereHasAppWith applies a predicate to check if query has an edge app with a given conditions (other predicates).
+func (f *AuthorizationCodeFilter) WhereHasAppWith(preds ...predicate.App) {
+	f.Where(entql.HasEdgeWith("app", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *AuthorizationCodeFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *AuthorizationCodeFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *EmailTemplateQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the EmailTemplateQuery builder.
+func (_q *EmailTemplateQuery) Filter() *EmailTemplateFilter {
+	return &EmailTemplateFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *EmailTemplateMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the EmailTemplateMutation builder.
+func (m *EmailTemplateMutation) Filter() *EmailTemplateFilter {
+	return &EmailTemplateFilter{config: m.config, predicateAdder: m}
+}
+
+// EmailTemplateFilter provides a generic filtering capability at runtime for EmailTemplateQuery.
+type EmailTemplateFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *EmailTemplateFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *EmailTemplateFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(emailtemplate.FieldID))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *EmailTemplateFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(emailtemplate.FieldAppID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *EmailTemplateFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(emailtemplate.FieldName))
+}
+
+// WhereSubject applies the entql string predicate on the subject field.
+func (f *EmailTemplateFilter) WhereSubject(p entql.StringP) {
+	f.Where(p.Field(emailtemplate.FieldSubject))
+}
+
+// WhereHTML applies the entql string predicate on the html field.
+func (f *EmailTemplateFilter) WhereHTML(p entql.StringP) {
+	f.Where(p.Field(emailtemplate.FieldHTML))
+}
+
+// WhereText applies the entql string predicate on the text field.
+func (f *EmailTemplateFilter) WhereText(p entql.StringP) {
+	f.Where(p.Field(emailtemplate.FieldText))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *EmailTemplateFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(emailtemplate.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *EmailTemplateFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(emailtemplate.FieldUpdatedAt))
+}
+
+// WhereHasApp applies a predicate to check if query has an edge app.
+func (f *EmailTemplateFilter) WhereHasApp() {
+	f.Where(entql.HasEdge("app"))
+}
+
+// WhereHasAppWith applies a predicate to check if query has an edge app with a given conditions (other predicates).
+func (f *EmailTemplateFilter) WhereHasAppWith(preds ...predicate.App) {
+	f.Where(entql.HasEdgeWith("app", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *EmailVerificationTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the EmailVerificationTokenQuery builder.
+func (_q *EmailVerificationTokenQuery) Filter() *EmailVerificationTokenFilter {
+	return &EmailVerificationTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *EmailVerificationTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the EmailVerificationTokenMutation builder.
+func (m *EmailVerificationTokenMutation) Filter() *EmailVerificationTokenFilter {
+	return &EmailVerificationTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// EmailVerificationTokenFilter provides a generic filtering capability at runtime for EmailVerificationTokenQuery.
+type EmailVerificationTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *EmailVerificationTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *EmailVerificationTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(emailverificationtoken.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *EmailVerificationTokenFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(emailverificationtoken.FieldUserID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *EmailVerificationTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(emailverificationtoken.FieldTokenHash))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *EmailVerificationTokenFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(emailverificationtoken.FieldEmail))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *EmailVerificationTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(emailverificationtoken.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *EmailVerificationTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(emailverificationtoken.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *EmailVerificationTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(emailverificationtoken.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *EmailVerificationTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *EmailVerificationTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LockoutQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LockoutQuery builder.
+func (_q *LockoutQuery) Filter() *LockoutFilter {
+	return &LockoutFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LockoutMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LockoutMutation builder.
+func (m *LockoutMutation) Filter() *LockoutFilter {
+	return &LockoutFilter{config: m.config, predicateAdder: m}
+}
+
+// LockoutFilter provides a generic filtering capability at runtime for LockoutQuery.
+type LockoutFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LockoutFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *LockoutFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(lockout.FieldID))
+}
+
+// WhereKind applies the entql string predicate on the kind field.
+func (f *LockoutFilter) WhereKind(p entql.StringP) {
+	f.Where(p.Field(lockout.FieldKind))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *LockoutFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(lockout.FieldAppID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *LockoutFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(lockout.FieldUserID))
+}
+
+// WhereIP applies the entql string predicate on the ip field.
+func (f *LockoutFilter) WhereIP(p entql.StringP) {
+	f.Where(p.Field(lockout.FieldIP))
+}
+
+// WhereFailures applies the entql int predicate on the failures field.
+func (f *LockoutFilter) WhereFailures(p entql.IntP) {
+	f.Where(p.Field(lockout.FieldFailures))
+}
+
+// WhereLockedUntil applies the entql time.Time predicate on the locked_until field.
+func (f *LockoutFilter) WhereLockedUntil(p entql.TimeP) {
+	f.Where(p.Field(lockout.FieldLockedUntil))
+}
+
+// WhereUnlockedAt applies the entql time.Time predicate on the unlocked_at field.
+func (f *LockoutFilter) WhereUnlockedAt(p entql.TimeP) {
+	f.Where(p.Field(lockout.FieldUnlockedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *LockoutFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(lockout.FieldCreatedAt))
+}
+
+// WhereHasApp applies a predicate to check if query has an edge app.
+func (f *LockoutFilter) WhereHasApp() {
+	f.Where(entql.HasEdge("app"))
+}
+
+// WhereHasAppWith applies a predicate to check if query has an edge app with a given conditions (other predicates).
+func (f *LockoutFilter) WhereHasAppWith(preds ...predicate.App) {
+	f.Where(entql.HasEdgeWith("app", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *LockoutFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *LockoutFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *LoginThrottleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the LoginThrottleQuery builder.
+func (_q *LoginThrottleQuery) Filter() *LoginThrottleFilter {
+	return &LoginThrottleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *LoginThrottleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the LoginThrottleMutation builder.
+func (m *LoginThrottleMutation) Filter() *LoginThrottleFilter {
+	return &LoginThrottleFilter{config: m.config, predicateAdder: m}
+}
+
+// LoginThrottleFilter provides a generic filtering capability at runtime for LoginThrottleQuery.
+type LoginThrottleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *LoginThrottleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *LoginThrottleFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(loginthrottle.FieldID))
+}
+
+// WhereIP applies the entql string predicate on the ip field.
+func (f *LoginThrottleFilter) WhereIP(p entql.StringP) {
+	f.Where(p.Field(loginthrottle.FieldIP))
+}
+
+// WhereFailures applies the entql int predicate on the failures field.
+func (f *LoginThrottleFilter) WhereFailures(p entql.IntP) {
+	f.Where(p.Field(loginthrottle.FieldFailures))
+}
+
+// WhereLastFailureAt applies the entql time.Time predicate on the last_failure_at field.
+func (f *LoginThrottleFilter) WhereLastFailureAt(p entql.TimeP) {
+	f.Where(p.Field(loginthrottle.FieldLastFailureAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MFAChallengeQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MFAChallengeQuery builder.
+func (_q *MFAChallengeQuery) Filter() *MFAChallengeFilter {
+	return &MFAChallengeFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MFAChallengeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MFAChallengeMutation builder.
+func (m *MFAChallengeMutation) Filter() *MFAChallengeFilter {
+	return &MFAChallengeFilter{config: m.config, predicateAdder: m}
+}
+
+// MFAChallengeFilter provides a generic filtering capability at runtime for MFAChallengeQuery.
+type MFAChallengeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MFAChallengeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *MFAChallengeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(mfachallenge.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *MFAChallengeFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(mfachallenge.FieldUserID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *MFAChallengeFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(mfachallenge.FieldTokenHash))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *MFAChallengeFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(mfachallenge.FieldAttempts))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *MFAChallengeFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(mfachallenge.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *MFAChallengeFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(mfachallenge.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MFAChallengeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(mfachallenge.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *MFAChallengeFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *MFAChallengeFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MFARecoveryCodeQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MFARecoveryCodeQuery builder.
+func (_q *MFARecoveryCodeQuery) Filter() *MFARecoveryCodeFilter {
+	return &MFARecoveryCodeFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MFARecoveryCodeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MFARecoveryCodeMutation builder.
+func (m *MFARecoveryCodeMutation) Filter() *MFARecoveryCodeFilter {
+	return &MFARecoveryCodeFilter{config: m.config, predicateAdder: m}
+}
+
+// MFARecoveryCodeFilter provides a generic filtering capability at runtime for MFARecoveryCodeQuery.
+type MFARecoveryCodeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MFARecoveryCodeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *MFARecoveryCodeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(mfarecoverycode.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *MFARecoveryCodeFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(mfarecoverycode.FieldUserID))
+}
+
+// WhereCodeHash applies the entql string predicate on the code_hash field.
+func (f *MFARecoveryCodeFilter) WhereCodeHash(p entql.StringP) {
+	f.Where(p.Field(mfarecoverycode.FieldCodeHash))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *MFARecoveryCodeFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(mfarecoverycode.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MFARecoveryCodeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(mfarecoverycode.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *MFARecoveryCodeFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *MFARecoveryCodeFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *MagicLinkTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MagicLinkTokenQuery builder.
+func (_q *MagicLinkTokenQuery) Filter() *MagicLinkTokenFilter {
+	return &MagicLinkTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MagicLinkTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MagicLinkTokenMutation builder.
+func (m *MagicLinkTokenMutation) Filter() *MagicLinkTokenFilter {
+	return &MagicLinkTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// MagicLinkTokenFilter provides a generic filtering capability at runtime for MagicLinkTokenQuery.
+type MagicLinkTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MagicLinkTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *MagicLinkTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(magiclinktoken.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *MagicLinkTokenFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(magiclinktoken.FieldUserID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *MagicLinkTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldTokenHash))
+}
+
+// WhereNonceHash applies the entql string predicate on the nonce_hash field.
+func (f *MagicLinkTokenFilter) WhereNonceHash(p entql.StringP) {
+	f.Where(p.Field(magiclinktoken.FieldNonceHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *MagicLinkTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(magiclinktoken.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *MagicLinkTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(magiclinktoken.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MagicLinkTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(magiclinktoken.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *MagicLinkTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *MagicLinkTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *OutboundEmailQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the OutboundEmailQuery builder.
+func (_q *OutboundEmailQuery) Filter() *OutboundEmailFilter {
+	return &OutboundEmailFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *OutboundEmailMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the OutboundEmailMutation builder.
+func (m *OutboundEmailMutation) Filter() *OutboundEmailFilter {
+	return &OutboundEmailFilter{config: m.config, predicateAdder: m}
+}
+
+// OutboundEmailFilter provides a generic filtering capability at runtime for OutboundEmailQuery.
+type OutboundEmailFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *OutboundEmailFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *OutboundEmailFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(outboundemail.FieldID))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *OutboundEmailFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(outboundemail.FieldAppID))
+}
+
+// WhereTemplate applies the entql string predicate on the template field.
+func (f *OutboundEmailFilter) WhereTemplate(p entql.StringP) {
+	f.Where(p.Field(outboundemail.FieldTemplate))
+}
+
+// WhereRecipient applies the entql string predicate on the recipient field.
+func (f *OutboundEmailFilter) WhereRecipient(p entql.StringP) {
+	f.Where(p.Field(outboundemail.FieldRecipient))
+}
+
+// WhereSubject applies the entql string predicate on the subject field.
+func (f *OutboundEmailFilter) WhereSubject(p entql.StringP) {
+	f.Where(p.Field(outboundemail.FieldSubject))
+}
+
+// WhereHTML applies the entql string predicate on the html field.
+func (f *OutboundEmailFilter) WhereHTML(p entql.StringP) {
+	f.Where(p.Field(outboundemail.FieldHTML))
+}
+
+// WhereText applies the entql string predicate on the text field.
+func (f *OutboundEmailFilter) WhereText(p entql.StringP) {
+	f.Where(p.Field(outboundemail.FieldText))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *OutboundEmailFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(outboundemail.FieldStatus))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *OutboundEmailFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(outboundemail.FieldAttempts))
+}
+
+// WhereNextAttemptAt applies the entql time.Time predicate on the next_attempt_at field.
+func (f *OutboundEmailFilter) WhereNextAttemptAt(p entql.TimeP) {
+	f.Where(p.Field(outboundemail.FieldNextAttemptAt))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *OutboundEmailFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(outboundemail.FieldLastError))
+}
+
+// WhereSentAt applies the entql time.Time predicate on the sent_at field.
+func (f *OutboundEmailFilter) WhereSentAt(p entql.TimeP) {
+	f.Where(p.Field(outboundemail.FieldSentAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *OutboundEmailFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(outboundemail.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PasswordHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasswordHistoryQuery builder.
+func (_q *PasswordHistoryQuery) Filter() *PasswordHistoryFilter {
+	return &PasswordHistoryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasswordHistoryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasswordHistoryMutation builder.
+func (m *PasswordHistoryMutation) Filter() *PasswordHistoryFilter {
+	return &PasswordHistoryFilter{config: m.config, predicateAdder: m}
+}
+
+// PasswordHistoryFilter provides a generic filtering capability at runtime for PasswordHistoryQuery.
+type PasswordHistoryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasswordHistoryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PasswordHistoryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(passwordhistory.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *PasswordHistoryFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(passwordhistory.FieldUserID))
+}
+
+// WherePasswordHash applies the entql string predicate on the password_hash field.
+func (f *PasswordHistoryFilter) WherePasswordHash(p entql.StringP) {
+	f.Where(p.Field(passwordhistory.FieldPasswordHash))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PasswordHistoryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordhistory.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *PasswordHistoryFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *PasswordHistoryFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PasswordResetTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasswordResetTokenQuery builder.
+func (_q *PasswordResetTokenQuery) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasswordResetTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasswordResetTokenMutation builder.
+func (m *PasswordResetTokenMutation) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// PasswordResetTokenFilter provides a generic filtering capability at runtime for PasswordResetTokenQuery.
+type PasswordResetTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasswordResetTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PasswordResetTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(passwordresettoken.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *PasswordResetTokenFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(passwordresettoken.FieldUserID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *PasswordResetTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldTokenHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *PasswordResetTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *PasswordResetTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PasswordResetTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *PasswordResetTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *PasswordResetTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *PermissionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PermissionQuery builder.
+func (_q *PermissionQuery) Filter() *PermissionFilter {
+	return &PermissionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PermissionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PermissionMutation builder.
+func (m *PermissionMutation) Filter() *PermissionFilter {
+	return &PermissionFilter{config: m.config, predicateAdder: m}
+}
+
+// PermissionFilter provides a generic filtering capability at runtime for PermissionQuery.
+type PermissionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PermissionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PermissionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(permission.FieldID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *PermissionFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(permission.FieldName))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PermissionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(permission.FieldCreatedAt))
+}
+
+// WhereHasRoles applies a predicate to check if query has an edge roles.
+func (f *PermissionFilter) WhereHasRoles() {
+	f.Where(entql.HasEdge("roles"))
+}
+
+// WhereHasRolesWith applies a predicate to check if query has an edge roles with a given conditions (other predicates).
+func (f *PermissionFilter) WhereHasRolesWith(preds ...predicate.Role) {
+	f.Where(entql.HasEdgeWith("roles", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RefreshTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RefreshTokenQuery builder.
+func (_q *RefreshTokenQuery) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RefreshTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// RefreshTokenFilter provides a generic filtering capability at runtime for RefreshTokenQuery.
+type RefreshTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RefreshTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RefreshTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(refreshtoken.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *RefreshTokenFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(refreshtoken.FieldUserID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *RefreshTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldTokenHash))
+}
+
+// WhereFamilyID applies the entql string predicate on the family_id field.
+func (f *RefreshTokenFilter) WhereFamilyID(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldFamilyID))
+}
+
+// WhereScope applies the entql string predicate on the scope field.
+func (f *RefreshTokenFilter) WhereScope(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldScope))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *RefreshTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *RefreshTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldUsedAt))
+}
+
+// WhereRevokedAt applies the entql time.Time predicate on the revoked_at field.
+func (f *RefreshTokenFilter) WhereRevokedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldRevokedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RefreshTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *RefreshTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *RefreshTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RevokedTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RevokedTokenQuery builder.
+func (_q *RevokedTokenQuery) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RevokedTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RevokedTokenMutation builder.
+func (m *RevokedTokenMutation) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// RevokedTokenFilter provides a generic filtering capability at runtime for RevokedTokenQuery.
+type RevokedTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RevokedTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RevokedTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(revokedtoken.FieldID))
+}
+
+// WhereJti applies the entql string predicate on the jti field.
+func (f *RevokedTokenFilter) WhereJti(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldJti))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *RevokedTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldExpiresAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RevokedTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RoleQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RoleQuery builder.
+func (_q *RoleQuery) Filter() *RoleFilter {
+	return &RoleFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RoleMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RoleMutation builder.
+func (m *RoleMutation) Filter() *RoleFilter {
+	return &RoleFilter{config: m.config, predicateAdder: m}
+}
+
+// RoleFilter provides a generic filtering capability at runtime for RoleQuery.
+type RoleFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RoleFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RoleFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(role.FieldID))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *RoleFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(role.FieldAppID))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *RoleFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(role.FieldName))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *RoleFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(role.FieldDescription))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RoleFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(role.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *RoleFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(role.FieldUpdatedAt))
+}
+
+// WhereHasApp applies a predicate to check if query has an edge app.
+func (f *RoleFilter) WhereHasApp() {
+	f.Where(entql.HasEdge("app"))
+}
+
+// WhereHasAppWith applies a predicate to check if query has an edge app with a given conditions (other predicates).
+func (f *RoleFilter) WhereHasAppWith(preds ...predicate.App) {
+	f.Where(entql.HasEdgeWith("app", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPermissions applies a predicate to check if query has an edge permissions.
+func (f *RoleFilter) WhereHasPermissions() {
+	f.Where(entql.HasEdge("permissions"))
+}
+
+// WhereHasPermissionsWith applies a predicate to check if query has an edge permissions with a given conditions (other predicates).
+func (f *RoleFilter) WhereHasPermissionsWith(preds ...predicate.Permission) {
+	f.Where(entql.HasEdgeWith("permissions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUsers applies a predicate to check if query has an edge users.
+func (f *RoleFilter) WhereHasUsers() {
+	f.Where(entql.HasEdge("users"))
+}
+
+// WhereHasUsersWith applies a predicate to check if query has an edge users with a given conditions (other predicates).
+func (f *RoleFilter) WhereHasUsersWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("users", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *SigningKeyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the SigningKeyQuery builder.
+func (_q *SigningKeyQuery) Filter() *SigningKeyFilter {
+	return &SigningKeyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *SigningKeyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the SigningKeyMutation builder.
+func (m *SigningKeyMutation) Filter() *SigningKeyFilter {
+	return &SigningKeyFilter{config: m.config, predicateAdder: m}
+}
+
+// SigningKeyFilter provides a generic filtering capability at runtime for SigningKeyQuery.
+type SigningKeyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *SigningKeyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *SigningKeyFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(signingkey.FieldID))
+}
+
+// WhereKid applies the entql string predicate on the kid field.
+func (f *SigningKeyFilter) WhereKid(p entql.StringP) {
+	f.Where(p.Field(signingkey.FieldKid))
+}
+
+// WhereAlgorithm applies the entql string predicate on the algorithm field.
+func (f *SigningKeyFilter) WhereAlgorithm(p entql.StringP) {
+	f.Where(p.Field(signingkey.FieldAlgorithm))
+}
+
+// WherePrivateKey applies the entql string predicate on the private_key field.
+func (f *SigningKeyFilter) WherePrivateKey(p entql.StringP) {
+	f.Where(p.Field(signingkey.FieldPrivateKey))
+}
+
+// WhereActivatesAt applies the entql time.Time predicate on the activates_at field.
+func (f *SigningKeyFilter) WhereActivatesAt(p entql.TimeP) {
+	f.Where(p.Field(signingkey.FieldActivatesAt))
+}
+
+// WhereRetiredAt applies the entql time.Time predicate on the retired_at field.
+func (f *SigningKeyFilter) WhereRetiredAt(p entql.TimeP) {
+	f.Where(p.Field(signingkey.FieldRetiredAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *SigningKeyFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(signingkey.FieldCreatedAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *UserQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserQuery builder.
+func (_q *UserQuery) Filter() *UserFilter {
+	return &UserFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
+func (m *UserMutation) Filter() *UserFilter {
+	return &UserFilter{config: m.config, predicateAdder: m}
+}
+
+// UserFilter provides a generic filtering capability at runtime for UserQuery.
+type UserFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *UserFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(user.FieldID))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *UserFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(user.FieldAppID))
+}
+
+// WhereFirstname applies the entql string predicate on the firstname field.
+func (f *UserFilter) WhereFirstname(p entql.StringP) {
+	f.Where(p.Field(user.FieldFirstname))
+}
+
+// WhereLastname applies the entql string predicate on the lastname field.
+func (f *UserFilter) WhereLastname(p entql.StringP) {
+	f.Where(p.Field(user.FieldLastname))
+}
+
+// WhereEmail applies the entql string predicate on the email field.
+func (f *UserFilter) WhereEmail(p entql.StringP) {
+	f.Where(p.Field(user.FieldEmail))
+}
+
+// WherePassword applies the entql string predicate on the password field.
+func (f *UserFilter) WherePassword(p entql.StringP) {
+	f.Where(p.Field(user.FieldPassword))
+}
+
+// WherePasswordChangedAt applies the entql time.Time predicate on the password_changed_at field.
+func (f *UserFilter) WherePasswordChangedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldPasswordChangedAt))
+}
+
+// WhereStatus applies the entql int8 predicate on the status field.
+func (f *UserFilter) WhereStatus(p entql.Int8P) {
+	f.Where(p.Field(user.FieldStatus))
+}
+
+// WherePlatformAdmin applies the entql bool predicate on the platform_admin field.
+func (f *UserFilter) WherePlatformAdmin(p entql.BoolP) {
+	f.Where(p.Field(user.FieldPlatformAdmin))
+}
+
+// WhereTokensValidAfter applies the entql time.Time predicate on the tokens_valid_after field.
+func (f *UserFilter) WhereTokensValidAfter(p entql.TimeP) {
+	f.Where(p.Field(user.FieldTokensValidAfter))
+}
+
+// WhereEmailVerifiedAt applies the entql time.Time predicate on the email_verified_at field.
+func (f *UserFilter) WhereEmailVerifiedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldEmailVerifiedAt))
+}
+
+// WhereTotpSecret applies the entql string predicate on the totp_secret field.
+func (f *UserFilter) WhereTotpSecret(p entql.StringP) {
+	f.Where(p.Field(user.FieldTotpSecret))
+}
+
+// WhereTotpConfirmedAt applies the entql time.Time predicate on the totp_confirmed_at field.
+func (f *UserFilter) WhereTotpConfirmedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldTotpConfirmedAt))
+}
+
+// WhereTotpLastStep applies the entql int64 predicate on the totp_last_step field.
+func (f *UserFilter) WhereTotpLastStep(p entql.Int64P) {
+	f.Where(p.Field(user.FieldTotpLastStep))
+}
+
+// WhereFailedLogins applies the entql int predicate on the failed_logins field.
+func (f *UserFilter) WhereFailedLogins(p entql.IntP) {
+	f.Where(p.Field(user.FieldFailedLogins))
+}
+
+// WhereLastFailedLoginAt applies the entql time.Time predicate on the last_failed_login_at field.
+func (f *UserFilter) WhereLastFailedLoginAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldLastFailedLoginAt))
+}
+
+// WhereLockedUntil applies the entql time.Time predicate on the locked_until field.
+func (f *UserFilter) WhereLockedUntil(p entql.TimeP) {
+	f.Where(p.Field(user.FieldLockedUntil))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldUpdatedAt))
+}
+
+// WhereHasApp applies a predicate to check if query has an edge app.
+func (f *UserFilter) WhereHasApp() {
+	f.Where(entql.HasEdge("app"))
+}
+
+// WhereHasAppWith applies a predicate to check if query has an edge app with a given conditions (other predicates).
+func (f *UserFilter) WhereHasAppWith(preds ...predicate.App) {
+	f.Where(entql.HasEdgeWith("app", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRefreshTokens applies a predicate to check if query has an edge refresh_tokens.
+func (f *UserFilter) WhereHasRefreshTokens() {
+	f.Where(entql.HasEdge("refresh_tokens"))
+}
+
+// WhereHasRefreshTokensWith applies a predicate to check if query has an edge refresh_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasRefreshTokensWith(preds ...predicate.RefreshToken) {
+	f.Where(entql.HasEdgeWith("refresh_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasAuthorizationCodes applies a predicate to check if query has an edge authorization_codes.
+func (f *UserFilter) WhereHasAuthorizationCodes() {
+	f.Where(entql.HasEdge("authorization_codes"))
+}
+
+// WhereHasAuthorizationCodesWith applies a predicate to check if query has an edge authorization_codes with a given conditions (other predicates).
+func (f *UserFilter) WhereHasAuthorizationCodesWith(preds ...predicate.AuthorizationCode) {
+	f.Where(entql.HasEdgeWith("authorization_codes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPasswordResetTokens applies a predicate to check if query has an edge password_reset_tokens.
+func (f *UserFilter) WhereHasPasswordResetTokens() {
+	f.Where(entql.HasEdge("password_reset_tokens"))
+}
+
+// WhereHasPasswordResetTokensWith applies a predicate to check if query has an edge password_reset_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasPasswordResetTokensWith(preds ...predicate.PasswordResetToken) {
+	f.Where(entql.HasEdgeWith("password_reset_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasEmailVerificationTokens applies a predicate to check if query has an edge email_verification_tokens.
+func (f *UserFilter) WhereHasEmailVerificationTokens() {
+	f.Where(entql.HasEdge("email_verification_tokens"))
+}
+
+// WhereHasEmailVerificationTokensWith applies a predicate to check if query has an edge email_verification_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasEmailVerificationTokensWith(preds ...predicate.EmailVerificationToken) {
+	f.Where(entql.HasEdgeWith("email_verification_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMfaRecoveryCodes applies a predicate to check if query has an edge mfa_recovery_codes.
+func (f *UserFilter) WhereHasMfaRecoveryCodes() {
+	f.Where(entql.HasEdge("mfa_recovery_codes"))
+}
+
+// WhereHasMfaRecoveryCodesWith applies a predicate to check if query has an edge mfa_recovery_codes with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMfaRecoveryCodesWith(preds ...predicate.MFARecoveryCode) {
+	f.Where(entql.HasEdgeWith("mfa_recovery_codes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMfaChallenges applies a predicate to check if query has an edge mfa_challenges.
+func (f *UserFilter) WhereHasMfaChallenges() {
+	f.Where(entql.HasEdge("mfa_challenges"))
+}
+
+// WhereHasMfaChallengesWith applies a predicate to check if query has an edge mfa_challenges with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMfaChallengesWith(preds ...predicate.MFAChallenge) {
+	f.Where(entql.HasEdgeWith("mfa_challenges", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWebauthnCredentials applies a predicate to check if query has an edge webauthn_credentials.
+func (f *UserFilter) WhereHasWebauthnCredentials() {
+	f.Where(entql.HasEdge("webauthn_credentials"))
+}
+
+// WhereHasWebauthnCredentialsWith applies a predicate to check if query has an edge webauthn_credentials with a given conditions (other predicates).
+func (f *UserFilter) WhereHasWebauthnCredentialsWith(preds ...predicate.WebAuthnCredential) {
+	f.Where(entql.HasEdgeWith("webauthn_credentials", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWebauthnChallenges applies a predicate to check if query has an edge webauthn_challenges.
+func (f *UserFilter) WhereHasWebauthnChallenges() {
+	f.Where(entql.HasEdge("webauthn_challenges"))
+}
+
+// WhereHasWebauthnChallengesWith applies a predicate to check if query has an edge webauthn_challenges with a given conditions (other predicates).
+func (f *UserFilter) WhereHasWebauthnChallengesWith(preds ...predicate.WebAuthnChallenge) {
+	f.Where(entql.HasEdgeWith("webauthn_challenges", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasLockouts applies a predicate to check if query has an edge lockouts.
+func (f *UserFilter) WhereHasLockouts() {
+	f.Where(entql.HasEdge("lockouts"))
+}
+
+// WhereHasLockoutsWith applies a predicate to check if query has an edge lockouts with a given conditions (other predicates).
+func (f *UserFilter) WhereHasLockoutsWith(preds ...predicate.Lockout) {
+	f.Where(entql.HasEdgeWith("lockouts", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPasswordHistory applies a predicate to check if query has an edge password_history.
+func (f *UserFilter) WhereHasPasswordHistory() {
+	f.Where(entql.HasEdge("password_history"))
+}
+
+// WhereHasPasswordHistoryWith applies a predicate to check if query has an edge password_history with a given conditions (other predicates).
+func (f *UserFilter) WhereHasPasswordHistoryWith(preds ...predicate.PasswordHistory) {
+	f.Where(entql.HasEdgeWith("password_history", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasMagicLinkTokens applies a predicate to check if query has an edge magic_link_tokens.
+func (f *UserFilter) WhereHasMagicLinkTokens() {
+	f.Where(entql.HasEdge("magic_link_tokens"))
+}
+
+// WhereHasMagicLinkTokensWith applies a predicate to check if query has an edge magic_link_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasMagicLinkTokensWith(preds ...predicate.MagicLinkToken) {
+	f.Where(entql.HasEdgeWith("magic_link_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRoles applies a predicate to check if query has an edge roles.
+func (f *UserFilter) WhereHasRoles() {
+	f.Where(entql.HasEdge("roles"))
+}
+
+// WhereHasRolesWith applies a predicate to check if query has an edge roles with a given conditions (other predicates).
+func (f *UserFilter) WhereHasRolesWith(preds ...predicate.Role) {
+	f.Where(entql.HasEdgeWith("roles", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WebAuthnChallengeQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebAuthnChallengeQuery builder.
+func (_q *WebAuthnChallengeQuery) Filter() *WebAuthnChallengeFilter {
+	return &WebAuthnChallengeFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebAuthnChallengeMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebAuthnChallengeMutation builder.
+func (m *WebAuthnChallengeMutation) Filter() *WebAuthnChallengeFilter {
+	return &WebAuthnChallengeFilter{config: m.config, predicateAdder: m}
+}
+
+// WebAuthnChallengeFilter provides a generic filtering capability at runtime for WebAuthnChallengeQuery.
+type WebAuthnChallengeFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebAuthnChallengeFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WebAuthnChallengeFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(webauthnchallenge.FieldID))
+}
+
+// WhereChallengeHash applies the entql string predicate on the challenge_hash field.
+func (f *WebAuthnChallengeFilter) WhereChallengeHash(p entql.StringP) {
+	f.Where(p.Field(webauthnchallenge.FieldChallengeHash))
+}
+
+// WhereCeremony applies the entql string predicate on the ceremony field.
+func (f *WebAuthnChallengeFilter) WhereCeremony(p entql.StringP) {
+	f.Where(p.Field(webauthnchallenge.FieldCeremony))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *WebAuthnChallengeFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(webauthnchallenge.FieldAppID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *WebAuthnChallengeFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(webauthnchallenge.FieldUserID))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *WebAuthnChallengeFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(webauthnchallenge.FieldExpiresAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *WebAuthnChallengeFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthnchallenge.FieldUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebAuthnChallengeFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthnchallenge.FieldCreatedAt))
+}
+
+// WhereHasApp applies a predicate to check if query has an edge app.
+func (f *WebAuthnChallengeFilter) WhereHasApp() {
+	f.Where(entql.HasEdge("app"))
+}
+
+// WhereHasAppWith applies a predicate to check if query has an edge app with a given conditions (other predicates).
+func (f *WebAuthnChallengeFilter) WhereHasAppWith(preds ...predicate.App) {
+	f.Where(entql.HasEdgeWith("app", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *WebAuthnChallengeFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *WebAuthnChallengeFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WebAuthnCredentialQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebAuthnCredentialQuery builder.
+func (_q *WebAuthnCredentialQuery) Filter() *WebAuthnCredentialFilter {
+	return &WebAuthnCredentialFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebAuthnCredentialMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebAuthnCredentialMutation builder.
+func (m *WebAuthnCredentialMutation) Filter() *WebAuthnCredentialFilter {
+	return &WebAuthnCredentialFilter{config: m.config, predicateAdder: m}
+}
+
+// WebAuthnCredentialFilter provides a generic filtering capability at runtime for WebAuthnCredentialQuery.
+type WebAuthnCredentialFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebAuthnCredentialFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WebAuthnCredentialFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(webauthncredential.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *WebAuthnCredentialFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(webauthncredential.FieldUserID))
+}
+
+// WhereCredentialID applies the entql []byte predicate on the credential_id field.
+func (f *WebAuthnCredentialFilter) WhereCredentialID(p entql.BytesP) {
+	f.Where(p.Field(webauthncredential.FieldCredentialID))
+}
+
+// WherePublicKey applies the entql []byte predicate on the public_key field.
+func (f *WebAuthnCredentialFilter) WherePublicKey(p entql.BytesP) {
+	f.Where(p.Field(webauthncredential.FieldPublicKey))
+}
+
+// WhereSignCount applies the entql uint32 predicate on the sign_count field.
+func (f *WebAuthnCredentialFilter) WhereSignCount(p entql.Uint32P) {
+	f.Where(p.Field(webauthncredential.FieldSignCount))
+}
+
+// WhereTransports applies the entql json.RawMessage predicate on the transports field.
+func (f *WebAuthnCredentialFilter) WhereTransports(p entql.BytesP) {
+	f.Where(p.Field(webauthncredential.FieldTransports))
+}
+
+// WhereAaguid applies the entql []byte predicate on the aaguid field.
+func (f *WebAuthnCredentialFilter) WhereAaguid(p entql.BytesP) {
+	f.Where(p.Field(webauthncredential.FieldAaguid))
+}
+
+// WhereBackupEligible applies the entql bool predicate on the backup_eligible field.
+func (f *WebAuthnCredentialFilter) WhereBackupEligible(p entql.BoolP) {
+	f.Where(p.Field(webauthncredential.FieldBackupEligible))
+}
+
+// WhereBackedUp applies the entql bool predicate on the backed_up field.
+func (f *WebAuthnCredentialFilter) WhereBackedUp(p entql.BoolP) {
+	f.Where(p.Field(webauthncredential.FieldBackedUp))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *WebAuthnCredentialFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(webauthncredential.FieldName))
+}
+
+// WhereLastUsedAt applies the entql time.Time predicate on the last_used_at field.
+func (f *WebAuthnCredentialFilter) WhereLastUsedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthncredential.FieldLastUsedAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebAuthnCredentialFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webauthncredential.FieldCreatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *WebAuthnCredentialFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *WebAuthnCredentialFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WebhookQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookQuery builder.
+func (_q *WebhookQuery) Filter() *WebhookFilter {
+	return &WebhookFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookMutation builder.
+func (m *WebhookMutation) Filter() *WebhookFilter {
+	return &WebhookFilter{config: m.config, predicateAdder: m}
+}
+
+// WebhookFilter provides a generic filtering capability at runtime for WebhookQuery.
+type WebhookFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WebhookFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(webhook.FieldID))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *WebhookFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(webhook.FieldAppID))
+}
+
+// WhereURL applies the entql string predicate on the url field.
+func (f *WebhookFilter) WhereURL(p entql.StringP) {
+	f.Where(p.Field(webhook.FieldURL))
+}
+
+// WhereSecret applies the entql string predicate on the secret field.
+func (f *WebhookFilter) WhereSecret(p entql.StringP) {
+	f.Where(p.Field(webhook.FieldSecret))
+}
+
+// WhereEvents applies the entql json.RawMessage predicate on the events field.
+func (f *WebhookFilter) WhereEvents(p entql.BytesP) {
+	f.Where(p.Field(webhook.FieldEvents))
+}
+
+// WhereEnabled applies the entql bool predicate on the enabled field.
+func (f *WebhookFilter) WhereEnabled(p entql.BoolP) {
+	f.Where(p.Field(webhook.FieldEnabled))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *WebhookFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(webhook.FieldDescription))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebhookFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhook.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WebhookFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhook.FieldUpdatedAt))
+}
+
+// WhereHasApp applies a predicate to check if query has an edge app.
+func (f *WebhookFilter) WhereHasApp() {
+	f.Where(entql.HasEdge("app"))
+}
+
+// WhereHasAppWith applies a predicate to check if query has an edge app with a given conditions (other predicates).
+func (f *WebhookFilter) WhereHasAppWith(preds ...predicate.App) {
+	f.Where(entql.HasEdgeWith("app", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDeliveries applies a predicate to check if query has an edge deliveries.
+func (f *WebhookFilter) WhereHasDeliveries() {
+	f.Where(entql.HasEdge("deliveries"))
+}
+
+// WhereHasDeliveriesWith applies a predicate to check if query has an edge deliveries with a given conditions (other predicates).
+func (f *WebhookFilter) WhereHasDeliveriesWith(preds ...predicate.WebhookDelivery) {
+	f.Where(entql.HasEdgeWith("deliveries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *WebhookDeliveryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookDeliveryQuery builder.
+func (_q *WebhookDeliveryQuery) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookDeliveryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{config: m.config, predicateAdder: m}
+}
+
+// WebhookDeliveryFilter provides a generic filtering capability at runtime for WebhookDeliveryQuery.
+type WebhookDeliveryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookDeliveryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WebhookDeliveryFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldID))
+}
+
+// WhereWebhookID applies the entql int predicate on the webhook_id field.
+func (f *WebhookDeliveryFilter) WhereWebhookID(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldWebhookID))
+}
+
+// WhereAppID applies the entql int predicate on the app_id field.
+func (f *WebhookDeliveryFilter) WhereAppID(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldAppID))
+}
+
+// WhereEventID applies the entql string predicate on the event_id field.
+func (f *WebhookDeliveryFilter) WhereEventID(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldEventID))
+}
+
+// WhereEvent applies the entql string predicate on the event field.
+func (f *WebhookDeliveryFilter) WhereEvent(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldEvent))
+}
+
+// WherePayload applies the entql string predicate on the payload field.
+func (f *WebhookDeliveryFilter) WherePayload(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldPayload))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WebhookDeliveryFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldStatus))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *WebhookDeliveryFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldAttempts))
+}
+
+// WhereNextAttemptAt applies the entql time.Time predicate on the next_attempt_at field.
+func (f *WebhookDeliveryFilter) WhereNextAttemptAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldNextAttemptAt))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *WebhookDeliveryFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldLastError))
+}
+
+// WhereResponseStatus applies the entql int predicate on the response_status field.
+func (f *WebhookDeliveryFilter) WhereResponseStatus(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldResponseStatus))
+}
+
+// WhereDeliveredAt applies the entql time.Time predicate on the delivered_at field.
+func (f *WebhookDeliveryFilter) WhereDeliveredAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldDeliveredAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebhookDeliveryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldCreatedAt))
+}
+
+// WhereHasWebhook applies a predicate to check if query has an edge webhook.
+func (f *WebhookDeliveryFilter) WhereHasWebhook() {
+	f.Where(entql.HasEdge("webhook"))
+}
+
+// WhereHasWebhookWith applies a predicate to check if query has an edge webhook with a given conditions (other predicates).
+func (f *WebhookDeliveryFilter) WhereHasWebhookWith(preds ...predicate.Webhook) {
+	f.Where(entql.HasEdgeWith("webhook", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/lockout"
+	"keeper/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Lockout is the model entity for the Lockout schema.
+type Lockout struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind lockout.Kind `json:"kind,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID *int `json:"app_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int `json:"user_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// Failures holds the value of the "failures" field.
+	Failures int `json:"failures,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil time.Time `json:"locked_until,omitempty"`
+	// UnlockedAt holds the value of the "unlocked_at" field.
+	UnlockedAt *time.Time `json:"unlocked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LockoutQuery when eager-loading is set.
+	Edges        LockoutEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LockoutEdges holds the relations/edges for other nodes in the graph.
+type LockoutEdges struct {
+	// App holds the value of the app edge.
+	App *App `json:"app,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AppOrErr returns the App value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LockoutEdges) AppOrErr() (*App, error) {
+	if e.App != nil {
+		return e.App, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: app.Label}
+	}
+	return nil, &NotLoadedError{edge: "app"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LockoutEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Lockout) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lockout.FieldID, lockout.FieldAppID, lockout.FieldUserID, lockout.FieldFailures:
+			values[i] = new(sql.NullInt64)
+		case lockout.FieldKind, lockout.FieldIP:
+			values[i] = new(sql.NullString)
+		case lockout.FieldLockedUntil, lockout.FieldUnlockedAt, lockout.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Lockout fields.
+func (_m *Lockout) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lockout.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case lockout.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = lockout.Kind(value.String)
+			}
+		case lockout.FieldAppID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				_m.AppID = new(int)
+				*_m.AppID = int(value.Int64)
+			}
+		case lockout.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case lockout.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case lockout.FieldFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failures", values[i])
+			} else if value.Valid {
+				_m.Failures = int(value.Int64)
+			}
+		case lockout.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = value.Time
+			}
+		case lockout.FieldUnlockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field unlocked_at", values[i])
+			} else if value.Valid {
+				_m.UnlockedAt = new(time.Time)
+				*_m.UnlockedAt = value.Time
+			}
+		case lockout.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Lockout.
+// This includes values selected through modifiers, order, etc.
+func (_m *Lockout) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryApp queries the "app" edge of the Lockout entity.
+func (_m *Lockout) QueryApp() *AppQuery {
+	return NewLockoutClient(_m.config).QueryApp(_m)
+}
+
+// QueryUser queries the "user" edge of the Lockout entity.
+func (_m *Lockout) QueryUser() *UserQuery {
+	return NewLockoutClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this Lockout.
+// Note that you need to call Lockout.Unwrap() before calling this method if this Lockout
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Lockout) Update() *LockoutUpdateOne {
+	return NewLockoutClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Lockout entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Lockout) Unwrap() *Lockout {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Lockout is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Lockout) String() string {
+	var builder strings.Builder
+	builder.WriteString("Lockout(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	if v := _m.AppID; v != nil {
+		builder.WriteString("app_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Failures))
+	builder.WriteString(", ")
+	builder.WriteString("locked_until=")
+	builder.WriteString(_m.LockedUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UnlockedAt; v != nil {
+		builder.WriteString("unlocked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Lockouts is a parsable slice of Lockout.
+type Lockouts []*Lockout
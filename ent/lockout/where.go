@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lockout
+
+import (
+	"keeper/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLTE(FieldID, id))
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldAppID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldUserID, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldIP, v))
+}
+
+// Failures applies equality check predicate on the "failures" field. It's identical to FailuresEQ.
+func Failures(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldFailures, v))
+}
+
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// UnlockedAt applies equality check predicate on the "unlocked_at" field. It's identical to UnlockedAtEQ.
+func UnlockedAt(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldUnlockedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldAppID, v))
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldAppID, vs...))
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldAppID, vs...))
+}
+
+// AppIDIsNil applies the IsNil predicate on the "app_id" field.
+func AppIDIsNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldIsNull(FieldAppID))
+}
+
+// AppIDNotNil applies the NotNil predicate on the "app_id" field.
+func AppIDNotNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotNull(FieldAppID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotNull(FieldUserID))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPIsNil applies the IsNil predicate on the "ip" field.
+func IPIsNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldIsNull(FieldIP))
+}
+
+// IPNotNil applies the NotNil predicate on the "ip" field.
+func IPNotNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotNull(FieldIP))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.Lockout {
+	return predicate.Lockout(sql.FieldContainsFold(FieldIP, v))
+}
+
+// FailuresEQ applies the EQ predicate on the "failures" field.
+func FailuresEQ(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldFailures, v))
+}
+
+// FailuresNEQ applies the NEQ predicate on the "failures" field.
+func FailuresNEQ(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldFailures, v))
+}
+
+// FailuresIn applies the In predicate on the "failures" field.
+func FailuresIn(vs ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldFailures, vs...))
+}
+
+// FailuresNotIn applies the NotIn predicate on the "failures" field.
+func FailuresNotIn(vs ...int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldFailures, vs...))
+}
+
+// FailuresGT applies the GT predicate on the "failures" field.
+func FailuresGT(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGT(FieldFailures, v))
+}
+
+// FailuresGTE applies the GTE predicate on the "failures" field.
+func FailuresGTE(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGTE(FieldFailures, v))
+}
+
+// FailuresLT applies the LT predicate on the "failures" field.
+func FailuresLT(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLT(FieldFailures, v))
+}
+
+// FailuresLTE applies the LTE predicate on the "failures" field.
+func FailuresLTE(v int) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLTE(FieldFailures, v))
+}
+
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// UnlockedAtEQ applies the EQ predicate on the "unlocked_at" field.
+func UnlockedAtEQ(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldUnlockedAt, v))
+}
+
+// UnlockedAtNEQ applies the NEQ predicate on the "unlocked_at" field.
+func UnlockedAtNEQ(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldUnlockedAt, v))
+}
+
+// UnlockedAtIn applies the In predicate on the "unlocked_at" field.
+func UnlockedAtIn(vs ...time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldUnlockedAt, vs...))
+}
+
+// UnlockedAtNotIn applies the NotIn predicate on the "unlocked_at" field.
+func UnlockedAtNotIn(vs ...time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldUnlockedAt, vs...))
+}
+
+// UnlockedAtGT applies the GT predicate on the "unlocked_at" field.
+func UnlockedAtGT(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGT(FieldUnlockedAt, v))
+}
+
+// UnlockedAtGTE applies the GTE predicate on the "unlocked_at" field.
+func UnlockedAtGTE(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGTE(FieldUnlockedAt, v))
+}
+
+// UnlockedAtLT applies the LT predicate on the "unlocked_at" field.
+func UnlockedAtLT(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLT(FieldUnlockedAt, v))
+}
+
+// UnlockedAtLTE applies the LTE predicate on the "unlocked_at" field.
+func UnlockedAtLTE(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLTE(FieldUnlockedAt, v))
+}
+
+// UnlockedAtIsNil applies the IsNil predicate on the "unlocked_at" field.
+func UnlockedAtIsNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldIsNull(FieldUnlockedAt))
+}
+
+// UnlockedAtNotNil applies the NotNil predicate on the "unlocked_at" field.
+func UnlockedAtNotNil() predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotNull(FieldUnlockedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Lockout {
+	return predicate.Lockout(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasApp applies the HasEdge predicate on the "app" edge.
+func HasApp() predicate.Lockout {
+	return predicate.Lockout(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AppTable, AppColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppWith applies the HasEdge predicate on the "app" edge with a given conditions (other predicates).
+func HasAppWith(preds ...predicate.App) predicate.Lockout {
+	return predicate.Lockout(func(s *sql.Selector) {
+		step := newAppStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Lockout {
+	return predicate.Lockout(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Lockout {
+	return predicate.Lockout(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Lockout) predicate.Lockout {
+	return predicate.Lockout(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Lockout) predicate.Lockout {
+	return predicate.Lockout(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Lockout) predicate.Lockout {
+	return predicate.Lockout(sql.NotPredicates(p))
+}
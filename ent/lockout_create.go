@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/lockout"
+	"keeper/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LockoutCreate is the builder for creating a Lockout entity.
+type LockoutCreate struct {
+	config
+	mutation *LockoutMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *LockoutCreate) SetKind(v lockout.Kind) *LockoutCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetAppID sets the "app_id" field.
+func (_c *LockoutCreate) SetAppID(v int) *LockoutCreate {
+	_c.mutation.SetAppID(v)
+	return _c
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_c *LockoutCreate) SetNillableAppID(v *int) *LockoutCreate {
+	if v != nil {
+		_c.SetAppID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LockoutCreate) SetUserID(v int) *LockoutCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *LockoutCreate) SetNillableUserID(v *int) *LockoutCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *LockoutCreate) SetIP(v string) *LockoutCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *LockoutCreate) SetNillableIP(v *string) *LockoutCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetFailures sets the "failures" field.
+func (_c *LockoutCreate) SetFailures(v int) *LockoutCreate {
+	_c.mutation.SetFailures(v)
+	return _c
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_c *LockoutCreate) SetLockedUntil(v time.Time) *LockoutCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetUnlockedAt sets the "unlocked_at" field.
+func (_c *LockoutCreate) SetUnlockedAt(v time.Time) *LockoutCreate {
+	_c.mutation.SetUnlockedAt(v)
+	return _c
+}
+
+// SetNillableUnlockedAt sets the "unlocked_at" field if the given value is not nil.
+func (_c *LockoutCreate) SetNillableUnlockedAt(v *time.Time) *LockoutCreate {
+	if v != nil {
+		_c.SetUnlockedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LockoutCreate) SetCreatedAt(v time.Time) *LockoutCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LockoutCreate) SetNillableCreatedAt(v *time.Time) *LockoutCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_c *LockoutCreate) SetApp(v *App) *LockoutCreate {
+	return _c.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *LockoutCreate) SetUser(v *User) *LockoutCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the LockoutMutation object of the builder.
+func (_c *LockoutCreate) Mutation() *LockoutMutation {
+	return _c.mutation
+}
+
+// Save creates the Lockout in the database.
+func (_c *LockoutCreate) Save(ctx context.Context) (*Lockout, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LockoutCreate) SaveX(ctx context.Context) *Lockout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LockoutCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LockoutCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LockoutCreate) defaults() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if lockout.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized lockout.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := lockout.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LockoutCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Lockout.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := lockout.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Lockout.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Failures(); !ok {
+		return &ValidationError{Name: "failures", err: errors.New(`ent: missing required field "Lockout.failures"`)}
+	}
+	if _, ok := _c.mutation.LockedUntil(); !ok {
+		return &ValidationError{Name: "locked_until", err: errors.New(`ent: missing required field "Lockout.locked_until"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Lockout.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LockoutCreate) sqlSave(ctx context.Context) (*Lockout, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LockoutCreate) createSpec() (*Lockout, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Lockout{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(lockout.Table, sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(lockout.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(lockout.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.Failures(); ok {
+		_spec.SetField(lockout.FieldFailures, field.TypeInt, value)
+		_node.Failures = value
+	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(lockout.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = value
+	}
+	if value, ok := _c.mutation.UnlockedAt(); ok {
+		_spec.SetField(lockout.FieldUnlockedAt, field.TypeTime, value)
+		_node.UnlockedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(lockout.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.AppTable,
+			Columns: []string{lockout.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AppID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.UserTable,
+			Columns: []string{lockout.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LockoutCreateBulk is the builder for creating many Lockout entities in bulk.
+type LockoutCreateBulk struct {
+	config
+	err      error
+	builders []*LockoutCreate
+}
+
+// Save creates the Lockout entities in the database.
+func (_c *LockoutCreateBulk) Save(ctx context.Context) ([]*Lockout, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Lockout, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LockoutMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LockoutCreateBulk) SaveX(ctx context.Context) []*Lockout {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LockoutCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LockoutCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
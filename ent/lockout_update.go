@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/lockout"
+	"keeper/ent/predicate"
+	"keeper/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LockoutUpdate is the builder for updating Lockout entities.
+type LockoutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LockoutMutation
+}
+
+// Where appends a list predicates to the LockoutUpdate builder.
+func (_u *LockoutUpdate) Where(ps ...predicate.Lockout) *LockoutUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *LockoutUpdate) SetKind(v lockout.Kind) *LockoutUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableKind(v *lockout.Kind) *LockoutUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *LockoutUpdate) SetAppID(v int) *LockoutUpdate {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableAppID(v *int) *LockoutUpdate {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (_u *LockoutUpdate) ClearAppID() *LockoutUpdate {
+	_u.mutation.ClearAppID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LockoutUpdate) SetUserID(v int) *LockoutUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableUserID(v *int) *LockoutUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LockoutUpdate) ClearUserID() *LockoutUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LockoutUpdate) SetIP(v string) *LockoutUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableIP(v *string) *LockoutUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *LockoutUpdate) ClearIP() *LockoutUpdate {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetFailures sets the "failures" field.
+func (_u *LockoutUpdate) SetFailures(v int) *LockoutUpdate {
+	_u.mutation.ResetFailures()
+	_u.mutation.SetFailures(v)
+	return _u
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableFailures(v *int) *LockoutUpdate {
+	if v != nil {
+		_u.SetFailures(*v)
+	}
+	return _u
+}
+
+// AddFailures adds value to the "failures" field.
+func (_u *LockoutUpdate) AddFailures(v int) *LockoutUpdate {
+	_u.mutation.AddFailures(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *LockoutUpdate) SetLockedUntil(v time.Time) *LockoutUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableLockedUntil(v *time.Time) *LockoutUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// SetUnlockedAt sets the "unlocked_at" field.
+func (_u *LockoutUpdate) SetUnlockedAt(v time.Time) *LockoutUpdate {
+	_u.mutation.SetUnlockedAt(v)
+	return _u
+}
+
+// SetNillableUnlockedAt sets the "unlocked_at" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableUnlockedAt(v *time.Time) *LockoutUpdate {
+	if v != nil {
+		_u.SetUnlockedAt(*v)
+	}
+	return _u
+}
+
+// ClearUnlockedAt clears the value of the "unlocked_at" field.
+func (_u *LockoutUpdate) ClearUnlockedAt() *LockoutUpdate {
+	_u.mutation.ClearUnlockedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LockoutUpdate) SetCreatedAt(v time.Time) *LockoutUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LockoutUpdate) SetNillableCreatedAt(v *time.Time) *LockoutUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *LockoutUpdate) SetApp(v *App) *LockoutUpdate {
+	return _u.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LockoutUpdate) SetUser(v *User) *LockoutUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LockoutMutation object of the builder.
+func (_u *LockoutUpdate) Mutation() *LockoutMutation {
+	return _u.mutation
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *LockoutUpdate) ClearApp() *LockoutUpdate {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LockoutUpdate) ClearUser() *LockoutUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LockoutUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LockoutUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LockoutUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LockoutUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LockoutUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := lockout.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Lockout.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LockoutUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lockout.Table, lockout.Columns, sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(lockout.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(lockout.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(lockout.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Failures(); ok {
+		_spec.SetField(lockout.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailures(); ok {
+		_spec.AddField(lockout.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(lockout.FieldLockedUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UnlockedAt(); ok {
+		_spec.SetField(lockout.FieldUnlockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnlockedAtCleared() {
+		_spec.ClearField(lockout.FieldUnlockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(lockout.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.AppTable,
+			Columns: []string{lockout.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.AppTable,
+			Columns: []string{lockout.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.UserTable,
+			Columns: []string{lockout.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.UserTable,
+			Columns: []string{lockout.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lockout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LockoutUpdateOne is the builder for updating a single Lockout entity.
+type LockoutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LockoutMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *LockoutUpdateOne) SetKind(v lockout.Kind) *LockoutUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableKind(v *lockout.Kind) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *LockoutUpdateOne) SetAppID(v int) *LockoutUpdateOne {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableAppID(v *int) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (_u *LockoutUpdateOne) ClearAppID() *LockoutUpdateOne {
+	_u.mutation.ClearAppID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *LockoutUpdateOne) SetUserID(v int) *LockoutUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableUserID(v *int) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *LockoutUpdateOne) ClearUserID() *LockoutUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *LockoutUpdateOne) SetIP(v string) *LockoutUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableIP(v *string) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// ClearIP clears the value of the "ip" field.
+func (_u *LockoutUpdateOne) ClearIP() *LockoutUpdateOne {
+	_u.mutation.ClearIP()
+	return _u
+}
+
+// SetFailures sets the "failures" field.
+func (_u *LockoutUpdateOne) SetFailures(v int) *LockoutUpdateOne {
+	_u.mutation.ResetFailures()
+	_u.mutation.SetFailures(v)
+	return _u
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableFailures(v *int) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetFailures(*v)
+	}
+	return _u
+}
+
+// AddFailures adds value to the "failures" field.
+func (_u *LockoutUpdateOne) AddFailures(v int) *LockoutUpdateOne {
+	_u.mutation.AddFailures(v)
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *LockoutUpdateOne) SetLockedUntil(v time.Time) *LockoutUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableLockedUntil(v *time.Time) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// SetUnlockedAt sets the "unlocked_at" field.
+func (_u *LockoutUpdateOne) SetUnlockedAt(v time.Time) *LockoutUpdateOne {
+	_u.mutation.SetUnlockedAt(v)
+	return _u
+}
+
+// SetNillableUnlockedAt sets the "unlocked_at" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableUnlockedAt(v *time.Time) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetUnlockedAt(*v)
+	}
+	return _u
+}
+
+// ClearUnlockedAt clears the value of the "unlocked_at" field.
+func (_u *LockoutUpdateOne) ClearUnlockedAt() *LockoutUpdateOne {
+	_u.mutation.ClearUnlockedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LockoutUpdateOne) SetCreatedAt(v time.Time) *LockoutUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LockoutUpdateOne) SetNillableCreatedAt(v *time.Time) *LockoutUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *LockoutUpdateOne) SetApp(v *App) *LockoutUpdateOne {
+	return _u.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LockoutUpdateOne) SetUser(v *User) *LockoutUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LockoutMutation object of the builder.
+func (_u *LockoutUpdateOne) Mutation() *LockoutMutation {
+	return _u.mutation
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *LockoutUpdateOne) ClearApp() *LockoutUpdateOne {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LockoutUpdateOne) ClearUser() *LockoutUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LockoutUpdate builder.
+func (_u *LockoutUpdateOne) Where(ps ...predicate.Lockout) *LockoutUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LockoutUpdateOne) Select(field string, fields ...string) *LockoutUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Lockout entity.
+func (_u *LockoutUpdateOne) Save(ctx context.Context) (*Lockout, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LockoutUpdateOne) SaveX(ctx context.Context) *Lockout {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LockoutUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LockoutUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LockoutUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := lockout.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Lockout.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LockoutUpdateOne) sqlSave(ctx context.Context) (_node *Lockout, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lockout.Table, lockout.Columns, sqlgraph.NewFieldSpec(lockout.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Lockout.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lockout.FieldID)
+		for _, f := range fields {
+			if !lockout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lockout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(lockout.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(lockout.FieldIP, field.TypeString, value)
+	}
+	if _u.mutation.IPCleared() {
+		_spec.ClearField(lockout.FieldIP, field.TypeString)
+	}
+	if value, ok := _u.mutation.Failures(); ok {
+		_spec.SetField(lockout.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailures(); ok {
+		_spec.AddField(lockout.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(lockout.FieldLockedUntil, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UnlockedAt(); ok {
+		_spec.SetField(lockout.FieldUnlockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnlockedAtCleared() {
+		_spec.ClearField(lockout.FieldUnlockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(lockout.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.AppTable,
+			Columns: []string{lockout.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.AppTable,
+			Columns: []string{lockout.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.UserTable,
+			Columns: []string{lockout.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   lockout.UserTable,
+			Columns: []string{lockout.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Lockout{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lockout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package magiclinktoken
+
+import (
+	"keeper/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldUserID, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// NonceHash applies equality check predicate on the "nonce_hash" field. It's identical to NonceHashEQ.
+func NonceHash(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldNonceHash, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// NonceHashEQ applies the EQ predicate on the "nonce_hash" field.
+func NonceHashEQ(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldNonceHash, v))
+}
+
+// NonceHashNEQ applies the NEQ predicate on the "nonce_hash" field.
+func NonceHashNEQ(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNEQ(FieldNonceHash, v))
+}
+
+// NonceHashIn applies the In predicate on the "nonce_hash" field.
+func NonceHashIn(vs ...string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIn(FieldNonceHash, vs...))
+}
+
+// NonceHashNotIn applies the NotIn predicate on the "nonce_hash" field.
+func NonceHashNotIn(vs ...string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotIn(FieldNonceHash, vs...))
+}
+
+// NonceHashGT applies the GT predicate on the "nonce_hash" field.
+func NonceHashGT(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGT(FieldNonceHash, v))
+}
+
+// NonceHashGTE applies the GTE predicate on the "nonce_hash" field.
+func NonceHashGTE(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGTE(FieldNonceHash, v))
+}
+
+// NonceHashLT applies the LT predicate on the "nonce_hash" field.
+func NonceHashLT(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLT(FieldNonceHash, v))
+}
+
+// NonceHashLTE applies the LTE predicate on the "nonce_hash" field.
+func NonceHashLTE(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLTE(FieldNonceHash, v))
+}
+
+// NonceHashContains applies the Contains predicate on the "nonce_hash" field.
+func NonceHashContains(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldContains(FieldNonceHash, v))
+}
+
+// NonceHashHasPrefix applies the HasPrefix predicate on the "nonce_hash" field.
+func NonceHashHasPrefix(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldHasPrefix(FieldNonceHash, v))
+}
+
+// NonceHashHasSuffix applies the HasSuffix predicate on the "nonce_hash" field.
+func NonceHashHasSuffix(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldHasSuffix(FieldNonceHash, v))
+}
+
+// NonceHashEqualFold applies the EqualFold predicate on the "nonce_hash" field.
+func NonceHashEqualFold(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEqualFold(FieldNonceHash, v))
+}
+
+// NonceHashContainsFold applies the ContainsFold predicate on the "nonce_hash" field.
+func NonceHashContainsFold(v string) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldContainsFold(FieldNonceHash, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MagicLinkToken) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MagicLinkToken) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MagicLinkToken) predicate.MagicLinkToken {
+	return predicate.MagicLinkToken(sql.NotPredicates(p))
+}
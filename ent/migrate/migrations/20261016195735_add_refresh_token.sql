@@ -0,0 +1,6 @@
+-- Create "kpr_refresh_token" table
+CREATE TABLE `kpr_refresh_token` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token_hash` text NOT NULL, `family_id` text NOT NULL, `expires_at` datetime NOT NULL, `used_at` datetime NULL, `revoked_at` datetime NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_refresh_token_kpr_user_refresh_tokens` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_refresh_token_token_hash_key" to table: "kpr_refresh_token"
+CREATE UNIQUE INDEX `kpr_refresh_token_token_hash_key` ON `kpr_refresh_token` (`token_hash`);
+-- Create index "refreshtoken_family_id" to table: "kpr_refresh_token"
+CREATE INDEX `refreshtoken_family_id` ON `kpr_refresh_token` (`family_id`);
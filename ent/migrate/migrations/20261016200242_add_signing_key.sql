@@ -0,0 +1,4 @@
+-- Create "kpr_signing_key" table
+CREATE TABLE `kpr_signing_key` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `kid` text NOT NULL, `algorithm` text NOT NULL, `private_key` text NOT NULL, `activates_at` datetime NOT NULL, `retired_at` datetime NULL, `created_at` datetime NOT NULL);
+-- Create index "kpr_signing_key_kid_key" to table: "kpr_signing_key"
+CREATE UNIQUE INDEX `kpr_signing_key_kid_key` ON `kpr_signing_key` (`kid`);
@@ -0,0 +1,8 @@
+-- Add column "tokens_valid_after" to table: "kpr_user"
+ALTER TABLE `kpr_user` ADD COLUMN `tokens_valid_after` datetime NULL;
+-- Create "kpr_revoked_token" table
+CREATE TABLE `kpr_revoked_token` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `jti` text NOT NULL, `expires_at` datetime NOT NULL, `created_at` datetime NOT NULL);
+-- Create index "kpr_revoked_token_jti_key" to table: "kpr_revoked_token"
+CREATE UNIQUE INDEX `kpr_revoked_token_jti_key` ON `kpr_revoked_token` (`jti`);
+-- Create index "revokedtoken_expires_at" to table: "kpr_revoked_token"
+CREATE INDEX `revokedtoken_expires_at` ON `kpr_revoked_token` (`expires_at`);
@@ -0,0 +1,32 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_kpr_app" table
+CREATE TABLE `new_kpr_app` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `client_id` text NOT NULL, `redirect_uris` json NULL, `status` integer NOT NULL DEFAULT (1), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- Copy rows from old table "kpr_app" to new temporary table "new_kpr_app"
+INSERT INTO `new_kpr_app` (`id`, `name`, `client_id`, `status`, `created_at`, `updated_at`) SELECT `id`, `name`, lower(hex(randomblob(16))), `status`, `created_at`, `updated_at` FROM `kpr_app`;
+-- Drop "kpr_app" table after copying rows
+DROP TABLE `kpr_app`;
+-- Rename temporary table "new_kpr_app" to "kpr_app"
+ALTER TABLE `new_kpr_app` RENAME TO `kpr_app`;
+-- Create index "kpr_app_name_key" to table: "kpr_app"
+CREATE UNIQUE INDEX `kpr_app_name_key` ON `kpr_app` (`name`);
+-- Create index "kpr_app_client_id_key" to table: "kpr_app"
+CREATE UNIQUE INDEX `kpr_app_client_id_key` ON `kpr_app` (`client_id`);
+-- Create "new_kpr_refresh_token" table
+CREATE TABLE `new_kpr_refresh_token` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token_hash` text NOT NULL, `family_id` text NOT NULL, `scope` text NOT NULL DEFAULT (''), `expires_at` datetime NOT NULL, `used_at` datetime NULL, `revoked_at` datetime NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_refresh_token_kpr_user_refresh_tokens` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "kpr_refresh_token" to new temporary table "new_kpr_refresh_token"
+INSERT INTO `new_kpr_refresh_token` (`id`, `token_hash`, `family_id`, `expires_at`, `used_at`, `revoked_at`, `created_at`, `user_id`) SELECT `id`, `token_hash`, `family_id`, `expires_at`, `used_at`, `revoked_at`, `created_at`, `user_id` FROM `kpr_refresh_token`;
+-- Drop "kpr_refresh_token" table after copying rows
+DROP TABLE `kpr_refresh_token`;
+-- Rename temporary table "new_kpr_refresh_token" to "kpr_refresh_token"
+ALTER TABLE `new_kpr_refresh_token` RENAME TO `kpr_refresh_token`;
+-- Create index "kpr_refresh_token_token_hash_key" to table: "kpr_refresh_token"
+CREATE UNIQUE INDEX `kpr_refresh_token_token_hash_key` ON `kpr_refresh_token` (`token_hash`);
+-- Create index "refreshtoken_family_id" to table: "kpr_refresh_token"
+CREATE INDEX `refreshtoken_family_id` ON `kpr_refresh_token` (`family_id`);
+-- Create "kpr_authorization_code" table
+CREATE TABLE `kpr_authorization_code` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `code_hash` text NOT NULL, `redirect_uri` text NOT NULL, `scope` text NOT NULL, `nonce` text NOT NULL DEFAULT (''), `code_challenge` text NOT NULL, `code_challenge_method` text NOT NULL, `auth_time` datetime NOT NULL, `expires_at` datetime NOT NULL, `used_at` datetime NULL, `created_at` datetime NOT NULL, `app_id` integer NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_authorization_code_kpr_app_authorization_codes` FOREIGN KEY (`app_id`) REFERENCES `kpr_app` (`id`) ON DELETE CASCADE, CONSTRAINT `kpr_authorization_code_kpr_user_authorization_codes` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_authorization_code_code_hash_key" to table: "kpr_authorization_code"
+CREATE UNIQUE INDEX `kpr_authorization_code_code_hash_key` ON `kpr_authorization_code` (`code_hash`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
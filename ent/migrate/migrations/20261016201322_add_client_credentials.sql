@@ -0,0 +1,4 @@
+-- Add column "client_secret_hash" to table: "kpr_app"
+ALTER TABLE `kpr_app` ADD COLUMN `client_secret_hash` text NULL;
+-- Add column "scopes" to table: "kpr_app"
+ALTER TABLE `kpr_app` ADD COLUMN `scopes` json NULL;
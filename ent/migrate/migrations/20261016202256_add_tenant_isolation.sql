@@ -0,0 +1,2 @@
+-- Add column "platform_admin" to table: "kpr_user"
+ALTER TABLE `kpr_user` ADD COLUMN `platform_admin` bool NOT NULL DEFAULT (false);
@@ -0,0 +1,12 @@
+-- Create "kpr_permission" table
+CREATE TABLE `kpr_permission` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `created_at` datetime NOT NULL);
+-- Create index "kpr_permission_name_key" to table: "kpr_permission"
+CREATE UNIQUE INDEX `kpr_permission_name_key` ON `kpr_permission` (`name`);
+-- Create "kpr_role" table
+CREATE TABLE `kpr_role` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `description` text NOT NULL DEFAULT (''), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `app_id` integer NOT NULL, CONSTRAINT `kpr_role_kpr_app_roles` FOREIGN KEY (`app_id`) REFERENCES `kpr_app` (`id`) ON DELETE CASCADE);
+-- Create index "role_app_id_name" to table: "kpr_role"
+CREATE UNIQUE INDEX `role_app_id_name` ON `kpr_role` (`app_id`, `name`);
+-- Create "kpr_role_permission" table
+CREATE TABLE `kpr_role_permission` (`role_id` integer NOT NULL, `permission_id` integer NOT NULL, PRIMARY KEY (`role_id`, `permission_id`), CONSTRAINT `kpr_role_permission_role_id` FOREIGN KEY (`role_id`) REFERENCES `kpr_role` (`id`) ON DELETE CASCADE, CONSTRAINT `kpr_role_permission_permission_id` FOREIGN KEY (`permission_id`) REFERENCES `kpr_permission` (`id`) ON DELETE CASCADE);
+-- Create "kpr_user_role" table
+CREATE TABLE `kpr_user_role` (`user_id` integer NOT NULL, `role_id` integer NOT NULL, PRIMARY KEY (`user_id`, `role_id`), CONSTRAINT `kpr_user_role_user_id` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE, CONSTRAINT `kpr_user_role_role_id` FOREIGN KEY (`role_id`) REFERENCES `kpr_role` (`id`) ON DELETE CASCADE);
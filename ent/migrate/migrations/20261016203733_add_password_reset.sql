@@ -0,0 +1,4 @@
+-- Create "kpr_password_reset_token" table
+CREATE TABLE `kpr_password_reset_token` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token_hash` text NOT NULL, `expires_at` datetime NOT NULL, `used_at` datetime NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_password_reset_token_kpr_user_password_reset_tokens` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_password_reset_token_token_hash_key" to table: "kpr_password_reset_token"
+CREATE UNIQUE INDEX `kpr_password_reset_token_token_hash_key` ON `kpr_password_reset_token` (`token_hash`);
@@ -0,0 +1,8 @@
+-- Add column "email_verified_at" to table: "kpr_user"
+ALTER TABLE `kpr_user` ADD COLUMN `email_verified_at` datetime NULL;
+-- Add column "require_verified_email" to table: "kpr_app"
+ALTER TABLE `kpr_app` ADD COLUMN `require_verified_email` bool NOT NULL DEFAULT (false);
+-- Create "kpr_email_verification_token" table
+CREATE TABLE `kpr_email_verification_token` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token_hash` text NOT NULL, `email` text NOT NULL, `expires_at` datetime NOT NULL, `used_at` datetime NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_email_verification_token_kpr_user_email_verification_tokens` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_email_verification_token_token_hash_key" to table: "kpr_email_verification_token"
+CREATE UNIQUE INDEX `kpr_email_verification_token_token_hash_key` ON `kpr_email_verification_token` (`token_hash`);
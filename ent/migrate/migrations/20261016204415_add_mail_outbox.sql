@@ -0,0 +1,8 @@
+-- Create "kpr_email_template" table
+CREATE TABLE `kpr_email_template` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `subject` text NOT NULL, `html` text NOT NULL, `text` text NOT NULL DEFAULT (''), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `app_id` integer NOT NULL, CONSTRAINT `kpr_email_template_kpr_app_email_templates` FOREIGN KEY (`app_id`) REFERENCES `kpr_app` (`id`) ON DELETE CASCADE);
+-- Create index "emailtemplate_app_id_name" to table: "kpr_email_template"
+CREATE UNIQUE INDEX `emailtemplate_app_id_name` ON `kpr_email_template` (`app_id`, `name`);
+-- Create "kpr_outbound_email" table
+CREATE TABLE `kpr_outbound_email` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `app_id` integer NULL, `template` text NOT NULL DEFAULT (''), `recipient` text NOT NULL, `subject` text NOT NULL, `html` text NOT NULL DEFAULT (''), `text` text NOT NULL DEFAULT (''), `status` text NOT NULL DEFAULT ('pending'), `attempts` integer NOT NULL DEFAULT (0), `next_attempt_at` datetime NOT NULL, `last_error` text NOT NULL DEFAULT (''), `sent_at` datetime NULL, `created_at` datetime NOT NULL);
+-- Create index "outboundemail_status_next_attempt_at" to table: "kpr_outbound_email"
+CREATE INDEX `outboundemail_status_next_attempt_at` ON `kpr_outbound_email` (`status`, `next_attempt_at`);
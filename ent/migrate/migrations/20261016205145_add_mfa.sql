@@ -0,0 +1,16 @@
+-- Add column "totp_secret" to table: "kpr_user"
+ALTER TABLE `kpr_user` ADD COLUMN `totp_secret` text NULL;
+-- Add column "totp_confirmed_at" to table: "kpr_user"
+ALTER TABLE `kpr_user` ADD COLUMN `totp_confirmed_at` datetime NULL;
+-- Add column "totp_last_step" to table: "kpr_user"
+ALTER TABLE `kpr_user` ADD COLUMN `totp_last_step` integer NOT NULL DEFAULT (0);
+-- Add column "require_mfa" to table: "kpr_app"
+ALTER TABLE `kpr_app` ADD COLUMN `require_mfa` bool NOT NULL DEFAULT (false);
+-- Create "kpr_mfa_challenge" table
+CREATE TABLE `kpr_mfa_challenge` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `token_hash` text NOT NULL, `attempts` integer NOT NULL DEFAULT (0), `expires_at` datetime NOT NULL, `used_at` datetime NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_mfa_challenge_kpr_user_mfa_challenges` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_mfa_challenge_token_hash_key" to table: "kpr_mfa_challenge"
+CREATE UNIQUE INDEX `kpr_mfa_challenge_token_hash_key` ON `kpr_mfa_challenge` (`token_hash`);
+-- Create "kpr_mfa_recovery_code" table
+CREATE TABLE `kpr_mfa_recovery_code` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `code_hash` text NOT NULL, `used_at` datetime NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_mfa_recovery_code_kpr_user_mfa_recovery_codes` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_mfa_recovery_code_code_hash_key" to table: "kpr_mfa_recovery_code"
+CREATE UNIQUE INDEX `kpr_mfa_recovery_code_code_hash_key` ON `kpr_mfa_recovery_code` (`code_hash`);
@@ -0,0 +1,12 @@
+-- Add column "webauthn_rp_id" to table: "kpr_app"
+ALTER TABLE `kpr_app` ADD COLUMN `webauthn_rp_id` text NULL;
+-- Add column "webauthn_origins" to table: "kpr_app"
+ALTER TABLE `kpr_app` ADD COLUMN `webauthn_origins` json NULL;
+-- Create "kpr_webauthn_challenge" table
+CREATE TABLE `kpr_webauthn_challenge` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `challenge_hash` text NOT NULL, `ceremony` text NOT NULL, `expires_at` datetime NOT NULL, `used_at` datetime NULL, `created_at` datetime NOT NULL, `app_id` integer NOT NULL, `user_id` integer NULL, CONSTRAINT `kpr_webauthn_challenge_kpr_app_webauthn_challenges` FOREIGN KEY (`app_id`) REFERENCES `kpr_app` (`id`) ON DELETE CASCADE, CONSTRAINT `kpr_webauthn_challenge_kpr_user_webauthn_challenges` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_webauthn_challenge_challenge_hash_key" to table: "kpr_webauthn_challenge"
+CREATE UNIQUE INDEX `kpr_webauthn_challenge_challenge_hash_key` ON `kpr_webauthn_challenge` (`challenge_hash`);
+-- Create "kpr_webauthn_credential" table
+CREATE TABLE `kpr_webauthn_credential` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `credential_id` blob NOT NULL, `public_key` blob NOT NULL, `sign_count` integer NOT NULL DEFAULT (0), `transports` json NULL, `aaguid` blob NULL, `backup_eligible` bool NOT NULL DEFAULT (false), `backed_up` bool NOT NULL DEFAULT (false), `name` text NOT NULL DEFAULT (''), `last_used_at` datetime NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_webauthn_credential_kpr_user_webauthn_credentials` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "kpr_webauthn_credential_credential_id_key" to table: "kpr_webauthn_credential"
+CREATE UNIQUE INDEX `kpr_webauthn_credential_credential_id_key` ON `kpr_webauthn_credential` (`credential_id`);
@@ -0,0 +1,34 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_kpr_user" table
+CREATE TABLE `new_kpr_user` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `firstname` text NOT NULL, `lastname` text NOT NULL, `email` text NOT NULL, `password` text NOT NULL, `status` integer NOT NULL DEFAULT (1), `platform_admin` bool NOT NULL DEFAULT (false), `tokens_valid_after` datetime NULL, `email_verified_at` datetime NULL, `totp_secret` text NULL, `totp_confirmed_at` datetime NULL, `totp_last_step` integer NOT NULL DEFAULT (0), `failed_logins` integer NOT NULL DEFAULT (0), `last_failed_login_at` datetime NULL, `locked_until` datetime NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `app_id` integer NOT NULL, CONSTRAINT `kpr_user_kpr_app_users` FOREIGN KEY (`app_id`) REFERENCES `kpr_app` (`id`) ON DELETE NO ACTION);
+-- Copy rows from old table "kpr_user" to new temporary table "new_kpr_user"
+INSERT INTO `new_kpr_user` (`id`, `firstname`, `lastname`, `email`, `password`, `status`, `platform_admin`, `tokens_valid_after`, `email_verified_at`, `totp_secret`, `totp_confirmed_at`, `totp_last_step`, `created_at`, `updated_at`, `app_id`) SELECT `id`, `firstname`, `lastname`, `email`, `password`, `status`, `platform_admin`, `tokens_valid_after`, `email_verified_at`, `totp_secret`, `totp_confirmed_at`, `totp_last_step`, `created_at`, `updated_at`, `app_id` FROM `kpr_user`;
+-- Drop "kpr_user" table after copying rows
+DROP TABLE `kpr_user`;
+-- Rename temporary table "new_kpr_user" to "kpr_user"
+ALTER TABLE `new_kpr_user` RENAME TO `kpr_user`;
+-- Create index "kpr_user_email_key" to table: "kpr_user"
+CREATE UNIQUE INDEX `kpr_user_email_key` ON `kpr_user` (`email`);
+-- Create "new_kpr_app" table
+CREATE TABLE `new_kpr_app` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `client_id` text NOT NULL, `redirect_uris` json NULL, `client_secret_hash` text NULL, `scopes` json NULL, `require_verified_email` bool NOT NULL DEFAULT (false), `require_mfa` bool NOT NULL DEFAULT (false), `webauthn_rp_id` text NULL, `webauthn_origins` json NULL, `max_login_attempts` integer NOT NULL DEFAULT (10), `lockout_duration` integer NOT NULL DEFAULT (900), `login_delay` integer NOT NULL DEFAULT (1), `max_ip_login_attempts` integer NOT NULL DEFAULT (100), `status` integer NOT NULL DEFAULT (1), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- Copy rows from old table "kpr_app" to new temporary table "new_kpr_app"
+INSERT INTO `new_kpr_app` (`id`, `name`, `client_id`, `redirect_uris`, `client_secret_hash`, `scopes`, `require_verified_email`, `require_mfa`, `webauthn_rp_id`, `webauthn_origins`, `status`, `created_at`, `updated_at`) SELECT `id`, `name`, `client_id`, `redirect_uris`, `client_secret_hash`, `scopes`, `require_verified_email`, `require_mfa`, `webauthn_rp_id`, `webauthn_origins`, `status`, `created_at`, `updated_at` FROM `kpr_app`;
+-- Drop "kpr_app" table after copying rows
+DROP TABLE `kpr_app`;
+-- Rename temporary table "new_kpr_app" to "kpr_app"
+ALTER TABLE `new_kpr_app` RENAME TO `kpr_app`;
+-- Create index "kpr_app_name_key" to table: "kpr_app"
+CREATE UNIQUE INDEX `kpr_app_name_key` ON `kpr_app` (`name`);
+-- Create index "kpr_app_client_id_key" to table: "kpr_app"
+CREATE UNIQUE INDEX `kpr_app_client_id_key` ON `kpr_app` (`client_id`);
+-- Create "kpr_lockout" table
+CREATE TABLE `kpr_lockout` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `kind` text NOT NULL, `ip` text NULL, `failures` integer NOT NULL, `locked_until` datetime NOT NULL, `unlocked_at` datetime NULL, `created_at` datetime NOT NULL, `app_id` integer NULL, `user_id` integer NULL, CONSTRAINT `kpr_lockout_kpr_app_lockouts` FOREIGN KEY (`app_id`) REFERENCES `kpr_app` (`id`) ON DELETE CASCADE, CONSTRAINT `kpr_lockout_kpr_user_lockouts` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "lockout_user_id_created_at" to table: "kpr_lockout"
+CREATE INDEX `lockout_user_id_created_at` ON `kpr_lockout` (`user_id`, `created_at`);
+-- Create "kpr_login_throttle" table
+CREATE TABLE `kpr_login_throttle` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `ip` text NOT NULL, `failures` integer NOT NULL DEFAULT (0), `last_failure_at` datetime NOT NULL);
+-- Create index "kpr_login_throttle_ip_key" to table: "kpr_login_throttle"
+CREATE UNIQUE INDEX `kpr_login_throttle_ip_key` ON `kpr_login_throttle` (`ip`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
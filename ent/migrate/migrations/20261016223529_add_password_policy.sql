@@ -0,0 +1,8 @@
+-- Add column "password_changed_at" to table: "kpr_user"
+ALTER TABLE `kpr_user` ADD COLUMN `password_changed_at` datetime NULL;
+-- Add column "password_policy" to table: "kpr_app"
+ALTER TABLE `kpr_app` ADD COLUMN `password_policy` json NULL;
+-- Create "kpr_password_history" table
+CREATE TABLE `kpr_password_history` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `password_hash` text NOT NULL, `created_at` datetime NOT NULL, `user_id` integer NOT NULL, CONSTRAINT `kpr_password_history_kpr_user_password_history` FOREIGN KEY (`user_id`) REFERENCES `kpr_user` (`id`) ON DELETE CASCADE);
+-- Create index "passwordhistory_user_id_created_at" to table: "kpr_password_history"
+CREATE INDEX `passwordhistory_user_id_created_at` ON `kpr_password_history` (`user_id`, `created_at`);
@@ -0,0 +1,10 @@
+-- Create "kpr_audit_log" table
+CREATE TABLE `kpr_audit_log` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `app_id` integer NULL, `actor_type` text NOT NULL, `actor_id` integer NULL, `action` text NOT NULL, `target_type` text NOT NULL, `target_id` integer NULL, `changes` text NULL, `metadata` text NULL, `ip` text NULL, `request_id` text NULL, `prev_hash` text NOT NULL, `hash` text NOT NULL, `created_at` datetime NOT NULL);
+-- Create index "kpr_audit_log_hash_key" to table: "kpr_audit_log"
+CREATE UNIQUE INDEX `kpr_audit_log_hash_key` ON `kpr_audit_log` (`hash`);
+-- Create index "auditlog_app_id" to table: "kpr_audit_log"
+CREATE INDEX `auditlog_app_id` ON `kpr_audit_log` (`app_id`);
+-- Create index "auditlog_action" to table: "kpr_audit_log"
+CREATE INDEX `auditlog_action` ON `kpr_audit_log` (`action`);
+-- Create index "auditlog_target_type_target_id" to table: "kpr_audit_log"
+CREATE INDEX `auditlog_target_type_target_id` ON `kpr_audit_log` (`target_type`, `target_id`);
@@ -0,0 +1,8 @@
+-- Create "kpr_webhook" table
+CREATE TABLE `kpr_webhook` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `url` text NOT NULL, `secret` text NOT NULL, `events` json NULL, `enabled` bool NOT NULL DEFAULT (true), `description` text NOT NULL DEFAULT (''), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `app_id` integer NOT NULL, CONSTRAINT `kpr_webhook_kpr_app_webhooks` FOREIGN KEY (`app_id`) REFERENCES `kpr_app` (`id`) ON DELETE CASCADE);
+-- Create "kpr_webhook_delivery" table
+CREATE TABLE `kpr_webhook_delivery` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `app_id` integer NOT NULL, `event_id` text NOT NULL, `event` text NOT NULL, `payload` text NOT NULL, `status` text NOT NULL DEFAULT ('pending'), `attempts` integer NOT NULL DEFAULT (0), `next_attempt_at` datetime NOT NULL, `last_error` text NOT NULL DEFAULT (''), `response_status` integer NOT NULL DEFAULT (0), `delivered_at` datetime NULL, `created_at` datetime NOT NULL, `webhook_id` integer NOT NULL, CONSTRAINT `kpr_webhook_delivery_kpr_webhook_deliveries` FOREIGN KEY (`webhook_id`) REFERENCES `kpr_webhook` (`id`) ON DELETE CASCADE);
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "kpr_webhook_delivery"
+CREATE INDEX `webhookdelivery_status_next_attempt_at` ON `kpr_webhook_delivery` (`status`, `next_attempt_at`);
+-- Create index "webhookdelivery_webhook_id_created_at" to table: "kpr_webhook_delivery"
+CREATE INDEX `webhookdelivery_webhook_id_created_at` ON `kpr_webhook_delivery` (`webhook_id`, `created_at`);
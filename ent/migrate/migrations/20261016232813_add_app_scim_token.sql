@@ -0,0 +1,18 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_kpr_app" table
+CREATE TABLE `new_kpr_app` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `name` text NOT NULL, `client_id` text NOT NULL, `redirect_uris` json NULL, `client_secret_hash` text NULL, `scopes` json NULL, `scim_token_hash` text NULL, `require_verified_email` bool NOT NULL DEFAULT (false), `require_mfa` bool NOT NULL DEFAULT (false), `magic_link_enabled` bool NOT NULL DEFAULT (false), `webauthn_rp_id` text NULL, `webauthn_origins` json NULL, `max_login_attempts` integer NOT NULL DEFAULT (10), `lockout_duration` integer NOT NULL DEFAULT (900), `login_delay` integer NOT NULL DEFAULT (1), `max_ip_login_attempts` integer NOT NULL DEFAULT (100), `password_policy` json NULL, `status` integer NOT NULL DEFAULT (1), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- Copy rows from old table "kpr_app" to new temporary table "new_kpr_app"
+INSERT INTO `new_kpr_app` (`id`, `name`, `client_id`, `redirect_uris`, `client_secret_hash`, `scopes`, `require_verified_email`, `require_mfa`, `magic_link_enabled`, `webauthn_rp_id`, `webauthn_origins`, `max_login_attempts`, `lockout_duration`, `login_delay`, `max_ip_login_attempts`, `password_policy`, `status`, `created_at`, `updated_at`) SELECT `id`, `name`, `client_id`, `redirect_uris`, `client_secret_hash`, `scopes`, `require_verified_email`, `require_mfa`, `magic_link_enabled`, `webauthn_rp_id`, `webauthn_origins`, `max_login_attempts`, `lockout_duration`, `login_delay`, `max_ip_login_attempts`, `password_policy`, `status`, `created_at`, `updated_at` FROM `kpr_app`;
+-- Drop "kpr_app" table after copying rows
+DROP TABLE `kpr_app`;
+-- Rename temporary table "new_kpr_app" to "kpr_app"
+ALTER TABLE `new_kpr_app` RENAME TO `kpr_app`;
+-- Create index "kpr_app_name_key" to table: "kpr_app"
+CREATE UNIQUE INDEX `kpr_app_name_key` ON `kpr_app` (`name`);
+-- Create index "kpr_app_client_id_key" to table: "kpr_app"
+CREATE UNIQUE INDEX `kpr_app_client_id_key` ON `kpr_app` (`client_id`);
+-- Create index "kpr_app_scim_token_hash_key" to table: "kpr_app"
+CREATE UNIQUE INDEX `kpr_app_scim_token_hash_key` ON `kpr_app` (`scim_token_hash`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
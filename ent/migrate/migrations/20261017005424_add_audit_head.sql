@@ -0,0 +1,2 @@
+-- Create "kpr_audit_head" table
+CREATE TABLE `kpr_audit_head` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `appended` integer NOT NULL DEFAULT (0));
@@ -13,6 +13,21 @@ var (
 	KprAppColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "client_id", Type: field.TypeString, Unique: true},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true},
+		{Name: "client_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "scim_token_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "require_verified_email", Type: field.TypeBool, Default: false},
+		{Name: "require_mfa", Type: field.TypeBool, Default: false},
+		{Name: "magic_link_enabled", Type: field.TypeBool, Default: false},
+		{Name: "webauthn_rp_id", Type: field.TypeString, Nullable: true},
+		{Name: "webauthn_origins", Type: field.TypeJSON, Nullable: true},
+		{Name: "max_login_attempts", Type: field.TypeInt, Default: 10},
+		{Name: "lockout_duration", Type: field.TypeInt, Default: 900},
+		{Name: "login_delay", Type: field.TypeInt, Default: 1},
+		{Name: "max_ip_login_attempts", Type: field.TypeInt, Default: 100},
+		{Name: "password_policy", Type: field.TypeJSON, Nullable: true},
 		{Name: "status", Type: field.TypeInt8, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -23,6 +38,462 @@ var (
 		Columns:    KprAppColumns,
 		PrimaryKey: []*schema.Column{KprAppColumns[0]},
 	}
+	// KprAuditHeadColumns holds the columns for the "kpr_audit_head" table.
+	KprAuditHeadColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "appended", Type: field.TypeInt, Default: 0},
+	}
+	// KprAuditHeadTable holds the schema information for the "kpr_audit_head" table.
+	KprAuditHeadTable = &schema.Table{
+		Name:       "kpr_audit_head",
+		Columns:    KprAuditHeadColumns,
+		PrimaryKey: []*schema.Column{KprAuditHeadColumns[0]},
+	}
+	// KprAuditLogColumns holds the columns for the "kpr_audit_log" table.
+	KprAuditLogColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "app_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_type", Type: field.TypeEnum, Enums: []string{"user", "app", "anonymous"}},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeInt, Nullable: true},
+		{Name: "changes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "metadata", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "prev_hash", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KprAuditLogTable holds the schema information for the "kpr_audit_log" table.
+	KprAuditLogTable = &schema.Table{
+		Name:       "kpr_audit_log",
+		Columns:    KprAuditLogColumns,
+		PrimaryKey: []*schema.Column{KprAuditLogColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_app_id",
+				Unique:  false,
+				Columns: []*schema.Column{KprAuditLogColumns[1]},
+			},
+			{
+				Name:    "auditlog_action",
+				Unique:  false,
+				Columns: []*schema.Column{KprAuditLogColumns[4]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{KprAuditLogColumns[5], KprAuditLogColumns[6]},
+			},
+		},
+	}
+	// KprAuthorizationCodeColumns holds the columns for the "kpr_authorization_code" table.
+	KprAuthorizationCodeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "redirect_uri", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "nonce", Type: field.TypeString, Default: ""},
+		{Name: "code_challenge", Type: field.TypeString},
+		{Name: "code_challenge_method", Type: field.TypeString},
+		{Name: "auth_time", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprAuthorizationCodeTable holds the schema information for the "kpr_authorization_code" table.
+	KprAuthorizationCodeTable = &schema.Table{
+		Name:       "kpr_authorization_code",
+		Columns:    KprAuthorizationCodeColumns,
+		PrimaryKey: []*schema.Column{KprAuthorizationCodeColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_authorization_code_kpr_app_authorization_codes",
+				Columns:    []*schema.Column{KprAuthorizationCodeColumns[11]},
+				RefColumns: []*schema.Column{KprAppColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "kpr_authorization_code_kpr_user_authorization_codes",
+				Columns:    []*schema.Column{KprAuthorizationCodeColumns[12]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprEmailTemplateColumns holds the columns for the "kpr_email_template" table.
+	KprEmailTemplateColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "html", Type: field.TypeString, Size: 2147483647},
+		{Name: "text", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "app_id", Type: field.TypeInt},
+	}
+	// KprEmailTemplateTable holds the schema information for the "kpr_email_template" table.
+	KprEmailTemplateTable = &schema.Table{
+		Name:       "kpr_email_template",
+		Columns:    KprEmailTemplateColumns,
+		PrimaryKey: []*schema.Column{KprEmailTemplateColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_email_template_kpr_app_email_templates",
+				Columns:    []*schema.Column{KprEmailTemplateColumns[7]},
+				RefColumns: []*schema.Column{KprAppColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "emailtemplate_app_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{KprEmailTemplateColumns[7], KprEmailTemplateColumns[1]},
+			},
+		},
+	}
+	// KprEmailVerificationTokenColumns holds the columns for the "kpr_email_verification_token" table.
+	KprEmailVerificationTokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprEmailVerificationTokenTable holds the schema information for the "kpr_email_verification_token" table.
+	KprEmailVerificationTokenTable = &schema.Table{
+		Name:       "kpr_email_verification_token",
+		Columns:    KprEmailVerificationTokenColumns,
+		PrimaryKey: []*schema.Column{KprEmailVerificationTokenColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_email_verification_token_kpr_user_email_verification_tokens",
+				Columns:    []*schema.Column{KprEmailVerificationTokenColumns[6]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprLockoutColumns holds the columns for the "kpr_lockout" table.
+	KprLockoutColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"account", "ip"}},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "failures", Type: field.TypeInt},
+		{Name: "locked_until", Type: field.TypeTime},
+		{Name: "unlocked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// KprLockoutTable holds the schema information for the "kpr_lockout" table.
+	KprLockoutTable = &schema.Table{
+		Name:       "kpr_lockout",
+		Columns:    KprLockoutColumns,
+		PrimaryKey: []*schema.Column{KprLockoutColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_lockout_kpr_app_lockouts",
+				Columns:    []*schema.Column{KprLockoutColumns[7]},
+				RefColumns: []*schema.Column{KprAppColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "kpr_lockout_kpr_user_lockouts",
+				Columns:    []*schema.Column{KprLockoutColumns[8]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lockout_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{KprLockoutColumns[8], KprLockoutColumns[6]},
+			},
+		},
+	}
+	// KprLoginThrottleColumns holds the columns for the "kpr_login_throttle" table.
+	KprLoginThrottleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "ip", Type: field.TypeString, Unique: true},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure_at", Type: field.TypeTime},
+	}
+	// KprLoginThrottleTable holds the schema information for the "kpr_login_throttle" table.
+	KprLoginThrottleTable = &schema.Table{
+		Name:       "kpr_login_throttle",
+		Columns:    KprLoginThrottleColumns,
+		PrimaryKey: []*schema.Column{KprLoginThrottleColumns[0]},
+	}
+	// KprMfaChallengeColumns holds the columns for the "kpr_mfa_challenge" table.
+	KprMfaChallengeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprMfaChallengeTable holds the schema information for the "kpr_mfa_challenge" table.
+	KprMfaChallengeTable = &schema.Table{
+		Name:       "kpr_mfa_challenge",
+		Columns:    KprMfaChallengeColumns,
+		PrimaryKey: []*schema.Column{KprMfaChallengeColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_mfa_challenge_kpr_user_mfa_challenges",
+				Columns:    []*schema.Column{KprMfaChallengeColumns[6]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprMfaRecoveryCodeColumns holds the columns for the "kpr_mfa_recovery_code" table.
+	KprMfaRecoveryCodeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprMfaRecoveryCodeTable holds the schema information for the "kpr_mfa_recovery_code" table.
+	KprMfaRecoveryCodeTable = &schema.Table{
+		Name:       "kpr_mfa_recovery_code",
+		Columns:    KprMfaRecoveryCodeColumns,
+		PrimaryKey: []*schema.Column{KprMfaRecoveryCodeColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_mfa_recovery_code_kpr_user_mfa_recovery_codes",
+				Columns:    []*schema.Column{KprMfaRecoveryCodeColumns[4]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprMagicLinkTokenColumns holds the columns for the "kpr_magic_link_token" table.
+	KprMagicLinkTokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "nonce_hash", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprMagicLinkTokenTable holds the schema information for the "kpr_magic_link_token" table.
+	KprMagicLinkTokenTable = &schema.Table{
+		Name:       "kpr_magic_link_token",
+		Columns:    KprMagicLinkTokenColumns,
+		PrimaryKey: []*schema.Column{KprMagicLinkTokenColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_magic_link_token_kpr_user_magic_link_tokens",
+				Columns:    []*schema.Column{KprMagicLinkTokenColumns[6]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprOutboundEmailColumns holds the columns for the "kpr_outbound_email" table.
+	KprOutboundEmailColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "app_id", Type: field.TypeInt, Nullable: true},
+		{Name: "template", Type: field.TypeString, Default: ""},
+		{Name: "recipient", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "html", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "text", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KprOutboundEmailTable holds the schema information for the "kpr_outbound_email" table.
+	KprOutboundEmailTable = &schema.Table{
+		Name:       "kpr_outbound_email",
+		Columns:    KprOutboundEmailColumns,
+		PrimaryKey: []*schema.Column{KprOutboundEmailColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboundemail_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{KprOutboundEmailColumns[7], KprOutboundEmailColumns[9]},
+			},
+		},
+	}
+	// KprPasswordHistoryColumns holds the columns for the "kpr_password_history" table.
+	KprPasswordHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprPasswordHistoryTable holds the schema information for the "kpr_password_history" table.
+	KprPasswordHistoryTable = &schema.Table{
+		Name:       "kpr_password_history",
+		Columns:    KprPasswordHistoryColumns,
+		PrimaryKey: []*schema.Column{KprPasswordHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_password_history_kpr_user_password_history",
+				Columns:    []*schema.Column{KprPasswordHistoryColumns[3]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordhistory_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{KprPasswordHistoryColumns[3], KprPasswordHistoryColumns[2]},
+			},
+		},
+	}
+	// KprPasswordResetTokenColumns holds the columns for the "kpr_password_reset_token" table.
+	KprPasswordResetTokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprPasswordResetTokenTable holds the schema information for the "kpr_password_reset_token" table.
+	KprPasswordResetTokenTable = &schema.Table{
+		Name:       "kpr_password_reset_token",
+		Columns:    KprPasswordResetTokenColumns,
+		PrimaryKey: []*schema.Column{KprPasswordResetTokenColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_password_reset_token_kpr_user_password_reset_tokens",
+				Columns:    []*schema.Column{KprPasswordResetTokenColumns[5]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprPermissionColumns holds the columns for the "kpr_permission" table.
+	KprPermissionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KprPermissionTable holds the schema information for the "kpr_permission" table.
+	KprPermissionTable = &schema.Table{
+		Name:       "kpr_permission",
+		Columns:    KprPermissionColumns,
+		PrimaryKey: []*schema.Column{KprPermissionColumns[0]},
+	}
+	// KprRefreshTokenColumns holds the columns for the "kpr_refresh_token" table.
+	KprRefreshTokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "family_id", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeString, Default: ""},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprRefreshTokenTable holds the schema information for the "kpr_refresh_token" table.
+	KprRefreshTokenTable = &schema.Table{
+		Name:       "kpr_refresh_token",
+		Columns:    KprRefreshTokenColumns,
+		PrimaryKey: []*schema.Column{KprRefreshTokenColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_refresh_token_kpr_user_refresh_tokens",
+				Columns:    []*schema.Column{KprRefreshTokenColumns[8]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{KprRefreshTokenColumns[2]},
+			},
+		},
+	}
+	// KprRevokedTokenColumns holds the columns for the "kpr_revoked_token" table.
+	KprRevokedTokenColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "jti", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KprRevokedTokenTable holds the schema information for the "kpr_revoked_token" table.
+	KprRevokedTokenTable = &schema.Table{
+		Name:       "kpr_revoked_token",
+		Columns:    KprRevokedTokenColumns,
+		PrimaryKey: []*schema.Column{KprRevokedTokenColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revokedtoken_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{KprRevokedTokenColumns[2]},
+			},
+		},
+	}
+	// KprRoleColumns holds the columns for the "kpr_role" table.
+	KprRoleColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "app_id", Type: field.TypeInt},
+	}
+	// KprRoleTable holds the schema information for the "kpr_role" table.
+	KprRoleTable = &schema.Table{
+		Name:       "kpr_role",
+		Columns:    KprRoleColumns,
+		PrimaryKey: []*schema.Column{KprRoleColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_role_kpr_app_roles",
+				Columns:    []*schema.Column{KprRoleColumns[5]},
+				RefColumns: []*schema.Column{KprAppColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "role_app_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{KprRoleColumns[5], KprRoleColumns[1]},
+			},
+		},
+	}
+	// KprSigningKeyColumns holds the columns for the "kpr_signing_key" table.
+	KprSigningKeyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kid", Type: field.TypeString, Unique: true},
+		{Name: "algorithm", Type: field.TypeString},
+		{Name: "private_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "activates_at", Type: field.TypeTime},
+		{Name: "retired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KprSigningKeyTable holds the schema information for the "kpr_signing_key" table.
+	KprSigningKeyTable = &schema.Table{
+		Name:       "kpr_signing_key",
+		Columns:    KprSigningKeyColumns,
+		PrimaryKey: []*schema.Column{KprSigningKeyColumns[0]},
+	}
 	// KprUserColumns holds the columns for the "kpr_user" table.
 	KprUserColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -30,7 +501,17 @@ var (
 		{Name: "lastname", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeString},
+		{Name: "password_changed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeInt8, Default: 1},
+		{Name: "platform_admin", Type: field.TypeBool, Default: false},
+		{Name: "tokens_valid_after", Type: field.TypeTime, Nullable: true},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "failed_logins", Type: field.TypeInt, Default: 0},
+		{Name: "last_failed_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "app_id", Type: field.TypeInt},
@@ -43,16 +524,218 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "kpr_user_kpr_app_users",
-				Columns:    []*schema.Column{KprUserColumns[8]},
+				Columns:    []*schema.Column{KprUserColumns[18]},
 				RefColumns: []*schema.Column{KprAppColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// KprWebauthnChallengeColumns holds the columns for the "kpr_webauthn_challenge" table.
+	KprWebauthnChallengeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "challenge_hash", Type: field.TypeString, Unique: true},
+		{Name: "ceremony", Type: field.TypeEnum, Enums: []string{"registration", "login"}},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// KprWebauthnChallengeTable holds the schema information for the "kpr_webauthn_challenge" table.
+	KprWebauthnChallengeTable = &schema.Table{
+		Name:       "kpr_webauthn_challenge",
+		Columns:    KprWebauthnChallengeColumns,
+		PrimaryKey: []*schema.Column{KprWebauthnChallengeColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_webauthn_challenge_kpr_app_webauthn_challenges",
+				Columns:    []*schema.Column{KprWebauthnChallengeColumns[6]},
+				RefColumns: []*schema.Column{KprAppColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "kpr_webauthn_challenge_kpr_user_webauthn_challenges",
+				Columns:    []*schema.Column{KprWebauthnChallengeColumns[7]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprWebauthnCredentialColumns holds the columns for the "kpr_webauthn_credential" table.
+	KprWebauthnCredentialColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backed_up", Type: field.TypeBool, Default: false},
+		{Name: "name", Type: field.TypeString, Default: ""},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// KprWebauthnCredentialTable holds the schema information for the "kpr_webauthn_credential" table.
+	KprWebauthnCredentialTable = &schema.Table{
+		Name:       "kpr_webauthn_credential",
+		Columns:    KprWebauthnCredentialColumns,
+		PrimaryKey: []*schema.Column{KprWebauthnCredentialColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_webauthn_credential_kpr_user_webauthn_credentials",
+				Columns:    []*schema.Column{KprWebauthnCredentialColumns[11]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprWebhookColumns holds the columns for the "kpr_webhook" table.
+	KprWebhookColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "app_id", Type: field.TypeInt},
+	}
+	// KprWebhookTable holds the schema information for the "kpr_webhook" table.
+	KprWebhookTable = &schema.Table{
+		Name:       "kpr_webhook",
+		Columns:    KprWebhookColumns,
+		PrimaryKey: []*schema.Column{KprWebhookColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_webhook_kpr_app_webhooks",
+				Columns:    []*schema.Column{KprWebhookColumns[8]},
+				RefColumns: []*schema.Column{KprAppColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprWebhookDeliveryColumns holds the columns for the "kpr_webhook_delivery" table.
+	KprWebhookDeliveryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "app_id", Type: field.TypeInt},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "delivered", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "response_status", Type: field.TypeInt, Default: 0},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// KprWebhookDeliveryTable holds the schema information for the "kpr_webhook_delivery" table.
+	KprWebhookDeliveryTable = &schema.Table{
+		Name:       "kpr_webhook_delivery",
+		Columns:    KprWebhookDeliveryColumns,
+		PrimaryKey: []*schema.Column{KprWebhookDeliveryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_webhook_delivery_kpr_webhook_deliveries",
+				Columns:    []*schema.Column{KprWebhookDeliveryColumns[12]},
+				RefColumns: []*schema.Column{KprWebhookColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{KprWebhookDeliveryColumns[5], KprWebhookDeliveryColumns[7]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{KprWebhookDeliveryColumns[12], KprWebhookDeliveryColumns[11]},
+			},
+		},
+	}
+	// KprRolePermissionColumns holds the columns for the "kpr_role_permission" table.
+	KprRolePermissionColumns = []*schema.Column{
+		{Name: "role_id", Type: field.TypeInt},
+		{Name: "permission_id", Type: field.TypeInt},
+	}
+	// KprRolePermissionTable holds the schema information for the "kpr_role_permission" table.
+	KprRolePermissionTable = &schema.Table{
+		Name:       "kpr_role_permission",
+		Columns:    KprRolePermissionColumns,
+		PrimaryKey: []*schema.Column{KprRolePermissionColumns[0], KprRolePermissionColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_role_permission_role_id",
+				Columns:    []*schema.Column{KprRolePermissionColumns[0]},
+				RefColumns: []*schema.Column{KprRoleColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "kpr_role_permission_permission_id",
+				Columns:    []*schema.Column{KprRolePermissionColumns[1]},
+				RefColumns: []*schema.Column{KprPermissionColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// KprUserRoleColumns holds the columns for the "kpr_user_role" table.
+	KprUserRoleColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "role_id", Type: field.TypeInt},
+	}
+	// KprUserRoleTable holds the schema information for the "kpr_user_role" table.
+	KprUserRoleTable = &schema.Table{
+		Name:       "kpr_user_role",
+		Columns:    KprUserRoleColumns,
+		PrimaryKey: []*schema.Column{KprUserRoleColumns[0], KprUserRoleColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "kpr_user_role_user_id",
+				Columns:    []*schema.Column{KprUserRoleColumns[0]},
+				RefColumns: []*schema.Column{KprUserColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "kpr_user_role_role_id",
+				Columns:    []*schema.Column{KprUserRoleColumns[1]},
+				RefColumns: []*schema.Column{KprRoleColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		KprAppTable,
+		KprAuditHeadTable,
+		KprAuditLogTable,
+		KprAuthorizationCodeTable,
+		KprEmailTemplateTable,
+		KprEmailVerificationTokenTable,
+		KprLockoutTable,
+		KprLoginThrottleTable,
+		KprMfaChallengeTable,
+		KprMfaRecoveryCodeTable,
+		KprMagicLinkTokenTable,
+		KprOutboundEmailTable,
+		KprPasswordHistoryTable,
+		KprPasswordResetTokenTable,
+		KprPermissionTable,
+		KprRefreshTokenTable,
+		KprRevokedTokenTable,
+		KprRoleTable,
+		KprSigningKeyTable,
 		KprUserTable,
+		KprWebauthnChallengeTable,
+		KprWebauthnCredentialTable,
+		KprWebhookTable,
+		KprWebhookDeliveryTable,
+		KprRolePermissionTable,
+		KprUserRoleTable,
 	}
 )
 
@@ -60,8 +743,96 @@ func init() {
 	KprAppTable.Annotation = &entsql.Annotation{
 		Table: "kpr_app",
 	}
+	KprAuditHeadTable.Annotation = &entsql.Annotation{
+		Table: "kpr_audit_head",
+	}
+	KprAuditLogTable.Annotation = &entsql.Annotation{
+		Table: "kpr_audit_log",
+	}
+	KprAuthorizationCodeTable.ForeignKeys[0].RefTable = KprAppTable
+	KprAuthorizationCodeTable.ForeignKeys[1].RefTable = KprUserTable
+	KprAuthorizationCodeTable.Annotation = &entsql.Annotation{
+		Table: "kpr_authorization_code",
+	}
+	KprEmailTemplateTable.ForeignKeys[0].RefTable = KprAppTable
+	KprEmailTemplateTable.Annotation = &entsql.Annotation{
+		Table: "kpr_email_template",
+	}
+	KprEmailVerificationTokenTable.ForeignKeys[0].RefTable = KprUserTable
+	KprEmailVerificationTokenTable.Annotation = &entsql.Annotation{
+		Table: "kpr_email_verification_token",
+	}
+	KprLockoutTable.ForeignKeys[0].RefTable = KprAppTable
+	KprLockoutTable.ForeignKeys[1].RefTable = KprUserTable
+	KprLockoutTable.Annotation = &entsql.Annotation{
+		Table: "kpr_lockout",
+	}
+	KprLoginThrottleTable.Annotation = &entsql.Annotation{
+		Table: "kpr_login_throttle",
+	}
+	KprMfaChallengeTable.ForeignKeys[0].RefTable = KprUserTable
+	KprMfaChallengeTable.Annotation = &entsql.Annotation{
+		Table: "kpr_mfa_challenge",
+	}
+	KprMfaRecoveryCodeTable.ForeignKeys[0].RefTable = KprUserTable
+	KprMfaRecoveryCodeTable.Annotation = &entsql.Annotation{
+		Table: "kpr_mfa_recovery_code",
+	}
+	KprMagicLinkTokenTable.ForeignKeys[0].RefTable = KprUserTable
+	KprMagicLinkTokenTable.Annotation = &entsql.Annotation{
+		Table: "kpr_magic_link_token",
+	}
+	KprOutboundEmailTable.Annotation = &entsql.Annotation{
+		Table: "kpr_outbound_email",
+	}
+	KprPasswordHistoryTable.ForeignKeys[0].RefTable = KprUserTable
+	KprPasswordHistoryTable.Annotation = &entsql.Annotation{
+		Table: "kpr_password_history",
+	}
+	KprPasswordResetTokenTable.ForeignKeys[0].RefTable = KprUserTable
+	KprPasswordResetTokenTable.Annotation = &entsql.Annotation{
+		Table: "kpr_password_reset_token",
+	}
+	KprPermissionTable.Annotation = &entsql.Annotation{
+		Table: "kpr_permission",
+	}
+	KprRefreshTokenTable.ForeignKeys[0].RefTable = KprUserTable
+	KprRefreshTokenTable.Annotation = &entsql.Annotation{
+		Table: "kpr_refresh_token",
+	}
+	KprRevokedTokenTable.Annotation = &entsql.Annotation{
+		Table: "kpr_revoked_token",
+	}
+	KprRoleTable.ForeignKeys[0].RefTable = KprAppTable
+	KprRoleTable.Annotation = &entsql.Annotation{
+		Table: "kpr_role",
+	}
+	KprSigningKeyTable.Annotation = &entsql.Annotation{
+		Table: "kpr_signing_key",
+	}
 	KprUserTable.ForeignKeys[0].RefTable = KprAppTable
 	KprUserTable.Annotation = &entsql.Annotation{
 		Table: "kpr_user",
 	}
+	KprWebauthnChallengeTable.ForeignKeys[0].RefTable = KprAppTable
+	KprWebauthnChallengeTable.ForeignKeys[1].RefTable = KprUserTable
+	KprWebauthnChallengeTable.Annotation = &entsql.Annotation{
+		Table: "kpr_webauthn_challenge",
+	}
+	KprWebauthnCredentialTable.ForeignKeys[0].RefTable = KprUserTable
+	KprWebauthnCredentialTable.Annotation = &entsql.Annotation{
+		Table: "kpr_webauthn_credential",
+	}
+	KprWebhookTable.ForeignKeys[0].RefTable = KprAppTable
+	KprWebhookTable.Annotation = &entsql.Annotation{
+		Table: "kpr_webhook",
+	}
+	KprWebhookDeliveryTable.ForeignKeys[0].RefTable = KprWebhookTable
+	KprWebhookDeliveryTable.Annotation = &entsql.Annotation{
+		Table: "kpr_webhook_delivery",
+	}
+	KprRolePermissionTable.ForeignKeys[0].RefTable = KprRoleTable
+	KprRolePermissionTable.ForeignKeys[1].RefTable = KprPermissionTable
+	KprUserRoleTable.ForeignKeys[0].RefTable = KprUserTable
+	KprUserRoleTable.ForeignKeys[1].RefTable = KprRoleTable
 }
@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/outboundemail"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboundEmailCreate is the builder for creating a OutboundEmail entity.
+type OutboundEmailCreate struct {
+	config
+	mutation *OutboundEmailMutation
+	hooks    []Hook
+}
+
+// SetAppID sets the "app_id" field.
+func (_c *OutboundEmailCreate) SetAppID(v int) *OutboundEmailCreate {
+	_c.mutation.SetAppID(v)
+	return _c
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableAppID(v *int) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetAppID(*v)
+	}
+	return _c
+}
+
+// SetTemplate sets the "template" field.
+func (_c *OutboundEmailCreate) SetTemplate(v string) *OutboundEmailCreate {
+	_c.mutation.SetTemplate(v)
+	return _c
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableTemplate(v *string) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetTemplate(*v)
+	}
+	return _c
+}
+
+// SetRecipient sets the "recipient" field.
+func (_c *OutboundEmailCreate) SetRecipient(v string) *OutboundEmailCreate {
+	_c.mutation.SetRecipient(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *OutboundEmailCreate) SetSubject(v string) *OutboundEmailCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetHTML sets the "html" field.
+func (_c *OutboundEmailCreate) SetHTML(v string) *OutboundEmailCreate {
+	_c.mutation.SetHTML(v)
+	return _c
+}
+
+// SetNillableHTML sets the "html" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableHTML(v *string) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetHTML(*v)
+	}
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *OutboundEmailCreate) SetText(v string) *OutboundEmailCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableText(v *string) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetText(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OutboundEmailCreate) SetStatus(v outboundemail.Status) *OutboundEmailCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableStatus(v *outboundemail.Status) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *OutboundEmailCreate) SetAttempts(v int) *OutboundEmailCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableAttempts(v *int) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *OutboundEmailCreate) SetNextAttemptAt(v time.Time) *OutboundEmailCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableNextAttemptAt(v *time.Time) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *OutboundEmailCreate) SetLastError(v string) *OutboundEmailCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableLastError(v *string) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *OutboundEmailCreate) SetSentAt(v time.Time) *OutboundEmailCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableSentAt(v *time.Time) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OutboundEmailCreate) SetCreatedAt(v time.Time) *OutboundEmailCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OutboundEmailCreate) SetNillableCreatedAt(v *time.Time) *OutboundEmailCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OutboundEmailMutation object of the builder.
+func (_c *OutboundEmailCreate) Mutation() *OutboundEmailMutation {
+	return _c.mutation
+}
+
+// Save creates the OutboundEmail in the database.
+func (_c *OutboundEmailCreate) Save(ctx context.Context) (*OutboundEmail, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OutboundEmailCreate) SaveX(ctx context.Context) *OutboundEmail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutboundEmailCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutboundEmailCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OutboundEmailCreate) defaults() {
+	if _, ok := _c.mutation.Template(); !ok {
+		v := outboundemail.DefaultTemplate
+		_c.mutation.SetTemplate(v)
+	}
+	if _, ok := _c.mutation.HTML(); !ok {
+		v := outboundemail.DefaultHTML
+		_c.mutation.SetHTML(v)
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		v := outboundemail.DefaultText
+		_c.mutation.SetText(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := outboundemail.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := outboundemail.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := outboundemail.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		v := outboundemail.DefaultLastError
+		_c.mutation.SetLastError(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := outboundemail.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OutboundEmailCreate) check() error {
+	if _, ok := _c.mutation.Template(); !ok {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required field "OutboundEmail.template"`)}
+	}
+	if _, ok := _c.mutation.Recipient(); !ok {
+		return &ValidationError{Name: "recipient", err: errors.New(`ent: missing required field "OutboundEmail.recipient"`)}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "OutboundEmail.subject"`)}
+	}
+	if _, ok := _c.mutation.HTML(); !ok {
+		return &ValidationError{Name: "html", err: errors.New(`ent: missing required field "OutboundEmail.html"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "OutboundEmail.text"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OutboundEmail.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := outboundemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboundEmail.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OutboundEmail.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "OutboundEmail.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.LastError(); !ok {
+		return &ValidationError{Name: "last_error", err: errors.New(`ent: missing required field "OutboundEmail.last_error"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OutboundEmail.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *OutboundEmailCreate) sqlSave(ctx context.Context) (*OutboundEmail, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OutboundEmailCreate) createSpec() (*OutboundEmail, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboundEmail{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(outboundemail.Table, sqlgraph.NewFieldSpec(outboundemail.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.AppID(); ok {
+		_spec.SetField(outboundemail.FieldAppID, field.TypeInt, value)
+		_node.AppID = &value
+	}
+	if value, ok := _c.mutation.Template(); ok {
+		_spec.SetField(outboundemail.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := _c.mutation.Recipient(); ok {
+		_spec.SetField(outboundemail.FieldRecipient, field.TypeString, value)
+		_node.Recipient = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(outboundemail.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.HTML(); ok {
+		_spec.SetField(outboundemail.FieldHTML, field.TypeString, value)
+		_node.HTML = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(outboundemail.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(outboundemail.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(outboundemail.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboundemail.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(outboundemail.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(outboundemail.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(outboundemail.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OutboundEmailCreateBulk is the builder for creating many OutboundEmail entities in bulk.
+type OutboundEmailCreateBulk struct {
+	config
+	err      error
+	builders []*OutboundEmailCreate
+}
+
+// Save creates the OutboundEmail entities in the database.
+func (_c *OutboundEmailCreateBulk) Save(ctx context.Context) ([]*OutboundEmail, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OutboundEmail, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboundEmailMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OutboundEmailCreateBulk) SaveX(ctx context.Context) []*OutboundEmail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OutboundEmailCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OutboundEmailCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/outboundemail"
+	"keeper/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboundEmailUpdate is the builder for updating OutboundEmail entities.
+type OutboundEmailUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboundEmailMutation
+}
+
+// Where appends a list predicates to the OutboundEmailUpdate builder.
+func (_u *OutboundEmailUpdate) Where(ps ...predicate.OutboundEmail) *OutboundEmailUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *OutboundEmailUpdate) SetAppID(v int) *OutboundEmailUpdate {
+	_u.mutation.ResetAppID()
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableAppID(v *int) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// AddAppID adds value to the "app_id" field.
+func (_u *OutboundEmailUpdate) AddAppID(v int) *OutboundEmailUpdate {
+	_u.mutation.AddAppID(v)
+	return _u
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (_u *OutboundEmailUpdate) ClearAppID() *OutboundEmailUpdate {
+	_u.mutation.ClearAppID()
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *OutboundEmailUpdate) SetTemplate(v string) *OutboundEmailUpdate {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableTemplate(v *string) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" field.
+func (_u *OutboundEmailUpdate) SetRecipient(v string) *OutboundEmailUpdate {
+	_u.mutation.SetRecipient(v)
+	return _u
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableRecipient(v *string) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetRecipient(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *OutboundEmailUpdate) SetSubject(v string) *OutboundEmailUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableSubject(v *string) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetHTML sets the "html" field.
+func (_u *OutboundEmailUpdate) SetHTML(v string) *OutboundEmailUpdate {
+	_u.mutation.SetHTML(v)
+	return _u
+}
+
+// SetNillableHTML sets the "html" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableHTML(v *string) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetHTML(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *OutboundEmailUpdate) SetText(v string) *OutboundEmailUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableText(v *string) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OutboundEmailUpdate) SetStatus(v outboundemail.Status) *OutboundEmailUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableStatus(v *outboundemail.Status) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *OutboundEmailUpdate) SetAttempts(v int) *OutboundEmailUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableAttempts(v *int) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboundEmailUpdate) AddAttempts(v int) *OutboundEmailUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *OutboundEmailUpdate) SetNextAttemptAt(v time.Time) *OutboundEmailUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableNextAttemptAt(v *time.Time) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *OutboundEmailUpdate) SetLastError(v string) *OutboundEmailUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableLastError(v *string) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *OutboundEmailUpdate) SetSentAt(v time.Time) *OutboundEmailUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableSentAt(v *time.Time) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *OutboundEmailUpdate) ClearSentAt() *OutboundEmailUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *OutboundEmailUpdate) SetCreatedAt(v time.Time) *OutboundEmailUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *OutboundEmailUpdate) SetNillableCreatedAt(v *time.Time) *OutboundEmailUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the OutboundEmailMutation object of the builder.
+func (_u *OutboundEmailUpdate) Mutation() *OutboundEmailMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OutboundEmailUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboundEmailUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OutboundEmailUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboundEmailUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutboundEmailUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := outboundemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboundEmail.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OutboundEmailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboundemail.Table, outboundemail.Columns, sqlgraph.NewFieldSpec(outboundemail.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AppID(); ok {
+		_spec.SetField(outboundemail.FieldAppID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAppID(); ok {
+		_spec.AddField(outboundemail.FieldAppID, field.TypeInt, value)
+	}
+	if _u.mutation.AppIDCleared() {
+		_spec.ClearField(outboundemail.FieldAppID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(outboundemail.FieldTemplate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Recipient(); ok {
+		_spec.SetField(outboundemail.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(outboundemail.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HTML(); ok {
+		_spec.SetField(outboundemail.FieldHTML, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(outboundemail.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(outboundemail.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outboundemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboundemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboundemail.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(outboundemail.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(outboundemail.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(outboundemail.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(outboundemail.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboundemail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OutboundEmailUpdateOne is the builder for updating a single OutboundEmail entity.
+type OutboundEmailUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboundEmailMutation
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *OutboundEmailUpdateOne) SetAppID(v int) *OutboundEmailUpdateOne {
+	_u.mutation.ResetAppID()
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableAppID(v *int) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// AddAppID adds value to the "app_id" field.
+func (_u *OutboundEmailUpdateOne) AddAppID(v int) *OutboundEmailUpdateOne {
+	_u.mutation.AddAppID(v)
+	return _u
+}
+
+// ClearAppID clears the value of the "app_id" field.
+func (_u *OutboundEmailUpdateOne) ClearAppID() *OutboundEmailUpdateOne {
+	_u.mutation.ClearAppID()
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *OutboundEmailUpdateOne) SetTemplate(v string) *OutboundEmailUpdateOne {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableTemplate(v *string) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" field.
+func (_u *OutboundEmailUpdateOne) SetRecipient(v string) *OutboundEmailUpdateOne {
+	_u.mutation.SetRecipient(v)
+	return _u
+}
+
+// SetNillableRecipient sets the "recipient" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableRecipient(v *string) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetRecipient(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *OutboundEmailUpdateOne) SetSubject(v string) *OutboundEmailUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableSubject(v *string) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetHTML sets the "html" field.
+func (_u *OutboundEmailUpdateOne) SetHTML(v string) *OutboundEmailUpdateOne {
+	_u.mutation.SetHTML(v)
+	return _u
+}
+
+// SetNillableHTML sets the "html" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableHTML(v *string) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetHTML(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *OutboundEmailUpdateOne) SetText(v string) *OutboundEmailUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableText(v *string) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OutboundEmailUpdateOne) SetStatus(v outboundemail.Status) *OutboundEmailUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableStatus(v *outboundemail.Status) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *OutboundEmailUpdateOne) SetAttempts(v int) *OutboundEmailUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableAttempts(v *int) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboundEmailUpdateOne) AddAttempts(v int) *OutboundEmailUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *OutboundEmailUpdateOne) SetNextAttemptAt(v time.Time) *OutboundEmailUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableNextAttemptAt(v *time.Time) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *OutboundEmailUpdateOne) SetLastError(v string) *OutboundEmailUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableLastError(v *string) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *OutboundEmailUpdateOne) SetSentAt(v time.Time) *OutboundEmailUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableSentAt(v *time.Time) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *OutboundEmailUpdateOne) ClearSentAt() *OutboundEmailUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *OutboundEmailUpdateOne) SetCreatedAt(v time.Time) *OutboundEmailUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *OutboundEmailUpdateOne) SetNillableCreatedAt(v *time.Time) *OutboundEmailUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the OutboundEmailMutation object of the builder.
+func (_u *OutboundEmailUpdateOne) Mutation() *OutboundEmailMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OutboundEmailUpdate builder.
+func (_u *OutboundEmailUpdateOne) Where(ps ...predicate.OutboundEmail) *OutboundEmailUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OutboundEmailUpdateOne) Select(field string, fields ...string) *OutboundEmailUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OutboundEmail entity.
+func (_u *OutboundEmailUpdateOne) Save(ctx context.Context) (*OutboundEmail, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OutboundEmailUpdateOne) SaveX(ctx context.Context) *OutboundEmail {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OutboundEmailUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OutboundEmailUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OutboundEmailUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := outboundemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboundEmail.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OutboundEmailUpdateOne) sqlSave(ctx context.Context) (_node *OutboundEmail, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboundemail.Table, outboundemail.Columns, sqlgraph.NewFieldSpec(outboundemail.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboundEmail.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboundemail.FieldID)
+		for _, f := range fields {
+			if !outboundemail.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboundemail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AppID(); ok {
+		_spec.SetField(outboundemail.FieldAppID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAppID(); ok {
+		_spec.AddField(outboundemail.FieldAppID, field.TypeInt, value)
+	}
+	if _u.mutation.AppIDCleared() {
+		_spec.ClearField(outboundemail.FieldAppID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(outboundemail.FieldTemplate, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Recipient(); ok {
+		_spec.SetField(outboundemail.FieldRecipient, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(outboundemail.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HTML(); ok {
+		_spec.SetField(outboundemail.FieldHTML, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(outboundemail.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(outboundemail.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outboundemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboundemail.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboundemail.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(outboundemail.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(outboundemail.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(outboundemail.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(outboundemail.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &OutboundEmail{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboundemail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -2,9 +2,468 @@
 
 package runtime
 
-// The schema-stitching logic is generated in keeper/ent/runtime.go
+import (
+	"context"
+	"keeper/ent/app"
+	"keeper/ent/audithead"
+	"keeper/ent/auditlog"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/emailtemplate"
+	"keeper/ent/emailverificationtoken"
+	"keeper/ent/lockout"
+	"keeper/ent/loginthrottle"
+	"keeper/ent/magiclinktoken"
+	"keeper/ent/mfachallenge"
+	"keeper/ent/mfarecoverycode"
+	"keeper/ent/outboundemail"
+	"keeper/ent/passwordhistory"
+	"keeper/ent/passwordresettoken"
+	"keeper/ent/permission"
+	"keeper/ent/refreshtoken"
+	"keeper/ent/revokedtoken"
+	"keeper/ent/role"
+	"keeper/ent/schema"
+	"keeper/ent/signingkey"
+	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"keeper/ent/webauthncredential"
+	"keeper/ent/webhook"
+	"keeper/ent/webhookdelivery"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	app.Policy = privacy.NewPolicies(schema.App{})
+	app.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := app.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	appFields := schema.App{}.Fields()
+	_ = appFields
+	// appDescClientID is the schema descriptor for client_id field.
+	appDescClientID := appFields[1].Descriptor()
+	// app.DefaultClientID holds the default value on creation for the client_id field.
+	app.DefaultClientID = appDescClientID.Default.(func() string)
+	// appDescRequireVerifiedEmail is the schema descriptor for require_verified_email field.
+	appDescRequireVerifiedEmail := appFields[6].Descriptor()
+	// app.DefaultRequireVerifiedEmail holds the default value on creation for the require_verified_email field.
+	app.DefaultRequireVerifiedEmail = appDescRequireVerifiedEmail.Default.(bool)
+	// appDescRequireMfa is the schema descriptor for require_mfa field.
+	appDescRequireMfa := appFields[7].Descriptor()
+	// app.DefaultRequireMfa holds the default value on creation for the require_mfa field.
+	app.DefaultRequireMfa = appDescRequireMfa.Default.(bool)
+	// appDescMagicLinkEnabled is the schema descriptor for magic_link_enabled field.
+	appDescMagicLinkEnabled := appFields[8].Descriptor()
+	// app.DefaultMagicLinkEnabled holds the default value on creation for the magic_link_enabled field.
+	app.DefaultMagicLinkEnabled = appDescMagicLinkEnabled.Default.(bool)
+	// appDescMaxLoginAttempts is the schema descriptor for max_login_attempts field.
+	appDescMaxLoginAttempts := appFields[11].Descriptor()
+	// app.DefaultMaxLoginAttempts holds the default value on creation for the max_login_attempts field.
+	app.DefaultMaxLoginAttempts = appDescMaxLoginAttempts.Default.(int)
+	// app.MaxLoginAttemptsValidator is a validator for the "max_login_attempts" field. It is called by the builders before save.
+	app.MaxLoginAttemptsValidator = appDescMaxLoginAttempts.Validators[0].(func(int) error)
+	// appDescLockoutDuration is the schema descriptor for lockout_duration field.
+	appDescLockoutDuration := appFields[12].Descriptor()
+	// app.DefaultLockoutDuration holds the default value on creation for the lockout_duration field.
+	app.DefaultLockoutDuration = appDescLockoutDuration.Default.(int)
+	// app.LockoutDurationValidator is a validator for the "lockout_duration" field. It is called by the builders before save.
+	app.LockoutDurationValidator = appDescLockoutDuration.Validators[0].(func(int) error)
+	// appDescLoginDelay is the schema descriptor for login_delay field.
+	appDescLoginDelay := appFields[13].Descriptor()
+	// app.DefaultLoginDelay holds the default value on creation for the login_delay field.
+	app.DefaultLoginDelay = appDescLoginDelay.Default.(int)
+	// app.LoginDelayValidator is a validator for the "login_delay" field. It is called by the builders before save.
+	app.LoginDelayValidator = appDescLoginDelay.Validators[0].(func(int) error)
+	// appDescMaxIPLoginAttempts is the schema descriptor for max_ip_login_attempts field.
+	appDescMaxIPLoginAttempts := appFields[14].Descriptor()
+	// app.DefaultMaxIPLoginAttempts holds the default value on creation for the max_ip_login_attempts field.
+	app.DefaultMaxIPLoginAttempts = appDescMaxIPLoginAttempts.Default.(int)
+	// app.MaxIPLoginAttemptsValidator is a validator for the "max_ip_login_attempts" field. It is called by the builders before save.
+	app.MaxIPLoginAttemptsValidator = appDescMaxIPLoginAttempts.Validators[0].(func(int) error)
+	// appDescStatus is the schema descriptor for status field.
+	appDescStatus := appFields[16].Descriptor()
+	// app.DefaultStatus holds the default value on creation for the status field.
+	app.DefaultStatus = appDescStatus.Default.(int8)
+	// appDescCreatedAt is the schema descriptor for created_at field.
+	appDescCreatedAt := appFields[17].Descriptor()
+	// app.DefaultCreatedAt holds the default value on creation for the created_at field.
+	app.DefaultCreatedAt = appDescCreatedAt.Default.(func() time.Time)
+	// appDescUpdatedAt is the schema descriptor for updated_at field.
+	appDescUpdatedAt := appFields[18].Descriptor()
+	// app.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	app.DefaultUpdatedAt = appDescUpdatedAt.Default.(func() time.Time)
+	// app.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	app.UpdateDefaultUpdatedAt = appDescUpdatedAt.UpdateDefault.(func() time.Time)
+	auditheadFields := schema.AuditHead{}.Fields()
+	_ = auditheadFields
+	// auditheadDescAppended is the schema descriptor for appended field.
+	auditheadDescAppended := auditheadFields[0].Descriptor()
+	// audithead.DefaultAppended holds the default value on creation for the appended field.
+	audithead.DefaultAppended = auditheadDescAppended.Default.(int)
+	auditlog.Policy = privacy.NewPolicies(schema.AuditLog{})
+	auditlog.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditlog.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[12].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	authorizationcodeFields := schema.AuthorizationCode{}.Fields()
+	_ = authorizationcodeFields
+	// authorizationcodeDescNonce is the schema descriptor for nonce field.
+	authorizationcodeDescNonce := authorizationcodeFields[5].Descriptor()
+	// authorizationcode.DefaultNonce holds the default value on creation for the nonce field.
+	authorizationcode.DefaultNonce = authorizationcodeDescNonce.Default.(string)
+	// authorizationcodeDescCreatedAt is the schema descriptor for created_at field.
+	authorizationcodeDescCreatedAt := authorizationcodeFields[11].Descriptor()
+	// authorizationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authorizationcode.DefaultCreatedAt = authorizationcodeDescCreatedAt.Default.(func() time.Time)
+	emailtemplate.Policy = privacy.NewPolicies(schema.EmailTemplate{})
+	emailtemplate.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := emailtemplate.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	emailtemplateFields := schema.EmailTemplate{}.Fields()
+	_ = emailtemplateFields
+	// emailtemplateDescName is the schema descriptor for name field.
+	emailtemplateDescName := emailtemplateFields[1].Descriptor()
+	// emailtemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	emailtemplate.NameValidator = emailtemplateDescName.Validators[0].(func(string) error)
+	// emailtemplateDescSubject is the schema descriptor for subject field.
+	emailtemplateDescSubject := emailtemplateFields[2].Descriptor()
+	// emailtemplate.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	emailtemplate.SubjectValidator = emailtemplateDescSubject.Validators[0].(func(string) error)
+	// emailtemplateDescHTML is the schema descriptor for html field.
+	emailtemplateDescHTML := emailtemplateFields[3].Descriptor()
+	// emailtemplate.HTMLValidator is a validator for the "html" field. It is called by the builders before save.
+	emailtemplate.HTMLValidator = emailtemplateDescHTML.Validators[0].(func(string) error)
+	// emailtemplateDescText is the schema descriptor for text field.
+	emailtemplateDescText := emailtemplateFields[4].Descriptor()
+	// emailtemplate.DefaultText holds the default value on creation for the text field.
+	emailtemplate.DefaultText = emailtemplateDescText.Default.(string)
+	// emailtemplateDescCreatedAt is the schema descriptor for created_at field.
+	emailtemplateDescCreatedAt := emailtemplateFields[5].Descriptor()
+	// emailtemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailtemplate.DefaultCreatedAt = emailtemplateDescCreatedAt.Default.(func() time.Time)
+	// emailtemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	emailtemplateDescUpdatedAt := emailtemplateFields[6].Descriptor()
+	// emailtemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	emailtemplate.DefaultUpdatedAt = emailtemplateDescUpdatedAt.Default.(func() time.Time)
+	// emailtemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	emailtemplate.UpdateDefaultUpdatedAt = emailtemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	emailverificationtokenFields := schema.EmailVerificationToken{}.Fields()
+	_ = emailverificationtokenFields
+	// emailverificationtokenDescCreatedAt is the schema descriptor for created_at field.
+	emailverificationtokenDescCreatedAt := emailverificationtokenFields[5].Descriptor()
+	// emailverificationtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	emailverificationtoken.DefaultCreatedAt = emailverificationtokenDescCreatedAt.Default.(func() time.Time)
+	lockout.Policy = privacy.NewPolicies(schema.Lockout{})
+	lockout.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := lockout.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	lockoutFields := schema.Lockout{}.Fields()
+	_ = lockoutFields
+	// lockoutDescCreatedAt is the schema descriptor for created_at field.
+	lockoutDescCreatedAt := lockoutFields[7].Descriptor()
+	// lockout.DefaultCreatedAt holds the default value on creation for the created_at field.
+	lockout.DefaultCreatedAt = lockoutDescCreatedAt.Default.(func() time.Time)
+	loginthrottleFields := schema.LoginThrottle{}.Fields()
+	_ = loginthrottleFields
+	// loginthrottleDescFailures is the schema descriptor for failures field.
+	loginthrottleDescFailures := loginthrottleFields[1].Descriptor()
+	// loginthrottle.DefaultFailures holds the default value on creation for the failures field.
+	loginthrottle.DefaultFailures = loginthrottleDescFailures.Default.(int)
+	mfachallengeFields := schema.MFAChallenge{}.Fields()
+	_ = mfachallengeFields
+	// mfachallengeDescAttempts is the schema descriptor for attempts field.
+	mfachallengeDescAttempts := mfachallengeFields[2].Descriptor()
+	// mfachallenge.DefaultAttempts holds the default value on creation for the attempts field.
+	mfachallenge.DefaultAttempts = mfachallengeDescAttempts.Default.(int)
+	// mfachallengeDescCreatedAt is the schema descriptor for created_at field.
+	mfachallengeDescCreatedAt := mfachallengeFields[5].Descriptor()
+	// mfachallenge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mfachallenge.DefaultCreatedAt = mfachallengeDescCreatedAt.Default.(func() time.Time)
+	mfarecoverycodeFields := schema.MFARecoveryCode{}.Fields()
+	_ = mfarecoverycodeFields
+	// mfarecoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	mfarecoverycodeDescCreatedAt := mfarecoverycodeFields[3].Descriptor()
+	// mfarecoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mfarecoverycode.DefaultCreatedAt = mfarecoverycodeDescCreatedAt.Default.(func() time.Time)
+	magiclinktokenFields := schema.MagicLinkToken{}.Fields()
+	_ = magiclinktokenFields
+	// magiclinktokenDescCreatedAt is the schema descriptor for created_at field.
+	magiclinktokenDescCreatedAt := magiclinktokenFields[5].Descriptor()
+	// magiclinktoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	magiclinktoken.DefaultCreatedAt = magiclinktokenDescCreatedAt.Default.(func() time.Time)
+	outboundemailFields := schema.OutboundEmail{}.Fields()
+	_ = outboundemailFields
+	// outboundemailDescTemplate is the schema descriptor for template field.
+	outboundemailDescTemplate := outboundemailFields[1].Descriptor()
+	// outboundemail.DefaultTemplate holds the default value on creation for the template field.
+	outboundemail.DefaultTemplate = outboundemailDescTemplate.Default.(string)
+	// outboundemailDescHTML is the schema descriptor for html field.
+	outboundemailDescHTML := outboundemailFields[4].Descriptor()
+	// outboundemail.DefaultHTML holds the default value on creation for the html field.
+	outboundemail.DefaultHTML = outboundemailDescHTML.Default.(string)
+	// outboundemailDescText is the schema descriptor for text field.
+	outboundemailDescText := outboundemailFields[5].Descriptor()
+	// outboundemail.DefaultText holds the default value on creation for the text field.
+	outboundemail.DefaultText = outboundemailDescText.Default.(string)
+	// outboundemailDescAttempts is the schema descriptor for attempts field.
+	outboundemailDescAttempts := outboundemailFields[7].Descriptor()
+	// outboundemail.DefaultAttempts holds the default value on creation for the attempts field.
+	outboundemail.DefaultAttempts = outboundemailDescAttempts.Default.(int)
+	// outboundemailDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboundemailDescNextAttemptAt := outboundemailFields[8].Descriptor()
+	// outboundemail.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboundemail.DefaultNextAttemptAt = outboundemailDescNextAttemptAt.Default.(func() time.Time)
+	// outboundemailDescLastError is the schema descriptor for last_error field.
+	outboundemailDescLastError := outboundemailFields[9].Descriptor()
+	// outboundemail.DefaultLastError holds the default value on creation for the last_error field.
+	outboundemail.DefaultLastError = outboundemailDescLastError.Default.(string)
+	// outboundemailDescCreatedAt is the schema descriptor for created_at field.
+	outboundemailDescCreatedAt := outboundemailFields[11].Descriptor()
+	// outboundemail.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboundemail.DefaultCreatedAt = outboundemailDescCreatedAt.Default.(func() time.Time)
+	passwordhistoryFields := schema.PasswordHistory{}.Fields()
+	_ = passwordhistoryFields
+	// passwordhistoryDescCreatedAt is the schema descriptor for created_at field.
+	passwordhistoryDescCreatedAt := passwordhistoryFields[2].Descriptor()
+	// passwordhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordhistory.DefaultCreatedAt = passwordhistoryDescCreatedAt.Default.(func() time.Time)
+	passwordresettokenFields := schema.PasswordResetToken{}.Fields()
+	_ = passwordresettokenFields
+	// passwordresettokenDescCreatedAt is the schema descriptor for created_at field.
+	passwordresettokenDescCreatedAt := passwordresettokenFields[4].Descriptor()
+	// passwordresettoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordresettoken.DefaultCreatedAt = passwordresettokenDescCreatedAt.Default.(func() time.Time)
+	permission.Policy = privacy.NewPolicies(schema.Permission{})
+	permission.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := permission.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	permissionFields := schema.Permission{}.Fields()
+	_ = permissionFields
+	// permissionDescCreatedAt is the schema descriptor for created_at field.
+	permissionDescCreatedAt := permissionFields[1].Descriptor()
+	// permission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	permission.DefaultCreatedAt = permissionDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescScope is the schema descriptor for scope field.
+	refreshtokenDescScope := refreshtokenFields[3].Descriptor()
+	// refreshtoken.DefaultScope holds the default value on creation for the scope field.
+	refreshtoken.DefaultScope = refreshtokenDescScope.Default.(string)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[7].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	revokedtokenFields := schema.RevokedToken{}.Fields()
+	_ = revokedtokenFields
+	// revokedtokenDescCreatedAt is the schema descriptor for created_at field.
+	revokedtokenDescCreatedAt := revokedtokenFields[2].Descriptor()
+	// revokedtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	revokedtoken.DefaultCreatedAt = revokedtokenDescCreatedAt.Default.(func() time.Time)
+	role.Policy = privacy.NewPolicies(schema.Role{})
+	role.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := role.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[1].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescDescription is the schema descriptor for description field.
+	roleDescDescription := roleFields[2].Descriptor()
+	// role.DefaultDescription holds the default value on creation for the description field.
+	role.DefaultDescription = roleDescDescription.Default.(string)
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleFields[3].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleFields[4].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescActivatesAt is the schema descriptor for activates_at field.
+	signingkeyDescActivatesAt := signingkeyFields[3].Descriptor()
+	// signingkey.DefaultActivatesAt holds the default value on creation for the activates_at field.
+	signingkey.DefaultActivatesAt = signingkeyDescActivatesAt.Default.(func() time.Time)
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyFields[5].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
+	user.Policy = privacy.NewPolicies(schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescStatus is the schema descriptor for status field.
+	userDescStatus := userFields[6].Descriptor()
+	// user.DefaultStatus holds the default value on creation for the status field.
+	user.DefaultStatus = userDescStatus.Default.(int8)
+	// userDescPlatformAdmin is the schema descriptor for platform_admin field.
+	userDescPlatformAdmin := userFields[7].Descriptor()
+	// user.DefaultPlatformAdmin holds the default value on creation for the platform_admin field.
+	user.DefaultPlatformAdmin = userDescPlatformAdmin.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[12].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescFailedLogins is the schema descriptor for failed_logins field.
+	userDescFailedLogins := userFields[13].Descriptor()
+	// user.DefaultFailedLogins holds the default value on creation for the failed_logins field.
+	user.DefaultFailedLogins = userDescFailedLogins.Default.(int)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[16].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[17].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webauthnchallengeFields := schema.WebAuthnChallenge{}.Fields()
+	_ = webauthnchallengeFields
+	// webauthnchallengeDescCreatedAt is the schema descriptor for created_at field.
+	webauthnchallengeDescCreatedAt := webauthnchallengeFields[6].Descriptor()
+	// webauthnchallenge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthnchallenge.DefaultCreatedAt = webauthnchallengeDescCreatedAt.Default.(func() time.Time)
+	webauthncredentialFields := schema.WebAuthnCredential{}.Fields()
+	_ = webauthncredentialFields
+	// webauthncredentialDescSignCount is the schema descriptor for sign_count field.
+	webauthncredentialDescSignCount := webauthncredentialFields[3].Descriptor()
+	// webauthncredential.DefaultSignCount holds the default value on creation for the sign_count field.
+	webauthncredential.DefaultSignCount = webauthncredentialDescSignCount.Default.(uint32)
+	// webauthncredentialDescBackupEligible is the schema descriptor for backup_eligible field.
+	webauthncredentialDescBackupEligible := webauthncredentialFields[6].Descriptor()
+	// webauthncredential.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	webauthncredential.DefaultBackupEligible = webauthncredentialDescBackupEligible.Default.(bool)
+	// webauthncredentialDescBackedUp is the schema descriptor for backed_up field.
+	webauthncredentialDescBackedUp := webauthncredentialFields[7].Descriptor()
+	// webauthncredential.DefaultBackedUp holds the default value on creation for the backed_up field.
+	webauthncredential.DefaultBackedUp = webauthncredentialDescBackedUp.Default.(bool)
+	// webauthncredentialDescName is the schema descriptor for name field.
+	webauthncredentialDescName := webauthncredentialFields[8].Descriptor()
+	// webauthncredential.DefaultName holds the default value on creation for the name field.
+	webauthncredential.DefaultName = webauthncredentialDescName.Default.(string)
+	// webauthncredentialDescCreatedAt is the schema descriptor for created_at field.
+	webauthncredentialDescCreatedAt := webauthncredentialFields[10].Descriptor()
+	// webauthncredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthncredential.DefaultCreatedAt = webauthncredentialDescCreatedAt.Default.(func() time.Time)
+	webhook.Policy = privacy.NewPolicies(schema.Webhook{})
+	webhook.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhook.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescDescription is the schema descriptor for description field.
+	webhookDescDescription := webhookFields[5].Descriptor()
+	// webhook.DefaultDescription holds the default value on creation for the description field.
+	webhook.DefaultDescription = webhookDescDescription.Default.(string)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[7].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookdelivery.Policy = privacy.NewPolicies(schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultLastError holds the default value on creation for the last_error field.
+	webhookdelivery.DefaultLastError = webhookdeliveryDescLastError.Default.(string)
+	// webhookdeliveryDescResponseStatus is the schema descriptor for response_status field.
+	webhookdeliveryDescResponseStatus := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.DefaultResponseStatus holds the default value on creation for the response_status field.
+	webhookdelivery.DefaultResponseStatus = webhookdeliveryDescResponseStatus.Default.(int)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
-	Version = "v0.14.5"                                         // Version of ent codegen.
-	Sum     = "h1:Rj2WOYJtCkWyFo6a+5wB3EfBRP0rnx1fMk6gGA0UUe4=" // Sum of ent codegen.
+	Version = "v0.14.5" // Version of ent codegen.
 )
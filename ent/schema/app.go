@@ -1,8 +1,14 @@
 package schema
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"time"
 
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+	"keeper/pkg/password"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -26,6 +32,42 @@ func (App) Annotations() []schema.Annotation {
 func (App) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name").Unique(),
+		field.String("client_id").Unique().Immutable().DefaultFunc(newClientID),
+		field.JSON("redirect_uris", []string{}).Optional(),
+		field.String("client_secret_hash").Optional().Nillable().Sensitive(),
+		field.Strings("scopes").Optional(),
+		// scim_token_hash is the hash of the bearer token identity providers
+		// provision the app's users with over SCIM. SCIM is disabled while
+		// it is unset.
+		field.String("scim_token_hash").Optional().Nillable().Unique().Sensitive(),
+		// require_verified_email stops users from logging in until they have
+		// verified their email.
+		field.Bool("require_verified_email").Default(false),
+		// require_mfa makes every user of the app complete a TOTP challenge,
+		// enrolling first if needed, before tokens are issued.
+		field.Bool("require_mfa").Default(false),
+		// magic_link_enabled lets users log in with a single-use link sent
+		// to their email instead of a password.
+		field.Bool("magic_link_enabled").Default(false),
+		// webauthn_rp_id is the domain passkeys of the app are bound to.
+		// Passkeys are disabled while it is empty.
+		field.String("webauthn_rp_id").Optional(),
+		// webauthn_origins are the origins allowed to use the app's passkeys,
+		// https://<webauthn_rp_id> when empty.
+		field.Strings("webauthn_origins").Optional(),
+		// Failed password logins are throttled per user and per client IP.
+		// After max_login_attempts failures in a row the user is locked for
+		// lockout_duration seconds. From the third failure on, the next
+		// attempt must also wait login_delay seconds, doubled with every
+		// further failure. max_ip_login_attempts failures from one IP lock
+		// that IP out of the app's logins. Zero disables a limit.
+		field.Int("max_login_attempts").NonNegative().Default(10),
+		field.Int("lockout_duration").Positive().Default(900),
+		field.Int("login_delay").NonNegative().Default(1),
+		field.Int("max_ip_login_attempts").NonNegative().Default(100),
+		// password_policy is the policy new passwords of the app's users must
+		// meet, password.DefaultPolicy() when unset.
+		field.JSON("password_policy", &password.Policy{}).Optional(),
 		field.Int8("status").Default(1),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
@@ -36,5 +78,53 @@ func (App) Fields() []ent.Field {
 func (App) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("users", User.Type),
+		edge.To("authorization_codes", AuthorizationCode.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("roles", Role.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("email_templates", EmailTemplate.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("webauthn_challenges", WebAuthnChallenge.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("lockouts", Lockout.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
+
+// Policy of the App. Callers only see and change their own app; creating and
+// deleting apps and changing their scopes is reserved to platform admins.
+func (App) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.OnMutationOperation(rule.DenyIfNotPlatformAdmin(), ent.OpCreate|ent.OpDelete|ent.OpDeleteOne),
+			rule.DenyAppScopeChange(),
+			rule.FilterTenantRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+	}
+}
+
+// newClientID generates the public OAuth client identifier of an app.
+func newClientID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
 	}
+	return hex.EncodeToString(b)
 }
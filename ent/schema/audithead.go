@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditHead holds the schema definition for the AuditHead entity. Its single
+// row is updated by every write to the audit trail before the newest entry
+// is read, which takes the write lock of the database until the transaction
+// ends, so that entries are chained one after another.
+type AuditHead struct {
+	ent.Schema
+}
+
+// Annotations of the AuditHead.
+func (AuditHead) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_audit_head"},
+	}
+}
+
+// Fields of the AuditHead.
+func (AuditHead) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("appended").Default(0),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity. Each entry
+// records a login or a change to a user, app or lockout, and is chained to
+// the previous entry by its hash so that edits and deletions are detectable.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Annotations of the AuditLog.
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_audit_log"},
+	}
+}
+
+// Fields of the AuditLog. Every field is immutable: entries are only ever
+// appended.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// app_id is the app the target belongs to. It has no edge so that
+		// deleting an app keeps its entries, which the chain needs.
+		field.Int("app_id").Optional().Nillable().Immutable(),
+		field.Enum("actor_type").Values("user", "app", "anonymous").Immutable(),
+		field.Int("actor_id").Optional().Nillable().Immutable(),
+		field.String("action").Immutable(),
+		field.String("target_type").Immutable(),
+		field.Int("target_id").Optional().Nillable().Immutable(),
+		// changes is the JSON object of the changed fields with their value
+		// before and after; metadata the JSON object of details of events
+		// that change no entity, such as the login method.
+		field.Text("changes").Optional().Immutable(),
+		field.Text("metadata").Optional().Immutable(),
+		field.String("ip").Optional().Immutable(),
+		field.String("request_id").Optional().Immutable(),
+		field.String("prev_hash").Immutable(),
+		field.String("hash").Unique().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("app_id"),
+		index.Fields("action"),
+		index.Fields("target_type", "target_id"),
+	}
+}
+
+// Policy of the AuditLog. Entries cannot be changed or removed, and callers
+// only see the entries of their own app.
+func (AuditLog) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.OnMutationOperation(
+				privacy.AlwaysDenyRule(),
+				ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+			),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+	}
+}
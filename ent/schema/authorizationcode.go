@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthorizationCode holds the schema definition for the AuthorizationCode entity.
+type AuthorizationCode struct {
+	ent.Schema
+}
+
+// Annotations of the AuthorizationCode.
+func (AuthorizationCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_authorization_code"},
+	}
+}
+
+// Fields of the AuthorizationCode.
+func (AuthorizationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").Unique().Sensitive(),
+		field.Int("app_id"),
+		field.Int("user_id"),
+		field.String("redirect_uri"),
+		field.String("scope"),
+		field.String("nonce").Default(""),
+		field.String("code_challenge"),
+		field.String("code_challenge_method"),
+		field.Time("auth_time"),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the AuthorizationCode.
+func (AuthorizationCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("authorization_codes").
+			Unique().
+			Required().
+			Field("app_id"),
+		edge.From("user", User.Type).
+			Ref("authorization_codes").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
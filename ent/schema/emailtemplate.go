@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmailTemplate holds the schema definition for the EmailTemplate entity. It
+// overrides one of the built-in email templates for an app.
+type EmailTemplate struct {
+	ent.Schema
+}
+
+// Annotations of the EmailTemplate.
+func (EmailTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_email_template"},
+	}
+}
+
+// Fields of the EmailTemplate.
+func (EmailTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("app_id"),
+		field.String("name").NotEmpty(),
+		field.String("subject").NotEmpty(),
+		field.Text("html").NotEmpty(),
+		field.Text("text").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EmailTemplate.
+func (EmailTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("email_templates").
+			Unique().
+			Required().
+			Field("app_id"),
+	}
+}
+
+// Indexes of the EmailTemplate.
+func (EmailTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("app_id", "name").Unique(),
+	}
+}
+
+// Policy of the EmailTemplate. Callers only see and change the templates of their own app.
+func (EmailTemplate) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyTenantChange(),
+			rule.FilterTenantRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+	}
+}
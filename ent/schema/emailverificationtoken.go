@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailVerificationToken holds the schema definition for the EmailVerificationToken entity.
+type EmailVerificationToken struct {
+	ent.Schema
+}
+
+// Annotations of the EmailVerificationToken.
+func (EmailVerificationToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_email_verification_token"},
+	}
+}
+
+// Fields of the EmailVerificationToken.
+func (EmailVerificationToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("token_hash").Unique().Sensitive(),
+		// email is the address the token verifies; it stops working once the
+		// user's email changes.
+		field.String("email"),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the EmailVerificationToken.
+func (EmailVerificationToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("email_verification_tokens").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Lockout holds the schema definition for the Lockout entity. It records
+// every time too many failed logins locked a user or a client IP out.
+type Lockout struct {
+	ent.Schema
+}
+
+// Annotations of the Lockout.
+func (Lockout) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_lockout"},
+	}
+}
+
+// Fields of the Lockout.
+func (Lockout) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("account", "ip"),
+		// app_id and user_id are those of the account the failed logins
+		// targeted. IP lockouts caused by unknown emails have neither.
+		field.Int("app_id").Optional().Nillable(),
+		field.Int("user_id").Optional().Nillable(),
+		field.String("ip").Optional(),
+		field.Int("failures"),
+		field.Time("locked_until"),
+		// unlocked_at is set when an administrator lifts the lockout early.
+		field.Time("unlocked_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Lockout.
+func (Lockout) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("lockouts").
+			Unique().
+			Field("app_id"),
+		edge.From("user", User.Type).
+			Ref("lockouts").
+			Unique().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the Lockout.
+func (Lockout) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
+
+// Policy of the Lockout. Callers only see the lockouts of their own app.
+func (Lockout) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.FilterTenantRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottle holds the schema definition for the LoginThrottle entity. It
+// counts the failed password logins made from a client IP, whichever
+// accounts they targeted. Each app decides how many of them lock the IP out
+// of its own logins.
+type LoginThrottle struct {
+	ent.Schema
+}
+
+// Annotations of the LoginThrottle.
+func (LoginThrottle) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_login_throttle"},
+	}
+}
+
+// Fields of the LoginThrottle.
+func (LoginThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("ip").Unique(),
+		field.Int("failures").Default(0),
+		field.Time("last_failure_at"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MagicLinkToken holds the schema definition for the MagicLinkToken entity.
+type MagicLinkToken struct {
+	ent.Schema
+}
+
+// Annotations of the MagicLinkToken.
+func (MagicLinkToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_magic_link_token"},
+	}
+}
+
+// Fields of the MagicLinkToken.
+func (MagicLinkToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("token_hash").Unique().Sensitive(),
+		// nonce_hash is the hash of the nonce cookie of the browser that
+		// asked for the link; the link only works in that browser.
+		field.String("nonce_hash").Sensitive(),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MagicLinkToken.
+func (MagicLinkToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("magic_link_tokens").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MFAChallenge holds the schema definition for the MFAChallenge entity. It is
+// issued when a password check succeeds for a user who must also present a
+// TOTP or recovery code.
+type MFAChallenge struct {
+	ent.Schema
+}
+
+// Annotations of the MFAChallenge.
+func (MFAChallenge) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_mfa_challenge"},
+	}
+}
+
+// Fields of the MFAChallenge.
+func (MFAChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("token_hash").Unique().Sensitive(),
+		field.Int("attempts").Default(0),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MFAChallenge.
+func (MFAChallenge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("mfa_challenges").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
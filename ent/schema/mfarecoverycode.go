@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MFARecoveryCode holds the schema definition for the MFARecoveryCode entity.
+// Each code can stand in for a TOTP code once.
+type MFARecoveryCode struct {
+	ent.Schema
+}
+
+// Annotations of the MFARecoveryCode.
+func (MFARecoveryCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_mfa_recovery_code"},
+	}
+}
+
+// Fields of the MFARecoveryCode.
+func (MFARecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("code_hash").Unique().Sensitive(),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the MFARecoveryCode.
+func (MFARecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("mfa_recovery_codes").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
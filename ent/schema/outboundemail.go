@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboundEmail holds the schema definition for the OutboundEmail entity. It is
+// an email waiting in the outbox to be delivered.
+type OutboundEmail struct {
+	ent.Schema
+}
+
+// Annotations of the OutboundEmail.
+func (OutboundEmail) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_outbound_email"},
+	}
+}
+
+// Fields of the OutboundEmail.
+func (OutboundEmail) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("app_id").Optional().Nillable(),
+		field.String("template").Default(""),
+		field.String("recipient"),
+		field.String("subject"),
+		// The bodies may carry one-time tokens; they are cleared once the
+		// message is sent or given up on.
+		field.Text("html").Default("").Sensitive(),
+		field.Text("text").Default("").Sensitive(),
+		field.Enum("status").Values("pending", "sent", "failed").Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.String("last_error").Default(""),
+		field.Time("sent_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Indexes of the OutboundEmail.
+func (OutboundEmail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
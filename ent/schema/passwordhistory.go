@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasswordHistory holds the schema definition for the PasswordHistory entity.
+// It keeps the hashes of a user's previous passwords so an app policy can
+// forbid reusing them.
+type PasswordHistory struct {
+	ent.Schema
+}
+
+// Annotations of the PasswordHistory.
+func (PasswordHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_password_history"},
+	}
+}
+
+// Fields of the PasswordHistory.
+func (PasswordHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("password_hash").Sensitive(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the PasswordHistory.
+func (PasswordHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("password_history").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
+
+// Indexes of the PasswordHistory.
+func (PasswordHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Permission holds the schema definition for the Permission entity.
+type Permission struct {
+	ent.Schema
+}
+
+// Annotations of the Permission.
+func (Permission) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_permission"},
+	}
+}
+
+// Fields of the Permission.
+func (Permission) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().Immutable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the Permission.
+func (Permission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("roles", Role.Type).
+			Ref("permissions"),
+	}
+}
+
+// Policy of the Permission. The catalog is shared by every app and only
+// changed by the server at startup or by platform admins.
+func (Permission) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNotPlatformAdmin(),
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Role holds the schema definition for the Role entity.
+type Role struct {
+	ent.Schema
+}
+
+// Annotations of the Role.
+func (Role) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_role"},
+	}
+}
+
+// Fields of the Role.
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("app_id"),
+		field.String("name").NotEmpty(),
+		field.String("description").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Role.
+func (Role) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("roles").
+			Unique().
+			Required().
+			Field("app_id"),
+		edge.To("permissions", Permission.Type).
+			StorageKey(edge.Table("kpr_role_permission"), edge.Columns("role_id", "permission_id")),
+		edge.From("users", User.Type).
+			Ref("roles"),
+	}
+}
+
+// Indexes of the Role.
+func (Role) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("app_id", "name").Unique(),
+	}
+}
+
+// Policy of the Role. Callers only see and change the roles of their own app.
+func (Role) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyTenantChange(),
+			rule.FilterTenantRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+	}
+}
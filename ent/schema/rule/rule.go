@@ -0,0 +1,134 @@
+// Package rule holds the privacy rules shared by the ent schemas.
+//
+// Every query and mutation made with the claims of an authenticated caller in
+// its context is scoped to the caller's app (tenant). Contexts without claims,
+// such as the login flows and background jobs, and platform administrators are
+// not scoped.
+package rule
+
+import (
+	"context"
+	"slices"
+
+	"keeper/ent"
+	"keeper/ent/privacy"
+	"keeper/pkg/auth"
+
+	"entgo.io/ent/entql"
+)
+
+// tenant returns the app the caller in ctx is confined to. It reports false
+// when the caller is not confined to a single app.
+func tenant(ctx context.Context) (int, bool) {
+	claims, ok := auth.GetClaimsFromContext(ctx)
+	if !ok || claims.PlatformAdmin {
+		return 0, false
+	}
+	return claims.AppID, true
+}
+
+// FilterTenantRule limits queries and mutations to the caller's app.
+func FilterTenantRule() privacy.QueryMutationRule {
+	// tenantFilter is implemented by the filters of entities with an app_id field.
+	type tenantFilter interface {
+		WhereAppID(entql.IntP)
+	}
+	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
+		appID, ok := tenant(ctx)
+		if !ok {
+			return privacy.Skip
+		}
+		switch f := f.(type) {
+		case *ent.AppFilter:
+			f.WhereID(entql.IntEQ(appID))
+		case tenantFilter:
+			f.WhereAppID(entql.IntEQ(appID))
+		default:
+			return privacy.Denyf("unexpected filter type %T", f)
+		}
+		return privacy.Skip
+	})
+}
+
+// DenyIfNotPlatformAdmin denies the mutation to callers confined to an app.
+func DenyIfNotPlatformAdmin() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		if _, ok := tenant(ctx); ok {
+			return privacy.Denyf("platform admin required")
+		}
+		return privacy.Skip
+	})
+}
+
+// DenyTenantChange denies creating or moving an entity with an app_id field
+// outside the caller's app.
+func DenyTenantChange() privacy.MutationRule {
+	// tenantMutation is implemented by the mutations of entities with an app_id field.
+	type tenantMutation interface {
+		AppID() (int, bool)
+	}
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		appID, ok := tenant(ctx)
+		if !ok {
+			return privacy.Skip
+		}
+		tm, ok := m.(tenantMutation)
+		if !ok {
+			return privacy.Denyf("unexpected mutation type %T", m)
+		}
+		if id, set := tm.AppID(); set && id != appID {
+			return privacy.Denyf("%s cannot be assigned to app %d", m.Type(), id)
+		}
+		return privacy.Skip
+	})
+}
+
+// DenyPlatformAdminChange denies granting or revoking platform administration
+// to callers confined to an app.
+func DenyPlatformAdminChange() privacy.MutationRule {
+	return privacy.UserMutationRuleFunc(func(ctx context.Context, m *ent.UserMutation) error {
+		if _, ok := tenant(ctx); !ok {
+			return privacy.Skip
+		}
+		admin, set := m.PlatformAdmin()
+		if !set {
+			return privacy.Skip
+		}
+		var old bool
+		if m.Op().Is(ent.OpUpdateOne) {
+			var err error
+			if old, err = m.OldPlatformAdmin(ctx); err != nil {
+				return privacy.Denyf("load platform admin: %v", err)
+			}
+		}
+		if admin != old {
+			return privacy.Denyf("platform admin required")
+		}
+		return privacy.Skip
+	})
+}
+
+// DenyAppScopeChange denies changing the scopes an app may be granted to
+// callers confined to an app, which would let an app widen its own access.
+func DenyAppScopeChange() privacy.MutationRule {
+	return privacy.AppMutationRuleFunc(func(ctx context.Context, m *ent.AppMutation) error {
+		if _, ok := tenant(ctx); !ok {
+			return privacy.Skip
+		}
+		scopes, set := m.Scopes()
+		_, appended := m.AppendedScopes()
+		if !set && !appended && !m.ScopesCleared() {
+			return privacy.Skip
+		}
+		if set && m.Op().Is(ent.OpUpdateOne) {
+			old, err := m.OldScopes(ctx)
+			if err != nil {
+				return privacy.Denyf("load app scopes: %v", err)
+			}
+			if slices.Equal(old, scopes) {
+				return privacy.Skip
+			}
+		}
+		return privacy.Denyf("platform admin required")
+	})
+}
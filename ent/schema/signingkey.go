@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Annotations of the SigningKey.
+func (SigningKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_signing_key"},
+	}
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("kid").Unique(),
+		field.String("algorithm"),
+		field.Text("private_key").Sensitive(),
+		field.Time("activates_at").Default(time.Now),
+		field.Time("retired_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
@@ -3,6 +3,9 @@ package schema
 import (
 	"time"
 
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -30,7 +33,26 @@ func (User) Fields() []ent.Field {
 		field.String("lastname"),
 		field.String("email").Unique(),
 		field.String("password").Sensitive(),
+		// password_changed_at is when the password was last set, the user's
+		// creation when unset. It makes the password expire under an app
+		// policy with a maximum age.
+		field.Time("password_changed_at").Optional().Nillable(),
 		field.Int8("status").Default(1),
+		field.Bool("platform_admin").Default(false),
+		field.Time("tokens_valid_after").Optional().Nillable(),
+		field.Time("email_verified_at").Optional().Nillable(),
+		// The TOTP secret is set when enrolment starts and only enforced once
+		// confirmed with a first code. totp_last_step is the time step of the
+		// last accepted code, which cannot be used again.
+		field.String("totp_secret").Optional().Nillable().Sensitive(),
+		field.Time("totp_confirmed_at").Optional().Nillable(),
+		field.Int64("totp_last_step").Default(0),
+		// failed_logins counts consecutive wrong passwords since
+		// last_failed_login_at; locked_until is set when they reach the
+		// app's max_login_attempts.
+		field.Int("failed_logins").Default(0),
+		field.Time("last_failed_login_at").Optional().Nillable(),
+		field.Time("locked_until").Optional().Nillable(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -47,5 +69,65 @@ func (User) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 			),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("authorization_codes", AuthorizationCode.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("password_reset_tokens", PasswordResetToken.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("email_verification_tokens", EmailVerificationToken.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("mfa_recovery_codes", MFARecoveryCode.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("mfa_challenges", MFAChallenge.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("webauthn_credentials", WebAuthnCredential.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("webauthn_challenges", WebAuthnChallenge.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("lockouts", Lockout.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("password_history", PasswordHistory.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("magic_link_tokens", MagicLinkToken.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+		edge.To("roles", Role.Type).
+			StorageKey(edge.Table("kpr_user_role"), edge.Columns("user_id", "role_id")),
+	}
+}
+
+// Policy of the User. Callers only see and change the users of their own app.
+func (User) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyTenantChange(),
+			rule.DenyPlatformAdminChange(),
+			rule.FilterTenantRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
 	}
 }
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WebAuthnChallenge holds the schema definition for the WebAuthnChallenge
+// entity. It is issued when a passkey registration or login begins and
+// consumed when the browser returns the signed challenge.
+type WebAuthnChallenge struct {
+	ent.Schema
+}
+
+// Annotations of the WebAuthnChallenge.
+func (WebAuthnChallenge) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_webauthn_challenge"},
+	}
+}
+
+// Fields of the WebAuthnChallenge.
+func (WebAuthnChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("challenge_hash").Unique().Sensitive(),
+		field.Enum("ceremony").Values("registration", "login"),
+		field.Int("app_id"),
+		// user_id is set for registrations and for logins that named the user.
+		field.Int("user_id").Optional().Nillable(),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the WebAuthnChallenge.
+func (WebAuthnChallenge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("webauthn_challenges").
+			Unique().
+			Required().
+			Field("app_id"),
+		edge.From("user", User.Type).
+			Ref("webauthn_challenges").
+			Unique().
+			Field("user_id"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WebAuthnCredential holds the schema definition for the WebAuthnCredential
+// entity, a passkey or security key a user signs in with.
+type WebAuthnCredential struct {
+	ent.Schema
+}
+
+// Annotations of the WebAuthnCredential.
+func (WebAuthnCredential) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_webauthn_credential"},
+	}
+}
+
+// Fields of the WebAuthnCredential.
+func (WebAuthnCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Bytes("credential_id").Unique().Immutable(),
+		// public_key is the COSE encoded credential public key.
+		field.Bytes("public_key").Immutable(),
+		// sign_count is the authenticator's counter at its last use. Synced
+		// passkeys always report zero.
+		field.Uint32("sign_count").Default(0),
+		field.Strings("transports").Optional(),
+		field.Bytes("aaguid").Optional(),
+		field.Bool("backup_eligible").Default(false),
+		field.Bool("backed_up").Default(false),
+		field.String("name").Default(""),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the WebAuthnCredential.
+func (WebAuthnCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("webauthn_credentials").
+			Unique().
+			Required().
+			Field("user_id"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity. It subscribes
+// a URL to the events of an app.
+type Webhook struct {
+	ent.Schema
+}
+
+// Annotations of the Webhook.
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_webhook"},
+	}
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("app_id"),
+		field.String("url").NotEmpty(),
+		// secret signs the payloads, so it is kept as is rather than hashed.
+		field.String("secret").Sensitive(),
+		// events are the event types delivered; all of them when empty.
+		field.Strings("events").Optional(),
+		field.Bool("enabled").Default(true),
+		field.String("description").Default(""),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("webhooks").
+			Unique().
+			Required().
+			Field("app_id"),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+			),
+	}
+}
+
+// Policy of the Webhook. Callers only see and change the webhooks of their own app.
+func (Webhook) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyTenantChange(),
+			rule.FilterTenantRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+	}
+}
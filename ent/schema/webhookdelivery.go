@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"keeper/ent/privacy"
+	"keeper/ent/schema/rule"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// It is an event queued for delivery to a webhook, and the log of how its
+// delivery went.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Annotations of the WebhookDelivery.
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "kpr_webhook_delivery"},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id"),
+		// app_id is that of the webhook, so that deliveries can be scoped
+		// to the caller's app.
+		field.Int("app_id"),
+		// event_id identifies the event; redeliveries share it with the
+		// delivery they repeat.
+		field.String("event_id"),
+		field.String("event"),
+		field.Text("payload"),
+		field.Enum("status").Values("pending", "delivered", "dead").Default("pending"),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_at").Default(time.Now),
+		field.String("last_error").Default(""),
+		// response_status is the HTTP status of the last attempt, zero when
+		// it got no response.
+		field.Int("response_status").Default(0),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique().
+			Required().
+			Field("webhook_id"),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "created_at"),
+	}
+}
+
+// Policy of the WebhookDelivery. Callers only see the deliveries of their own app.
+func (WebhookDelivery) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyTenantChange(),
+			rule.FilterTenantRule(),
+		},
+		Query: privacy.QueryPolicy{
+			rule.FilterTenantRule(),
+		},
+	}
+}
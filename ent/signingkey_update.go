@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/predicate"
+	"keeper/ent/signingkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKeyUpdate is the builder for updating SigningKey entities.
+type SigningKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (_u *SigningKeyUpdate) Where(ps ...predicate.SigningKey) *SigningKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKid sets the "kid" field.
+func (_u *SigningKeyUpdate) SetKid(v string) *SigningKeyUpdate {
+	_u.mutation.SetKid(v)
+	return _u
+}
+
+// SetNillableKid sets the "kid" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableKid(v *string) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetKid(*v)
+	}
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SigningKeyUpdate) SetAlgorithm(v string) *SigningKeyUpdate {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableAlgorithm(v *string) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_u *SigningKeyUpdate) SetPrivateKey(v string) *SigningKeyUpdate {
+	_u.mutation.SetPrivateKey(v)
+	return _u
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillablePrivateKey(v *string) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetPrivateKey(*v)
+	}
+	return _u
+}
+
+// SetActivatesAt sets the "activates_at" field.
+func (_u *SigningKeyUpdate) SetActivatesAt(v time.Time) *SigningKeyUpdate {
+	_u.mutation.SetActivatesAt(v)
+	return _u
+}
+
+// SetNillableActivatesAt sets the "activates_at" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableActivatesAt(v *time.Time) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetActivatesAt(*v)
+	}
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *SigningKeyUpdate) SetRetiredAt(v time.Time) *SigningKeyUpdate {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableRetiredAt(v *time.Time) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *SigningKeyUpdate) ClearRetiredAt() *SigningKeyUpdate {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SigningKeyUpdate) SetCreatedAt(v time.Time) *SigningKeyUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SigningKeyUpdate) SetNillableCreatedAt(v *time.Time) *SigningKeyUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (_u *SigningKeyUpdate) Mutation() *SigningKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *SigningKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kid(); ok {
+		_spec.SetField(signingkey.FieldKid, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(signingkey.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActivatesAt(); ok {
+		_spec.SetField(signingkey.FieldActivatesAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(signingkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(signingkey.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SigningKeyUpdateOne is the builder for updating a single SigningKey entity.
+type SigningKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// SetKid sets the "kid" field.
+func (_u *SigningKeyUpdateOne) SetKid(v string) *SigningKeyUpdateOne {
+	_u.mutation.SetKid(v)
+	return _u
+}
+
+// SetNillableKid sets the "kid" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableKid(v *string) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetKid(*v)
+	}
+	return _u
+}
+
+// SetAlgorithm sets the "algorithm" field.
+func (_u *SigningKeyUpdateOne) SetAlgorithm(v string) *SigningKeyUpdateOne {
+	_u.mutation.SetAlgorithm(v)
+	return _u
+}
+
+// SetNillableAlgorithm sets the "algorithm" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableAlgorithm(v *string) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetAlgorithm(*v)
+	}
+	return _u
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (_u *SigningKeyUpdateOne) SetPrivateKey(v string) *SigningKeyUpdateOne {
+	_u.mutation.SetPrivateKey(v)
+	return _u
+}
+
+// SetNillablePrivateKey sets the "private_key" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillablePrivateKey(v *string) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetPrivateKey(*v)
+	}
+	return _u
+}
+
+// SetActivatesAt sets the "activates_at" field.
+func (_u *SigningKeyUpdateOne) SetActivatesAt(v time.Time) *SigningKeyUpdateOne {
+	_u.mutation.SetActivatesAt(v)
+	return _u
+}
+
+// SetNillableActivatesAt sets the "activates_at" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableActivatesAt(v *time.Time) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetActivatesAt(*v)
+	}
+	return _u
+}
+
+// SetRetiredAt sets the "retired_at" field.
+func (_u *SigningKeyUpdateOne) SetRetiredAt(v time.Time) *SigningKeyUpdateOne {
+	_u.mutation.SetRetiredAt(v)
+	return _u
+}
+
+// SetNillableRetiredAt sets the "retired_at" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableRetiredAt(v *time.Time) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetRetiredAt(*v)
+	}
+	return _u
+}
+
+// ClearRetiredAt clears the value of the "retired_at" field.
+func (_u *SigningKeyUpdateOne) ClearRetiredAt() *SigningKeyUpdateOne {
+	_u.mutation.ClearRetiredAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SigningKeyUpdateOne) SetCreatedAt(v time.Time) *SigningKeyUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SigningKeyUpdateOne) SetNillableCreatedAt(v *time.Time) *SigningKeyUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (_u *SigningKeyUpdateOne) Mutation() *SigningKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (_u *SigningKeyUpdateOne) Where(ps ...predicate.SigningKey) *SigningKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SigningKeyUpdateOne) Select(field string, fields ...string) *SigningKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SigningKey entity.
+func (_u *SigningKeyUpdateOne) Save(ctx context.Context) (*SigningKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SigningKeyUpdateOne) SaveX(ctx context.Context) *SigningKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *SigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *SigningKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingkey.FieldID)
+		for _, f := range fields {
+			if !signingkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kid(); ok {
+		_spec.SetField(signingkey.FieldKid, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Algorithm(); ok {
+		_spec.SetField(signingkey.FieldAlgorithm, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ActivatesAt(); ok {
+		_spec.SetField(signingkey.FieldActivatesAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RetiredAt(); ok {
+		_spec.SetField(signingkey.FieldRetiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.RetiredAtCleared() {
+		_spec.ClearField(signingkey.FieldRetiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(signingkey.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &SigningKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -28,8 +28,28 @@ type User struct {
 	Email string `json:"email,omitempty"`
 	// Password holds the value of the "password" field.
 	Password string `json:"-"`
+	// PasswordChangedAt holds the value of the "password_changed_at" field.
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
 	// Status holds the value of the "status" field.
 	Status int8 `json:"status,omitempty"`
+	// PlatformAdmin holds the value of the "platform_admin" field.
+	PlatformAdmin bool `json:"platform_admin,omitempty"`
+	// TokensValidAfter holds the value of the "tokens_valid_after" field.
+	TokensValidAfter *time.Time `json:"tokens_valid_after,omitempty"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// TotpSecret holds the value of the "totp_secret" field.
+	TotpSecret *string `json:"-"`
+	// TotpConfirmedAt holds the value of the "totp_confirmed_at" field.
+	TotpConfirmedAt *time.Time `json:"totp_confirmed_at,omitempty"`
+	// TotpLastStep holds the value of the "totp_last_step" field.
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
+	// FailedLogins holds the value of the "failed_logins" field.
+	FailedLogins int `json:"failed_logins,omitempty"`
+	// LastFailedLoginAt holds the value of the "last_failed_login_at" field.
+	LastFailedLoginAt *time.Time `json:"last_failed_login_at,omitempty"`
+	// LockedUntil holds the value of the "locked_until" field.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -44,9 +64,33 @@ type User struct {
 type UserEdges struct {
 	// App holds the value of the app edge.
 	App *App `json:"app,omitempty"`
+	// RefreshTokens holds the value of the refresh_tokens edge.
+	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
+	// AuthorizationCodes holds the value of the authorization_codes edge.
+	AuthorizationCodes []*AuthorizationCode `json:"authorization_codes,omitempty"`
+	// PasswordResetTokens holds the value of the password_reset_tokens edge.
+	PasswordResetTokens []*PasswordResetToken `json:"password_reset_tokens,omitempty"`
+	// EmailVerificationTokens holds the value of the email_verification_tokens edge.
+	EmailVerificationTokens []*EmailVerificationToken `json:"email_verification_tokens,omitempty"`
+	// MfaRecoveryCodes holds the value of the mfa_recovery_codes edge.
+	MfaRecoveryCodes []*MFARecoveryCode `json:"mfa_recovery_codes,omitempty"`
+	// MfaChallenges holds the value of the mfa_challenges edge.
+	MfaChallenges []*MFAChallenge `json:"mfa_challenges,omitempty"`
+	// WebauthnCredentials holds the value of the webauthn_credentials edge.
+	WebauthnCredentials []*WebAuthnCredential `json:"webauthn_credentials,omitempty"`
+	// WebauthnChallenges holds the value of the webauthn_challenges edge.
+	WebauthnChallenges []*WebAuthnChallenge `json:"webauthn_challenges,omitempty"`
+	// Lockouts holds the value of the lockouts edge.
+	Lockouts []*Lockout `json:"lockouts,omitempty"`
+	// PasswordHistory holds the value of the password_history edge.
+	PasswordHistory []*PasswordHistory `json:"password_history,omitempty"`
+	// MagicLinkTokens holds the value of the magic_link_tokens edge.
+	MagicLinkTokens []*MagicLinkToken `json:"magic_link_tokens,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*Role `json:"roles,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [13]bool
 }
 
 // AppOrErr returns the App value or an error if the edge
@@ -60,16 +104,126 @@ func (e UserEdges) AppOrErr() (*App, error) {
 	return nil, &NotLoadedError{edge: "app"}
 }
 
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[1] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refresh_tokens"}
+}
+
+// AuthorizationCodesOrErr returns the AuthorizationCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuthorizationCodesOrErr() ([]*AuthorizationCode, error) {
+	if e.loadedTypes[2] {
+		return e.AuthorizationCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "authorization_codes"}
+}
+
+// PasswordResetTokensOrErr returns the PasswordResetTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordResetTokensOrErr() ([]*PasswordResetToken, error) {
+	if e.loadedTypes[3] {
+		return e.PasswordResetTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "password_reset_tokens"}
+}
+
+// EmailVerificationTokensOrErr returns the EmailVerificationTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailVerificationTokensOrErr() ([]*EmailVerificationToken, error) {
+	if e.loadedTypes[4] {
+		return e.EmailVerificationTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "email_verification_tokens"}
+}
+
+// MfaRecoveryCodesOrErr returns the MfaRecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MfaRecoveryCodesOrErr() ([]*MFARecoveryCode, error) {
+	if e.loadedTypes[5] {
+		return e.MfaRecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "mfa_recovery_codes"}
+}
+
+// MfaChallengesOrErr returns the MfaChallenges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MfaChallengesOrErr() ([]*MFAChallenge, error) {
+	if e.loadedTypes[6] {
+		return e.MfaChallenges, nil
+	}
+	return nil, &NotLoadedError{edge: "mfa_challenges"}
+}
+
+// WebauthnCredentialsOrErr returns the WebauthnCredentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnCredentialsOrErr() ([]*WebAuthnCredential, error) {
+	if e.loadedTypes[7] {
+		return e.WebauthnCredentials, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_credentials"}
+}
+
+// WebauthnChallengesOrErr returns the WebauthnChallenges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnChallengesOrErr() ([]*WebAuthnChallenge, error) {
+	if e.loadedTypes[8] {
+		return e.WebauthnChallenges, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_challenges"}
+}
+
+// LockoutsOrErr returns the Lockouts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LockoutsOrErr() ([]*Lockout, error) {
+	if e.loadedTypes[9] {
+		return e.Lockouts, nil
+	}
+	return nil, &NotLoadedError{edge: "lockouts"}
+}
+
+// PasswordHistoryOrErr returns the PasswordHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasswordHistoryOrErr() ([]*PasswordHistory, error) {
+	if e.loadedTypes[10] {
+		return e.PasswordHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "password_history"}
+}
+
+// MagicLinkTokensOrErr returns the MagicLinkTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MagicLinkTokensOrErr() ([]*MagicLinkToken, error) {
+	if e.loadedTypes[11] {
+		return e.MagicLinkTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "magic_link_tokens"}
+}
+
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RolesOrErr() ([]*Role, error) {
+	if e.loadedTypes[12] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID, user.FieldAppID, user.FieldStatus:
+		case user.FieldPlatformAdmin:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldAppID, user.FieldStatus, user.FieldTotpLastStep, user.FieldFailedLogins:
 			values[i] = new(sql.NullInt64)
-		case user.FieldFirstname, user.FieldLastname, user.FieldEmail, user.FieldPassword:
+		case user.FieldFirstname, user.FieldLastname, user.FieldEmail, user.FieldPassword, user.FieldTotpSecret:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldPasswordChangedAt, user.FieldTokensValidAfter, user.FieldEmailVerifiedAt, user.FieldTotpConfirmedAt, user.FieldLastFailedLoginAt, user.FieldLockedUntil, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -122,12 +276,79 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Password = value.String
 			}
+		case user.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				_m.PasswordChangedAt = new(time.Time)
+				*_m.PasswordChangedAt = value.Time
+			}
 		case user.FieldStatus:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = int8(value.Int64)
 			}
+		case user.FieldPlatformAdmin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field platform_admin", values[i])
+			} else if value.Valid {
+				_m.PlatformAdmin = value.Bool
+			}
+		case user.FieldTokensValidAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens_valid_after", values[i])
+			} else if value.Valid {
+				_m.TokensValidAfter = new(time.Time)
+				*_m.TokensValidAfter = value.Time
+			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				_m.EmailVerifiedAt = new(time.Time)
+				*_m.EmailVerifiedAt = value.Time
+			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				_m.TotpSecret = new(string)
+				*_m.TotpSecret = value.String
+			}
+		case user.FieldTotpConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_confirmed_at", values[i])
+			} else if value.Valid {
+				_m.TotpConfirmedAt = new(time.Time)
+				*_m.TotpConfirmedAt = value.Time
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				_m.TotpLastStep = value.Int64
+			}
+		case user.FieldFailedLogins:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_logins", values[i])
+			} else if value.Valid {
+				_m.FailedLogins = int(value.Int64)
+			}
+		case user.FieldLastFailedLoginAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_login_at", values[i])
+			} else if value.Valid {
+				_m.LastFailedLoginAt = new(time.Time)
+				*_m.LastFailedLoginAt = value.Time
+			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = new(time.Time)
+				*_m.LockedUntil = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -158,6 +379,66 @@ func (_m *User) QueryApp() *AppQuery {
 	return NewUserClient(_m.config).QueryApp(_m)
 }
 
+// QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
+func (_m *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return NewUserClient(_m.config).QueryRefreshTokens(_m)
+}
+
+// QueryAuthorizationCodes queries the "authorization_codes" edge of the User entity.
+func (_m *User) QueryAuthorizationCodes() *AuthorizationCodeQuery {
+	return NewUserClient(_m.config).QueryAuthorizationCodes(_m)
+}
+
+// QueryPasswordResetTokens queries the "password_reset_tokens" edge of the User entity.
+func (_m *User) QueryPasswordResetTokens() *PasswordResetTokenQuery {
+	return NewUserClient(_m.config).QueryPasswordResetTokens(_m)
+}
+
+// QueryEmailVerificationTokens queries the "email_verification_tokens" edge of the User entity.
+func (_m *User) QueryEmailVerificationTokens() *EmailVerificationTokenQuery {
+	return NewUserClient(_m.config).QueryEmailVerificationTokens(_m)
+}
+
+// QueryMfaRecoveryCodes queries the "mfa_recovery_codes" edge of the User entity.
+func (_m *User) QueryMfaRecoveryCodes() *MFARecoveryCodeQuery {
+	return NewUserClient(_m.config).QueryMfaRecoveryCodes(_m)
+}
+
+// QueryMfaChallenges queries the "mfa_challenges" edge of the User entity.
+func (_m *User) QueryMfaChallenges() *MFAChallengeQuery {
+	return NewUserClient(_m.config).QueryMfaChallenges(_m)
+}
+
+// QueryWebauthnCredentials queries the "webauthn_credentials" edge of the User entity.
+func (_m *User) QueryWebauthnCredentials() *WebAuthnCredentialQuery {
+	return NewUserClient(_m.config).QueryWebauthnCredentials(_m)
+}
+
+// QueryWebauthnChallenges queries the "webauthn_challenges" edge of the User entity.
+func (_m *User) QueryWebauthnChallenges() *WebAuthnChallengeQuery {
+	return NewUserClient(_m.config).QueryWebauthnChallenges(_m)
+}
+
+// QueryLockouts queries the "lockouts" edge of the User entity.
+func (_m *User) QueryLockouts() *LockoutQuery {
+	return NewUserClient(_m.config).QueryLockouts(_m)
+}
+
+// QueryPasswordHistory queries the "password_history" edge of the User entity.
+func (_m *User) QueryPasswordHistory() *PasswordHistoryQuery {
+	return NewUserClient(_m.config).QueryPasswordHistory(_m)
+}
+
+// QueryMagicLinkTokens queries the "magic_link_tokens" edge of the User entity.
+func (_m *User) QueryMagicLinkTokens() *MagicLinkTokenQuery {
+	return NewUserClient(_m.config).QueryMagicLinkTokens(_m)
+}
+
+// QueryRoles queries the "roles" edge of the User entity.
+func (_m *User) QueryRoles() *RoleQuery {
+	return NewUserClient(_m.config).QueryRoles(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -195,9 +476,50 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("password=<sensitive>")
 	builder.WriteString(", ")
+	if v := _m.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("platform_admin=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlatformAdmin))
+	builder.WriteString(", ")
+	if v := _m.TokensValidAfter; v != nil {
+		builder.WriteString("tokens_valid_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.TotpConfirmedAt; v != nil {
+		builder.WriteString("totp_confirmed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastStep))
+	builder.WriteString(", ")
+	builder.WriteString("failed_logins=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedLogins))
+	builder.WriteString(", ")
+	if v := _m.LastFailedLoginAt; v != nil {
+		builder.WriteString("last_failed_login_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
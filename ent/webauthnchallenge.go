@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WebAuthnChallenge is the model entity for the WebAuthnChallenge schema.
+type WebAuthnChallenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ChallengeHash holds the value of the "challenge_hash" field.
+	ChallengeHash string `json:"-"`
+	// Ceremony holds the value of the "ceremony" field.
+	Ceremony webauthnchallenge.Ceremony `json:"ceremony,omitempty"`
+	// AppID holds the value of the "app_id" field.
+	AppID int `json:"app_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int `json:"user_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebAuthnChallengeQuery when eager-loading is set.
+	Edges        WebAuthnChallengeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebAuthnChallengeEdges holds the relations/edges for other nodes in the graph.
+type WebAuthnChallengeEdges struct {
+	// App holds the value of the app edge.
+	App *App `json:"app,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AppOrErr returns the App value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebAuthnChallengeEdges) AppOrErr() (*App, error) {
+	if e.App != nil {
+		return e.App, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: app.Label}
+	}
+	return nil, &NotLoadedError{edge: "app"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebAuthnChallengeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebAuthnChallenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldID, webauthnchallenge.FieldAppID, webauthnchallenge.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case webauthnchallenge.FieldChallengeHash, webauthnchallenge.FieldCeremony:
+			values[i] = new(sql.NullString)
+		case webauthnchallenge.FieldExpiresAt, webauthnchallenge.FieldUsedAt, webauthnchallenge.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebAuthnChallenge fields.
+func (_m *WebAuthnChallenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case webauthnchallenge.FieldChallengeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge_hash", values[i])
+			} else if value.Valid {
+				_m.ChallengeHash = value.String
+			}
+		case webauthnchallenge.FieldCeremony:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ceremony", values[i])
+			} else if value.Valid {
+				_m.Ceremony = webauthnchallenge.Ceremony(value.String)
+			}
+		case webauthnchallenge.FieldAppID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field app_id", values[i])
+			} else if value.Valid {
+				_m.AppID = int(value.Int64)
+			}
+		case webauthnchallenge.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case webauthnchallenge.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case webauthnchallenge.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case webauthnchallenge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebAuthnChallenge.
+// This includes values selected through modifiers, order, etc.
+func (_m *WebAuthnChallenge) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryApp queries the "app" edge of the WebAuthnChallenge entity.
+func (_m *WebAuthnChallenge) QueryApp() *AppQuery {
+	return NewWebAuthnChallengeClient(_m.config).QueryApp(_m)
+}
+
+// QueryUser queries the "user" edge of the WebAuthnChallenge entity.
+func (_m *WebAuthnChallenge) QueryUser() *UserQuery {
+	return NewWebAuthnChallengeClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this WebAuthnChallenge.
+// Note that you need to call WebAuthnChallenge.Unwrap() before calling this method if this WebAuthnChallenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WebAuthnChallenge) Update() *WebAuthnChallengeUpdateOne {
+	return NewWebAuthnChallengeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WebAuthnChallenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WebAuthnChallenge) Unwrap() *WebAuthnChallenge {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebAuthnChallenge is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WebAuthnChallenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebAuthnChallenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("challenge_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("ceremony=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ceremony))
+	builder.WriteString(", ")
+	builder.WriteString("app_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AppID))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebAuthnChallenges is a parsable slice of WebAuthnChallenge.
+type WebAuthnChallenges []*WebAuthnChallenge
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webauthnchallenge
+
+import (
+	"keeper/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldID, id))
+}
+
+// ChallengeHash applies equality check predicate on the "challenge_hash" field. It's identical to ChallengeHashEQ.
+func ChallengeHash(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldChallengeHash, v))
+}
+
+// AppID applies equality check predicate on the "app_id" field. It's identical to AppIDEQ.
+func AppID(v int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldAppID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldUserID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChallengeHashEQ applies the EQ predicate on the "challenge_hash" field.
+func ChallengeHashEQ(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldChallengeHash, v))
+}
+
+// ChallengeHashNEQ applies the NEQ predicate on the "challenge_hash" field.
+func ChallengeHashNEQ(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldChallengeHash, v))
+}
+
+// ChallengeHashIn applies the In predicate on the "challenge_hash" field.
+func ChallengeHashIn(vs ...string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldChallengeHash, vs...))
+}
+
+// ChallengeHashNotIn applies the NotIn predicate on the "challenge_hash" field.
+func ChallengeHashNotIn(vs ...string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldChallengeHash, vs...))
+}
+
+// ChallengeHashGT applies the GT predicate on the "challenge_hash" field.
+func ChallengeHashGT(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldChallengeHash, v))
+}
+
+// ChallengeHashGTE applies the GTE predicate on the "challenge_hash" field.
+func ChallengeHashGTE(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldChallengeHash, v))
+}
+
+// ChallengeHashLT applies the LT predicate on the "challenge_hash" field.
+func ChallengeHashLT(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldChallengeHash, v))
+}
+
+// ChallengeHashLTE applies the LTE predicate on the "challenge_hash" field.
+func ChallengeHashLTE(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldChallengeHash, v))
+}
+
+// ChallengeHashContains applies the Contains predicate on the "challenge_hash" field.
+func ChallengeHashContains(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldContains(FieldChallengeHash, v))
+}
+
+// ChallengeHashHasPrefix applies the HasPrefix predicate on the "challenge_hash" field.
+func ChallengeHashHasPrefix(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldHasPrefix(FieldChallengeHash, v))
+}
+
+// ChallengeHashHasSuffix applies the HasSuffix predicate on the "challenge_hash" field.
+func ChallengeHashHasSuffix(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldHasSuffix(FieldChallengeHash, v))
+}
+
+// ChallengeHashEqualFold applies the EqualFold predicate on the "challenge_hash" field.
+func ChallengeHashEqualFold(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEqualFold(FieldChallengeHash, v))
+}
+
+// ChallengeHashContainsFold applies the ContainsFold predicate on the "challenge_hash" field.
+func ChallengeHashContainsFold(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldContainsFold(FieldChallengeHash, v))
+}
+
+// CeremonyEQ applies the EQ predicate on the "ceremony" field.
+func CeremonyEQ(v Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldCeremony, v))
+}
+
+// CeremonyNEQ applies the NEQ predicate on the "ceremony" field.
+func CeremonyNEQ(v Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldCeremony, v))
+}
+
+// CeremonyIn applies the In predicate on the "ceremony" field.
+func CeremonyIn(vs ...Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldCeremony, vs...))
+}
+
+// CeremonyNotIn applies the NotIn predicate on the "ceremony" field.
+func CeremonyNotIn(vs ...Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldCeremony, vs...))
+}
+
+// AppIDEQ applies the EQ predicate on the "app_id" field.
+func AppIDEQ(v int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldAppID, v))
+}
+
+// AppIDNEQ applies the NEQ predicate on the "app_id" field.
+func AppIDNEQ(v int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldAppID, v))
+}
+
+// AppIDIn applies the In predicate on the "app_id" field.
+func AppIDIn(vs ...int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldAppID, vs...))
+}
+
+// AppIDNotIn applies the NotIn predicate on the "app_id" field.
+func AppIDNotIn(vs ...int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldAppID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotNull(FieldUserID))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasApp applies the HasEdge predicate on the "app" edge.
+func HasApp() predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AppTable, AppColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppWith applies the HasEdge predicate on the "app" edge with a given conditions (other predicates).
+func HasAppWith(preds ...predicate.App) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(func(s *sql.Selector) {
+		step := newAppStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebAuthnChallenge) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebAuthnChallenge) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebAuthnChallenge) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.NotPredicates(p))
+}
@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebAuthnChallengeCreate is the builder for creating a WebAuthnChallenge entity.
+type WebAuthnChallengeCreate struct {
+	config
+	mutation *WebAuthnChallengeMutation
+	hooks    []Hook
+}
+
+// SetChallengeHash sets the "challenge_hash" field.
+func (_c *WebAuthnChallengeCreate) SetChallengeHash(v string) *WebAuthnChallengeCreate {
+	_c.mutation.SetChallengeHash(v)
+	return _c
+}
+
+// SetCeremony sets the "ceremony" field.
+func (_c *WebAuthnChallengeCreate) SetCeremony(v webauthnchallenge.Ceremony) *WebAuthnChallengeCreate {
+	_c.mutation.SetCeremony(v)
+	return _c
+}
+
+// SetAppID sets the "app_id" field.
+func (_c *WebAuthnChallengeCreate) SetAppID(v int) *WebAuthnChallengeCreate {
+	_c.mutation.SetAppID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WebAuthnChallengeCreate) SetUserID(v int) *WebAuthnChallengeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableUserID(v *int) *WebAuthnChallengeCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *WebAuthnChallengeCreate) SetExpiresAt(v time.Time) *WebAuthnChallengeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *WebAuthnChallengeCreate) SetUsedAt(v time.Time) *WebAuthnChallengeCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableUsedAt(v *time.Time) *WebAuthnChallengeCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WebAuthnChallengeCreate) SetCreatedAt(v time.Time) *WebAuthnChallengeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableCreatedAt(v *time.Time) *WebAuthnChallengeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_c *WebAuthnChallengeCreate) SetApp(v *App) *WebAuthnChallengeCreate {
+	return _c.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *WebAuthnChallengeCreate) SetUser(v *User) *WebAuthnChallengeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the WebAuthnChallengeMutation object of the builder.
+func (_c *WebAuthnChallengeCreate) Mutation() *WebAuthnChallengeMutation {
+	return _c.mutation
+}
+
+// Save creates the WebAuthnChallenge in the database.
+func (_c *WebAuthnChallengeCreate) Save(ctx context.Context) (*WebAuthnChallenge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebAuthnChallengeCreate) SaveX(ctx context.Context) *WebAuthnChallenge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebAuthnChallengeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebAuthnChallengeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebAuthnChallengeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := webauthnchallenge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebAuthnChallengeCreate) check() error {
+	if _, ok := _c.mutation.ChallengeHash(); !ok {
+		return &ValidationError{Name: "challenge_hash", err: errors.New(`ent: missing required field "WebAuthnChallenge.challenge_hash"`)}
+	}
+	if _, ok := _c.mutation.Ceremony(); !ok {
+		return &ValidationError{Name: "ceremony", err: errors.New(`ent: missing required field "WebAuthnChallenge.ceremony"`)}
+	}
+	if v, ok := _c.mutation.Ceremony(); ok {
+		if err := webauthnchallenge.CeremonyValidator(v); err != nil {
+			return &ValidationError{Name: "ceremony", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.ceremony": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AppID(); !ok {
+		return &ValidationError{Name: "app_id", err: errors.New(`ent: missing required field "WebAuthnChallenge.app_id"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WebAuthnChallenge.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebAuthnChallenge.created_at"`)}
+	}
+	if len(_c.mutation.AppIDs()) == 0 {
+		return &ValidationError{Name: "app", err: errors.New(`ent: missing required edge "WebAuthnChallenge.app"`)}
+	}
+	return nil
+}
+
+func (_c *WebAuthnChallengeCreate) sqlSave(ctx context.Context) (*WebAuthnChallenge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebAuthnChallengeCreate) createSpec() (*WebAuthnChallenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebAuthnChallenge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webauthnchallenge.Table, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ChallengeHash(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallengeHash, field.TypeString, value)
+		_node.ChallengeHash = value
+	}
+	if value, ok := _c.mutation.Ceremony(); ok {
+		_spec.SetField(webauthnchallenge.FieldCeremony, field.TypeEnum, value)
+		_node.Ceremony = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.AppTable,
+			Columns: []string{webauthnchallenge.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AppID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.UserTable,
+			Columns: []string{webauthnchallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebAuthnChallengeCreateBulk is the builder for creating many WebAuthnChallenge entities in bulk.
+type WebAuthnChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*WebAuthnChallengeCreate
+}
+
+// Save creates the WebAuthnChallenge entities in the database.
+func (_c *WebAuthnChallengeCreateBulk) Save(ctx context.Context) ([]*WebAuthnChallenge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebAuthnChallenge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebAuthnChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebAuthnChallengeCreateBulk) SaveX(ctx context.Context) []*WebAuthnChallenge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebAuthnChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebAuthnChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeper/ent/app"
+	"keeper/ent/predicate"
+	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WebAuthnChallengeUpdate is the builder for updating WebAuthnChallenge entities.
+type WebAuthnChallengeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebAuthnChallengeMutation
+}
+
+// Where appends a list predicates to the WebAuthnChallengeUpdate builder.
+func (_u *WebAuthnChallengeUpdate) Where(ps ...predicate.WebAuthnChallenge) *WebAuthnChallengeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChallengeHash sets the "challenge_hash" field.
+func (_u *WebAuthnChallengeUpdate) SetChallengeHash(v string) *WebAuthnChallengeUpdate {
+	_u.mutation.SetChallengeHash(v)
+	return _u
+}
+
+// SetNillableChallengeHash sets the "challenge_hash" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdate) SetNillableChallengeHash(v *string) *WebAuthnChallengeUpdate {
+	if v != nil {
+		_u.SetChallengeHash(*v)
+	}
+	return _u
+}
+
+// SetCeremony sets the "ceremony" field.
+func (_u *WebAuthnChallengeUpdate) SetCeremony(v webauthnchallenge.Ceremony) *WebAuthnChallengeUpdate {
+	_u.mutation.SetCeremony(v)
+	return _u
+}
+
+// SetNillableCeremony sets the "ceremony" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdate) SetNillableCeremony(v *webauthnchallenge.Ceremony) *WebAuthnChallengeUpdate {
+	if v != nil {
+		_u.SetCeremony(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *WebAuthnChallengeUpdate) SetAppID(v int) *WebAuthnChallengeUpdate {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdate) SetNillableAppID(v *int) *WebAuthnChallengeUpdate {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebAuthnChallengeUpdate) SetUserID(v int) *WebAuthnChallengeUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdate) SetNillableUserID(v *int) *WebAuthnChallengeUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *WebAuthnChallengeUpdate) ClearUserID() *WebAuthnChallengeUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WebAuthnChallengeUpdate) SetExpiresAt(v time.Time) *WebAuthnChallengeUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdate) SetNillableExpiresAt(v *time.Time) *WebAuthnChallengeUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *WebAuthnChallengeUpdate) SetUsedAt(v time.Time) *WebAuthnChallengeUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdate) SetNillableUsedAt(v *time.Time) *WebAuthnChallengeUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *WebAuthnChallengeUpdate) ClearUsedAt() *WebAuthnChallengeUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WebAuthnChallengeUpdate) SetCreatedAt(v time.Time) *WebAuthnChallengeUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdate) SetNillableCreatedAt(v *time.Time) *WebAuthnChallengeUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *WebAuthnChallengeUpdate) SetApp(v *App) *WebAuthnChallengeUpdate {
+	return _u.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WebAuthnChallengeUpdate) SetUser(v *User) *WebAuthnChallengeUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WebAuthnChallengeMutation object of the builder.
+func (_u *WebAuthnChallengeUpdate) Mutation() *WebAuthnChallengeMutation {
+	return _u.mutation
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *WebAuthnChallengeUpdate) ClearApp() *WebAuthnChallengeUpdate {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WebAuthnChallengeUpdate) ClearUser() *WebAuthnChallengeUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebAuthnChallengeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebAuthnChallengeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebAuthnChallengeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebAuthnChallengeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebAuthnChallengeUpdate) check() error {
+	if v, ok := _u.mutation.Ceremony(); ok {
+		if err := webauthnchallenge.CeremonyValidator(v); err != nil {
+			return &ValidationError{Name: "ceremony", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.ceremony": %w`, err)}
+		}
+	}
+	if _u.mutation.AppCleared() && len(_u.mutation.AppIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebAuthnChallenge.app"`)
+	}
+	return nil
+}
+
+func (_u *WebAuthnChallengeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthnchallenge.Table, webauthnchallenge.Columns, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChallengeHash(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallengeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ceremony(); ok {
+		_spec.SetField(webauthnchallenge.FieldCeremony, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(webauthnchallenge.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.AppTable,
+			Columns: []string{webauthnchallenge.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.AppTable,
+			Columns: []string{webauthnchallenge.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.UserTable,
+			Columns: []string{webauthnchallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.UserTable,
+			Columns: []string{webauthnchallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthnchallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebAuthnChallengeUpdateOne is the builder for updating a single WebAuthnChallenge entity.
+type WebAuthnChallengeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebAuthnChallengeMutation
+}
+
+// SetChallengeHash sets the "challenge_hash" field.
+func (_u *WebAuthnChallengeUpdateOne) SetChallengeHash(v string) *WebAuthnChallengeUpdateOne {
+	_u.mutation.SetChallengeHash(v)
+	return _u
+}
+
+// SetNillableChallengeHash sets the "challenge_hash" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdateOne) SetNillableChallengeHash(v *string) *WebAuthnChallengeUpdateOne {
+	if v != nil {
+		_u.SetChallengeHash(*v)
+	}
+	return _u
+}
+
+// SetCeremony sets the "ceremony" field.
+func (_u *WebAuthnChallengeUpdateOne) SetCeremony(v webauthnchallenge.Ceremony) *WebAuthnChallengeUpdateOne {
+	_u.mutation.SetCeremony(v)
+	return _u
+}
+
+// SetNillableCeremony sets the "ceremony" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdateOne) SetNillableCeremony(v *webauthnchallenge.Ceremony) *WebAuthnChallengeUpdateOne {
+	if v != nil {
+		_u.SetCeremony(*v)
+	}
+	return _u
+}
+
+// SetAppID sets the "app_id" field.
+func (_u *WebAuthnChallengeUpdateOne) SetAppID(v int) *WebAuthnChallengeUpdateOne {
+	_u.mutation.SetAppID(v)
+	return _u
+}
+
+// SetNillableAppID sets the "app_id" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdateOne) SetNillableAppID(v *int) *WebAuthnChallengeUpdateOne {
+	if v != nil {
+		_u.SetAppID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WebAuthnChallengeUpdateOne) SetUserID(v int) *WebAuthnChallengeUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdateOne) SetNillableUserID(v *int) *WebAuthnChallengeUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *WebAuthnChallengeUpdateOne) ClearUserID() *WebAuthnChallengeUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *WebAuthnChallengeUpdateOne) SetExpiresAt(v time.Time) *WebAuthnChallengeUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdateOne) SetNillableExpiresAt(v *time.Time) *WebAuthnChallengeUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *WebAuthnChallengeUpdateOne) SetUsedAt(v time.Time) *WebAuthnChallengeUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdateOne) SetNillableUsedAt(v *time.Time) *WebAuthnChallengeUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *WebAuthnChallengeUpdateOne) ClearUsedAt() *WebAuthnChallengeUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WebAuthnChallengeUpdateOne) SetCreatedAt(v time.Time) *WebAuthnChallengeUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WebAuthnChallengeUpdateOne) SetNillableCreatedAt(v *time.Time) *WebAuthnChallengeUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *WebAuthnChallengeUpdateOne) SetApp(v *App) *WebAuthnChallengeUpdateOne {
+	return _u.SetAppID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WebAuthnChallengeUpdateOne) SetUser(v *User) *WebAuthnChallengeUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WebAuthnChallengeMutation object of the builder.
+func (_u *WebAuthnChallengeUpdateOne) Mutation() *WebAuthnChallengeMutation {
+	return _u.mutation
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *WebAuthnChallengeUpdateOne) ClearApp() *WebAuthnChallengeUpdateOne {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WebAuthnChallengeUpdateOne) ClearUser() *WebAuthnChallengeUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the WebAuthnChallengeUpdate builder.
+func (_u *WebAuthnChallengeUpdateOne) Where(ps ...predicate.WebAuthnChallenge) *WebAuthnChallengeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebAuthnChallengeUpdateOne) Select(field string, fields ...string) *WebAuthnChallengeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebAuthnChallenge entity.
+func (_u *WebAuthnChallengeUpdateOne) Save(ctx context.Context) (*WebAuthnChallenge, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebAuthnChallengeUpdateOne) SaveX(ctx context.Context) *WebAuthnChallenge {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebAuthnChallengeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebAuthnChallengeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebAuthnChallengeUpdateOne) check() error {
+	if v, ok := _u.mutation.Ceremony(); ok {
+		if err := webauthnchallenge.CeremonyValidator(v); err != nil {
+			return &ValidationError{Name: "ceremony", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.ceremony": %w`, err)}
+		}
+	}
+	if _u.mutation.AppCleared() && len(_u.mutation.AppIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebAuthnChallenge.app"`)
+	}
+	return nil
+}
+
+func (_u *WebAuthnChallengeUpdateOne) sqlSave(ctx context.Context) (_node *WebAuthnChallenge, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webauthnchallenge.Table, webauthnchallenge.Columns, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebAuthnChallenge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webauthnchallenge.FieldID)
+		for _, f := range fields {
+			if !webauthnchallenge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webauthnchallenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChallengeHash(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallengeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Ceremony(); ok {
+		_spec.SetField(webauthnchallenge.FieldCeremony, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(webauthnchallenge.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.AppTable,
+			Columns: []string{webauthnchallenge.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.AppTable,
+			Columns: []string{webauthnchallenge.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.UserTable,
+			Columns: []string{webauthnchallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.UserTable,
+			Columns: []string{webauthnchallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebAuthnChallenge{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webauthnchallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
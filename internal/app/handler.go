@@ -2,9 +2,13 @@ package app
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"keeper/pkg/auth"
 	"keeper/pkg/render"
@@ -27,6 +31,13 @@ func NewAppHandler(svc AppService) *AppHandler {
 	}
 }
 
+// Permissions a token needs to call the app endpoints. App tokens are
+// granted them as scopes.
+const (
+	PermissionRead  = "apps:read"
+	PermissionWrite = "apps:write"
+)
+
 // Routes returns the chi router for app endpoints.
 func (h *AppHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
 	r := chi.NewRouter()
@@ -35,13 +46,16 @@ func (h *AppHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
 	r.Group(func(r chi.Router) {
 		r.Use(auth.Middleware(jwtManager))
 
-		r.Post("/", h.CreateApp)
-		r.Get("/", h.ListApps)
+		r.With(auth.RequirePermission(PermissionWrite)).Post("/", h.CreateApp)
+		r.With(auth.RequirePermission(PermissionRead)).Get("/", h.ListApps)
 
 		r.Route("/{id}", func(r chi.Router) {
-			r.Get("/", h.GetAppByID)
-			r.Put("/", h.UpdateApp)
-			r.Delete("/", h.DeleteApp)
+			r.With(auth.RequirePermission(PermissionRead)).Get("/", h.GetAppByID)
+			r.With(auth.RequirePermission(PermissionWrite)).Put("/", h.UpdateApp)
+			r.With(auth.RequirePermission(PermissionWrite)).Delete("/", h.DeleteApp)
+			r.With(auth.RequirePermission(PermissionWrite)).Post("/secret", h.RotateSecret)
+			r.With(auth.RequirePermission(PermissionWrite)).Post("/scim-token", h.RotateSCIMToken)
+			r.With(auth.RequirePermission(PermissionWrite)).Delete("/scim-token", h.RevokeSCIMToken)
 		})
 	})
 
@@ -58,6 +72,7 @@ func (h *AppHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
 // @Success 201 {object} render.Response{data=App}
 // @Failure 400 {object} render.Response
 // @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /apps [post]
@@ -77,6 +92,10 @@ func (h *AppHandler) CreateApp(w http.ResponseWriter, r *http.Request) {
 
 	a, err := h.svc.Create(r.Context(), req)
 	if err != nil {
+		if errors.Is(err, ErrForbidden) {
+			render.Error(w, http.StatusForbidden, err.Error())
+			return
+		}
 		render.Error(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -85,23 +104,107 @@ func (h *AppHandler) CreateApp(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListApps godoc
-// @Summary List all apps
-// @Description Get a list of all registered apps
+// @Summary List apps
+// @Description Get a page of the caller's app, or of every app for platform admins, with filters, sorting and cursor pagination. The meta of the response holds the total number of matching apps and the cursor of the next page.
 // @Tags apps
 // @Produce json
-// @Success 200 {object} render.Response{data=[]App}
+// @Param status query int false "Status: 0 or 1"
+// @Param created_after query string false "Oldest creation time, RFC 3339"
+// @Param created_before query string false "Time before the newest creation time, RFC 3339"
+// @Param q query string false "Text to find in the name"
+// @Param sort query string false "Sort field: id, name, status, created_at or updated_at" default(id)
+// @Param order query string false "asc or desc" default(asc)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, at most 200" default(50)
+// @Success 200 {object} render.Response{data=[]App,meta=render.Meta}
+// @Failure 400 {object} render.Response
 // @Failure 401 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /apps [get]
 func (h *AppHandler) ListApps(w http.ResponseWriter, r *http.Request) {
-	apps, err := h.svc.List(r.Context())
+	req, err := listAppsRequestFromQuery(r.URL.Query())
+	if err != nil {
+		slog.Warn("invalid list apps request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.svc.List(r.Context(), req)
 	if err != nil {
+		if errors.Is(err, ErrInvalidSort) || errors.Is(err, ErrInvalidCursor) {
+			render.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		render.Error(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	render.JSON(w, http.StatusOK, apps)
+	render.Page(w, http.StatusOK, page.Apps, render.Meta{Total: page.Total, NextCursor: page.NextCursor})
+}
+
+func listAppsRequestFromQuery(q url.Values) (ListAppsRequest, error) {
+	req := ListAppsRequest{
+		Q:      q.Get("q"),
+		Sort:   q.Get("sort"),
+		Cursor: q.Get("cursor"),
+	}
+	var err error
+	if status, err := intParam(q, "status"); err != nil {
+		return req, err
+	} else if status != nil {
+		if *status != 0 && *status != 1 {
+			return req, errors.New("status must be 0 or 1")
+		}
+		s := int8(*status)
+		req.Status = &s
+	}
+	if req.CreatedAfter, err = timeParam(q, "created_after"); err != nil {
+		return req, err
+	}
+	if req.CreatedBefore, err = timeParam(q, "created_before"); err != nil {
+		return req, err
+	}
+	switch q.Get("order") {
+	case "", "asc":
+	case "desc":
+		req.Desc = true
+	default:
+		return req, errors.New("order must be asc or desc")
+	}
+	if limit, err := intParam(q, "limit"); err != nil {
+		return req, err
+	} else if limit != nil {
+		if *limit < 1 || *limit > MaxListLimit {
+			return req, fmt.Errorf("limit must be between 1 and %d", MaxListLimit)
+		}
+		req.Limit = *limit
+	}
+	return req, nil
+}
+
+func intParam(q url.Values, name string) (*int, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return &n, nil
+}
+
+func timeParam(q url.Values, name string) (*time.Time, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected RFC 3339 time", name)
+	}
+	return &t, nil
 }
 
 // GetAppByID godoc
@@ -146,6 +249,8 @@ func (h *AppHandler) GetAppByID(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} render.Response{data=App}
 // @Failure 400 {object} render.Response
 // @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /apps/{id} [put]
@@ -173,7 +278,14 @@ func (h *AppHandler) UpdateApp(w http.ResponseWriter, r *http.Request) {
 
 	a, err := h.svc.Update(r.Context(), id, req)
 	if err != nil {
-		render.Error(w, http.StatusInternalServerError, err.Error())
+		switch {
+		case errors.Is(err, ErrAppNotFound):
+			render.Error(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrForbidden):
+			render.Error(w, http.StatusForbidden, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
@@ -189,6 +301,8 @@ func (h *AppHandler) UpdateApp(w http.ResponseWriter, r *http.Request) {
 // @Success 204 "No Content"
 // @Failure 400 {object} render.Response
 // @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /apps/{id} [delete]
@@ -202,7 +316,102 @@ func (h *AppHandler) DeleteApp(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.svc.Delete(r.Context(), id); err != nil {
-		render.Error(w, http.StatusInternalServerError, err.Error())
+		switch {
+		case errors.Is(err, ErrAppNotFound):
+			render.Error(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrForbidden):
+			render.Error(w, http.StatusForbidden, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// RotateSecret godoc
+// @Summary Generate a client secret
+// @Description Generate a new client secret for the app, replacing any previous one. The secret is only returned once.
+// @Tags apps
+// @Produce json
+// @Param id path int true "App ID"
+// @Success 200 {object} render.Response{data=ClientCredentials}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Security Bearer
+// @Router /apps/{id}/secret [post]
+func (h *AppHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid app id in rotate secret request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid app id")
+		return
+	}
+
+	creds, err := h.svc.RotateSecret(r.Context(), id)
+	if err != nil {
+		render.Error(w, http.StatusNotFound, "app not found")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, creds)
+}
+
+// RotateSCIMToken godoc
+// @Summary Generate a SCIM token
+// @Description Generate a new bearer token identity providers use to provision the app's users at /scim/v2, replacing any previous one. The token is only returned once.
+// @Tags apps
+// @Produce json
+// @Param id path int true "App ID"
+// @Success 200 {object} render.Response{data=SCIMToken}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Security Bearer
+// @Router /apps/{id}/scim-token [post]
+func (h *AppHandler) RotateSCIMToken(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid app id in rotate scim token request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid app id")
+		return
+	}
+
+	token, err := h.svc.RotateSCIMToken(r.Context(), id)
+	if err != nil {
+		render.Error(w, http.StatusNotFound, "app not found")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, token)
+}
+
+// RevokeSCIMToken godoc
+// @Summary Revoke the SCIM token
+// @Description Remove the app's SCIM token, which disables SCIM provisioning for the app
+// @Tags apps
+// @Param id path int true "App ID"
+// @Success 204
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Security Bearer
+// @Router /apps/{id}/scim-token [delete]
+func (h *AppHandler) RevokeSCIMToken(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid app id in revoke scim token request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid app id")
+		return
+	}
+
+	if err := h.svc.RevokeSCIMToken(r.Context(), id); err != nil {
+		render.Error(w, http.StatusNotFound, "app not found")
 		return
 	}
 
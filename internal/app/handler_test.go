@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"keeper/pkg/render"
 
@@ -34,12 +35,12 @@ func (m *mockAppService) GetByID(ctx context.Context, id int) (*App, error) {
 	return args.Get(0).(*App), args.Error(1)
 }
 
-func (m *mockAppService) List(ctx context.Context) ([]*App, error) {
-	args := m.Called(ctx)
+func (m *mockAppService) List(ctx context.Context, req ListAppsRequest) (*AppPage, error) {
+	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*App), args.Error(1)
+	return args.Get(0).(*AppPage), args.Error(1)
 }
 
 func (m *mockAppService) Update(ctx context.Context, id int, req UpdateAppRequest) (*App, error) {
@@ -55,6 +56,27 @@ func (m *mockAppService) Delete(ctx context.Context, id int) error {
 	return args.Error(0)
 }
 
+func (m *mockAppService) RotateSecret(ctx context.Context, id int) (*ClientCredentials, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ClientCredentials), args.Error(1)
+}
+
+func (m *mockAppService) RotateSCIMToken(ctx context.Context, id int) (*SCIMToken, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SCIMToken), args.Error(1)
+}
+
+func (m *mockAppService) RevokeSCIMToken(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
 func TestHandler_Create(t *testing.T) {
 	svc := new(mockAppService)
 	handler := NewAppHandler(svc)
@@ -96,7 +118,7 @@ func TestHandler_List(t *testing.T) {
 		{ID: 2, Name: "App 2", Status: 1},
 	}
 
-	svc.On("List", mock.Anything).Return(expectedApps, nil)
+	svc.On("List", mock.Anything, ListAppsRequest{}).Return(&AppPage{Apps: expectedApps, Total: 3, NextCursor: "next"}, nil)
 
 	req, _ := http.NewRequest("GET", "/apps", nil)
 	rr := httptest.NewRecorder()
@@ -111,4 +133,69 @@ func TestHandler_List(t *testing.T) {
 
 	dataList := resp.Data.([]interface{})
 	assert.Len(t, dataList, 2)
+	assert.Equal(t, &render.Meta{Total: 3, NextCursor: "next"}, resp.Meta)
+}
+
+func TestHandler_ListQuery(t *testing.T) {
+	status := int8(0)
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(svc *mockAppService)
+		wantStatusCode int
+	}{
+		{
+			name:  "Filters",
+			query: "?status=0&created_after=2026-01-01T00:00:00Z&q=bill&sort=name&order=desc&cursor=abc&limit=10",
+			mockSetup: func(svc *mockAppService) {
+				svc.On("List", mock.Anything, ListAppsRequest{
+					Status:       &status,
+					CreatedAfter: &after,
+					Q:            "bill",
+					Sort:         "name",
+					Desc:         true,
+					Cursor:       "abc",
+					Limit:        10,
+				}).Return(&AppPage{Apps: []*App{}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{"Invalid status", "?status=2", func(svc *mockAppService) {}, http.StatusBadRequest},
+		{"Invalid time", "?created_before=yesterday", func(svc *mockAppService) {}, http.StatusBadRequest},
+		{"Invalid order", "?order=up", func(svc *mockAppService) {}, http.StatusBadRequest},
+		{"Limit too large", "?limit=1000", func(svc *mockAppService) {}, http.StatusBadRequest},
+		{
+			name:  "Invalid sort",
+			query: "?sort=client_secret_hash",
+			mockSetup: func(svc *mockAppService) {
+				svc.On("List", mock.Anything, ListAppsRequest{Sort: "client_secret_hash"}).Return(nil, ErrInvalidSort)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Invalid cursor",
+			query: "?cursor=abc",
+			mockSetup: func(svc *mockAppService) {
+				svc.On("List", mock.Anything, ListAppsRequest{Cursor: "abc"}).Return(nil, ErrInvalidCursor)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockAppService)
+			tt.mockSetup(svc)
+			handler := NewAppHandler(svc)
+
+			req, _ := http.NewRequest("GET", "/apps"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler.ListApps(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			svc.AssertExpectations(t)
+		})
+	}
 }
@@ -2,25 +2,109 @@ package app
 
 import (
 	"time"
+
+	"keeper/pkg/password"
 )
 
 // App represents the domain model for an app.
 type App struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Status    int8      `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                   int             `json:"id"`
+	Name                 string          `json:"name"`
+	ClientID             string          `json:"client_id"`
+	RedirectURIs         []string        `json:"redirect_uris"`
+	Scopes               []string        `json:"scopes"`
+	RequireVerifiedEmail bool            `json:"require_verified_email"`
+	RequireMFA           bool            `json:"require_mfa"`
+	MagicLinkEnabled     bool            `json:"magic_link_enabled"`
+	WebAuthnRPID         string          `json:"webauthn_rp_id,omitempty"`
+	WebAuthnOrigins      []string        `json:"webauthn_origins,omitempty"`
+	MaxLoginAttempts     int             `json:"max_login_attempts"`
+	LockoutDuration      int             `json:"lockout_duration"`
+	LoginDelay           int             `json:"login_delay"`
+	MaxIPLoginAttempts   int             `json:"max_ip_login_attempts"`
+	PasswordPolicy       password.Policy `json:"password_policy"`
+	HasSecret            bool            `json:"has_secret"`
+	HasSCIMToken         bool            `json:"has_scim_token"`
+	Status               int8            `json:"status"`
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+}
+
+// ListAppsRequest filters, sorts and pages the apps returned by List. Apps
+// are sorted by Sort, one of SortFields, then by ID. Cursor is the
+// NextCursor of the previous page, which must have had the same sort.
+type ListAppsRequest struct {
+	Status        *int8
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Q matches apps whose name contains it, ignoring case.
+	Q      string
+	Sort   string
+	Desc   bool
+	Cursor string
+	Limit  int
+}
+
+// AppPage is a page of apps.
+type AppPage struct {
+	Apps []*App
+	// Total is the number of apps matching the filters, across all pages.
+	Total int
+	// NextCursor fetches the next page. It is empty on the last page.
+	NextCursor string
 }
 
 // CreateAppRequest defines the payload for creating an app.
 type CreateAppRequest struct {
-	Name   string `json:"name" validate:"required"`
-	Status int8   `json:"status" validate:"omitempty"`
+	Name                 string   `json:"name" validate:"required"`
+	RedirectURIs         []string `json:"redirect_uris" validate:"omitempty,dive,url"`
+	Scopes               []string `json:"scopes" validate:"omitempty,dive,required,excludesall= "`
+	RequireVerifiedEmail bool     `json:"require_verified_email"`
+	RequireMFA           bool     `json:"require_mfa"`
+	MagicLinkEnabled     bool     `json:"magic_link_enabled"`
+	WebAuthnRPID         string   `json:"webauthn_rp_id" validate:"omitempty,hostname"`
+	WebAuthnOrigins      []string `json:"webauthn_origins" validate:"omitempty,dive,url"`
+	// MaxLoginAttempts, LockoutDuration (seconds), LoginDelay (seconds) and
+	// MaxIPLoginAttempts throttle failed password logins. Omitted values
+	// keep their defaults and zero disables a limit.
+	MaxLoginAttempts   *int `json:"max_login_attempts" validate:"omitempty,min=0"`
+	LockoutDuration    *int `json:"lockout_duration" validate:"omitempty,min=1"`
+	LoginDelay         *int `json:"login_delay" validate:"omitempty,min=0"`
+	MaxIPLoginAttempts *int `json:"max_ip_login_attempts" validate:"omitempty,min=0"`
+	// PasswordPolicy is the policy new passwords of the app's users must
+	// meet. It defaults to password.DefaultPolicy when omitted.
+	PasswordPolicy *password.Policy `json:"password_policy"`
+	Status         int8             `json:"status" validate:"omitempty"`
 }
 
 // UpdateAppRequest defines the payload for updating an app.
 type UpdateAppRequest struct {
-	Name   *string `json:"name" validate:"omitempty"`
-	Status *int8   `json:"status" validate:"omitempty"`
+	Name                 *string  `json:"name" validate:"omitempty"`
+	RedirectURIs         []string `json:"redirect_uris" validate:"omitempty,dive,url"`
+	Scopes               []string `json:"scopes" validate:"omitempty,dive,required,excludesall= "`
+	RequireVerifiedEmail *bool    `json:"require_verified_email"`
+	RequireMFA           *bool    `json:"require_mfa"`
+	MagicLinkEnabled     *bool    `json:"magic_link_enabled"`
+	WebAuthnRPID         *string  `json:"webauthn_rp_id" validate:"omitempty,hostname"`
+	WebAuthnOrigins      []string `json:"webauthn_origins" validate:"omitempty,dive,url"`
+	MaxLoginAttempts     *int     `json:"max_login_attempts" validate:"omitempty,min=0"`
+	LockoutDuration      *int     `json:"lockout_duration" validate:"omitempty,min=1"`
+	LoginDelay           *int     `json:"login_delay" validate:"omitempty,min=0"`
+	MaxIPLoginAttempts   *int     `json:"max_ip_login_attempts" validate:"omitempty,min=0"`
+	// PasswordPolicy replaces the whole password policy when set.
+	PasswordPolicy *password.Policy `json:"password_policy"`
+	Status         *int8            `json:"status" validate:"omitempty"`
+}
+
+// ClientCredentials is returned once when an app's client secret is generated.
+// Only a hash of the secret is stored.
+type ClientCredentials struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// SCIMToken is returned once when an app's SCIM bearer token is generated.
+// Only a hash of the token is stored.
+type SCIMToken struct {
+	Token string `json:"token"`
 }
@@ -6,6 +6,9 @@ import (
 	"log/slog"
 
 	"keeper/ent"
+	"keeper/ent/app"
+	"keeper/ent/predicate"
+	"keeper/internal/db"
 )
 
 // AppRepository handles database operations for apps.
@@ -18,13 +21,31 @@ func NewAppRepository(client *ent.Client) *AppRepository {
 	return &AppRepository{client: client}
 }
 
-// Create creates a new app in the database.
+// Create creates a new app in the database. Changes to apps are made in a
+// transaction, so that the audit entries they record are stored with them.
 func (r *AppRepository) Create(ctx context.Context, a *ent.App) (*ent.App, error) {
-	created, err := r.client.App.
-		Create().
-		SetName(a.Name).
-		SetStatus(a.Status).
-		Save(ctx)
+	var created *ent.App
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		var err error
+		created, err = tx.App.
+			Create().
+			SetName(a.Name).
+			SetRedirectUris(a.RedirectUris).
+			SetScopes(a.Scopes).
+			SetRequireVerifiedEmail(a.RequireVerifiedEmail).
+			SetRequireMfa(a.RequireMfa).
+			SetMagicLinkEnabled(a.MagicLinkEnabled).
+			SetWebauthnRpID(a.WebauthnRpID).
+			SetWebauthnOrigins(a.WebauthnOrigins).
+			SetMaxLoginAttempts(a.MaxLoginAttempts).
+			SetLockoutDuration(a.LockoutDuration).
+			SetLoginDelay(a.LoginDelay).
+			SetMaxIPLoginAttempts(a.MaxIPLoginAttempts).
+			SetPasswordPolicy(a.PasswordPolicy).
+			SetStatus(a.Status).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		slog.Error("database error: failed to create app", "name", a.Name, "error", err)
 		return nil, err
@@ -46,22 +67,54 @@ func (r *AppRepository) GetByID(ctx context.Context, id int) (*ent.App, error) {
 	return a, nil
 }
 
-// List retrieves all apps.
-func (r *AppRepository) List(ctx context.Context) ([]*ent.App, error) {
-	apps, err := r.client.App.Query().All(ctx)
+// List retrieves up to limit apps matching the predicates, sorted by the
+// sort column and then by ID, starting after the cursor when there is one.
+// It also returns the number of apps matching the predicates.
+func (r *AppRepository) List(ctx context.Context, where []predicate.App, sort string, desc bool, after *db.Cursor, limit int) ([]*ent.App, int, error) {
+	q := r.client.App.Query().Where(where...)
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		slog.Error("database error: failed to count apps", "error", err)
+		return nil, 0, err
+	}
+	if after != nil {
+		q.Where(predicate.App(after.After()))
+	}
+	apps, err := q.
+		Order(app.OrderOption(db.Order(sort, desc))).
+		Limit(limit).
+		All(ctx)
 	if err != nil {
 		slog.Error("database error: failed to list apps", "error", err)
-		return nil, err
+		return nil, 0, err
 	}
-	return apps, nil
+	return apps, total, nil
 }
 
-// Update updates an existing app.
+// Update updates an existing app, in a transaction so that the webhook
+// deliveries the change queues are stored with it.
 func (r *AppRepository) Update(ctx context.Context, id int, a *ent.App) (*ent.App, error) {
-	updated, err := r.client.App.UpdateOneID(id).
-		SetName(a.Name).
-		SetStatus(a.Status).
-		Save(ctx)
+	var updated *ent.App
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		var err error
+		updated, err = tx.App.UpdateOneID(id).
+			SetName(a.Name).
+			SetRedirectUris(a.RedirectUris).
+			SetScopes(a.Scopes).
+			SetRequireVerifiedEmail(a.RequireVerifiedEmail).
+			SetRequireMfa(a.RequireMfa).
+			SetMagicLinkEnabled(a.MagicLinkEnabled).
+			SetWebauthnRpID(a.WebauthnRpID).
+			SetWebauthnOrigins(a.WebauthnOrigins).
+			SetMaxLoginAttempts(a.MaxLoginAttempts).
+			SetLockoutDuration(a.LockoutDuration).
+			SetLoginDelay(a.LoginDelay).
+			SetMaxIPLoginAttempts(a.MaxIPLoginAttempts).
+			SetPasswordPolicy(a.PasswordPolicy).
+			SetStatus(a.Status).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		slog.Error("database error: failed to update app", "id", id, "error", err)
 		return nil, err
@@ -71,7 +124,9 @@ func (r *AppRepository) Update(ctx context.Context, id int, a *ent.App) (*ent.Ap
 
 // Delete deletes an app by its ID.
 func (r *AppRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.App.DeleteOneID(id).Exec(ctx)
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.App.DeleteOneID(id).Exec(ctx)
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
 			slog.Warn("app not found for deletion", "id", id)
@@ -82,3 +137,48 @@ func (r *AppRepository) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// SetClientSecretHash replaces the hash of an app's client secret.
+func (r *AppRepository) SetClientSecretHash(ctx context.Context, id int, secretHash string) (*ent.App, error) {
+	var updated *ent.App
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		var err error
+		updated, err = tx.App.UpdateOneID(id).
+			SetClientSecretHash(secretHash).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("app not found for secret rotation", "id", id)
+			return nil, fmt.Errorf("app not found: %w", err)
+		}
+		slog.Error("database error: failed to set client secret", "id", id, "error", err)
+		return nil, err
+	}
+	return updated, nil
+}
+
+// SetSCIMTokenHash replaces the hash of an app's SCIM token, or removes it
+// when tokenHash is nil.
+func (r *AppRepository) SetSCIMTokenHash(ctx context.Context, id int, tokenHash *string) (*ent.App, error) {
+	var updated *ent.App
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		upd := tx.App.UpdateOneID(id).SetNillableScimTokenHash(tokenHash)
+		if tokenHash == nil {
+			upd.ClearScimTokenHash()
+		}
+		var err error
+		updated, err = upd.Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("app not found for scim token change", "id", id)
+			return nil, fmt.Errorf("app not found: %w", err)
+		}
+		slog.Error("database error: failed to set scim token", "id", id, "error", err)
+		return nil, err
+	}
+	return updated, nil
+}
@@ -2,19 +2,59 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"keeper/ent"
+	entapp "keeper/ent/app"
+	"keeper/ent/predicate"
+	"keeper/ent/privacy"
+	"keeper/internal/db"
+	"keeper/pkg/auth"
+	"keeper/pkg/password"
 )
 
 // AppService defines the business logic for apps.
 type AppService interface {
 	Create(ctx context.Context, req CreateAppRequest) (*App, error)
 	GetByID(ctx context.Context, id int) (*App, error)
-	List(ctx context.Context) ([]*App, error)
+	List(ctx context.Context, req ListAppsRequest) (*AppPage, error)
 	Update(ctx context.Context, id int, req UpdateAppRequest) (*App, error)
 	Delete(ctx context.Context, id int) error
+	RotateSecret(ctx context.Context, id int) (*ClientCredentials, error)
+	RotateSCIMToken(ctx context.Context, id int) (*SCIMToken, error)
+	RevokeSCIMToken(ctx context.Context, id int) error
+}
+
+var (
+	// ErrAppNotFound is returned when the requested app does not exist.
+	ErrAppNotFound = errors.New("app not found")
+	// ErrForbidden is returned when the caller may not make a change, such as
+	// creating an app or widening its scopes without being a platform admin.
+	ErrForbidden = errors.New("operation not permitted")
+	// ErrInvalidSort is returned when apps are listed by a field not in SortFields.
+	ErrInvalidSort = errors.New("invalid sort field")
+	// ErrInvalidCursor is returned when a list cursor is not one List
+	// returned for the same sort.
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// DefaultListLimit is the page size of List when none is given, and
+// MaxListLimit the largest one.
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// SortFields are the fields List can sort apps by, with how to read them.
+var SortFields = map[string]func(*ent.App) any{
+	entapp.FieldID:        func(a *ent.App) any { return a.ID },
+	entapp.FieldName:      func(a *ent.App) any { return a.Name },
+	entapp.FieldStatus:    func(a *ent.App) any { return a.Status },
+	entapp.FieldCreatedAt: func(a *ent.App) any { return a.CreatedAt },
+	entapp.FieldUpdatedAt: func(a *ent.App) any { return a.UpdatedAt },
 }
 
 type appService struct {
@@ -33,14 +73,31 @@ func (s *appService) Create(ctx context.Context, req CreateAppRequest) (*App, er
 	if req.Status != 0 {
 		status = req.Status
 	}
+	passwordPolicy := password.PolicyOrDefault(req.PasswordPolicy)
 
 	a := &ent.App{
-		Name:   req.Name,
-		Status: status,
+		Name:                 req.Name,
+		RedirectUris:         req.RedirectURIs,
+		Scopes:               req.Scopes,
+		RequireVerifiedEmail: req.RequireVerifiedEmail,
+		RequireMfa:           req.RequireMFA,
+		MagicLinkEnabled:     req.MagicLinkEnabled,
+		WebauthnRpID:         req.WebAuthnRPID,
+		WebauthnOrigins:      req.WebAuthnOrigins,
+		MaxLoginAttempts:     valueOr(req.MaxLoginAttempts, entapp.DefaultMaxLoginAttempts),
+		LockoutDuration:      valueOr(req.LockoutDuration, entapp.DefaultLockoutDuration),
+		LoginDelay:           valueOr(req.LoginDelay, entapp.DefaultLoginDelay),
+		MaxIPLoginAttempts:   valueOr(req.MaxIPLoginAttempts, entapp.DefaultMaxIPLoginAttempts),
+		PasswordPolicy:       &passwordPolicy,
+		Status:               status,
 	}
 
 	created, err := s.repo.Create(ctx, a)
 	if err != nil {
+		if errors.Is(err, privacy.Deny) {
+			slog.Warn("app creation denied", "name", req.Name, "error", err)
+			return nil, ErrForbidden
+		}
 		return nil, fmt.Errorf("repository create: %w", err)
 	}
 
@@ -57,36 +114,123 @@ func (s *appService) GetByID(ctx context.Context, id int) (*App, error) {
 	return s.toDomain(a), nil
 }
 
-func (s *appService) List(ctx context.Context) ([]*App, error) {
+// List returns a page of the apps that match req: the caller's app, or
+// every app for platform admins.
+func (s *appService) List(ctx context.Context, req ListAppsRequest) (*AppPage, error) {
 	slog.Info("listing apps")
-	apps, err := s.repo.List(ctx)
+	sort := req.Sort
+	if sort == "" {
+		sort = entapp.FieldID
+	}
+	sortValue, ok := SortFields[sort]
+	if !ok {
+		return nil, ErrInvalidSort
+	}
+	var after *db.Cursor
+	if req.Cursor != "" {
+		c, err := db.DecodeCursor(req.Cursor)
+		if err != nil || c.Sort != sort || c.Desc != req.Desc {
+			return nil, ErrInvalidCursor
+		}
+		after = &c
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	limit = min(limit, MaxListLimit)
+
+	var where []predicate.App
+	if req.Status != nil {
+		where = append(where, entapp.StatusEQ(*req.Status))
+	}
+	if req.CreatedAfter != nil {
+		where = append(where, entapp.CreatedAtGTE(req.CreatedAfter.UTC()))
+	}
+	if req.CreatedBefore != nil {
+		where = append(where, entapp.CreatedAtLT(req.CreatedBefore.UTC()))
+	}
+	if q := strings.TrimSpace(req.Q); q != "" {
+		where = append(where, entapp.NameContainsFold(q))
+	}
+
+	// One app more than asked for tells whether there is a next page.
+	apps, total, err := s.repo.List(ctx, where, sort, req.Desc, after, limit+1)
 	if err != nil {
 		return nil, err
 	}
 
-	domainApps := make([]*App, len(apps))
+	page := &AppPage{Apps: make([]*App, 0, min(len(apps), limit)), Total: total}
 	for i, a := range apps {
-		domainApps[i] = s.toDomain(a)
+		if i == limit {
+			last := apps[i-1]
+			page.NextCursor = db.NewCursor(sort, req.Desc, sortValue(last), last.ID).Encode()
+			break
+		}
+		page.Apps = append(page.Apps, s.toDomain(a))
 	}
-	return domainApps, nil
+	return page, nil
 }
 
 func (s *appService) Update(ctx context.Context, id int, req UpdateAppRequest) (*App, error) {
 	slog.Info("updating app", "id", id)
 	existing, err := s.repo.GetByID(ctx, id)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrAppNotFound
+		}
 		return nil, err
 	}
 
 	if req.Name != nil {
 		existing.Name = *req.Name
 	}
+	if req.RedirectURIs != nil {
+		existing.RedirectUris = req.RedirectURIs
+	}
+	if req.Scopes != nil {
+		existing.Scopes = req.Scopes
+	}
+	if req.RequireVerifiedEmail != nil {
+		existing.RequireVerifiedEmail = *req.RequireVerifiedEmail
+	}
+	if req.RequireMFA != nil {
+		existing.RequireMfa = *req.RequireMFA
+	}
+	if req.MagicLinkEnabled != nil {
+		existing.MagicLinkEnabled = *req.MagicLinkEnabled
+	}
+	if req.WebAuthnRPID != nil {
+		existing.WebauthnRpID = *req.WebAuthnRPID
+	}
+	if req.WebAuthnOrigins != nil {
+		existing.WebauthnOrigins = req.WebAuthnOrigins
+	}
+	if req.MaxLoginAttempts != nil {
+		existing.MaxLoginAttempts = *req.MaxLoginAttempts
+	}
+	if req.LockoutDuration != nil {
+		existing.LockoutDuration = *req.LockoutDuration
+	}
+	if req.LoginDelay != nil {
+		existing.LoginDelay = *req.LoginDelay
+	}
+	if req.MaxIPLoginAttempts != nil {
+		existing.MaxIPLoginAttempts = *req.MaxIPLoginAttempts
+	}
+	if req.PasswordPolicy != nil {
+		existing.PasswordPolicy = req.PasswordPolicy
+	}
 	if req.Status != nil {
 		existing.Status = *req.Status
 	}
 
 	updated, err := s.repo.Update(ctx, id, existing)
 	if err != nil {
+		if errors.Is(err, privacy.Deny) {
+			slog.Warn("app update denied", "id", id, "error", err)
+			return nil, ErrForbidden
+		}
 		return nil, err
 	}
 
@@ -98,18 +242,89 @@ func (s *appService) Delete(ctx context.Context, id int) error {
 	slog.Info("deleting app", "id", id)
 	err := s.repo.Delete(ctx, id)
 	if err != nil {
+		switch {
+		case errors.Is(err, privacy.Deny):
+			slog.Warn("app deletion denied", "id", id, "error", err)
+			return ErrForbidden
+		case ent.IsNotFound(err):
+			return ErrAppNotFound
+		}
 		return err
 	}
 	slog.Info("app deleted successfully", "id", id)
 	return nil
 }
 
+func (s *appService) RotateSecret(ctx context.Context, id int) (*ClientCredentials, error) {
+	slog.Info("rotating client secret", "id", id)
+	secret, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate client secret: %w", err)
+	}
+
+	updated, err := s.repo.SetClientSecretHash(ctx, id, auth.HashToken(secret))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("client secret rotated", "id", id, "client_id", updated.ClientID)
+	return &ClientCredentials{ClientID: updated.ClientID, ClientSecret: secret}, nil
+}
+
+func (s *appService) RotateSCIMToken(ctx context.Context, id int) (*SCIMToken, error) {
+	slog.Info("rotating scim token", "id", id)
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate scim token: %w", err)
+	}
+
+	hash := auth.HashToken(token)
+	if _, err := s.repo.SetSCIMTokenHash(ctx, id, &hash); err != nil {
+		return nil, err
+	}
+
+	slog.Info("scim token rotated", "id", id)
+	return &SCIMToken{Token: token}, nil
+}
+
+func (s *appService) RevokeSCIMToken(ctx context.Context, id int) error {
+	slog.Info("revoking scim token", "id", id)
+	if _, err := s.repo.SetSCIMTokenHash(ctx, id, nil); err != nil {
+		return err
+	}
+	slog.Info("scim token revoked", "id", id)
+	return nil
+}
+
 func (s *appService) toDomain(a *ent.App) *App {
 	return &App{
-		ID:        a.ID,
-		Name:      a.Name,
-		Status:    a.Status,
-		CreatedAt: a.CreatedAt,
-		UpdatedAt: a.UpdatedAt,
+		ID:                   a.ID,
+		Name:                 a.Name,
+		ClientID:             a.ClientID,
+		RedirectURIs:         a.RedirectUris,
+		Scopes:               a.Scopes,
+		RequireVerifiedEmail: a.RequireVerifiedEmail,
+		RequireMFA:           a.RequireMfa,
+		MagicLinkEnabled:     a.MagicLinkEnabled,
+		WebAuthnRPID:         a.WebauthnRpID,
+		WebAuthnOrigins:      a.WebauthnOrigins,
+		MaxLoginAttempts:     a.MaxLoginAttempts,
+		LockoutDuration:      a.LockoutDuration,
+		LoginDelay:           a.LoginDelay,
+		MaxIPLoginAttempts:   a.MaxIPLoginAttempts,
+		PasswordPolicy:       password.PolicyOrDefault(a.PasswordPolicy),
+		HasSecret:            a.ClientSecretHash != nil,
+		HasSCIMToken:         a.ScimTokenHash != nil,
+		Status:               a.Status,
+		CreatedAt:            a.CreatedAt,
+		UpdatedAt:            a.UpdatedAt,
+	}
+}
+
+// valueOr returns *v, or def when v is nil.
+func valueOr(v *int, def int) int {
+	if v == nil {
+		return def
 	}
+	return *v
 }
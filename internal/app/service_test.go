@@ -3,8 +3,11 @@ package app
 import (
 	"context"
 	"testing"
+	"time"
 
 	"keeper/ent/enttest"
+	"keeper/pkg/auth"
+	"keeper/pkg/password"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
@@ -22,7 +25,8 @@ func TestService_Create(t *testing.T) {
 
 	ctx := context.Background()
 	req := CreateAppRequest{
-		Name: "Test App",
+		Name:         "Test App",
+		RedirectURIs: []string{"https://app.example.com/callback"},
 	}
 
 	a, err := svc.Create(ctx, req)
@@ -30,6 +34,12 @@ func TestService_Create(t *testing.T) {
 	assert.NotNil(t, a)
 	assert.Equal(t, req.Name, a.Name)
 	assert.Equal(t, int8(1), a.Status)
+	assert.Len(t, a.ClientID, 32)
+	assert.Equal(t, req.RedirectURIs, a.RedirectURIs)
+	assert.Equal(t, 10, a.MaxLoginAttempts)
+	assert.Equal(t, 900, a.LockoutDuration)
+	assert.Equal(t, password.DefaultPolicy(), a.PasswordPolicy)
+	assert.False(t, a.MagicLinkEnabled)
 }
 
 func TestService_Update(t *testing.T) {
@@ -50,15 +60,25 @@ func TestService_Update(t *testing.T) {
 
 	newName := "Updated App"
 	newStatus := int8(0)
+	noLockout := 0
+	magicLink := true
+	policy := password.Policy{MinLength: 12, RequireDigit: true, History: 5, MaxAgeDays: 90}
 	req := UpdateAppRequest{
-		Name:   &newName,
-		Status: &newStatus,
+		Name:             &newName,
+		Status:           &newStatus,
+		MaxLoginAttempts: &noLockout,
+		MagicLinkEnabled: &magicLink,
+		PasswordPolicy:   &policy,
 	}
 
 	updated, err := svc.Update(ctx, a.ID, req)
 	assert.NoError(t, err)
 	assert.Equal(t, newName, updated.Name)
 	assert.Equal(t, newStatus, updated.Status)
+	assert.Equal(t, 0, updated.MaxLoginAttempts)
+	assert.Equal(t, 1, updated.LoginDelay)
+	assert.True(t, updated.MagicLinkEnabled)
+	assert.Equal(t, policy, updated.PasswordPolicy)
 }
 
 func TestService_Delete(t *testing.T) {
@@ -85,6 +105,86 @@ func TestService_Delete(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestService_RotateSecret(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_app_secret?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewAppRepository(client)
+	svc := NewAppService(repo)
+
+	ctx := context.Background()
+	a, err := svc.Create(ctx, CreateAppRequest{
+		Name:   "Service App",
+		Scopes: []string{"users:read"},
+	})
+	assert.NoError(t, err)
+	assert.False(t, a.HasSecret)
+
+	first, err := svc.RotateSecret(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, a.ClientID, first.ClientID)
+	assert.NotEmpty(t, first.ClientSecret)
+
+	second, err := svc.RotateSecret(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.ClientSecret, second.ClientSecret)
+
+	stored, err := client.App.Get(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, auth.HashToken(second.ClientSecret), *stored.ClientSecretHash)
+
+	got, err := svc.GetByID(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.True(t, got.HasSecret)
+	assert.Equal(t, []string{"users:read"}, got.Scopes)
+
+	_, err = svc.RotateSecret(ctx, 9999)
+	assert.Error(t, err)
+}
+
+func TestService_SCIMToken(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_app_scim_token?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewAppService(NewAppRepository(client))
+
+	ctx := context.Background()
+	a, err := svc.Create(ctx, CreateAppRequest{Name: "Provisioned App"})
+	assert.NoError(t, err)
+	assert.False(t, a.HasSCIMToken)
+
+	first, err := svc.RotateSCIMToken(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, first.Token)
+
+	second, err := svc.RotateSCIMToken(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Token, second.Token)
+
+	stored, err := client.App.Get(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, auth.HashToken(second.Token), *stored.ScimTokenHash)
+
+	got, err := svc.GetByID(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.True(t, got.HasSCIMToken)
+
+	err = svc.RevokeSCIMToken(ctx, a.ID)
+	assert.NoError(t, err)
+	got, err = svc.GetByID(ctx, a.ID)
+	assert.NoError(t, err)
+	assert.False(t, got.HasSCIMToken)
+
+	_, err = svc.RotateSCIMToken(ctx, 9999)
+	assert.Error(t, err)
+}
+
 func TestService_List(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent_app_list?mode=memory&cache=shared&_fk=1")
 	defer func() {
@@ -99,7 +199,144 @@ func TestService_List(t *testing.T) {
 	_, _ = svc.Create(ctx, CreateAppRequest{Name: "App 1"})
 	_, _ = svc.Create(ctx, CreateAppRequest{Name: "App 2"})
 
-	apps, err := svc.List(ctx)
+	page, err := svc.List(ctx, ListAppsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, page.Apps, 2)
+	assert.Equal(t, 2, page.Total)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestService_ListPages(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_app_list_pages?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewAppService(NewAppRepository(client))
+	ctx := context.Background()
+
+	for _, name := range []string{"Billing", "Search", "Analytics", "billing-staging", "Mail"} {
+		_, err := svc.Create(ctx, CreateAppRequest{Name: name})
+		assert.NoError(t, err)
+	}
+	legacy, err := svc.Create(ctx, CreateAppRequest{Name: "Legacy"})
+	assert.NoError(t, err)
+	_, err = client.App.UpdateOneID(legacy.ID).SetStatus(0).Save(ctx)
 	assert.NoError(t, err)
-	assert.Len(t, apps, 2)
+
+	t.Run("SortAndPaginate", func(t *testing.T) {
+		var names []string
+		req := ListAppsRequest{Sort: "name", Desc: true, Limit: 4}
+		for {
+			page, err := svc.List(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, 6, page.Total)
+			for _, a := range page.Apps {
+				names = append(names, a.Name)
+			}
+			if page.NextCursor == "" {
+				break
+			}
+			req.Cursor = page.NextCursor
+		}
+		assert.Equal(t, []string{"billing-staging", "Search", "Mail", "Legacy", "Billing", "Analytics"}, names)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		page, err := svc.List(ctx, ListAppsRequest{Q: "BILL"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, page.Total)
+
+		active := int8(1)
+		page, err = svc.List(ctx, ListAppsRequest{Status: &active})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, page.Total)
+
+		future := time.Now().Add(time.Hour)
+		page, err = svc.List(ctx, ListAppsRequest{CreatedAfter: &future})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, page.Total)
+		assert.Empty(t, page.Apps)
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := svc.List(ctx, ListAppsRequest{Sort: "client_secret_hash"})
+		assert.ErrorIs(t, err, ErrInvalidSort)
+
+		page, err := svc.List(ctx, ListAppsRequest{Sort: "name", Limit: 1})
+		assert.NoError(t, err)
+		_, err = svc.List(ctx, ListAppsRequest{Sort: "created_at", Cursor: page.NextCursor})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+		_, err = svc.List(ctx, ListAppsRequest{Cursor: "not-a-cursor"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestService_TenantIsolation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_app_tenant?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewAppRepository(client)
+	svc := NewAppService(repo)
+
+	ctx := context.Background()
+	appA, err := svc.Create(ctx, CreateAppRequest{Name: "Tenant A", Scopes: []string{"users:read"}})
+	assert.NoError(t, err)
+	appB, err := svc.Create(ctx, CreateAppRequest{Name: "Tenant B"})
+	assert.NoError(t, err)
+
+	tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: appA.ID, UserID: 1})
+	adminCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: appA.ID, UserID: 1, PlatformAdmin: true})
+
+	t.Run("OwnAppOnly", func(t *testing.T) {
+		page, err := svc.List(tenantCtx, ListAppsRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Apps, 1)
+		assert.Equal(t, 1, page.Total)
+		assert.Equal(t, appA.ID, page.Apps[0].ID)
+
+		_, err = svc.GetByID(tenantCtx, appB.ID)
+		assert.Error(t, err)
+
+		name := "Hijacked"
+		_, err = svc.Update(tenantCtx, appB.ID, UpdateAppRequest{Name: &name})
+		assert.ErrorIs(t, err, ErrAppNotFound)
+
+		_, err = svc.RotateSecret(tenantCtx, appB.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("UpdateOwnApp", func(t *testing.T) {
+		name := "Tenant A Renamed"
+		a, err := svc.Update(tenantCtx, appA.ID, UpdateAppRequest{Name: &name, Scopes: []string{"users:read"}})
+		assert.NoError(t, err)
+		assert.Equal(t, name, a.Name)
+
+		_, err = svc.Update(tenantCtx, appA.ID, UpdateAppRequest{Scopes: []string{"users:read", "apps:write"}})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("PlatformAdminRequired", func(t *testing.T) {
+		_, err := svc.Create(tenantCtx, CreateAppRequest{Name: "Tenant C"})
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		err = svc.Delete(tenantCtx, appA.ID)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("PlatformAdmin", func(t *testing.T) {
+		page, err := svc.List(adminCtx, ListAppsRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Apps, 2)
+
+		a, err := svc.Update(adminCtx, appB.ID, UpdateAppRequest{Scopes: []string{"users:read"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"users:read"}, a.Scopes)
+
+		assert.NoError(t, svc.Delete(adminCtx, appB.ID))
+	})
 }
@@ -0,0 +1,143 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// PermissionRead is the permission a token needs to read the audit trail.
+// App tokens are granted it as a scope.
+const PermissionRead = "audit:read"
+
+// AuditHandler handles HTTP requests for the audit trail.
+type AuditHandler struct {
+	svc AuditService
+}
+
+// NewAuditHandler creates a new audit handler.
+func NewAuditHandler(svc AuditService) *AuditHandler {
+	return &AuditHandler{svc: svc}
+}
+
+// Routes returns the chi router for audit endpoints.
+func (h *AuditHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
+	r := chi.NewRouter()
+
+	// All routes are protected
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Middleware(jwtManager))
+
+		r.With(auth.RequirePermission(PermissionRead)).Get("/", h.List)
+	})
+
+	return r
+}
+
+// List godoc
+// @Summary List audit trail entries
+// @Description Get the logins, failed logins and changes to users, apps and lockouts of the caller's app, or of every app for platform admins, newest first
+// @Tags audit
+// @Produce json
+// @Param app_id query int false "App ID"
+// @Param actor_id query int false "ID of the user or app that acted"
+// @Param action query string false "Action, such as auth.login_failed or user.update"
+// @Param target_type query string false "Target type: user, app or lockout"
+// @Param target_id query int false "Target ID"
+// @Param since query string false "Oldest time, RFC 3339"
+// @Param until query string false "Time before the newest entry, RFC 3339"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, at most 200"
+// @Success 200 {object} render.Response{data=Page}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /audit [get]
+func (h *AuditHandler) List(w http.ResponseWriter, r *http.Request) {
+	req, err := listRequestFromQuery(r.URL.Query())
+	if err != nil {
+		slog.Warn("invalid list audit request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.svc.List(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCursor) {
+			render.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, page)
+}
+
+func listRequestFromQuery(q url.Values) (ListRequest, error) {
+	req := ListRequest{
+		Action:     q.Get("action"),
+		TargetType: q.Get("target_type"),
+		Cursor:     q.Get("cursor"),
+	}
+	var err error
+	if req.AppID, err = intParam(q, "app_id"); err != nil {
+		return req, err
+	}
+	if req.ActorID, err = intParam(q, "actor_id"); err != nil {
+		return req, err
+	}
+	if req.TargetID, err = intParam(q, "target_id"); err != nil {
+		return req, err
+	}
+	if req.Since, err = timeParam(q, "since"); err != nil {
+		return req, err
+	}
+	if req.Until, err = timeParam(q, "until"); err != nil {
+		return req, err
+	}
+	if limit, err := intParam(q, "limit"); err != nil {
+		return req, err
+	} else if limit != nil {
+		if *limit < 1 || *limit > MaxLimit {
+			return req, fmt.Errorf("limit must be between 1 and %d", MaxLimit)
+		}
+		req.Limit = *limit
+	}
+	return req, nil
+}
+
+func intParam(q url.Values, name string) (*int, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return &n, nil
+}
+
+func timeParam(q url.Values, name string) (*time.Time, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected RFC 3339 time", name)
+	}
+	return &t, nil
+}
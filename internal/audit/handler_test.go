@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"keeper/pkg/render"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAuditService struct {
+	mock.Mock
+}
+
+func (m *mockAuditService) List(ctx context.Context, req ListRequest) (*Page, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Page), args.Error(1)
+}
+
+func (m *mockAuditService) Verify(ctx context.Context) (*VerifyResult, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*VerifyResult), args.Error(1)
+}
+
+func TestHandler_List(t *testing.T) {
+	appID, targetID := 1, 7
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		url            string
+		mockSetup      func(svc *mockAuditService)
+		wantStatusCode int
+		wantCursor     string
+	}{
+		{
+			name: "Success with filters",
+			url:  "/audit?app_id=1&action=user.update&target_type=user&target_id=7&since=2026-10-01T00:00:00Z&cursor=42&limit=10",
+			mockSetup: func(svc *mockAuditService) {
+				svc.On("List", mock.Anything, ListRequest{
+					AppID:      &appID,
+					Action:     "user.update",
+					TargetType: "user",
+					TargetID:   &targetID,
+					Since:      &since,
+					Cursor:     "42",
+					Limit:      10,
+				}).Return(&Page{Entries: []*Entry{{ID: 41, Action: "user.update"}}, NextCursor: "41"}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantCursor:     "41",
+		},
+		{
+			name:           "Invalid target ID",
+			url:            "/audit?target_id=abc",
+			mockSetup:      func(svc *mockAuditService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid time",
+			url:            "/audit?until=yesterday",
+			mockSetup:      func(svc *mockAuditService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "Limit too large",
+			url:            "/audit?limit=1000",
+			mockSetup:      func(svc *mockAuditService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid cursor",
+			url:  "/audit?cursor=abc",
+			mockSetup: func(svc *mockAuditService) {
+				svc.On("List", mock.Anything, ListRequest{Cursor: "abc"}).Return(nil, ErrInvalidCursor)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockAuditService)
+			tt.mockSetup(svc)
+			handler := NewAuditHandler(svc)
+
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			rr := httptest.NewRecorder()
+
+			handler.List(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			if tt.wantStatusCode == http.StatusOK {
+				var resp struct {
+					render.Response
+					Data Page `json:"data"`
+				}
+				err := json.Unmarshal(rr.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCursor, resp.Data.NextCursor)
+				assert.Len(t, resp.Data.Entries, 1)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"strings"
+
+	"keeper/ent"
+	"keeper/ent/hook"
+	entrole "keeper/ent/role"
+	entuser "keeper/ent/user"
+	"keeper/internal/db"
+)
+
+// redacted replaces the values of sensitive fields in recorded changes.
+const redacted = "[redacted]"
+
+// sensitiveFields hold secrets. Changes to them are recorded without their
+// values.
+var sensitiveFields = map[string]bool{
+	"password":           true,
+	"totp_secret":        true,
+	"client_secret_hash": true,
+	"scim_token_hash":    true,
+}
+
+// ignoredFields are bookkeeping that changes on every login attempt, or with
+// every other change, and is not recorded.
+var ignoredFields = map[string]bool{
+	"updated_at":           true,
+	"failed_logins":        true,
+	"last_failed_login_at": true,
+	"totp_last_step":       true,
+}
+
+// Register records the creation, update and deletion of single users, apps
+// and lockouts made with client. Bulk updates, which the services only use
+// for bookkeeping, are not recorded.
+func Register(client *ent.Client, r *Recorder) {
+	h := hook.On(r.Hook(), ent.OpCreate|ent.OpUpdateOne|ent.OpDeleteOne)
+	client.User.Use(h)
+	client.App.Use(h)
+	client.Lockout.Use(h)
+}
+
+// Hook returns an ent hook that records each mutation it runs on, with the
+// fields it changed before and after, once the mutation succeeded. Failing
+// to record fails the mutation, so that, run in a transaction, the change is
+// rolled back rather than left out of the trail.
+func (r *Recorder) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			id, _ := db.MutationID(m)
+
+			// A deleted entity and the old values of updated fields are
+			// gone once the mutation ran.
+			var (
+				old       ent.Value
+				oldFields map[string]ent.Value
+				roles     []int
+				err       error
+			)
+			switch {
+			case m.Op().Is(ent.OpDeleteOne):
+				if old, err = load(ctx, m, id); err != nil {
+					return nil, err
+				}
+			case m.Op().Is(ent.OpUpdateOne):
+				if oldFields, err = db.OldFields(ctx, m, recorded); err != nil {
+					return nil, err
+				}
+				if rolesChanged(m) {
+					if roles, err = roleIDs(ctx, m, id); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			e := Event{
+				Action:     strings.ToLower(m.Type()) + "." + operation(m.Op()),
+				TargetType: strings.ToLower(m.Type()),
+			}
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				e.AppID, e.TargetID = target(v)
+				e.Changes = createdFields(m)
+			case m.Op().Is(ent.OpUpdateOne):
+				e.AppID, e.TargetID = target(v)
+				e.Changes = updatedFields(m, oldFields)
+				if rolesChanged(m) {
+					if err := diffRoles(ctx, m, id, roles, e.Changes); err != nil {
+						slog.Error("recording audit entry failed", "action", e.Action, "id", id, "error", err)
+						return nil, err
+					}
+				}
+				if len(e.Changes) == 0 {
+					return v, nil
+				}
+			case m.Op().Is(ent.OpDeleteOne):
+				e.AppID, e.TargetID = target(old)
+				e.Changes = deletedFields(old)
+			}
+
+			// Write through the mutation's client: a write on another
+			// connection would wait for the transaction the mutation may
+			// run in. A mutation run outside of one has its entry
+			// appended in a transaction of its own.
+			client := db.MutationClient(m)
+			if db.InTx(m) {
+				err = r.record(ctx, NewAuditRepository(client), e)
+			} else {
+				err = db.WithTx(ctx, client, func(tx *ent.Client) error {
+					return r.record(ctx, NewAuditRepository(tx), e)
+				})
+			}
+			if err != nil {
+				slog.Error("recording audit entry failed", "action", e.Action, "id", id, "error", err)
+				return nil, err
+			}
+			return v, nil
+		})
+	}
+}
+
+func operation(op ent.Op) string {
+	switch {
+	case op.Is(ent.OpCreate):
+		return "create"
+	case op.Is(ent.OpDeleteOne):
+		return "delete"
+	default:
+		return "update"
+	}
+}
+
+// load reads the entity a mutation is for.
+func load(ctx context.Context, m ent.Mutation, id int) (ent.Value, error) {
+	switch m := m.(type) {
+	case *ent.UserMutation:
+		return m.Client().User.Get(ctx, id)
+	case *ent.AppMutation:
+		return m.Client().App.Get(ctx, id)
+	case *ent.LockoutMutation:
+		return m.Client().Lockout.Get(ctx, id)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T", m)
+}
+
+// target returns the app an entity belongs to and its ID.
+func target(v ent.Value) (appID, id *int) {
+	switch v := v.(type) {
+	case *ent.User:
+		return &v.AppID, &v.ID
+	case *ent.App:
+		return &v.ID, &v.ID
+	case *ent.Lockout:
+		return v.AppID, &v.ID
+	}
+	return nil, nil
+}
+
+// createdFields returns the fields of a created entity, defaults included.
+func createdFields(m ent.Mutation) map[string]Change {
+	changes := make(map[string]Change)
+	for _, f := range m.Fields() {
+		if ignoredFields[f] {
+			continue
+		}
+		after, _ := m.Field(f)
+		changes[f] = Change{After: redact(f, after)}
+	}
+	return changes
+}
+
+// recorded reports whether changes to a field are recorded.
+func recorded(field string) bool {
+	return !ignoredFields[field]
+}
+
+// updatedFields returns the fields of old an update changed, leaving out
+// those it set to the value they had.
+func updatedFields(m ent.Mutation, old map[string]ent.Value) map[string]Change {
+	changes := make(map[string]Change)
+	for _, c := range db.ChangedFields(m, old) {
+		changes[c.Field] = Change{Before: redact(c.Field, c.Before), After: redact(c.Field, c.After)}
+	}
+	return changes
+}
+
+// deletedFields returns the fields of a deleted entity, without its edges and
+// secrets. They are read from the fields of the ent struct rather than its
+// JSON, which leaves zero values out.
+func deletedFields(v ent.Value) map[string]Change {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	changes := make(map[string]Change, rv.NumField())
+	for i := range rv.NumField() {
+		sf := rv.Type().Field(i)
+		// Sensitive fields are tagged "-".
+		f, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if !sf.IsExported() || f == "" || f == "-" || f == "edges" || f == "id" {
+			continue
+		}
+		changes[f] = Change{Before: rv.Field(i).Interface()}
+	}
+	return changes
+}
+
+func redact(field string, v any) any {
+	if !sensitiveFields[field] {
+		return v
+	}
+	if b, err := json.Marshal(v); err != nil || string(b) == "null" {
+		return nil
+	}
+	return redacted
+}
+
+// rolesChanged reports whether m changes the roles of a user.
+func rolesChanged(m ent.Mutation) bool {
+	if _, ok := m.(*ent.UserMutation); !ok {
+		return false
+	}
+	edges := slices.Concat(m.AddedEdges(), m.RemovedEdges(), m.ClearedEdges())
+	return slices.Contains(edges, entuser.EdgeRoles)
+}
+
+// roleIDs returns the IDs of the roles of the user m is for.
+func roleIDs(ctx context.Context, m ent.Mutation, id int) ([]int, error) {
+	um := m.(*ent.UserMutation)
+	ids, err := um.Client().Role.Query().
+		Where(entrole.HasUsersWith(entuser.IDEQ(id))).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// diffRoles adds the roles of a user before and after m to changes when they
+// differ.
+func diffRoles(ctx context.Context, m ent.Mutation, id int, before []int, changes map[string]Change) error {
+	after, err := roleIDs(ctx, m, id)
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(before, after) {
+		changes[entuser.EdgeRoles] = Change{Before: before, After: after}
+	}
+	return nil
+}
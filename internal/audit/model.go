@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"time"
+)
+
+// Actions recorded in the audit trail. Changes to entities are recorded as
+// the entity type and the operation, such as user.update.
+const (
+	ActionLogin       = "auth.login"
+	ActionLoginFailed = "auth.login_failed"
+)
+
+// Entry represents the domain model for an audit trail entry.
+type Entry struct {
+	ID         int               `json:"id"`
+	AppID      *int              `json:"app_id,omitempty"`
+	ActorType  string            `json:"actor_type"`
+	ActorID    *int              `json:"actor_id,omitempty"`
+	Action     string            `json:"action"`
+	TargetType string            `json:"target_type"`
+	TargetID   *int              `json:"target_id,omitempty"`
+	Changes    map[string]Change `json:"changes,omitempty"`
+	Metadata   map[string]string `json:"metadata,omitempty"`
+	IP         string            `json:"ip,omitempty"`
+	RequestID  string            `json:"request_id,omitempty"`
+	PrevHash   string            `json:"prev_hash"`
+	Hash       string            `json:"hash"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+// Change is the value of a field before and after a change. Values of
+// secrets, such as password hashes, are replaced by "[redacted]".
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// ListRequest filters the entries returned by List. Entries are returned
+// newest first; Cursor is the NextCursor of the previous page.
+type ListRequest struct {
+	AppID      *int
+	ActorID    *int
+	Action     string
+	TargetType string
+	TargetID   *int
+	Since      *time.Time
+	Until      *time.Time
+	Cursor     string
+	Limit      int
+}
+
+// Page is a page of audit trail entries.
+type Page struct {
+	Entries []*Entry `json:"entries"`
+	// NextCursor fetches the next, older, page. It is empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// VerifyResult is the outcome of checking the hash chain.
+type VerifyResult struct {
+	Entries int `json:"entries"`
+	// Head is the hash of the last entry. Keeping a copy of it elsewhere
+	// detects the removal of the newest entries, which the chain cannot.
+	Head string `json:"head"`
+	// BrokenAt is the ID of the first entry that does not match its hash or
+	// the hash of the entry before it, with the reason in Reason.
+	BrokenAt *int   `json:"broken_at,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// Valid reports whether the whole chain checked out.
+func (r *VerifyResult) Valid() bool {
+	return r.BrokenAt == nil
+}
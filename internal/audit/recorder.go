@@ -0,0 +1,199 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/auditlog"
+	"keeper/internal/db"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// Event is something to record in the audit trail.
+type Event struct {
+	Action     string
+	AppID      *int
+	TargetType string
+	TargetID   *int
+	Changes    map[string]Change
+	Metadata   map[string]string
+	// ActorType and ActorID are the one who acted. They default to the
+	// caller whose claims are in the context.
+	ActorType auditlog.ActorType
+	ActorID   *int
+}
+
+// Recorder appends entries to the audit trail, each chained to the one
+// before by its hash. Each entry is appended in a transaction that first
+// locks the chain head, so concurrent writers, in this process or another,
+// never chain two entries to the same one.
+type Recorder struct {
+	repo *AuditRepository
+}
+
+// NewRecorder creates a new audit trail recorder.
+func NewRecorder(repo *AuditRepository) *Recorder {
+	return &Recorder{repo: repo}
+}
+
+// Record appends an entry for e, with the client IP and request ID in ctx.
+func (r *Recorder) Record(ctx context.Context, e Event) error {
+	return db.WithTx(ctx, r.repo.client, func(tx *ent.Client) error {
+		return r.record(ctx, NewAuditRepository(tx), e)
+	})
+}
+
+// record appends an entry for e with repo, which has to be bound to a
+// transaction: the chain stays locked until it ends.
+func (r *Recorder) record(ctx context.Context, repo *AuditRepository, e Event) error {
+	l := &ent.AuditLog{
+		AppID:      e.AppID,
+		ActorType:  e.ActorType,
+		ActorID:    e.ActorID,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		IP:         auth.GetClientIPFromContext(ctx),
+		RequestID:  middleware.GetReqID(ctx),
+	}
+	if l.ActorType == "" {
+		l.ActorType, l.ActorID = actorOf(ctx)
+	}
+	if len(e.Changes) > 0 {
+		b, err := json.Marshal(e.Changes)
+		if err != nil {
+			return fmt.Errorf("encode audit changes: %w", err)
+		}
+		l.Changes = string(b)
+	}
+	if len(e.Metadata) > 0 {
+		b, err := json.Marshal(e.Metadata)
+		if err != nil {
+			return fmt.Errorf("encode audit metadata: %w", err)
+		}
+		l.Metadata = string(b)
+	}
+
+	if err := repo.LockChain(ctx); err != nil {
+		return err
+	}
+	last, err := repo.Last(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if last != nil {
+		l.PrevHash = last.Hash
+	}
+	l.CreatedAt = time.Now().UTC()
+	l.Hash = entryHash(l)
+
+	_, err = repo.Create(ctx, l)
+	return err
+}
+
+// Login entries are written in a transaction of their own, which can meet
+// the lock of a concurrent write. They are tried loginAttempts times, the
+// first retry after loginRetryDelay and each next one after twice as long.
+const (
+	loginAttempts   = 5
+	loginRetryDelay = 20 * time.Millisecond
+)
+
+// RecordLogin records a login attempt. It implements user.Auditor. A user
+// who logs in is the actor of the entry; failed attempts are anonymous. A
+// write that still fails after its retries is returned, and fails the login.
+func (r *Recorder) RecordLogin(ctx context.Context, e user.LoginEvent) error {
+	ev := Event{
+		Action:     ActionLogin,
+		AppID:      e.AppID,
+		TargetType: "user",
+		TargetID:   e.UserID,
+		Metadata:   map[string]string{"method": e.Method},
+		ActorType:  auditlog.ActorTypeUser,
+		ActorID:    e.UserID,
+	}
+	if e.Failure != "" {
+		ev.Action = ActionLoginFailed
+		ev.ActorType, ev.ActorID = auditlog.ActorTypeAnonymous, nil
+		ev.Metadata["reason"] = e.Failure
+		ev.Metadata["email"] = e.Email
+	}
+
+	delay := loginRetryDelay
+	for attempt := 1; ; attempt++ {
+		err := r.Record(ctx, ev)
+		if err == nil || attempt == loginAttempts {
+			return err
+		}
+		slog.Warn("recording audit entry failed, retrying", "action", ev.Action, "attempt", attempt, "error", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// actorOf returns who is acting according to the claims in ctx: a user, an
+// app using a client credentials token, or an anonymous caller, such as one
+// logging in.
+func actorOf(ctx context.Context) (auditlog.ActorType, *int) {
+	claims, ok := auth.GetClaimsFromContext(ctx)
+	switch {
+	case !ok:
+		return auditlog.ActorTypeAnonymous, nil
+	case claims.IsClientToken():
+		appID := claims.AppID
+		return auditlog.ActorTypeApp, &appID
+	default:
+		userID := claims.UserID
+		return auditlog.ActorTypeUser, &userID
+	}
+}
+
+// hashedEntry is the content of an entry its hash covers, in a fixed order.
+type hashedEntry struct {
+	PrevHash   string `json:"prev_hash"`
+	AppID      *int   `json:"app_id"`
+	ActorType  string `json:"actor_type"`
+	ActorID    *int   `json:"actor_id"`
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	TargetID   *int   `json:"target_id"`
+	Changes    string `json:"changes"`
+	Metadata   string `json:"metadata"`
+	IP         string `json:"ip"`
+	RequestID  string `json:"request_id"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// entryHash returns the hex encoded SHA-256 of the content of l and the hash
+// of the entry before it.
+func entryHash(l *ent.AuditLog) string {
+	b, _ := json.Marshal(hashedEntry{
+		PrevHash:   l.PrevHash,
+		AppID:      l.AppID,
+		ActorType:  string(l.ActorType),
+		ActorID:    l.ActorID,
+		Action:     l.Action,
+		TargetType: l.TargetType,
+		TargetID:   l.TargetID,
+		Changes:    l.Changes,
+		Metadata:   l.Metadata,
+		IP:         l.IP,
+		RequestID:  l.RequestID,
+		CreatedAt:  l.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+
+	"keeper/ent"
+	"keeper/ent/auditlog"
+	"keeper/ent/predicate"
+	"keeper/ent/privacy"
+)
+
+// AuditRepository handles database operations for the audit trail.
+type AuditRepository struct {
+	client *ent.Client
+}
+
+// NewAuditRepository creates a new audit repository.
+func NewAuditRepository(client *ent.Client) *AuditRepository {
+	return &AuditRepository{client: client}
+}
+
+// chainContext lets the chain be read and extended whatever app the caller
+// is confined to, as it runs through the entries of every app.
+func chainContext(ctx context.Context) context.Context {
+	return privacy.DecisionContext(ctx, privacy.Allow)
+}
+
+// Create appends an entry to the trail.
+func (r *AuditRepository) Create(ctx context.Context, l *ent.AuditLog) (*ent.AuditLog, error) {
+	created, err := r.client.AuditLog.
+		Create().
+		SetNillableAppID(l.AppID).
+		SetActorType(l.ActorType).
+		SetNillableActorID(l.ActorID).
+		SetAction(l.Action).
+		SetTargetType(l.TargetType).
+		SetNillableTargetID(l.TargetID).
+		SetChanges(l.Changes).
+		SetMetadata(l.Metadata).
+		SetIP(l.IP).
+		SetRequestID(l.RequestID).
+		SetPrevHash(l.PrevHash).
+		SetHash(l.Hash).
+		SetCreatedAt(l.CreatedAt).
+		Save(chainContext(ctx))
+	if err != nil {
+		slog.Error("database error: failed to create audit entry", "action", l.Action, "error", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// LockChain updates the chain head, creating it for the first entry. The
+// update holds the write lock of the database until the transaction the
+// repository runs in ends, so that no other entry is appended meanwhile.
+func (r *AuditRepository) LockChain(ctx context.Context) error {
+	n, err := r.client.AuditHead.Update().AddAppended(1).Save(ctx)
+	if err == nil && n == 0 {
+		err = r.client.AuditHead.Create().SetAppended(1).Exec(ctx)
+	}
+	if err != nil {
+		slog.Error("database error: failed to lock audit chain", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Last retrieves the newest entry of the trail, of any app.
+func (r *AuditRepository) Last(ctx context.Context) (*ent.AuditLog, error) {
+	l, err := r.client.AuditLog.Query().
+		Order(ent.Desc(auditlog.FieldID)).
+		First(chainContext(ctx))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("database error: failed to get last audit entry", "error", err)
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+// ListAfter retrieves up to limit entries of any app with an ID above
+// afterID, oldest first.
+func (r *AuditRepository) ListAfter(ctx context.Context, afterID, limit int) ([]*ent.AuditLog, error) {
+	entries, err := r.client.AuditLog.Query().
+		Where(auditlog.IDGT(afterID)).
+		Order(ent.Asc(auditlog.FieldID)).
+		Limit(limit).
+		All(chainContext(ctx))
+	if err != nil {
+		slog.Error("database error: failed to list audit entries", "after_id", afterID, "error", err)
+		return nil, err
+	}
+	return entries, nil
+}
+
+// List retrieves up to limit entries matching the predicates with an ID
+// below beforeID, or any ID when beforeID is 0, newest first.
+func (r *AuditRepository) List(ctx context.Context, where []predicate.AuditLog, beforeID, limit int) ([]*ent.AuditLog, error) {
+	if beforeID > 0 {
+		where = append(where, auditlog.IDLT(beforeID))
+	}
+	entries, err := r.client.AuditLog.Query().
+		Where(where...).
+		Order(ent.Desc(auditlog.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list audit entries", "error", err)
+		return nil, err
+	}
+	return entries, nil
+}
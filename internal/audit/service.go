@@ -0,0 +1,161 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"keeper/ent"
+	"keeper/ent/auditlog"
+	"keeper/ent/predicate"
+)
+
+// Page sizes of List.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+// verifyBatch is how many entries Verify reads at a time.
+const verifyBatch = 1000
+
+var (
+	// ErrInvalidCursor is returned when a list cursor is not one List returned.
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// AuditService defines the business logic for the audit trail.
+type AuditService interface {
+	List(ctx context.Context, req ListRequest) (*Page, error)
+	Verify(ctx context.Context) (*VerifyResult, error)
+}
+
+type auditService struct {
+	repo *AuditRepository
+}
+
+// NewAuditService creates a new audit service.
+func NewAuditService(repo *AuditRepository) AuditService {
+	return &auditService{repo: repo}
+}
+
+// List returns the entries of the caller's app, or of every app for
+// platform admins, that match req, newest first.
+func (s *auditService) List(ctx context.Context, req ListRequest) (*Page, error) {
+	var beforeID int
+	if req.Cursor != "" {
+		id, err := strconv.Atoi(req.Cursor)
+		if err != nil || id <= 0 {
+			return nil, ErrInvalidCursor
+		}
+		beforeID = id
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	var where []predicate.AuditLog
+	if req.AppID != nil {
+		where = append(where, auditlog.AppIDEQ(*req.AppID))
+	}
+	if req.ActorID != nil {
+		where = append(where, auditlog.ActorIDEQ(*req.ActorID))
+	}
+	if req.Action != "" {
+		where = append(where, auditlog.ActionEQ(req.Action))
+	}
+	if req.TargetType != "" {
+		where = append(where, auditlog.TargetTypeEQ(req.TargetType))
+	}
+	if req.TargetID != nil {
+		where = append(where, auditlog.TargetIDEQ(*req.TargetID))
+	}
+	if req.Since != nil {
+		where = append(where, auditlog.CreatedAtGTE(req.Since.UTC()))
+	}
+	if req.Until != nil {
+		where = append(where, auditlog.CreatedAtLT(req.Until.UTC()))
+	}
+
+	// One entry more than asked for tells whether there is a next page.
+	entries, err := s.repo.List(ctx, where, beforeID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page{Entries: make([]*Entry, 0, min(len(entries), limit))}
+	for i, l := range entries {
+		if i == limit {
+			page.NextCursor = strconv.Itoa(entries[i-1].ID)
+			break
+		}
+		page.Entries = append(page.Entries, s.toDomain(l))
+	}
+	return page, nil
+}
+
+// Verify checks every entry against its hash and the hash of the entry
+// before it, oldest first, and stops at the first that does not match.
+func (s *auditService) Verify(ctx context.Context) (*VerifyResult, error) {
+	slog.Info("verifying audit trail")
+	res := &VerifyResult{}
+	afterID := 0
+	for {
+		entries, err := s.repo.ListAfter(ctx, afterID, verifyBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range entries {
+			switch {
+			case l.PrevHash != res.Head:
+				res.BrokenAt, res.Reason = &l.ID, "previous hash does not match the entry before"
+			case entryHash(l) != l.Hash:
+				res.BrokenAt, res.Reason = &l.ID, "hash does not match the entry"
+			}
+			if res.BrokenAt != nil {
+				slog.Warn("audit trail broken", "id", l.ID, "reason", res.Reason)
+				return res, nil
+			}
+			res.Entries++
+			res.Head = l.Hash
+			afterID = l.ID
+		}
+		if len(entries) < verifyBatch {
+			break
+		}
+	}
+	slog.Info("audit trail verified", "entries", res.Entries)
+	return res, nil
+}
+
+func (s *auditService) toDomain(l *ent.AuditLog) *Entry {
+	e := &Entry{
+		ID:         l.ID,
+		AppID:      l.AppID,
+		ActorType:  string(l.ActorType),
+		ActorID:    l.ActorID,
+		Action:     l.Action,
+		TargetType: l.TargetType,
+		TargetID:   l.TargetID,
+		IP:         l.IP,
+		RequestID:  l.RequestID,
+		PrevHash:   l.PrevHash,
+		Hash:       l.Hash,
+		CreatedAt:  l.CreatedAt,
+	}
+	if l.Changes != "" {
+		if err := json.Unmarshal([]byte(l.Changes), &e.Changes); err != nil {
+			slog.Warn("unreadable audit changes", "id", l.ID, "error", err)
+		}
+	}
+	if l.Metadata != "" {
+		if err := json.Unmarshal([]byte(l.Metadata), &e.Metadata); err != nil {
+			slog.Warn("unreadable audit metadata", "id", l.ID, "error", err)
+		}
+	}
+	return e
+}
@@ -0,0 +1,435 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"keeper/ent"
+	entapp "keeper/ent/app"
+	"keeper/ent/auditlog"
+	"keeper/ent/enttest"
+	"keeper/internal/app"
+	"keeper/internal/db"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	"github.com/go-chi/chi/v5/middleware"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_AuditTrail(t *testing.T) {
+	dsn := "file:ent_audit_trail?mode=memory&cache=shared&_fk=1"
+	client := enttest.Open(t, "sqlite3", dsn)
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewAuditRepository(client)
+	rec := NewRecorder(repo)
+	Register(client, rec)
+	svc := NewAuditService(repo)
+
+	ctx := context.Background()
+	a, err := client.App.Create().SetName("Audit App").Save(ctx)
+	assert.NoError(t, err)
+	other, err := client.App.Create().SetName("Other App").Save(ctx)
+	assert.NoError(t, err)
+	r, err := client.Role.Create().SetName("admin").SetAppID(a.ID).Save(ctx)
+	assert.NoError(t, err)
+
+	adminCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: a.ID, UserID: 99, PlatformAdmin: true})
+	adminCtx = auth.WithClientIP(adminCtx, "203.0.113.7")
+	adminCtx = context.WithValue(adminCtx, middleware.RequestIDKey, "req-1")
+
+	u, err := client.User.Create().
+		SetAppID(a.ID).
+		SetFirstname("Ada").
+		SetLastname("Lovelace").
+		SetEmail("ada@example.com").
+		SetPassword("hash-1").
+		Save(adminCtx)
+	assert.NoError(t, err)
+
+	_, err = client.User.UpdateOneID(u.ID).
+		SetFirstname("Augusta").
+		SetPassword("hash-2").
+		SetLastname("Lovelace").
+		ClearRoles().
+		AddRoleIDs(r.ID).
+		Save(adminCtx)
+	assert.NoError(t, err)
+
+	// Bookkeeping and bulk updates are not recorded.
+	_, err = client.User.UpdateOneID(u.ID).AddFailedLogins(1).Save(adminCtx)
+	assert.NoError(t, err)
+	_, err = client.User.Update().SetStatus(1).Save(adminCtx)
+	assert.NoError(t, err)
+
+	err = rec.RecordLogin(ctx, user.LoginEvent{AppID: &a.ID, UserID: &u.ID, Email: u.Email, Method: user.LoginMethodPassword})
+	assert.NoError(t, err)
+	err = rec.RecordLogin(ctx, user.LoginEvent{Email: "nobody@example.com", Method: user.LoginMethodPassword, Failure: user.LoginFailureUnknownUser})
+	assert.NoError(t, err)
+
+	_, err = client.User.Create().
+		SetAppID(other.ID).
+		SetFirstname("Grace").
+		SetLastname("Hopper").
+		SetEmail("grace@example.com").
+		SetPassword("hash-3").
+		Save(ctx)
+	assert.NoError(t, err)
+
+	err = client.User.DeleteOneID(u.ID).Exec(adminCtx)
+	assert.NoError(t, err)
+
+	t.Run("Records changes with actor, IP and request ID", func(t *testing.T) {
+		page, err := svc.List(ctx, ListRequest{TargetType: "user", TargetID: &u.ID})
+		assert.NoError(t, err)
+		if !assert.Len(t, page.Entries, 4) {
+			return
+		}
+		deleted, login, updated, created := page.Entries[0], page.Entries[1], page.Entries[2], page.Entries[3]
+
+		assert.Equal(t, "user.create", created.Action)
+		assert.Equal(t, "user", created.ActorType)
+		assert.Equal(t, 99, *created.ActorID)
+		assert.Equal(t, a.ID, *created.AppID)
+		assert.Equal(t, "203.0.113.7", created.IP)
+		assert.Equal(t, "req-1", created.RequestID)
+		assert.Equal(t, "Ada", created.Changes["firstname"].After)
+		assert.Equal(t, redacted, created.Changes["password"].After)
+		assert.NotContains(t, created.Changes, "updated_at")
+
+		assert.Equal(t, "user.update", updated.Action)
+		assert.Equal(t, Change{Before: "Ada", After: "Augusta"}, updated.Changes["firstname"])
+		assert.Equal(t, Change{Before: redacted, After: redacted}, updated.Changes["password"])
+		assert.Equal(t, Change{Before: nil, After: []any{float64(r.ID)}}, updated.Changes["roles"])
+		assert.NotContains(t, updated.Changes, "lastname")
+
+		assert.Equal(t, ActionLogin, login.Action)
+		assert.Equal(t, "user", login.ActorType)
+		assert.Equal(t, u.ID, *login.ActorID)
+		assert.Equal(t, "password", login.Metadata["method"])
+
+		assert.Equal(t, "user.delete", deleted.Action)
+		assert.Equal(t, "Augusta", deleted.Changes["firstname"].Before)
+		assert.Equal(t, Change{Before: false}, deleted.Changes["platform_admin"])
+		assert.Equal(t, Change{Before: nil}, deleted.Changes["email_verified_at"])
+		assert.NotContains(t, deleted.Changes, "password")
+	})
+
+	t.Run("Records failed logins", func(t *testing.T) {
+		page, err := svc.List(ctx, ListRequest{Action: ActionLoginFailed})
+		assert.NoError(t, err)
+		if assert.Len(t, page.Entries, 1) {
+			e := page.Entries[0]
+			assert.Equal(t, "anonymous", e.ActorType)
+			assert.Nil(t, e.AppID)
+			assert.Equal(t, "unknown_user", e.Metadata["reason"])
+			assert.Equal(t, "nobody@example.com", e.Metadata["email"])
+		}
+	})
+
+	t.Run("Scopes entries to the caller's app", func(t *testing.T) {
+		tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: other.ID, UserID: 1})
+		page, err := svc.List(tenantCtx, ListRequest{})
+		assert.NoError(t, err)
+		actions := make([]string, len(page.Entries))
+		for i, e := range page.Entries {
+			assert.Equal(t, other.ID, *e.AppID)
+			actions[i] = e.Action
+		}
+		assert.Equal(t, []string{"user.create", "app.create"}, actions)
+
+		page, err = svc.List(tenantCtx, ListRequest{AppID: &a.ID})
+		assert.NoError(t, err)
+		assert.Empty(t, page.Entries)
+	})
+
+	t.Run("Paginates with a cursor", func(t *testing.T) {
+		all, err := svc.List(ctx, ListRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, all.NextCursor)
+
+		var ids []int
+		req := ListRequest{Limit: 3}
+		for {
+			page, err := svc.List(ctx, req)
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, len(page.Entries), 3)
+			for _, e := range page.Entries {
+				ids = append(ids, e.ID)
+			}
+			if page.NextCursor == "" {
+				break
+			}
+			req.Cursor = page.NextCursor
+		}
+		assert.Len(t, ids, len(all.Entries))
+		for i, e := range all.Entries {
+			assert.Equal(t, e.ID, ids[i])
+		}
+
+		_, err = svc.List(ctx, ListRequest{Cursor: "abc"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("Entries cannot be changed", func(t *testing.T) {
+		last, err := repo.Last(ctx)
+		assert.NoError(t, err)
+		err = client.AuditLog.DeleteOneID(last.ID).Exec(adminCtx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Verify detects tampering", func(t *testing.T) {
+		res, err := svc.Verify(ctx)
+		assert.NoError(t, err)
+		assert.True(t, res.Valid())
+		assert.Equal(t, 8, res.Entries)
+
+		last, err := repo.Last(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, last.Hash, res.Head)
+
+		db, err := sql.Open("sqlite3", dsn)
+		assert.NoError(t, err)
+		defer db.Close()
+
+		entries, err := repo.ListAfter(ctx, 0, 10)
+		assert.NoError(t, err)
+		edited, removed := entries[3], entries[6]
+
+		_, err = db.Exec("UPDATE kpr_audit_log SET ip = ? WHERE id = ?", "198.51.100.1", edited.ID)
+		assert.NoError(t, err)
+		res, err = svc.Verify(ctx)
+		assert.NoError(t, err)
+		if assert.False(t, res.Valid()) {
+			assert.Equal(t, edited.ID, *res.BrokenAt)
+			assert.Equal(t, 3, res.Entries)
+		}
+
+		_, err = db.Exec("UPDATE kpr_audit_log SET ip = ? WHERE id = ?", edited.IP, edited.ID)
+		assert.NoError(t, err)
+		_, err = db.Exec("DELETE FROM kpr_audit_log WHERE id = ?", removed.ID)
+		assert.NoError(t, err)
+		res, err = svc.Verify(ctx)
+		assert.NoError(t, err)
+		if assert.False(t, res.Valid()) {
+			assert.Equal(t, entries[7].ID, *res.BrokenAt)
+			assert.Equal(t, "previous hash does not match the entry before", res.Reason)
+		}
+	})
+}
+
+func TestRecorder_Failures(t *testing.T) {
+	dsn := "file:ent_audit_failures?mode=memory&cache=shared&_fk=1"
+	client := enttest.Open(t, "sqlite3", dsn)
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewAuditRepository(client)
+	rec := NewRecorder(repo)
+	Register(client, rec)
+
+	ctx := context.Background()
+	a, err := client.App.Create().SetName("Failures App").Save(ctx)
+	assert.NoError(t, err)
+	u, err := client.User.Create().SetAppID(a.ID).SetFirstname("Ada").SetLastname("Lovelace").
+		SetEmail("ada@example.com").SetPassword("hash").Save(ctx)
+	assert.NoError(t, err)
+
+	count := func(action string) int {
+		n, err := client.AuditLog.Query().Where(auditlog.ActionEQ(action)).Count(ctx)
+		assert.NoError(t, err)
+		return n
+	}
+
+	t.Run("Every change and login that succeeds is recorded", func(t *testing.T) {
+		var (
+			wg              sync.WaitGroup
+			updates, logins atomic.Int32
+		)
+		for i := range 20 {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				err := db.WithTx(ctx, client, func(tx *ent.Client) error {
+					return tx.User.UpdateOneID(u.ID).SetFirstname(fmt.Sprintf("Ada %d", i)).Exec(ctx)
+				})
+				if err == nil {
+					updates.Add(1)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				err := rec.RecordLogin(ctx, user.LoginEvent{AppID: &a.ID, UserID: &u.ID, Email: u.Email, Method: user.LoginMethodPassword})
+				if err == nil {
+					logins.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Positive(t, updates.Load())
+		assert.Equal(t, int(updates.Load()), count("user.update"))
+		assert.Equal(t, int(logins.Load()), count(ActionLogin))
+
+		res, err := NewAuditService(repo).Verify(ctx)
+		assert.NoError(t, err)
+		assert.True(t, res.Valid())
+	})
+
+	t.Run("A change that cannot be recorded is rolled back", func(t *testing.T) {
+		conn, err := sql.Open("sqlite3", dsn)
+		assert.NoError(t, err)
+		defer conn.Close()
+		empty, err := client.App.Create().SetName("Empty App").Save(ctx)
+		assert.NoError(t, err)
+		_, err = conn.Exec("CREATE TRIGGER fail_audit BEFORE INSERT ON kpr_audit_log BEGIN SELECT RAISE(ABORT, 'audit unavailable'); END")
+		assert.NoError(t, err)
+		defer func() {
+			_, err := conn.Exec("DROP TRIGGER fail_audit")
+			assert.NoError(t, err)
+		}()
+
+		before, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		err = db.WithTx(ctx, client, func(tx *ent.Client) error {
+			return tx.User.UpdateOneID(u.ID).SetFirstname("Unrecorded").Exec(ctx)
+		})
+		assert.ErrorContains(t, err, "audit unavailable")
+		after, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, before.Firstname, after.Firstname)
+
+		err = rec.RecordLogin(ctx, user.LoginEvent{AppID: &a.ID, UserID: &u.ID, Email: u.Email, Method: user.LoginMethodPassword})
+		assert.ErrorContains(t, err, "audit unavailable")
+
+		// The repositories make every audited change in a transaction.
+		users, apps := user.NewUserRepository(client), app.NewAppRepository(client)
+		_, err = apps.Create(ctx, &ent.App{Name: "Unrecorded App", LockoutDuration: 900})
+		assert.ErrorContains(t, err, "audit unavailable")
+		n, err := client.App.Query().Where(entapp.NameEQ("Unrecorded App")).Count(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+
+		assert.ErrorContains(t, apps.Delete(ctx, empty.ID), "audit unavailable")
+		_, err = client.App.Get(ctx, empty.ID)
+		assert.NoError(t, err)
+
+		assert.ErrorContains(t, users.SetTOTPSecret(ctx, u.ID, "secret"), "audit unavailable")
+		assert.ErrorContains(t, users.RevokeSessions(ctx, u.ID, time.Now()), "audit unavailable")
+		after, err = client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, after.TotpSecret)
+		assert.Nil(t, after.TokensValidAfter)
+	})
+}
+
+func TestRecorder_Chain(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_audit_chain?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	rec := NewRecorder(NewAuditRepository(client))
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		err := rec.Record(ctx, Event{Action: "app.update", TargetType: "app", Metadata: map[string]string{"n": string(rune('a' + i))}})
+		assert.NoError(t, err)
+	}
+
+	entries, err := client.AuditLog.Query().Order(ent.Asc("id")).All(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 3) {
+		assert.Empty(t, entries[0].PrevHash)
+		assert.Equal(t, entries[0].Hash, entries[1].PrevHash)
+		assert.Equal(t, entries[1].Hash, entries[2].PrevHash)
+		for _, l := range entries {
+			assert.Equal(t, entryHash(l), l.Hash)
+			assert.Equal(t, "anonymous", string(l.ActorType))
+		}
+	}
+}
+
+func TestRecorder_ConcurrentWriters(t *testing.T) {
+	// A file database, unlike a shared in-memory one, lets a connection read
+	// the chain while another holds an uncommitted entry.
+	dsn := "file:" + filepath.Join(t.TempDir(), "audit.db") + "?_fk=1&_busy_timeout=10000&_journal_mode=WAL&_txlock=immediate"
+	client := enttest.Open(t, "sqlite3", dsn)
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewAuditRepository(client)
+	rec := NewRecorder(repo)
+	Register(client, rec)
+
+	ctx := context.Background()
+	a, err := client.App.Create().SetName("Concurrent App").Save(ctx)
+	assert.NoError(t, err)
+
+	var (
+		wg      sync.WaitGroup
+		written atomic.Int32
+	)
+	for i := range 10 {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			err := db.WithTx(ctx, client, func(tx *ent.Client) error {
+				if err := tx.App.UpdateOneID(a.ID).SetName(fmt.Sprintf("Concurrent App %d", i)).Exec(ctx); err != nil {
+					return err
+				}
+				// Others write while the entry is not committed yet.
+				time.Sleep(5 * time.Millisecond)
+				return nil
+			})
+			if assert.NoError(t, err) {
+				written.Add(1)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			err := rec.RecordLogin(ctx, user.LoginEvent{AppID: &a.ID, Email: "ada@example.com", Method: user.LoginMethodPassword, Failure: "invalid_credentials"})
+			if assert.NoError(t, err) {
+				written.Add(1)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			// A transaction that is rolled back leaves no entry to chain to.
+			err := db.WithTx(ctx, client, func(tx *ent.Client) error {
+				if err := tx.App.UpdateOneID(a.ID).SetRequireMfa(true).Exec(ctx); err != nil {
+					return err
+				}
+				return errors.New("rolled back")
+			})
+			assert.EqualError(t, err, "rolled back")
+		}()
+	}
+	wg.Wait()
+
+	n, err := client.AuditLog.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int(written.Load())+1, n)
+
+	res, err := NewAuditService(repo).Verify(ctx)
+	assert.NoError(t, err)
+	assert.True(t, res.Valid(), res.Reason)
+	assert.Equal(t, n, res.Entries)
+}
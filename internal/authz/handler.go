@@ -0,0 +1,142 @@
+package authz
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// AuthzHandler handles HTTP requests for authorization decisions.
+type AuthzHandler struct {
+	svc      AuthzService
+	validate *validator.Validate
+}
+
+// NewAuthzHandler creates a new authorization handler.
+func NewAuthzHandler(svc AuthzService) *AuthzHandler {
+	return &AuthzHandler{
+		svc:      svc,
+		validate: validator.New(),
+	}
+}
+
+// PermissionCheck is the permission a token needs to ask for decisions.
+const PermissionCheck = "authz:check"
+
+// Routes returns the chi router for authorization endpoints.
+func (h *AuthzHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
+	r := chi.NewRouter()
+
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Middleware(jwtManager))
+		r.Use(auth.RequirePermission(PermissionCheck))
+
+		r.Post("/check", h.Check)
+		r.Post("/check/batch", h.CheckBatch)
+	})
+
+	return r
+}
+
+// Check godoc
+// @Summary Check a permission
+// @Description Decide whether a user may perform an action, optionally on a resource. The user and app default to the caller's.
+// @Tags authz
+// @Accept json
+// @Produce json
+// @Param check body CheckRequest true "Check details"
+// @Success 200 {object} render.Response{data=Decision}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /authz/check [post]
+func (h *AuthzHandler) Check(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	var req CheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode check request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid check request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	d, err := h.svc.Check(r.Context(), claims, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, d)
+}
+
+// CheckBatch godoc
+// @Summary Check several permissions
+// @Description Decide up to 100 checks at once. Decisions are returned in request order.
+// @Tags authz
+// @Accept json
+// @Produce json
+// @Param checks body BatchCheckRequest true "Checks"
+// @Success 200 {object} render.Response{data=BatchCheckResponse}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /authz/check/batch [post]
+func (h *AuthzHandler) CheckBatch(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	var req BatchCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode batch check request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid batch check request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.svc.CheckBatch(r.Context(), claims, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, res)
+}
+
+// writeError renders an authorization service error with its HTTP status.
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrMissingSubject):
+		render.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		render.Error(w, http.StatusInternalServerError, err.Error())
+	}
+}
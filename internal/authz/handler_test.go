@@ -0,0 +1,127 @@
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAuthzService struct {
+	mock.Mock
+}
+
+func (m *mockAuthzService) Check(ctx context.Context, caller *auth.UserClaims, req CheckRequest) (*Decision, error) {
+	args := m.Called(ctx, caller, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Decision), args.Error(1)
+}
+
+func (m *mockAuthzService) CheckBatch(ctx context.Context, caller *auth.UserClaims, req BatchCheckRequest) (*BatchCheckResponse, error) {
+	args := m.Called(ctx, caller, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BatchCheckResponse), args.Error(1)
+}
+
+func TestHandler_Check(t *testing.T) {
+	svc := new(mockAuthzService)
+	handler := NewAuthzHandler(svc)
+
+	claims := &auth.UserClaims{AppID: 1, UserID: 1}
+	reqBody := CheckRequest{Action: "documents:read"}
+	svc.On("Check", mock.Anything, claims, reqBody).Return(&Decision{Allowed: true, Reason: `granted by role "viewer"`}, nil)
+
+	t.Run("Allowed", func(t *testing.T) {
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest("POST", "/authz/check", bytes.NewBuffer(body))
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserClaimsKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.Check(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp render.Response
+		err := json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+
+		dataMap := resp.Data.(map[string]interface{})
+		assert.Equal(t, true, dataMap["allowed"])
+	})
+
+	t.Run("MissingAction", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/authz/check", bytes.NewBufferString("{}"))
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserClaimsKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.Check(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("MissingSubject", func(t *testing.T) {
+		clientClaims := &auth.UserClaims{AppID: 1, ClientID: "docs-api"}
+		svc.On("Check", mock.Anything, clientClaims, reqBody).Return(nil, ErrMissingSubject)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest("POST", "/authz/check", bytes.NewBuffer(body))
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserClaimsKey, clientClaims))
+		rr := httptest.NewRecorder()
+
+		handler.Check(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandler_CheckBatch(t *testing.T) {
+	svc := new(mockAuthzService)
+	handler := NewAuthzHandler(svc)
+
+	claims := &auth.UserClaims{AppID: 1, UserID: 1}
+	reqBody := BatchCheckRequest{Checks: []CheckRequest{{Action: "documents:read"}, {Action: "documents:write"}}}
+	svc.On("CheckBatch", mock.Anything, claims, reqBody).Return(&BatchCheckResponse{Decisions: []Decision{
+		{Allowed: true, Reason: `granted by role "viewer"`},
+		{Allowed: false, Reason: ReasonNotGranted},
+	}}, nil)
+
+	t.Run("Decisions", func(t *testing.T) {
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest("POST", "/authz/check/batch", bytes.NewBuffer(body))
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserClaimsKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.CheckBatch(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+
+		var resp render.Response
+		err := json.Unmarshal(rr.Body.Bytes(), &resp)
+		assert.NoError(t, err)
+
+		dataMap := resp.Data.(map[string]interface{})
+		assert.Len(t, dataMap["decisions"], 2)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/authz/check/batch", bytes.NewBufferString(`{"checks":[]}`))
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserClaimsKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.CheckBatch(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,36 @@
+package authz
+
+// Resource describes what an action is performed on. AppID and OwnerID are
+// optional attributes: when set, the resource must belong to the checked app
+// and permissions granted only on own resources require OwnerID to be the user.
+type Resource struct {
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	AppID   int    `json:"app_id"`
+	OwnerID int    `json:"owner_id"`
+}
+
+// CheckRequest asks whether a user may perform an action in an app. UserID
+// and AppID default to the user and app of the caller's token.
+type CheckRequest struct {
+	UserID   int       `json:"user_id" validate:"omitempty,gt=0"`
+	AppID    int       `json:"app_id" validate:"omitempty,gt=0"`
+	Action   string    `json:"action" validate:"required"`
+	Resource *Resource `json:"resource"`
+}
+
+// Decision is the outcome of a check with the reason it was reached.
+type Decision struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+}
+
+// BatchCheckRequest asks several questions at once.
+type BatchCheckRequest struct {
+	Checks []CheckRequest `json:"checks" validate:"required,min=1,max=100,dive"`
+}
+
+// BatchCheckResponse holds one decision per check, in request order.
+type BatchCheckResponse struct {
+	Decisions []Decision `json:"decisions"`
+}
@@ -0,0 +1,37 @@
+package authz
+
+import (
+	"context"
+	"log/slog"
+
+	"keeper/ent"
+	"keeper/ent/user"
+)
+
+// AuthzRepository handles database reads for authorization decisions.
+type AuthzRepository struct {
+	client *ent.Client
+}
+
+// NewAuthzRepository creates a new authorization repository.
+func NewAuthzRepository(client *ent.Client) *AuthzRepository {
+	return &AuthzRepository{client: client}
+}
+
+// GetUserWithPermissions retrieves a user with their roles and the permissions
+// those roles grant.
+func (r *AuthzRepository) GetUserWithPermissions(ctx context.Context, id int) (*ent.User, error) {
+	u, err := r.client.User.Query().
+		Where(user.IDEQ(id)).
+		WithRoles(func(q *ent.RoleQuery) {
+			q.WithPermissions()
+		}).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("database error: failed to get user permissions", "id", id, "error", err)
+		}
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,141 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"keeper/ent"
+	"keeper/pkg/auth"
+)
+
+// AuthzService defines the business logic for authorization decisions.
+type AuthzService interface {
+	Check(ctx context.Context, caller *auth.UserClaims, req CheckRequest) (*Decision, error)
+	CheckBatch(ctx context.Context, caller *auth.UserClaims, req BatchCheckRequest) (*BatchCheckResponse, error)
+}
+
+// ErrMissingSubject is returned when an app token checks an action without naming the user.
+var ErrMissingSubject = errors.New("user_id is required for app tokens")
+
+// OwnSuffix marks a permission that only grants its action on resources owned
+// by the user, e.g. "documents:edit:own".
+const OwnSuffix = ":own"
+
+// Reasons given with decisions.
+const (
+	ReasonNotMember     = "user is not a member of the app"
+	ReasonInactive      = "user is not active"
+	ReasonResourceApp   = "resource belongs to another app"
+	ReasonPlatformAdmin = "user is a platform admin"
+	ReasonNotOwner      = "permission is only granted on own resources"
+	ReasonNotGranted    = "permission not granted"
+)
+
+type authzService struct {
+	repo *AuthzRepository
+}
+
+// NewAuthzService creates a new authorization service.
+func NewAuthzService(repo *AuthzRepository) AuthzService {
+	return &authzService{repo: repo}
+}
+
+func (s *authzService) Check(ctx context.Context, caller *auth.UserClaims, req CheckRequest) (*Decision, error) {
+	d, err := s.check(ctx, caller, req, map[int]*ent.User{})
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (s *authzService) CheckBatch(ctx context.Context, caller *auth.UserClaims, req BatchCheckRequest) (*BatchCheckResponse, error) {
+	// Batches usually ask about the same few users, so load each one once.
+	users := map[int]*ent.User{}
+	res := &BatchCheckResponse{Decisions: make([]Decision, len(req.Checks))}
+	for i, c := range req.Checks {
+		d, err := s.check(ctx, caller, c, users)
+		if err != nil {
+			return nil, fmt.Errorf("check %d: %w", i, err)
+		}
+		res.Decisions[i] = d
+	}
+	return res, nil
+}
+
+// check resolves the subject of req, defaulting to the caller, and evaluates it.
+// users caches the subjects already loaded; a nil entry is an unknown user.
+func (s *authzService) check(ctx context.Context, caller *auth.UserClaims, req CheckRequest, users map[int]*ent.User) (Decision, error) {
+	userID, appID := req.UserID, req.AppID
+	if userID == 0 {
+		if caller.IsClientToken() {
+			return Decision{}, ErrMissingSubject
+		}
+		userID = caller.UserID
+	}
+	if appID == 0 {
+		appID = caller.AppID
+	}
+
+	u, ok := users[userID]
+	if !ok {
+		var err error
+		// Callers can only see users of their own app, so checks about
+		// other apps' users are denied as non-members.
+		u, err = s.repo.GetUserWithPermissions(ctx, userID)
+		if err != nil && !ent.IsNotFound(err) {
+			return Decision{}, err
+		}
+		users[userID] = u
+	}
+
+	d := evaluate(u, appID, req.Action, req.Resource)
+	slog.Info("authorization decision", "user_id", userID, "app_id", appID, "action", req.Action, "allowed", d.Allowed, "reason", d.Reason)
+	return d, nil
+}
+
+// evaluate decides whether u may perform action on res in the app.
+func evaluate(u *ent.User, appID int, action string, res *Resource) Decision {
+	switch {
+	case u == nil:
+		return deny(ReasonNotMember)
+	case u.Status != 1:
+		return deny(ReasonInactive)
+	case u.PlatformAdmin:
+		return allow(ReasonPlatformAdmin)
+	case u.AppID != appID:
+		return deny(ReasonNotMember)
+	case res != nil && res.AppID != 0 && res.AppID != appID:
+		return deny(ReasonResourceApp)
+	}
+
+	var ownRole string
+	for _, r := range u.Edges.Roles {
+		for _, p := range r.Edges.Permissions {
+			switch {
+			case p.Name == action:
+				return allow(fmt.Sprintf("granted by role %q", r.Name))
+			case ownRole == "" && strings.TrimSuffix(p.Name, OwnSuffix) == action && p.Name != action:
+				ownRole = r.Name
+			}
+		}
+	}
+
+	if ownRole != "" {
+		if res != nil && res.OwnerID != 0 && res.OwnerID == u.ID {
+			return allow(fmt.Sprintf("granted by role %q on own resource", ownRole))
+		}
+		return deny(ReasonNotOwner)
+	}
+	return deny(ReasonNotGranted)
+}
+
+func allow(reason string) Decision {
+	return Decision{Allowed: true, Reason: reason}
+}
+
+func deny(reason string) Decision {
+	return Decision{Allowed: false, Reason: reason}
+}
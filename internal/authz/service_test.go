@@ -0,0 +1,144 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"keeper/ent"
+	"keeper/ent/enttest"
+	"keeper/pkg/auth"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Check(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_authz_check?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewAuthzService(NewAuthzRepository(client))
+	ctx := context.Background()
+
+	appA, err := client.App.Create().SetName("Docs").Save(ctx)
+	assert.NoError(t, err)
+	appB, err := client.App.Create().SetName("Billing").Save(ctx)
+	assert.NoError(t, err)
+
+	read, err := client.Permission.Create().SetName("documents:read").Save(ctx)
+	assert.NoError(t, err)
+	editOwn, err := client.Permission.Create().SetName("documents:edit:own").Save(ctx)
+	assert.NoError(t, err)
+	editor, err := client.Role.Create().SetAppID(appA.ID).SetName("editor").AddPermissions(read, editOwn).Save(ctx)
+	assert.NoError(t, err)
+
+	newUser := func(email string, a *ent.App) *ent.UserCreate {
+		return client.User.Create().
+			SetAppID(a.ID).
+			SetFirstname("Authz").
+			SetLastname("User").
+			SetEmail(email).
+			SetPassword("hash")
+	}
+	member, err := newUser("member@example.com", appA).AddRoles(editor).Save(ctx)
+	assert.NoError(t, err)
+	inactive, err := newUser("inactive@example.com", appA).AddRoles(editor).SetStatus(0).Save(ctx)
+	assert.NoError(t, err)
+	admin, err := newUser("admin@example.com", appB).SetPlatformAdmin(true).Save(ctx)
+	assert.NoError(t, err)
+	outsider, err := newUser("outsider@example.com", appB).Save(ctx)
+	assert.NoError(t, err)
+
+	caller := &auth.UserClaims{AppID: appA.ID, UserID: member.ID}
+
+	tests := []struct {
+		name    string
+		req     CheckRequest
+		allowed bool
+		reason  string
+	}{
+		{"Granted", CheckRequest{Action: "documents:read"}, true, `granted by role "editor"`},
+		{"NotGranted", CheckRequest{Action: "documents:delete"}, false, ReasonNotGranted},
+		{"OwnResource", CheckRequest{Action: "documents:edit", Resource: &Resource{Type: "document", ID: "1", OwnerID: member.ID}}, true, `granted by role "editor" on own resource`},
+		{"OtherOwner", CheckRequest{Action: "documents:edit", Resource: &Resource{Type: "document", ID: "2", OwnerID: outsider.ID}}, false, ReasonNotOwner},
+		{"NoResource", CheckRequest{Action: "documents:edit"}, false, ReasonNotOwner},
+		{"ResourceOfOtherApp", CheckRequest{Action: "documents:read", Resource: &Resource{AppID: appB.ID}}, false, ReasonResourceApp},
+		{"OtherApp", CheckRequest{AppID: appB.ID, Action: "documents:read"}, false, ReasonNotMember},
+		{"Inactive", CheckRequest{UserID: inactive.ID, Action: "documents:read"}, false, ReasonInactive},
+		{"PlatformAdmin", CheckRequest{UserID: admin.ID, Action: "documents:delete"}, true, ReasonPlatformAdmin},
+		{"UnknownUser", CheckRequest{UserID: 9999, Action: "documents:read"}, false, ReasonNotMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := svc.Check(ctx, caller, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.allowed, d.Allowed)
+			assert.Equal(t, tt.reason, d.Reason)
+		})
+	}
+
+	t.Run("TenantIsolation", func(t *testing.T) {
+		// Callers cannot see users of other apps, so they are never members.
+		tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, caller)
+		d, err := svc.Check(tenantCtx, caller, CheckRequest{UserID: outsider.ID, AppID: appB.ID, Action: "documents:read"})
+		assert.NoError(t, err)
+		assert.False(t, d.Allowed)
+		assert.Equal(t, ReasonNotMember, d.Reason)
+	})
+
+	t.Run("ClientTokenWithoutUser", func(t *testing.T) {
+		client := &auth.UserClaims{AppID: appA.ID, ClientID: "docs-api"}
+		_, err := svc.Check(ctx, client, CheckRequest{Action: "documents:read"})
+		assert.ErrorIs(t, err, ErrMissingSubject)
+
+		d, err := svc.Check(ctx, client, CheckRequest{UserID: member.ID, Action: "documents:read"})
+		assert.NoError(t, err)
+		assert.True(t, d.Allowed)
+	})
+}
+
+func TestService_CheckBatch(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_authz_batch?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewAuthzService(NewAuthzRepository(client))
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("Docs").Save(ctx)
+	assert.NoError(t, err)
+	read, err := client.Permission.Create().SetName("documents:read").Save(ctx)
+	assert.NoError(t, err)
+	viewer, err := client.Role.Create().SetAppID(a.ID).SetName("viewer").AddPermissions(read).Save(ctx)
+	assert.NoError(t, err)
+	u, err := client.User.Create().
+		SetAppID(a.ID).
+		SetFirstname("Batch").
+		SetLastname("User").
+		SetEmail("batch@example.com").
+		SetPassword("hash").
+		AddRoles(viewer).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	caller := &auth.UserClaims{AppID: a.ID, UserID: u.ID}
+	res, err := svc.CheckBatch(ctx, caller, BatchCheckRequest{Checks: []CheckRequest{
+		{Action: "documents:read"},
+		{Action: "documents:write"},
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, res.Decisions, 2)
+	assert.True(t, res.Decisions[0].Allowed)
+	assert.False(t, res.Decisions[1].Allowed)
+
+	_, err = svc.CheckBatch(ctx, &auth.UserClaims{AppID: a.ID, ClientID: "docs-api"}, BatchCheckRequest{Checks: []CheckRequest{
+		{UserID: u.ID, Action: "documents:read"},
+		{Action: "documents:read"},
+	}})
+	assert.ErrorIs(t, err, ErrMissingSubject)
+}
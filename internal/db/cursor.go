@@ -0,0 +1,102 @@
+package db
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ErrInvalidCursor is returned when a cursor was not made by Cursor.Encode.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor marks the last row of a page of a list sorted by the Sort column,
+// then by ID, in the same direction. The next page starts after it.
+type Cursor struct {
+	Sort string `json:"s"`
+	Desc bool   `json:"d,omitempty"`
+	// Value is the Sort column of the row, or Time when it is a time, so
+	// that it is compared as one.
+	Value any        `json:"v,omitempty"`
+	Time  *time.Time `json:"t,omitempty"`
+	ID    int        `json:"i"`
+}
+
+// NewCursor returns the cursor of the row with the given ID, whose sort
+// column holds value.
+func NewCursor(sort string, desc bool, value any, id int) Cursor {
+	c := Cursor{Sort: sort, Desc: desc, ID: id}
+	if t, ok := value.(time.Time); ok {
+		c.Time = &t
+	} else if sort != "id" {
+		c.Value = value
+	}
+	return c
+}
+
+// Encode returns the opaque form of the cursor handed to clients.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor reads a cursor returned by Encode.
+func DecodeCursor(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var c Cursor
+	if err := dec.Decode(&c); err != nil || c.Sort == "" || c.ID <= 0 {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if n, ok := c.Value.(json.Number); ok {
+		if c.Value, err = n.Int64(); err != nil {
+			return Cursor{}, ErrInvalidCursor
+		}
+	}
+	return c, nil
+}
+
+// After returns the predicate of the rows that come after the cursor.
+func (c Cursor) After() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		cmp := sql.GT
+		if c.Desc {
+			cmp = sql.LT
+		}
+		id := s.C("id")
+		if c.Sort == "id" {
+			s.Where(cmp(id, c.ID))
+			return
+		}
+		var value any = c.Value
+		if c.Time != nil {
+			value = *c.Time
+		}
+		col := s.C(c.Sort)
+		s.Where(sql.Or(
+			cmp(col, value),
+			sql.And(sql.EQ(col, value), cmp(id, c.ID)),
+		))
+	}
+}
+
+// Order returns the order of a list sorted by the sort column, then by ID.
+func Order(sort string, desc bool) func(*sql.Selector) {
+	dir := sql.Asc
+	if desc {
+		dir = sql.Desc
+	}
+	return func(s *sql.Selector) {
+		if sort != "id" {
+			s.OrderBy(dir(s.C(sort)))
+		}
+		s.OrderBy(dir(s.C("id")))
+	}
+}
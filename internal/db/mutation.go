@@ -0,0 +1,91 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"keeper/ent"
+)
+
+// MutationClient returns the client a mutation runs with. Hooks that write
+// through it take part in the transaction the mutation may run in.
+func MutationClient(m ent.Mutation) *ent.Client {
+	return m.(interface{ Client() *ent.Client }).Client()
+}
+
+// InTx reports whether a mutation runs in a transaction.
+func InTx(m ent.Mutation) bool {
+	_, err := m.(interface{ Tx() (*ent.Tx, error) }).Tx()
+	return err == nil
+}
+
+// MutationID returns the ID of the entity an update or deletion of a single
+// entity is for.
+func MutationID(m ent.Mutation) (int, bool) {
+	im, ok := m.(interface{ ID() (int, bool) })
+	if !ok {
+		return 0, false
+	}
+	return im.ID()
+}
+
+// FieldChange is the value of a field before and after an update.
+type FieldChange struct {
+	Field  string
+	Before ent.Value
+	After  ent.Value
+}
+
+// OldFields reads the values the fields an update sets or clears have, for
+// the fields keep reports true for. A hook has to call it before the update
+// runs, and pass its result to ChangedFields once it succeeded.
+func OldFields(ctx context.Context, m ent.Mutation, keep func(field string) bool) (map[string]ent.Value, error) {
+	old := make(map[string]ent.Value)
+	for _, f := range slices.Concat(m.Fields(), m.ClearedFields()) {
+		if _, ok := old[f]; ok || !keep(f) {
+			continue
+		}
+		v, err := m.OldField(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		old[f] = v
+	}
+	return old, nil
+}
+
+// ChangedFields returns the fields of old an update gave a new value, sorted
+// by name. Fields it set to the value they had are left out; cleared fields
+// are nil after.
+func ChangedFields(m ent.Mutation, old map[string]ent.Value) []FieldChange {
+	var changes []FieldChange
+	for f, before := range old {
+		var after ent.Value
+		if !m.FieldCleared(f) {
+			after, _ = m.Field(f)
+		}
+		if same, err := equal(before, after); err == nil && same {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: f, Before: before, After: after})
+	}
+	slices.SortFunc(changes, func(a, b FieldChange) int { return strings.Compare(a.Field, b.Field) })
+	return changes
+}
+
+// equal reports whether two field values are the same, comparing their JSON
+// encodings so that a value and a pointer to it are equal.
+func equal(a, b any) (bool, error) {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	jb, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(ja, jb), nil
+}
@@ -0,0 +1,21 @@
+package db
+
+import "time"
+
+// Work queued in the database, such as outgoing email and webhook
+// deliveries, is retried after retryBase, then after twice as long each
+// time, up to retryMax.
+const (
+	retryBase = 30 * time.Second
+	retryMax  = time.Hour
+)
+
+// RetryDelay is the wait before queued work is tried again after the given
+// number of failed attempts.
+func RetryDelay(attempts int) time.Duration {
+	d := retryBase
+	for i := 1; i < attempts && d < retryMax; i++ {
+		d *= 2
+	}
+	return min(d, retryMax)
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"keeper/ent"
+)
+
+// ErrSearchUnavailable is returned when the SQLite driver was built without
+// FTS5, which needs the sqlite_fts5 build tag, so users cannot be searched.
+var ErrSearchUnavailable = errors.New("full-text search unavailable: build with -tags sqlite_fts5")
+
+// UserSearchTable is the FTS5 index of the names and emails of kpr_user.
+// It is an external content table: it stores the index only and reads the
+// text back from kpr_user, whose triggers keep it in sync.
+const UserSearchTable = "kpr_user_fts"
+
+// Highlight markers wrap the matched words in the text the search index
+// returns. Control characters cannot be typed in names, so the text around
+// them can be escaped before they are replaced by tags.
+const (
+	HighlightStart = "\x02"
+	HighlightEnd   = "\x03"
+)
+
+// userSearchSchema creates the index and the triggers that update it when
+// users change. Columns other than app_id are searched; app_id scopes
+// searches to an app. The prefix indexes speed up prefix queries of two and
+// three characters.
+var userSearchSchema = []string{
+	`CREATE VIRTUAL TABLE IF NOT EXISTS kpr_user_fts USING fts5(
+		firstname, lastname, email, app_id UNINDEXED,
+		content='kpr_user', content_rowid='id',
+		tokenize='unicode61 remove_diacritics 2', prefix='2 3'
+	)`,
+	`CREATE TRIGGER IF NOT EXISTS kpr_user_fts_insert AFTER INSERT ON kpr_user BEGIN
+		INSERT INTO kpr_user_fts(rowid, firstname, lastname, email, app_id)
+		VALUES (new.id, new.firstname, new.lastname, new.email, new.app_id);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS kpr_user_fts_delete AFTER DELETE ON kpr_user BEGIN
+		INSERT INTO kpr_user_fts(kpr_user_fts, rowid, firstname, lastname, email, app_id)
+		VALUES ('delete', old.id, old.firstname, old.lastname, old.email, old.app_id);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS kpr_user_fts_update AFTER UPDATE OF firstname, lastname, email, app_id ON kpr_user BEGIN
+		INSERT INTO kpr_user_fts(kpr_user_fts, rowid, firstname, lastname, email, app_id)
+		VALUES ('delete', old.id, old.firstname, old.lastname, old.email, old.app_id);
+		INSERT INTO kpr_user_fts(rowid, firstname, lastname, email, app_id)
+		VALUES (new.id, new.firstname, new.lastname, new.email, new.app_id);
+	END`,
+}
+
+// EnsureUserSearch creates the user search index and its triggers when they
+// are missing, then rebuilds the index from kpr_user. It runs after every
+// migration: SQLite migrations that change kpr_user copy it to a new table,
+// which drops its triggers, so the index may have missed changes.
+func EnsureUserSearch(ctx context.Context, client *ent.Client) error {
+	for _, stmt := range userSearchSchema {
+		if _, err := client.ExecContext(ctx, stmt); err != nil {
+			if IsSearchUnavailable(err) {
+				return ErrSearchUnavailable
+			}
+			return err
+		}
+	}
+	_, err := client.ExecContext(ctx, `INSERT INTO kpr_user_fts(kpr_user_fts) VALUES ('rebuild')`)
+	return err
+}
+
+// IsSearchUnavailable reports whether err comes from a driver without FTS5,
+// or a database whose search index was never created.
+func IsSearchUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return errors.Is(err, ErrSearchUnavailable) ||
+		strings.Contains(msg, "no such module: fts5") ||
+		strings.Contains(msg, "no such table: "+UserSearchTable)
+}
+
+// MatchQuery turns the words of q into an FTS5 query that matches the rows
+// containing a word starting with each of them. Words are quoted, so the
+// query syntax of FTS5 cannot be used, and words the tokenizer splits, such
+// as email addresses, match as phrases. It returns "" when q has no words.
+func MatchQuery(q string) string {
+	var terms []string
+	for _, word := range strings.Fields(q) {
+		// Words of punctuation only have no tokens and would match nothing.
+		if !strings.ContainsFunc(word, isTokenChar) {
+			continue
+		}
+		terms = append(terms, `"`+strings.ReplaceAll(word, `"`, `""`)+`"*`)
+	}
+	return strings.Join(terms, " ")
+}
+
+// isTokenChar reports whether r is part of the tokens of the unicode61
+// tokenizer rather than a separator.
+func isTokenChar(r rune) bool {
+	return r >= 0x80 || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
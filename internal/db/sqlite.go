@@ -2,11 +2,13 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
 	"keeper/ent"
 	"keeper/ent/migrate"
+	_ "keeper/ent/runtime" // Register schema defaults, hooks and privacy policies
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -32,6 +34,20 @@ func NewSQLiteClient(path string) (*ent.Client, error) {
 		return nil, fmt.Errorf("failed creating schema resources: %v", err)
 	}
 
+	// The search index lives outside the ent schema, so it is set up, and
+	// rebuilt, after the schema has been migrated.
+	slog.Info("rebuilding user search index")
+	if err := EnsureUserSearch(context.Background(), client); err != nil {
+		if !errors.Is(err, ErrSearchUnavailable) {
+			slog.Error("failed to rebuild user search index", "error", err)
+			if cerr := client.Close(); cerr != nil {
+				slog.Error("failed to close client after search index failure", "error", cerr)
+			}
+			return nil, fmt.Errorf("failed rebuilding user search index: %v", err)
+		}
+		slog.Warn("user search disabled", "error", err)
+	}
+
 	slog.Info("database initialization completed successfully")
 	return client, nil
 }
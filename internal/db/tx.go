@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"keeper/ent"
+)
+
+// WithTx runs fn with a client bound to a new transaction, which is committed
+// when fn succeeds and rolled back otherwise. Hooks write through the client
+// of the mutation they run on, so what they add, such as queued webhook
+// deliveries and audit entries, is committed with the change or not at all.
+func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	if err := fn(tx.Client()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback: %v", err, rerr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package key
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// KeyHandler handles HTTP requests for signing key rotation.
+type KeyHandler struct {
+	svc      KeyService
+	validate *validator.Validate
+}
+
+// NewKeyHandler creates a new signing key handler.
+func NewKeyHandler(svc KeyService) *KeyHandler {
+	return &KeyHandler{
+		svc:      svc,
+		validate: validator.New(),
+	}
+}
+
+// Routes returns the chi router for signing key endpoints.
+func (h *KeyHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
+	r := chi.NewRouter()
+
+	// Signing keys are shared by every app, so only platform admins may manage them
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Middleware(jwtManager))
+		r.Use(auth.RequirePlatformAdmin)
+
+		r.Get("/", h.ListKeys)
+		r.Post("/", h.CreateKey)
+		r.Post("/{kid}/retire", h.RetireKey)
+	})
+
+	return r
+}
+
+// ListKeys godoc
+// @Summary List signing keys
+// @Description Get every signing key with its rotation status
+// @Tags keys
+// @Produce json
+// @Success 200 {object} render.Response{data=[]Key}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /keys [get]
+func (h *KeyHandler) ListKeys(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.svc.List(r.Context())
+	if err != nil {
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, keys)
+}
+
+// CreateKey godoc
+// @Summary Add a signing key
+// @Description Generate or import a signing key, optionally scheduled to activate later
+// @Tags keys
+// @Accept json
+// @Produce json
+// @Param key body CreateKeyRequest true "Key details"
+// @Success 201 {object} render.Response{data=Key}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /keys [post]
+func (h *KeyHandler) CreateKey(w http.ResponseWriter, r *http.Request) {
+	var req CreateKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode create key request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid create key request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	k, err := h.svc.Create(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ErrInvalidKey) {
+			render.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, k)
+}
+
+// RetireKey godoc
+// @Summary Retire a signing key
+// @Description Stop trusting a key once the tokens it signed have expired
+// @Tags keys
+// @Produce json
+// @Param kid path string true "Key ID"
+// @Success 200 {object} render.Response{data=Key}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /keys/{kid}/retire [post]
+func (h *KeyHandler) RetireKey(w http.ResponseWriter, r *http.Request) {
+	kid := chi.URLParam(r, "kid")
+
+	k, err := h.svc.Retire(r.Context(), kid)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrKeyNotFound):
+			render.Error(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrActiveKey), errors.Is(err, ErrConfigKey):
+			render.Error(w, http.StatusConflict, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, k)
+}
@@ -0,0 +1,106 @@
+package key
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockKeyService struct {
+	mock.Mock
+}
+
+func (m *mockKeyService) List(ctx context.Context) ([]*Key, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Key), args.Error(1)
+}
+
+func (m *mockKeyService) Create(ctx context.Context, req CreateKeyRequest) (*Key, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Key), args.Error(1)
+}
+
+func (m *mockKeyService) Retire(ctx context.Context, kid string) (*Key, error) {
+	args := m.Called(ctx, kid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Key), args.Error(1)
+}
+
+func (m *mockKeyService) Reload(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func TestHandler_Create(t *testing.T) {
+	svc := new(mockKeyService)
+	handler := NewKeyHandler(svc)
+
+	reqBody := CreateKeyRequest{Algorithm: "ES256"}
+	expectedKey := &Key{Kid: "kid-1", Algorithm: "ES256", Source: SourceDatabase, Status: auth.KeyStatusActive}
+
+	svc.On("Create", mock.Anything, reqBody).Return(expectedKey, nil)
+
+	body, _ := json.Marshal(reqBody)
+	req, _ := http.NewRequest("POST", "/keys", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	handler.CreateKey(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var resp render.Response
+	err := json.Unmarshal(rr.Body.Bytes(), &resp)
+	assert.NoError(t, err)
+
+	dataMap := resp.Data.(map[string]interface{})
+	assert.Equal(t, expectedKey.Kid, dataMap["kid"])
+}
+
+func TestHandler_Create_InvalidAlgorithm(t *testing.T) {
+	svc := new(mockKeyService)
+	handler := NewKeyHandler(svc)
+
+	body, _ := json.Marshal(CreateKeyRequest{Algorithm: "HS256"})
+	req, _ := http.NewRequest("POST", "/keys", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	handler.CreateKey(rr, req)
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	svc.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestHandler_Retire_Active(t *testing.T) {
+	svc := new(mockKeyService)
+	handler := NewKeyHandler(svc)
+
+	svc.On("Retire", mock.Anything, "kid-1").Return(nil, ErrActiveKey)
+
+	req, _ := http.NewRequest("POST", "/keys/kid-1/retire", nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("kid", "kid-1")
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	rr := httptest.NewRecorder()
+
+	handler.RetireKey(rr, req)
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
@@ -0,0 +1,32 @@
+package key
+
+import (
+	"time"
+)
+
+// Key sources.
+const (
+	SourceConfig   = "config"
+	SourceDatabase = "database"
+)
+
+// Key represents the domain model for a signing key. Private key material is never exposed.
+type Key struct {
+	ID          int        `json:"id,omitempty"`
+	Kid         string     `json:"kid"`
+	Algorithm   string     `json:"algorithm"`
+	Source      string     `json:"source"`
+	Status      string     `json:"status"`
+	ActivatesAt time.Time  `json:"activates_at"`
+	RetiredAt   *time.Time `json:"retired_at,omitempty"`
+	VerifyUntil *time.Time `json:"verify_until,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+// CreateKeyRequest defines the payload for adding a signing key.
+// Either an algorithm to generate a key for, or a PEM encoded private key to import, is required.
+type CreateKeyRequest struct {
+	Algorithm   string     `json:"algorithm" validate:"required_without=PrivateKey,omitempty,oneof=RS256 ES256 ES384 ES512 EdDSA"`
+	PrivateKey  string     `json:"private_key" validate:"required_without=Algorithm"`
+	ActivatesAt *time.Time `json:"activates_at"`
+}
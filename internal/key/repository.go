@@ -0,0 +1,77 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/signingkey"
+)
+
+// KeyRepository handles database operations for signing keys.
+type KeyRepository struct {
+	client *ent.Client
+}
+
+// NewKeyRepository creates a new signing key repository.
+func NewKeyRepository(client *ent.Client) *KeyRepository {
+	return &KeyRepository{client: client}
+}
+
+// Create stores a new signing key.
+func (r *KeyRepository) Create(ctx context.Context, k *ent.SigningKey) (*ent.SigningKey, error) {
+	created, err := r.client.SigningKey.
+		Create().
+		SetKid(k.Kid).
+		SetAlgorithm(k.Algorithm).
+		SetPrivateKey(k.PrivateKey).
+		SetActivatesAt(k.ActivatesAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create signing key", "kid", k.Kid, "error", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetByKid retrieves a signing key by its key ID.
+func (r *KeyRepository) GetByKid(ctx context.Context, kid string) (*ent.SigningKey, error) {
+	k, err := r.client.SigningKey.Query().
+		Where(signingkey.KidEQ(kid)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("signing key not found in database", "kid", kid)
+			return nil, fmt.Errorf("signing key not found: %w", err)
+		}
+		slog.Error("database error: failed to get signing key", "kid", kid, "error", err)
+		return nil, err
+	}
+	return k, nil
+}
+
+// List retrieves all signing keys ordered by activation time.
+func (r *KeyRepository) List(ctx context.Context) ([]*ent.SigningKey, error) {
+	keys, err := r.client.SigningKey.Query().
+		Order(ent.Asc(signingkey.FieldActivatesAt)).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list signing keys", "error", err)
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Retire marks a signing key as retired at the given time.
+func (r *KeyRepository) Retire(ctx context.Context, id int, at time.Time) (*ent.SigningKey, error) {
+	k, err := r.client.SigningKey.UpdateOneID(id).
+		SetRetiredAt(at).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to retire signing key", "id", id, "error", err)
+		return nil, err
+	}
+	return k, nil
+}
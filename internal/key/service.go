@@ -0,0 +1,199 @@
+package key
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/pkg/auth"
+)
+
+var (
+	// ErrKeyNotFound is returned when no key matches the requested kid.
+	ErrKeyNotFound = errors.New("signing key not found")
+	// ErrActiveKey is returned when retiring the key that currently signs tokens.
+	ErrActiveKey = errors.New("cannot retire the active signing key; add a new key first")
+	// ErrInvalidKey is returned when a key cannot be generated or parsed.
+	ErrInvalidKey = errors.New("invalid signing key")
+	// ErrConfigKey is returned when retiring the key loaded from configuration.
+	ErrConfigKey = errors.New("the configured key is retired automatically once a newer key is active")
+)
+
+// KeyService defines the business logic for signing key rotation.
+type KeyService interface {
+	List(ctx context.Context) ([]*Key, error)
+	Create(ctx context.Context, req CreateKeyRequest) (*Key, error)
+	Retire(ctx context.Context, kid string) (*Key, error)
+	Reload(ctx context.Context) error
+}
+
+type keyService struct {
+	repo      *KeyRepository
+	keyring   *auth.Keyring
+	bootstrap auth.Key
+}
+
+// NewKeyService creates a new signing key service. The bootstrap key comes from
+// configuration and signs until a key stored in the database is activated.
+func NewKeyService(repo *KeyRepository, keyring *auth.Keyring, bootstrap auth.Key) KeyService {
+	return &keyService{repo: repo, keyring: keyring, bootstrap: bootstrap}
+}
+
+func (s *keyService) List(ctx context.Context) ([]*Key, error) {
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byKid := make(map[string]*ent.SigningKey, len(stored))
+	for _, k := range stored {
+		byKid[k.Kid] = k
+	}
+
+	states := s.keyring.States(time.Now())
+	keys := make([]*Key, 0, len(states))
+	for _, st := range states {
+		k := &Key{
+			Kid:         st.Signer.KeyID(),
+			Algorithm:   st.Signer.Method().Alg(),
+			Source:      SourceConfig,
+			Status:      st.Status,
+			ActivatesAt: st.ActivatesAt,
+		}
+		if !st.VerifyUntil.IsZero() {
+			verifyUntil := st.VerifyUntil
+			k.VerifyUntil = &verifyUntil
+		}
+		if e, ok := byKid[k.Kid]; ok {
+			k.ID = e.ID
+			k.Source = SourceDatabase
+			k.RetiredAt = e.RetiredAt
+			k.CreatedAt = &e.CreatedAt
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+func (s *keyService) Create(ctx context.Context, req CreateKeyRequest) (*Key, error) {
+	var (
+		pk  crypto.Signer
+		err error
+	)
+	if req.PrivateKey != "" {
+		pk, err = auth.ParsePrivateKeyPEM([]byte(req.PrivateKey))
+	} else {
+		pk, err = auth.GenerateKey(req.Algorithm)
+	}
+	if err != nil {
+		slog.Warn("failed to prepare signing key", "error", err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+
+	signer, err := auth.NewSigner("", pk)
+	if err != nil {
+		slog.Warn("unsupported signing key", "error", err)
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+
+	pemKey, err := auth.MarshalPrivateKeyPEM(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	activatesAt := time.Now()
+	if req.ActivatesAt != nil && req.ActivatesAt.After(activatesAt) {
+		activatesAt = *req.ActivatesAt
+	}
+
+	slog.Info("adding signing key", "kid", signer.KeyID(), "alg", signer.Method().Alg(), "activates_at", activatesAt)
+	created, err := s.repo.Create(ctx, &ent.SigningKey{
+		Kid:         signer.KeyID(),
+		Algorithm:   signer.Method().Alg(),
+		PrivateKey:  string(pemKey),
+		ActivatesAt: activatesAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repository create: %w", err)
+	}
+
+	if err := s.Reload(ctx); err != nil {
+		return nil, err
+	}
+
+	slog.Info("signing key added successfully", "kid", created.Kid)
+	return s.find(ctx, created.Kid)
+}
+
+func (s *keyService) Retire(ctx context.Context, kid string) (*Key, error) {
+	slog.Info("retiring signing key", "kid", kid)
+	if kid == s.bootstrap.Signer.KeyID() {
+		return nil, ErrConfigKey
+	}
+
+	k, err := s.repo.GetByKid(ctx, kid)
+	if err != nil {
+		return nil, ErrKeyNotFound
+	}
+
+	now := time.Now()
+	if active, err := s.keyring.Active(now); err == nil && active.KeyID() == kid {
+		slog.Warn("refusing to retire active signing key", "kid", kid)
+		return nil, ErrActiveKey
+	}
+
+	if k.RetiredAt == nil {
+		if _, err := s.repo.Retire(ctx, k.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.Reload(ctx); err != nil {
+		return nil, err
+	}
+
+	slog.Info("signing key retired successfully", "kid", kid)
+	return s.find(ctx, kid)
+}
+
+// Reload rebuilds the keyring from the configured key and the keys stored in the database.
+func (s *keyService) Reload(ctx context.Context) error {
+	stored, err := s.repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list signing keys: %w", err)
+	}
+
+	keys := []auth.Key{s.bootstrap}
+	for _, k := range stored {
+		signer, err := auth.NewSignerFromPEM(k.Kid, []byte(k.PrivateKey))
+		if err != nil {
+			slog.Error("skipping unreadable signing key", "kid", k.Kid, "error", err)
+			continue
+		}
+		key := auth.Key{Signer: signer, ActivatesAt: k.ActivatesAt}
+		if k.RetiredAt != nil {
+			key.RetiredAt = *k.RetiredAt
+		}
+		keys = append(keys, key)
+	}
+
+	s.keyring.Replace(keys)
+	return nil
+}
+
+func (s *keyService) find(ctx context.Context, kid string) (*Key, error) {
+	keys, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if k.Kid == kid {
+			return k, nil
+		}
+	}
+	return nil, ErrKeyNotFound
+}
@@ -0,0 +1,119 @@
+package key
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"keeper/ent/enttest"
+	"keeper/pkg/auth"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Rotation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_key_rotation?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	keyring := auth.NewKeyring(time.Hour)
+	jwtManager := auth.NewJWTManagerWithKeyring(keyring, time.Hour)
+	svc := NewKeyService(NewKeyRepository(client), keyring, auth.Key{Signer: auth.NewHMACSigner("", "secret")})
+
+	ctx := context.Background()
+	assert.NoError(t, svc.Reload(ctx))
+
+	oldToken, err := jwtManager.Generate(1, 1)
+	assert.NoError(t, err)
+
+	// Activating a new key switches signing but keeps trusting the old key.
+	created, err := svc.Create(ctx, CreateKeyRequest{Algorithm: "ES256"})
+	assert.NoError(t, err)
+	assert.Equal(t, auth.KeyStatusActive, created.Status)
+	assert.Equal(t, SourceDatabase, created.Source)
+
+	newToken, err := jwtManager.Generate(1, 1)
+	assert.NoError(t, err)
+	_, err = jwtManager.Verify(newToken)
+	assert.NoError(t, err)
+	_, err = jwtManager.Verify(oldToken)
+	assert.NoError(t, err)
+
+	// A key scheduled for later is published but does not sign yet.
+	activatesAt := time.Now().Add(24 * time.Hour)
+	scheduled, err := svc.Create(ctx, CreateKeyRequest{Algorithm: "EdDSA", ActivatesAt: &activatesAt})
+	assert.NoError(t, err)
+	assert.Equal(t, auth.KeyStatusPending, scheduled.Status)
+	assert.Len(t, jwtManager.JWKS().Keys, 2)
+
+	active, err := keyring.Active(time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, created.Kid, active.KeyID())
+
+	active, err = keyring.Active(activatesAt.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, scheduled.Kid, active.KeyID())
+
+	keys, err := svc.List(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, keys, 3) {
+		assert.Equal(t, SourceConfig, keys[0].Source)
+		assert.Equal(t, auth.KeyStatusRetiring, keys[0].Status)
+		assert.NotNil(t, keys[0].VerifyUntil)
+	}
+
+	t.Run("RetireActiveKey", func(t *testing.T) {
+		_, err := svc.Retire(ctx, created.Kid)
+		assert.ErrorIs(t, err, ErrActiveKey)
+	})
+
+	t.Run("RetireConfigKey", func(t *testing.T) {
+		_, err := svc.Retire(ctx, "")
+		assert.ErrorIs(t, err, ErrConfigKey)
+	})
+
+	t.Run("RetirePendingKey", func(t *testing.T) {
+		retired, err := svc.Retire(ctx, scheduled.Kid)
+		assert.NoError(t, err)
+		assert.NotNil(t, retired.RetiredAt)
+		assert.Equal(t, auth.KeyStatusExpired, retired.Status)
+
+		// The cancelled key never takes over signing.
+		active, err := keyring.Active(activatesAt.Add(time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, created.Kid, active.KeyID())
+	})
+
+	t.Run("UnknownKey", func(t *testing.T) {
+		_, err := svc.Retire(ctx, "missing")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("InvalidImport", func(t *testing.T) {
+		_, err := svc.Create(ctx, CreateKeyRequest{PrivateKey: "not a pem"})
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
+
+func TestKeyring_Expiry(t *testing.T) {
+	now := time.Now()
+	oldSigner := auth.NewHMACSigner("old", "secret")
+	newSigner := auth.NewHMACSigner("new", "secret")
+
+	keyring := auth.NewKeyring(time.Hour,
+		auth.Key{Signer: oldSigner},
+		auth.Key{Signer: newSigner, ActivatesAt: now},
+	)
+
+	_, ok := keyring.Verifier("old", now.Add(30*time.Minute))
+	assert.True(t, ok)
+
+	_, ok = keyring.Verifier("old", now.Add(2*time.Hour))
+	assert.False(t, ok)
+
+	_, ok = keyring.Verifier("new", now.Add(2*time.Hour))
+	assert.True(t, ok)
+}
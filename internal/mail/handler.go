@@ -0,0 +1,170 @@
+package mail
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// MailHandler handles HTTP requests for email templates.
+type MailHandler struct {
+	svc      MailService
+	validate *validator.Validate
+}
+
+// NewMailHandler creates a new mail handler.
+func NewMailHandler(svc MailService) *MailHandler {
+	return &MailHandler{
+		svc:      svc,
+		validate: validator.New(),
+	}
+}
+
+// Permissions a token needs to call the mail endpoints. App tokens are
+// granted them as scopes.
+const (
+	PermissionRead  = "mail:read"
+	PermissionWrite = "mail:write"
+)
+
+// Routes returns the chi router for mail endpoints.
+func (h *MailHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
+	r := chi.NewRouter()
+
+	// All routes are protected
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Middleware(jwtManager))
+
+		r.With(auth.RequirePermission(PermissionRead)).Get("/templates", h.ListTemplates)
+		r.With(auth.RequirePermission(PermissionRead)).Get("/templates/defaults", h.ListDefaultTemplates)
+		r.With(auth.RequirePermission(PermissionWrite)).Put("/templates", h.PutTemplate)
+		r.With(auth.RequirePermission(PermissionWrite)).Delete("/templates/{id}", h.DeleteTemplate)
+	})
+
+	return r
+}
+
+// ListTemplates godoc
+// @Summary List email template overrides
+// @Description Get the template overrides of the caller's app, or of every app for platform admins
+// @Tags mail
+// @Produce json
+// @Success 200 {object} render.Response{data=[]Template}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /mail/templates [get]
+func (h *MailHandler) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	templates, err := h.svc.ListTemplates(r.Context())
+	if err != nil {
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, templates)
+}
+
+// ListDefaultTemplates godoc
+// @Summary List built-in email templates
+// @Description Get the built-in templates apps can override, with the source used when they do not
+// @Tags mail
+// @Produce json
+// @Success 200 {object} render.Response{data=[]DefaultTemplate}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Security Bearer
+// @Router /mail/templates/defaults [get]
+func (h *MailHandler) ListDefaultTemplates(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, http.StatusOK, h.svc.ListDefaults(r.Context()))
+}
+
+// PutTemplate godoc
+// @Summary Override an email template
+// @Description Create or replace an app's override of a built-in template. Subject and text are text/template sources, html is an html/template source; all are rendered with the fields AppName, Firstname, Lastname, Email, Token and ExpiresAt.
+// @Tags mail
+// @Accept json
+// @Produce json
+// @Param template body PutTemplateRequest true "Template details"
+// @Success 200 {object} render.Response{data=Template}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /mail/templates [put]
+func (h *MailHandler) PutTemplate(w http.ResponseWriter, r *http.Request) {
+	var req PutTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode put email template request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid put email template request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	t, err := h.svc.PutTemplate(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, t)
+}
+
+// DeleteTemplate godoc
+// @Summary Delete an email template override
+// @Description Remove an app's override so the built-in template is used again
+// @Tags mail
+// @Produce json
+// @Param id path int true "Template ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /mail/templates/{id} [delete]
+func (h *MailHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid email template id in delete request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid template id")
+		return
+	}
+
+	if err := h.svc.DeleteTemplate(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// writeError renders a mail service error with its HTTP status.
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUnknownTemplate), errors.Is(err, ErrInvalidTemplate), errors.Is(err, ErrAppNotFound):
+		render.Error(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrForbidden):
+		render.Error(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrTemplateNotFound):
+		render.Error(w, http.StatusNotFound, err.Error())
+	default:
+		render.Error(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,147 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockMailService struct {
+	mock.Mock
+}
+
+func (m *mockMailService) ListTemplates(ctx context.Context) ([]*Template, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Template), args.Error(1)
+}
+
+func (m *mockMailService) ListDefaults(ctx context.Context) []*DefaultTemplate {
+	args := m.Called(ctx)
+	return args.Get(0).([]*DefaultTemplate)
+}
+
+func (m *mockMailService) PutTemplate(ctx context.Context, req PutTemplateRequest) (*Template, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Template), args.Error(1)
+}
+
+func (m *mockMailService) DeleteTemplate(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestHandler_ListDefaultTemplates(t *testing.T) {
+	svc := new(mockMailService)
+	handler := NewMailHandler(svc)
+
+	svc.On("ListDefaults", mock.Anything).Return([]*DefaultTemplate{
+		{Name: TemplateEmailVerification, Subject: "Verify"},
+		{Name: TemplatePasswordReset, Subject: "Reset"},
+	})
+
+	req, _ := http.NewRequest("GET", "/mail/templates/defaults", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ListDefaultTemplates(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp render.Response
+	err := json.Unmarshal(rr.Body.Bytes(), &resp)
+	assert.NoError(t, err)
+
+	dataList := resp.Data.([]interface{})
+	assert.Len(t, dataList, 2)
+}
+
+func TestHandler_PutTemplate(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"Saved", nil, http.StatusOK},
+		{"UnknownTemplate", ErrUnknownTemplate, http.StatusBadRequest},
+		{"InvalidTemplate", ErrInvalidTemplate, http.StatusBadRequest},
+		{"Forbidden", ErrForbidden, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockMailService)
+			handler := NewMailHandler(svc)
+
+			reqBody := PutTemplateRequest{AppID: 1, Name: TemplatePasswordReset, Subject: "Reset", HTML: "<p>{{.Token}}</p>"}
+			if tt.err != nil {
+				svc.On("PutTemplate", mock.Anything, reqBody).Return(nil, tt.err)
+			} else {
+				svc.On("PutTemplate", mock.Anything, reqBody).Return(&Template{ID: 1, AppID: 1, Name: reqBody.Name}, nil)
+			}
+
+			body, _ := json.Marshal(reqBody)
+			req, _ := http.NewRequest("PUT", "/mail/templates", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			handler.PutTemplate(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+
+	t.Run("MissingFields", func(t *testing.T) {
+		handler := NewMailHandler(new(mockMailService))
+
+		req, _ := http.NewRequest("PUT", "/mail/templates", bytes.NewBufferString(`{"app_id":1,"name":"password_reset"}`))
+		rr := httptest.NewRecorder()
+
+		handler.PutTemplate(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandler_DeleteTemplate(t *testing.T) {
+	svc := new(mockMailService)
+	handler := NewMailHandler(svc)
+
+	svc.On("DeleteTemplate", mock.Anything, 1).Return(nil)
+	svc.On("DeleteTemplate", mock.Anything, 2).Return(ErrTemplateNotFound)
+
+	tests := []struct {
+		id   string
+		want int
+	}{
+		{"1", http.StatusNoContent},
+		{"2", http.StatusNotFound},
+		{"abc", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			req, _ := http.NewRequest("DELETE", "/mail/templates/"+tt.id, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			rr := httptest.NewRecorder()
+
+			handler.DeleteTemplate(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package mail
+
+import (
+	"time"
+)
+
+// Template represents an app's override of a built-in email template.
+type Template struct {
+	ID        int       `json:"id"`
+	AppID     int       `json:"app_id"`
+	Name      string    `json:"name"`
+	Subject   string    `json:"subject"`
+	HTML      string    `json:"html"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DefaultTemplate represents a built-in email template.
+type DefaultTemplate struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+}
+
+// PutTemplateRequest defines the payload for overriding a built-in template
+// for an app. Name must be one of the built-in templates.
+type PutTemplateRequest struct {
+	AppID   int    `json:"app_id" validate:"required"`
+	Name    string `json:"name" validate:"required"`
+	Subject string `json:"subject" validate:"required"`
+	HTML    string `json:"html" validate:"required"`
+	Text    string `json:"text"`
+}
+
+// TemplateData is what templates are rendered with.
+type TemplateData struct {
+	AppName   string
+	Firstname string
+	Lastname  string
+	Email     string
+	Token     string
+	// Link is the login link of magic link emails.
+	Link      string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,217 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/internal/db"
+	"keeper/internal/user"
+	"keeper/pkg/mailer"
+)
+
+const (
+	// defaultMaxAttempts is how many times a message is tried before it is
+	// marked failed. With db.RetryDelay between attempts that spans about
+	// four hours.
+	defaultMaxAttempts = 8
+	// deliverBatch is how many due messages one Deliver call sends at most.
+	deliverBatch = 50
+	// claimLease is how long a claimed message is hidden from other workers
+	// while it is being sent.
+	claimLease = 5 * time.Minute
+)
+
+// Outbox persists outgoing email and delivers it in the background, so that a
+// mail server outage neither loses messages nor fails the request that sent them.
+type Outbox struct {
+	repo        *MailRepository
+	mailer      mailer.Mailer
+	from        string
+	templates   *mailer.Templates
+	maxAttempts int
+}
+
+// OutboxOption configures an Outbox.
+type OutboxOption func(*Outbox)
+
+// WithMaxAttempts sets how many times a message is tried before it is marked failed.
+func WithMaxAttempts(n int) OutboxOption {
+	return func(o *Outbox) {
+		if n > 0 {
+			o.maxAttempts = n
+		}
+	}
+}
+
+// NewOutbox creates an outbox delivering through m with from as the sender.
+func NewOutbox(repo *MailRepository, m mailer.Mailer, from string, opts ...OutboxOption) *Outbox {
+	o := &Outbox{
+		repo:        repo,
+		mailer:      m,
+		from:        from,
+		templates:   mailer.NewTemplates(defaultTemplates),
+		maxAttempts: defaultMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Enqueue renders the named template, using the app's override when it has
+// one, and stores the message for delivery to the recipient.
+func (o *Outbox) Enqueue(ctx context.Context, appID *int, name, to string, data TemplateData) error {
+	var override *mailer.Template
+	if appID != nil {
+		t, err := o.repo.GetTemplate(ctx, *appID, name)
+		switch {
+		case err == nil:
+			override = &mailer.Template{Subject: t.Subject, HTML: t.HTML, Text: t.Text}
+		case !ent.IsNotFound(err):
+			return err
+		}
+	}
+
+	msg, err := o.templates.Render(name, override, data)
+	if err != nil && override != nil {
+		// Overrides are checked when saved, so this only happens when a
+		// template relies on data a flow does not provide. Fall back to the
+		// built-in template rather than not sending the email at all.
+		slog.Warn("email template override failed to render, using default", "app_id", *appID, "name", name, "error", err)
+		msg, err = o.templates.Render(name, nil, data)
+	}
+	if err != nil {
+		return fmt.Errorf("render %s email: %w", name, err)
+	}
+
+	queued, err := o.repo.Enqueue(ctx, &ent.OutboundEmail{
+		AppID:     appID,
+		Template:  name,
+		Recipient: to,
+		Subject:   msg.Subject,
+		HTML:      msg.HTML,
+		Text:      msg.Text,
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("email queued", "id", queued.ID, "template", name)
+	return nil
+}
+
+// Deliver sends the messages that are due and reports how many were sent.
+// Failed messages are retried with exponential backoff until they have been
+// tried maxAttempts times.
+func (o *Outbox) Deliver(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := o.repo.ListDue(ctx, now, deliverBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, m := range due {
+		claimed, err := o.repo.Claim(ctx, m.ID, now, now.Add(claimLease))
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+
+		err = o.mailer.Send(ctx, mailer.Message{
+			From:    o.from,
+			To:      []string{m.Recipient},
+			Subject: m.Subject,
+			HTML:    m.HTML,
+			Text:    m.Text,
+		})
+		if err == nil {
+			if err := o.repo.MarkSent(ctx, m.ID); err != nil {
+				return sent, err
+			}
+			sent++
+			continue
+		}
+
+		attempts := m.Attempts + 1
+		if attempts >= o.maxAttempts {
+			slog.Error("giving up on email", "id", m.ID, "template", m.Template, "attempts", attempts, "error", err)
+			if err := o.repo.MarkFailed(ctx, m.ID, err.Error()); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		next := time.Now().Add(db.RetryDelay(attempts))
+		slog.Warn("email delivery failed, will retry", "id", m.ID, "template", m.Template, "attempts", attempts, "next_attempt_at", next, "error", err)
+		if err := o.repo.MarkRetry(ctx, m.ID, next, err.Error()); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// Run delivers due messages every interval until ctx is cancelled.
+func (o *Outbox) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := o.Deliver(ctx)
+			if err != nil {
+				slog.Error("failed to deliver queued email", "error", err)
+				continue
+			}
+			if n > 0 {
+				slog.Info("delivered queued email", "count", n)
+			}
+		}
+	}
+}
+
+// Notifier sends the user service's account messages through the outbox.
+type Notifier struct {
+	outbox *Outbox
+}
+
+var _ user.Notifier = (*Notifier)(nil)
+
+// NewNotifier creates a notifier backed by outbox.
+func NewNotifier(outbox *Outbox) *Notifier {
+	return &Notifier{outbox: outbox}
+}
+
+// PasswordReset queues the password reset email.
+func (n *Notifier) PasswordReset(ctx context.Context, u *user.User, token string, expiresAt time.Time) error {
+	return n.send(ctx, TemplatePasswordReset, u, token, expiresAt)
+}
+
+// EmailVerification queues the email verification email.
+func (n *Notifier) EmailVerification(ctx context.Context, u *user.User, token string, expiresAt time.Time) error {
+	return n.send(ctx, TemplateEmailVerification, u, token, expiresAt)
+}
+
+// MagicLink queues the magic link login email.
+func (n *Notifier) MagicLink(ctx context.Context, u *user.User, link string, expiresAt time.Time) error {
+	return n.enqueue(ctx, TemplateMagicLink, u, TemplateData{Link: link, ExpiresAt: expiresAt})
+}
+
+func (n *Notifier) send(ctx context.Context, name string, u *user.User, token string, expiresAt time.Time) error {
+	return n.enqueue(ctx, name, u, TemplateData{Token: token, ExpiresAt: expiresAt})
+}
+
+// enqueue queues the template for u, filling in who it is addressed to.
+func (n *Notifier) enqueue(ctx context.Context, name string, u *user.User, data TemplateData) error {
+	appID := u.AppID
+	data.AppName = u.AppName
+	data.Firstname = u.Firstname
+	data.Lastname = u.Lastname
+	data.Email = u.Email
+	return n.outbox.Enqueue(ctx, &appID, name, u.Email, data)
+}
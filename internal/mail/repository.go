@@ -0,0 +1,199 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/emailtemplate"
+	"keeper/ent/outboundemail"
+)
+
+// MailRepository handles database operations for email templates and the outbox.
+type MailRepository struct {
+	client *ent.Client
+}
+
+// NewMailRepository creates a new mail repository.
+func NewMailRepository(client *ent.Client) *MailRepository {
+	return &MailRepository{client: client}
+}
+
+// ListTemplates retrieves all template overrides.
+func (r *MailRepository) ListTemplates(ctx context.Context) ([]*ent.EmailTemplate, error) {
+	templates, err := r.client.EmailTemplate.Query().
+		Order(ent.Asc(emailtemplate.FieldAppID), ent.Asc(emailtemplate.FieldName)).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list email templates", "error", err)
+		return nil, err
+	}
+	return templates, nil
+}
+
+// GetTemplate retrieves an app's override of the named template.
+func (r *MailRepository) GetTemplate(ctx context.Context, appID int, name string) (*ent.EmailTemplate, error) {
+	t, err := r.client.EmailTemplate.Query().
+		Where(
+			emailtemplate.AppIDEQ(appID),
+			emailtemplate.NameEQ(name),
+		).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.Error("database error: failed to get email template", "app_id", appID, "name", name, "error", err)
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// PutTemplate creates or replaces an app's override of a template.
+func (r *MailRepository) PutTemplate(ctx context.Context, t *ent.EmailTemplate) (*ent.EmailTemplate, error) {
+	existing, err := r.GetTemplate(ctx, t.AppID, t.Name)
+	switch {
+	case ent.IsNotFound(err):
+		created, err := r.client.EmailTemplate.
+			Create().
+			SetAppID(t.AppID).
+			SetName(t.Name).
+			SetSubject(t.Subject).
+			SetHTML(t.HTML).
+			SetText(t.Text).
+			Save(ctx)
+		if err != nil {
+			slog.Error("database error: failed to create email template", "app_id", t.AppID, "name", t.Name, "error", err)
+			return nil, err
+		}
+		return created, nil
+	case err != nil:
+		return nil, err
+	}
+
+	updated, err := r.client.EmailTemplate.UpdateOne(existing).
+		SetSubject(t.Subject).
+		SetHTML(t.HTML).
+		SetText(t.Text).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to update email template", "id", existing.ID, "error", err)
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteTemplate deletes a template override by its ID.
+func (r *MailRepository) DeleteTemplate(ctx context.Context, id int) error {
+	err := r.client.EmailTemplate.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("email template not found for deletion", "id", id)
+			return fmt.Errorf("email template not found: %w", err)
+		}
+		slog.Error("database error: failed to delete email template", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// Enqueue adds a message to the outbox.
+func (r *MailRepository) Enqueue(ctx context.Context, m *ent.OutboundEmail) (*ent.OutboundEmail, error) {
+	created, err := r.client.OutboundEmail.
+		Create().
+		SetNillableAppID(m.AppID).
+		SetTemplate(m.Template).
+		SetRecipient(m.Recipient).
+		SetSubject(m.Subject).
+		SetHTML(m.HTML).
+		SetText(m.Text).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to enqueue email", "template", m.Template, "error", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListDue retrieves up to limit pending messages whose next attempt is due.
+func (r *MailRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*ent.OutboundEmail, error) {
+	msgs, err := r.client.OutboundEmail.Query().
+		Where(
+			outboundemail.StatusEQ(outboundemail.StatusPending),
+			outboundemail.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(outboundemail.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list due emails", "error", err)
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// Claim postpones a due message to leaseUntil so that no other worker picks it
+// up while it is being sent. It reports false when another worker claimed it first.
+func (r *MailRepository) Claim(ctx context.Context, id int, now, leaseUntil time.Time) (bool, error) {
+	n, err := r.client.OutboundEmail.Update().
+		Where(
+			outboundemail.IDEQ(id),
+			outboundemail.StatusEQ(outboundemail.StatusPending),
+			outboundemail.NextAttemptAtLTE(now),
+		).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to claim email", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// MarkSent records a successful delivery and drops the message bodies.
+func (r *MailRepository) MarkSent(ctx context.Context, id int) error {
+	err := r.client.OutboundEmail.UpdateOneID(id).
+		SetStatus(outboundemail.StatusSent).
+		AddAttempts(1).
+		SetSentAt(time.Now()).
+		SetLastError("").
+		SetHTML("").
+		SetText("").
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark email sent", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// MarkRetry records a failed attempt and schedules the next one.
+func (r *MailRepository) MarkRetry(ctx context.Context, id int, next time.Time, lastError string) error {
+	err := r.client.OutboundEmail.UpdateOneID(id).
+		AddAttempts(1).
+		SetNextAttemptAt(next).
+		SetLastError(lastError).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to schedule email retry", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// MarkFailed gives up on a message and drops its bodies.
+func (r *MailRepository) MarkFailed(ctx context.Context, id int, lastError string) error {
+	err := r.client.OutboundEmail.UpdateOneID(id).
+		SetStatus(outboundemail.StatusFailed).
+		AddAttempts(1).
+		SetLastError(lastError).
+		SetHTML("").
+		SetText("").
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark email failed", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
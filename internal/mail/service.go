@@ -0,0 +1,128 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"keeper/ent"
+	"keeper/ent/privacy"
+	"keeper/pkg/mailer"
+)
+
+// MailService defines the business logic for email templates.
+type MailService interface {
+	ListTemplates(ctx context.Context) ([]*Template, error)
+	ListDefaults(ctx context.Context) []*DefaultTemplate
+	PutTemplate(ctx context.Context, req PutTemplateRequest) (*Template, error)
+	DeleteTemplate(ctx context.Context, id int) error
+}
+
+var (
+	// ErrTemplateNotFound is returned when the requested template override does not exist.
+	ErrTemplateNotFound = errors.New("email template not found")
+	// ErrUnknownTemplate is returned when an override names no built-in template.
+	ErrUnknownTemplate = errors.New("unknown email template")
+	// ErrInvalidTemplate is returned when an override does not parse or render.
+	ErrInvalidTemplate = errors.New("invalid email template")
+	// ErrAppNotFound is returned when an override is for an app that does not exist.
+	ErrAppNotFound = errors.New("app not found")
+	// ErrForbidden is returned when the caller may not make a change, such as
+	// overriding a template of another app.
+	ErrForbidden = errors.New("operation not permitted")
+)
+
+type mailService struct {
+	repo      *MailRepository
+	templates *mailer.Templates
+}
+
+// NewMailService creates a new mail service.
+func NewMailService(repo *MailRepository) MailService {
+	return &mailService{repo: repo, templates: mailer.NewTemplates(defaultTemplates)}
+}
+
+func (s *mailService) ListTemplates(ctx context.Context) ([]*Template, error) {
+	slog.Info("listing email templates")
+	templates, err := s.repo.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainTemplates := make([]*Template, len(templates))
+	for i, t := range templates {
+		domainTemplates[i] = s.toDomain(t)
+	}
+	return domainTemplates, nil
+}
+
+func (s *mailService) ListDefaults(_ context.Context) []*DefaultTemplate {
+	names := s.templates.Names()
+	defaults := make([]*DefaultTemplate, len(names))
+	for i, name := range names {
+		t, _ := s.templates.Get(name)
+		defaults[i] = &DefaultTemplate{Name: name, Subject: t.Subject, HTML: t.HTML, Text: t.Text}
+	}
+	return defaults
+}
+
+func (s *mailService) PutTemplate(ctx context.Context, req PutTemplateRequest) (*Template, error) {
+	slog.Info("putting email template", "app_id", req.AppID, "name", req.Name)
+	if _, ok := s.templates.Get(req.Name); !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, req.Name)
+	}
+
+	// Render with sample data so that a template referring to fields that do
+	// not exist is rejected now rather than when a user needs the email.
+	tmpl := mailer.Template{Subject: req.Subject, HTML: req.HTML, Text: req.Text}
+	if _, err := tmpl.Render(sampleData); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	saved, err := s.repo.PutTemplate(ctx, &ent.EmailTemplate{
+		AppID:   req.AppID,
+		Name:    req.Name,
+		Subject: req.Subject,
+		HTML:    req.HTML,
+		Text:    req.Text,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, privacy.Deny):
+			slog.Warn("email template change denied", "app_id", req.AppID, "name", req.Name, "error", err)
+			return nil, ErrForbidden
+		case ent.IsConstraintError(err):
+			return nil, ErrAppNotFound
+		}
+		return nil, fmt.Errorf("repository put template: %w", err)
+	}
+
+	slog.Info("email template saved successfully", "id", saved.ID, "app_id", saved.AppID, "name", saved.Name)
+	return s.toDomain(saved), nil
+}
+
+func (s *mailService) DeleteTemplate(ctx context.Context, id int) error {
+	slog.Info("deleting email template", "id", id)
+	if err := s.repo.DeleteTemplate(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTemplateNotFound
+		}
+		return err
+	}
+	slog.Info("email template deleted successfully", "id", id)
+	return nil
+}
+
+func (s *mailService) toDomain(t *ent.EmailTemplate) *Template {
+	return &Template{
+		ID:        t.ID,
+		AppID:     t.AppID,
+		Name:      t.Name,
+		Subject:   t.Subject,
+		HTML:      t.HTML,
+		Text:      t.Text,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
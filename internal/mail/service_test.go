@@ -0,0 +1,387 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"keeper/ent/enttest"
+	"keeper/ent/outboundemail"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+	"keeper/pkg/mailer"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeMailer records messages and fails while failures is positive.
+type fakeMailer struct {
+	mu       sync.Mutex
+	sent     []mailer.Message
+	failures int
+}
+
+func (m *fakeMailer) Send(_ context.Context, msg mailer.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failures > 0 {
+		m.failures--
+		return errors.New("connection refused")
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestService_Templates(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_mail_templates?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewMailService(NewMailRepository(client))
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("Mail App").Save(ctx)
+	assert.NoError(t, err)
+	other, err := client.App.Create().SetName("Other App").Save(ctx)
+	assert.NoError(t, err)
+
+	defaults := svc.ListDefaults(ctx)
+	assert.Len(t, defaults, 3)
+	assert.Equal(t, TemplateEmailVerification, defaults[0].Name)
+
+	req := PutTemplateRequest{
+		AppID:   a.ID,
+		Name:    TemplatePasswordReset,
+		Subject: "{{.AppName}} reset",
+		HTML:    "<p>{{.Token}}</p>",
+	}
+	created, err := svc.PutTemplate(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, a.ID, created.AppID)
+
+	t.Run("Replace", func(t *testing.T) {
+		req := req
+		req.Subject = "Reset your {{.AppName}} password"
+		updated, err := svc.PutTemplate(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, updated.ID)
+		assert.Equal(t, req.Subject, updated.Subject)
+
+		templates, err := svc.ListTemplates(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, templates, 1)
+	})
+
+	t.Run("UnknownTemplate", func(t *testing.T) {
+		req := req
+		req.Name = "welcome"
+		_, err := svc.PutTemplate(ctx, req)
+		assert.ErrorIs(t, err, ErrUnknownTemplate)
+	})
+
+	t.Run("InvalidTemplate", func(t *testing.T) {
+		req := req
+		req.HTML = "<p>{{.Token}</p>"
+		_, err := svc.PutTemplate(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidTemplate)
+
+		req.HTML = "<p>{{.Password}}</p>"
+		_, err = svc.PutTemplate(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidTemplate)
+	})
+
+	t.Run("UnknownApp", func(t *testing.T) {
+		req := req
+		req.AppID = 999
+		_, err := svc.PutTemplate(ctx, req)
+		assert.ErrorIs(t, err, ErrAppNotFound)
+	})
+
+	t.Run("Tenant", func(t *testing.T) {
+		tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: other.ID, UserID: 1})
+
+		templates, err := svc.ListTemplates(tenantCtx)
+		assert.NoError(t, err)
+		assert.Empty(t, templates)
+
+		_, err = svc.PutTemplate(tenantCtx, req)
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		err = svc.DeleteTemplate(tenantCtx, created.ID)
+		assert.ErrorIs(t, err, ErrTemplateNotFound)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, svc.DeleteTemplate(ctx, created.ID))
+		assert.ErrorIs(t, svc.DeleteTemplate(ctx, created.ID), ErrTemplateNotFound)
+	})
+}
+
+func TestOutbox_Deliver(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_mail_outbox?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewMailRepository(client)
+	transport := &fakeMailer{}
+	outbox := NewOutbox(repo, transport, "Keeper <no-reply@example.com>", WithMaxAttempts(3))
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("Outbox App").Save(ctx)
+	assert.NoError(t, err)
+	_, err = NewMailService(repo).PutTemplate(ctx, PutTemplateRequest{
+		AppID:   a.ID,
+		Name:    TemplatePasswordReset,
+		Subject: "{{.AppName}}: new password for {{.Firstname}}",
+		HTML:    "<p>Code: {{.Token}}</p>",
+	})
+	assert.NoError(t, err)
+
+	// makeDue moves every pending message's next attempt into the past.
+	makeDue := func() {
+		err := client.OutboundEmail.Update().
+			Where(outboundemail.StatusEQ(outboundemail.StatusPending)).
+			SetNextAttemptAt(time.Now().Add(-time.Second)).
+			Exec(ctx)
+		assert.NoError(t, err)
+	}
+
+	notifier := NewNotifier(outbox)
+	u := &user.User{AppID: a.ID, AppName: a.Name, Firstname: "Ada", Email: "ada@example.com"}
+
+	t.Run("Override", func(t *testing.T) {
+		assert.NoError(t, notifier.PasswordReset(ctx, u, "reset-token", time.Now().Add(time.Hour)))
+
+		n, err := outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		if assert.Len(t, transport.sent, 1) {
+			msg := transport.sent[0]
+			assert.Equal(t, []string{u.Email}, msg.To)
+			assert.Equal(t, "Outbox App: new password for Ada", msg.Subject)
+			assert.Equal(t, "<p>Code: reset-token</p>", msg.HTML)
+		}
+
+		sent, err := client.OutboundEmail.Query().Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, outboundemail.StatusSent, sent.Status)
+		assert.NotNil(t, sent.SentAt)
+		assert.Empty(t, sent.HTML)
+
+		// Sent messages are not sent again.
+		makeDue()
+		n, err = outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		transport.sent = nil
+		assert.NoError(t, notifier.EmailVerification(ctx, u, "verify-token", time.Now().Add(time.Hour)))
+
+		_, err := outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		if assert.Len(t, transport.sent, 1) {
+			assert.Equal(t, "Verify your email for Outbox App", transport.sent[0].Subject)
+			assert.Contains(t, transport.sent[0].Text, "verify-token")
+		}
+	})
+
+	t.Run("MagicLink", func(t *testing.T) {
+		transport.sent = nil
+		link := "https://id.example.com/users/auth/magic-link/verify?token=magic-token"
+		assert.NoError(t, notifier.MagicLink(ctx, u, link, time.Now().Add(time.Hour)))
+
+		_, err := outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		if assert.Len(t, transport.sent, 1) {
+			assert.Equal(t, "Sign in to Outbox App", transport.sent[0].Subject)
+			assert.Contains(t, transport.sent[0].Text, link)
+			assert.Contains(t, transport.sent[0].HTML, `href="`+link+`"`)
+		}
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		transport.sent = nil
+		transport.failures = 1
+		assert.NoError(t, outbox.Enqueue(ctx, nil, TemplateEmailVerification, "bob@example.com", TemplateData{AppName: "Keeper", Token: "t"}))
+
+		n, err := outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		m, err := client.OutboundEmail.Query().Where(outboundemail.RecipientEQ("bob@example.com")).Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, outboundemail.StatusPending, m.Status)
+		assert.Equal(t, 1, m.Attempts)
+		assert.Equal(t, "connection refused", m.LastError)
+		assert.True(t, m.NextAttemptAt.After(time.Now()))
+
+		// Not due yet.
+		n, err = outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		makeDue()
+		n, err = outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Len(t, transport.sent, 1)
+	})
+
+	t.Run("GiveUp", func(t *testing.T) {
+		transport.failures = 3
+		assert.NoError(t, outbox.Enqueue(ctx, nil, TemplateEmailVerification, "eve@example.com", TemplateData{AppName: "Keeper", Token: "t"}))
+
+		for range 3 {
+			makeDue()
+			_, err := outbox.Deliver(ctx)
+			assert.NoError(t, err)
+		}
+
+		m, err := client.OutboundEmail.Query().Where(outboundemail.RecipientEQ("eve@example.com")).Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, outboundemail.StatusFailed, m.Status)
+		assert.Equal(t, 3, m.Attempts)
+		assert.Empty(t, m.Text)
+	})
+}
+
+// smtpStandIn is a minimal SMTP server that accepts every message.
+type smtpStandIn struct {
+	ln       net.Listener
+	mu       sync.Mutex
+	from     string
+	rcpt     []string
+	messages []string
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	s := &smtpStandIn{ln: ln}
+	go s.serve()
+	t.Cleanup(func() { _ = ln.Close() })
+	return s
+}
+
+func (s *smtpStandIn) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpStandIn) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP stand-in")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb := strings.ToUpper(strings.Fields(line + " ")[0])
+		switch verb {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250-localhost")
+			_ = tp.PrintfLine("250 8BITMIME")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = line
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			s.mu.Lock()
+			s.rcpt = append(s.rcpt, line)
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, string(data))
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 Bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func TestOutbox_SMTP(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_mail_smtp?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	server := newSMTPStandIn(t)
+	host, portStr, err := net.SplitHostPort(server.ln.Addr().String())
+	assert.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	assert.NoError(t, err)
+
+	transport := mailer.NewSMTPMailer(mailer.SMTPConfig{Host: host, Port: port, Timeout: 5 * time.Second})
+	outbox := NewOutbox(NewMailRepository(client), transport, "Keeper <no-reply@example.com>")
+	ctx := context.Background()
+
+	err = outbox.Enqueue(ctx, nil, TemplatePasswordReset, "ada@example.com", TemplateData{
+		AppName:   "Keeper",
+		Firstname: "Ada",
+		Email:     "ada@example.com",
+		Token:     "smtp-token",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.NoError(t, err)
+
+	n, err := outbox.Deliver(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	server.mu.Lock()
+	assert.Equal(t, "MAIL FROM:<no-reply@example.com> BODY=8BITMIME", server.from)
+	assert.Equal(t, []string{"RCPT TO:<ada@example.com>"}, server.rcpt)
+	if assert.Len(t, server.messages, 1) {
+		msg := server.messages[0]
+		assert.Contains(t, msg, "Subject: Reset your Keeper password")
+		assert.Contains(t, msg, "Content-Type: multipart/alternative")
+		assert.Contains(t, msg, "smtp-token")
+	}
+	server.mu.Unlock()
+
+	t.Run("Unreachable", func(t *testing.T) {
+		_ = server.ln.Close()
+		err := outbox.Enqueue(ctx, nil, TemplatePasswordReset, "bob@example.com", TemplateData{AppName: "Keeper", Token: "t"})
+		assert.NoError(t, err)
+
+		n, err := outbox.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+
+		m, err := client.OutboundEmail.Query().Where(outboundemail.RecipientEQ("bob@example.com")).Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, outboundemail.StatusPending, m.Status)
+		assert.Contains(t, m.LastError, "connect")
+	})
+}
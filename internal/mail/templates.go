@@ -0,0 +1,71 @@
+package mail
+
+import (
+	"keeper/pkg/mailer"
+)
+
+// Names of the built-in templates.
+const (
+	TemplatePasswordReset     = "password_reset"
+	TemplateEmailVerification = "email_verification"
+	TemplateMagicLink         = "magic_link"
+)
+
+// defaultTemplates are used for apps that do not override them.
+var defaultTemplates = map[string]mailer.Template{
+	TemplatePasswordReset: {
+		Subject: "Reset your {{.AppName}} password",
+		HTML: `<p>Hello {{.Firstname}},</p>
+<p>We received a request to reset the password of your {{.AppName}} account. Use this code to choose a new password:</p>
+<p><strong>{{.Token}}</strong></p>
+<p>The code expires at {{.ExpiresAt.Format "2006-01-02 15:04 MST"}}. If you did not ask to reset your password, you can ignore this email.</p>`,
+		Text: `Hello {{.Firstname}},
+
+We received a request to reset the password of your {{.AppName}} account. Use this code to choose a new password:
+
+{{.Token}}
+
+The code expires at {{.ExpiresAt.Format "2006-01-02 15:04 MST"}}. If you did not ask to reset your password, you can ignore this email.
+`,
+	},
+	TemplateEmailVerification: {
+		Subject: "Verify your email for {{.AppName}}",
+		HTML: `<p>Hello {{.Firstname}},</p>
+<p>Please confirm that {{.Email}} is your email address with this code:</p>
+<p><strong>{{.Token}}</strong></p>
+<p>The code expires at {{.ExpiresAt.Format "2006-01-02 15:04 MST"}}.</p>`,
+		Text: `Hello {{.Firstname}},
+
+Please confirm that {{.Email}} is your email address with this code:
+
+{{.Token}}
+
+The code expires at {{.ExpiresAt.Format "2006-01-02 15:04 MST"}}.
+`,
+	},
+	TemplateMagicLink: {
+		Subject: "Sign in to {{.AppName}}",
+		HTML: `<p>Hello {{.Firstname}},</p>
+<p>Use this link to sign in to your {{.AppName}} account. It only works once, in the browser you asked for it from:</p>
+<p><a href="{{.Link}}">Sign in to {{.AppName}}</a></p>
+<p>The link expires at {{.ExpiresAt.Format "2006-01-02 15:04 MST"}}. If you did not ask to sign in, you can ignore this email.</p>`,
+		Text: `Hello {{.Firstname}},
+
+Use this link to sign in to your {{.AppName}} account. It only works once, in the browser you asked for it from:
+
+{{.Link}}
+
+The link expires at {{.ExpiresAt.Format "2006-01-02 15:04 MST"}}. If you did not ask to sign in, you can ignore this email.
+`,
+	},
+}
+
+// sampleData is used to check that template overrides render.
+var sampleData = TemplateData{
+	AppName:   "Example",
+	Firstname: "Ada",
+	Lastname:  "Lovelace",
+	Email:     "ada@example.com",
+	Token:     "sample-token",
+	Link:      "https://example.com/login?token=sample-token",
+}
@@ -0,0 +1,469 @@
+package oauth
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	"github.com/go-chi/chi/v5"
+)
+
+//go:embed templates/*.html
+var templates embed.FS
+
+var loginTemplate = template.Must(template.ParseFS(templates, "templates/login.html"))
+
+// OAuthHandler handles the OpenID Connect provider endpoints.
+type OAuthHandler struct {
+	svc OAuthService
+}
+
+// NewOAuthHandler creates a new OAuth handler.
+func NewOAuthHandler(svc OAuthService) *OAuthHandler {
+	return &OAuthHandler{svc: svc}
+}
+
+// Routes returns the chi router for the /oauth endpoints.
+func (h *OAuthHandler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/token", h.Token)
+	r.Post("/introspect", h.Introspect)
+	r.Post("/revoke", h.Revoke)
+
+	return r
+}
+
+// loginPage is the data rendered by the login template.
+type loginPage struct {
+	Action     string
+	ClientName string
+	Request    *AuthorizeRequest
+	Email      string
+	MFAToken   string
+	Error      string
+}
+
+// Discovery godoc
+// @Summary OpenID Connect discovery
+// @Description Get the OpenID Provider configuration document
+// @Tags oauth
+// @Produce json
+// @Success 200 {object} ProviderMetadata
+// @Router /.well-known/openid-configuration [get]
+func (h *OAuthHandler) Discovery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, h.svc.Metadata())
+}
+
+// Authorize godoc
+// @Summary Authorization endpoint
+// @Description Start an authorization code flow with PKCE and show the login page
+// @Tags oauth
+// @Produce html
+// @Param response_type query string true "Must be code"
+// @Param client_id query string true "Client ID of the app"
+// @Param redirect_uri query string true "Registered redirect URI"
+// @Param scope query string false "Space separated scopes, e.g. openid profile email"
+// @Param state query string false "Opaque value returned to the client"
+// @Param nonce query string false "Value copied into the ID token"
+// @Param code_challenge query string true "PKCE code challenge"
+// @Param code_challenge_method query string true "Must be S256"
+// @Success 200 "Login page"
+// @Failure 302 "Redirect to the client with an error"
+// @Failure 400 "Error page"
+// @Router /authorize [get]
+func (h *OAuthHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	req := authorizeRequestFromForm(r.URL.Query())
+
+	client, err := h.svc.ValidateAuthorize(r.Context(), req)
+	if err != nil {
+		h.authorizeError(w, r, req, client, err)
+		return
+	}
+
+	h.renderLogin(w, http.StatusOK, loginPage{ClientName: client.Name, Request: &req})
+}
+
+// Login godoc
+// @Summary Submit the login form
+// @Description Authenticate the user and redirect back to the client with an authorization code. Users who need a second factor are shown a code step, which posts the mfa_token and code back to this endpoint.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce html
+// @Param email formData string false "Email"
+// @Param password formData string false "Password"
+// @Param mfa_token formData string false "MFA token of the code step"
+// @Param code formData string false "TOTP or recovery code"
+// @Param response_type formData string true "Must be code"
+// @Param client_id formData string true "Client ID of the app"
+// @Param redirect_uri formData string true "Registered redirect URI"
+// @Param scope formData string false "Space separated scopes"
+// @Param state formData string false "Opaque value returned to the client"
+// @Param nonce formData string false "Value copied into the ID token"
+// @Param code_challenge formData string true "PKCE code challenge"
+// @Param code_challenge_method formData string true "Must be S256"
+// @Success 302 "Redirect to the client with an authorization code"
+// @Success 200 "Code step of the login page"
+// @Failure 400 "Error page"
+// @Failure 401 "Login page with an error"
+// @Failure 403 "Login page asking to verify the email or set up MFA"
+// @Router /authorize [post]
+func (h *OAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		h.renderLogin(w, http.StatusBadRequest, loginPage{Error: "Invalid request."})
+		return
+	}
+	req := authorizeRequestFromForm(r.PostForm)
+	if token := r.PostForm.Get("mfa_token"); token != "" {
+		h.loginMFA(w, r, req, token)
+		return
+	}
+	email := r.PostForm.Get("email")
+
+	redirect, challenge, err := h.svc.Authorize(r.Context(), req, email, r.PostForm.Get("password"))
+	if err != nil {
+		// Authorize validates the request before the credentials, so the
+		// client is known whenever the credentials are what failed.
+		client, verr := h.svc.ValidateAuthorize(r.Context(), req)
+		if verr == nil && errors.Is(err, user.ErrInvalidCredentials) {
+			h.renderLogin(w, http.StatusUnauthorized, loginPage{
+				ClientName: client.Name,
+				Request:    &req,
+				Email:      email,
+				Error:      "Invalid email or password.",
+			})
+			return
+		}
+		if verr == nil && errors.Is(err, user.ErrEmailNotVerified) {
+			h.renderLogin(w, http.StatusForbidden, loginPage{
+				ClientName: client.Name,
+				Request:    &req,
+				Email:      email,
+				Error:      "Please verify your email before signing in.",
+			})
+			return
+		}
+		if verr == nil && errors.Is(err, user.ErrMFAEnrollmentRequired) {
+			h.renderLogin(w, http.StatusForbidden, loginPage{
+				ClientName: client.Name,
+				Request:    &req,
+				Email:      email,
+				Error:      "Please set up two-factor authentication before signing in.",
+			})
+			return
+		}
+		if verr == nil && errors.Is(err, user.ErrPasswordExpired) {
+			h.renderLogin(w, http.StatusForbidden, loginPage{
+				ClientName: client.Name,
+				Request:    &req,
+				Email:      email,
+				Error:      "Your password has expired. Please change it before signing in.",
+			})
+			return
+		}
+		h.authorizeError(w, r, req, client, err)
+		return
+	}
+
+	if challenge != nil {
+		client, err := h.svc.ValidateAuthorize(r.Context(), req)
+		if err != nil {
+			h.authorizeError(w, r, req, client, err)
+			return
+		}
+		h.renderLogin(w, http.StatusOK, loginPage{
+			ClientName: client.Name,
+			Request:    &req,
+			MFAToken:   challenge.Token,
+		})
+		return
+	}
+
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// loginMFA completes a sign-in with the code entered on the code step of the
+// login page.
+func (h *OAuthHandler) loginMFA(w http.ResponseWriter, r *http.Request, req AuthorizeRequest, token string) {
+	redirect, err := h.svc.AuthorizeMFA(r.Context(), req, token, r.PostForm.Get("code"))
+	if err != nil {
+		client, verr := h.svc.ValidateAuthorize(r.Context(), req)
+		if verr == nil && errors.Is(err, user.ErrInvalidMFACode) {
+			h.renderLogin(w, http.StatusUnauthorized, loginPage{
+				ClientName: client.Name,
+				Request:    &req,
+				MFAToken:   token,
+				Error:      "Invalid code.",
+			})
+			return
+		}
+		if verr == nil && errors.Is(err, user.ErrInvalidMFAToken) {
+			h.renderLogin(w, http.StatusUnauthorized, loginPage{
+				ClientName: client.Name,
+				Request:    &req,
+				Error:      "Your sign-in has expired. Please sign in again.",
+			})
+			return
+		}
+		h.authorizeError(w, r, req, client, err)
+		return
+	}
+
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+// authorizeError sends an authorization error back to the client, or shows it
+// to the user when the client or its redirect URI is not trusted.
+func (h *OAuthHandler) authorizeError(w http.ResponseWriter, r *http.Request, req AuthorizeRequest, client *Client, err error) {
+	oe, ok := IsOAuthError(err)
+	if !ok {
+		slog.Error("authorization request failed", "client_id", req.ClientID, "error", err)
+		oe = newError("server_error", "")
+	}
+
+	if client == nil {
+		slog.Warn("invalid authorization request", "client_id", req.ClientID, "error", oe)
+		h.renderLogin(w, http.StatusBadRequest, loginPage{Error: oe.Description})
+		return
+	}
+
+	http.Redirect(w, r, RedirectURL(req.RedirectURI, url.Values{
+		"error":             {oe.Code},
+		"error_description": {oe.Description},
+		"state":             {req.State},
+	}), http.StatusFound)
+}
+
+func (h *OAuthHandler) renderLogin(w http.ResponseWriter, status int, page loginPage) {
+	if page.Action == "" {
+		page.Action = "/authorize"
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("Content-Security-Policy", "frame-ancestors 'none'")
+	w.WriteHeader(status)
+	if err := loginTemplate.Execute(w, page); err != nil {
+		slog.Error("failed to render login page", "error", err)
+	}
+}
+
+// Token godoc
+// @Summary Token endpoint
+// @Description Exchange an authorization code, refresh token or client credentials for tokens. An ID token is included when the openid scope was granted.
+// @Description Confidential clients authenticate with HTTP Basic or client_secret.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param grant_type formData string true "authorization_code, refresh_token or client_credentials"
+// @Param client_id formData string false "Client ID of the app, unless sent with HTTP Basic"
+// @Param client_secret formData string false "Client secret, unless sent with HTTP Basic"
+// @Param scope formData string false "Scopes requested with client_credentials; defaults to all scopes of the app"
+// @Param code formData string false "Authorization code"
+// @Param redirect_uri formData string false "Redirect URI used in the authorization request"
+// @Param code_verifier formData string false "PKCE code verifier"
+// @Param refresh_token formData string false "Refresh token"
+// @Success 200 {object} TokenResponse
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Router /token [post]
+// @Router /oauth/token [post]
+func (h *OAuthHandler) Token(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, newError("invalid_request", "invalid form body"))
+		return
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+	req := TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scope:        r.PostForm.Get("scope"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+	}
+	res, err := h.svc.Token(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// Introspect godoc
+// @Summary Token introspection
+// @Description Describe an access or refresh token issued to the calling app (RFC 7662). The app must authenticate with its client secret.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param token formData string true "Token to introspect"
+// @Param token_type_hint formData string false "access_token or refresh_token"
+// @Param client_id formData string false "Client ID of the app, unless sent with HTTP Basic"
+// @Param client_secret formData string false "Client secret, unless sent with HTTP Basic"
+// @Success 200 {object} IntrospectionResponse
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Router /oauth/introspect [post]
+func (h *OAuthHandler) Introspect(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	req, ok := tokenHintRequest(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.Introspect(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// Revoke godoc
+// @Summary Token revocation
+// @Description Revoke an access token, or a refresh token and every token rotated from the same login (RFC 7009). Unknown tokens are ignored.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param token formData string true "Token to revoke"
+// @Param token_type_hint formData string false "access_token or refresh_token"
+// @Param client_id formData string false "Client ID of the app, unless sent with HTTP Basic"
+// @Param client_secret formData string false "Client secret for confidential clients, unless sent with HTTP Basic"
+// @Success 200 "OK"
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Router /oauth/revoke [post]
+func (h *OAuthHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	req, ok := tokenHintRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.Revoke(r.Context(), req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// tokenHintRequest parses an introspection or revocation request, writing an
+// error response when it is malformed.
+func tokenHintRequest(w http.ResponseWriter, r *http.Request) (TokenHintRequest, bool) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, newError("invalid_request", "invalid form body"))
+		return TokenHintRequest{}, false
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+	req := TokenHintRequest{
+		ClientID:      clientID,
+		ClientSecret:  clientSecret,
+		Token:         r.PostForm.Get("token"),
+		TokenTypeHint: r.PostForm.Get("token_type_hint"),
+	}
+	if req.Token == "" {
+		writeError(w, newError("invalid_request", "token is required"))
+		return TokenHintRequest{}, false
+	}
+	return req, true
+}
+
+// clientCredentials returns the client ID and secret sent with HTTP Basic
+// authentication or, failing that, in the form body.
+func clientCredentials(r *http.Request) (string, string) {
+	if id, secret, ok := r.BasicAuth(); ok {
+		// client_secret_basic form-encodes both values (RFC 6749 section 2.3.1).
+		id, _ = url.QueryUnescape(id)
+		secret, _ = url.QueryUnescape(secret)
+		return id, secret
+	}
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+}
+
+// UserInfo godoc
+// @Summary UserInfo endpoint
+// @Description Get the claims about the user the access token was issued to. Requires the openid scope.
+// @Tags oauth
+// @Produce json
+// @Success 200 {object} UserInfo
+// @Failure 401 "Missing or invalid access token"
+// @Failure 403 {object} Error
+// @Security Bearer
+// @Router /userinfo [get]
+func (h *OAuthHandler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		writeError(w, newError("invalid_token", ""))
+		return
+	}
+
+	info, err := h.svc.UserInfo(r.Context(), claims)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+func authorizeRequestFromForm(v url.Values) AuthorizeRequest {
+	return AuthorizeRequest{
+		ResponseType:        v.Get("response_type"),
+		ClientID:            v.Get("client_id"),
+		RedirectURI:         v.Get("redirect_uri"),
+		Scope:               v.Get("scope"),
+		State:               v.Get("state"),
+		Nonce:               v.Get("nonce"),
+		CodeChallenge:       v.Get("code_challenge"),
+		CodeChallengeMethod: v.Get("code_challenge_method"),
+	}
+}
+
+// writeJSON writes a bare JSON document, as OAuth clients do not expect the
+// render.Response envelope.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an OAuth error response with the status its code calls for.
+func writeError(w http.ResponseWriter, err error) {
+	oe, ok := IsOAuthError(err)
+	if !ok {
+		slog.Error("oauth request failed", "error", err)
+		writeJSON(w, http.StatusInternalServerError, newError("server_error", ""))
+		return
+	}
+
+	status := http.StatusBadRequest
+	switch oe.Code {
+	case "invalid_client":
+		status = http.StatusUnauthorized
+	case "invalid_token":
+		status = http.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	case "insufficient_scope":
+		status = http.StatusForbidden
+		w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="openid"`)
+	}
+	writeJSON(w, status, oe)
+}
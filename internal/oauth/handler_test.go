@@ -0,0 +1,281 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockService struct {
+	mock.Mock
+}
+
+func (m *mockService) Metadata() ProviderMetadata {
+	args := m.Called()
+	return args.Get(0).(ProviderMetadata)
+}
+
+func (m *mockService) ValidateAuthorize(ctx context.Context, req AuthorizeRequest) (*Client, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Client), args.Error(1)
+}
+
+func (m *mockService) Authorize(ctx context.Context, req AuthorizeRequest, email, password string) (string, *user.MFAChallenge, error) {
+	args := m.Called(ctx, req, email, password)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*user.MFAChallenge), args.Error(2)
+}
+
+func (m *mockService) AuthorizeMFA(ctx context.Context, req AuthorizeRequest, mfaToken, code string) (string, error) {
+	args := m.Called(ctx, req, mfaToken, code)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockService) Token(ctx context.Context, req TokenRequest) (*TokenResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*TokenResponse), args.Error(1)
+}
+
+func (m *mockService) UserInfo(ctx context.Context, claims *auth.UserClaims) (*UserInfo, error) {
+	args := m.Called(ctx, claims)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserInfo), args.Error(1)
+}
+
+func (m *mockService) Introspect(ctx context.Context, req TokenHintRequest) (*IntrospectionResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*IntrospectionResponse), args.Error(1)
+}
+
+func (m *mockService) Revoke(ctx context.Context, req TokenHintRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func TestHandler_Authorize(t *testing.T) {
+	client := &Client{ID: 1, ClientID: "abc", Name: "Test App"}
+
+	t.Run("ShowsLoginPage", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewOAuthHandler(svc)
+		svc.On("ValidateAuthorize", mock.Anything, mock.Anything).Return(client, nil)
+
+		req, _ := http.NewRequest("GET", "/authorize?client_id=abc&response_type=code", nil)
+		rr := httptest.NewRecorder()
+		handler.Authorize(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Sign in to Test App")
+		assert.Equal(t, "DENY", rr.Header().Get("X-Frame-Options"))
+	})
+
+	t.Run("UntrustedRedirectShowsError", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewOAuthHandler(svc)
+		svc.On("ValidateAuthorize", mock.Anything, mock.Anything).Return(nil, newError("invalid_client", "unknown client"))
+
+		req, _ := http.NewRequest("GET", "/authorize?client_id=nope&redirect_uri=https://evil.example.com", nil)
+		rr := httptest.NewRecorder()
+		handler.Authorize(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		assert.Empty(t, rr.Header().Get("Location"))
+	})
+
+	t.Run("RedirectsErrorsToClient", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewOAuthHandler(svc)
+		svc.On("ValidateAuthorize", mock.Anything, mock.Anything).Return(client, newError("invalid_scope", ""))
+
+		req, _ := http.NewRequest("GET", "/authorize?client_id=abc&redirect_uri=https://app.example.com/cb&state=s1", nil)
+		rr := httptest.NewRecorder()
+		handler.Authorize(rr, req)
+
+		assert.Equal(t, http.StatusFound, rr.Code)
+		assert.Equal(t, "https://app.example.com/cb?error=invalid_scope&state=s1", rr.Header().Get("Location"))
+	})
+}
+
+func TestHandler_Login(t *testing.T) {
+	client := &Client{ID: 1, ClientID: "abc", Name: "Test App"}
+	form := url.Values{
+		"client_id":    {"abc"},
+		"redirect_uri": {"https://app.example.com/cb"},
+		"email":        {"ada@example.com"},
+		"password":     {"password123"},
+	}
+
+	newRequest := func() *http.Request {
+		req, _ := http.NewRequest("POST", "/authorize", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewOAuthHandler(svc)
+		svc.On("Authorize", mock.Anything, mock.Anything, "ada@example.com", "password123").
+			Return("https://app.example.com/cb?code=c1", nil, nil)
+
+		rr := httptest.NewRecorder()
+		handler.Login(rr, newRequest())
+
+		assert.Equal(t, http.StatusFound, rr.Code)
+		assert.Equal(t, "https://app.example.com/cb?code=c1", rr.Header().Get("Location"))
+	})
+
+	t.Run("InvalidCredentials", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewOAuthHandler(svc)
+		svc.On("Authorize", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", nil, user.ErrInvalidCredentials)
+		svc.On("ValidateAuthorize", mock.Anything, mock.Anything).Return(client, nil)
+
+		rr := httptest.NewRecorder()
+		handler.Login(rr, newRequest())
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Invalid email or password.")
+	})
+
+	t.Run("MFAChallenge", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewOAuthHandler(svc)
+		svc.On("Authorize", mock.Anything, mock.Anything, "ada@example.com", "password123").
+			Return("", &user.MFAChallenge{Token: "mfa-token", ExpiresIn: 300}, nil)
+		svc.On("ValidateAuthorize", mock.Anything, mock.Anything).Return(client, nil)
+
+		rr := httptest.NewRecorder()
+		handler.Login(rr, newRequest())
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `name="mfa_token" value="mfa-token"`)
+		assert.NotContains(t, rr.Body.String(), `name="password"`)
+	})
+
+	t.Run("MFACode", func(t *testing.T) {
+		mfaForm := url.Values{
+			"client_id":    {"abc"},
+			"redirect_uri": {"https://app.example.com/cb"},
+			"mfa_token":    {"mfa-token"},
+			"code":         {"123456"},
+		}
+		newMFARequest := func() *http.Request {
+			req, _ := http.NewRequest("POST", "/authorize", strings.NewReader(mfaForm.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return req
+		}
+
+		svc := new(mockService)
+		handler := NewOAuthHandler(svc)
+		svc.On("AuthorizeMFA", mock.Anything, mock.Anything, "mfa-token", "123456").
+			Return("https://app.example.com/cb?code=c1", nil).Once()
+		svc.On("AuthorizeMFA", mock.Anything, mock.Anything, "mfa-token", "123456").
+			Return("", user.ErrInvalidMFACode).Once()
+		svc.On("AuthorizeMFA", mock.Anything, mock.Anything, "mfa-token", "123456").
+			Return("", user.ErrInvalidMFAToken).Once()
+		svc.On("ValidateAuthorize", mock.Anything, mock.Anything).Return(client, nil)
+
+		rr := httptest.NewRecorder()
+		handler.Login(rr, newMFARequest())
+		assert.Equal(t, http.StatusFound, rr.Code)
+		assert.Equal(t, "https://app.example.com/cb?code=c1", rr.Header().Get("Location"))
+
+		rr = httptest.NewRecorder()
+		handler.Login(rr, newMFARequest())
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Invalid code.")
+		assert.Contains(t, rr.Body.String(), `name="mfa_token"`)
+
+		rr = httptest.NewRecorder()
+		handler.Login(rr, newMFARequest())
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		assert.Contains(t, rr.Body.String(), `name="password"`)
+	})
+}
+
+func TestHandler_Token(t *testing.T) {
+	svc := new(mockService)
+	handler := NewOAuthHandler(svc)
+
+	svc.On("Token", mock.Anything, TokenRequest{GrantType: "authorization_code", ClientID: "abc", Code: "good"}).
+		Return(&TokenResponse{AccessToken: "at", TokenType: "Bearer"}, nil)
+	svc.On("Token", mock.Anything, TokenRequest{GrantType: "authorization_code", ClientID: "abc", Code: "bad"}).
+		Return(nil, newError("invalid_grant", ""))
+
+	post := func(code string) *httptest.ResponseRecorder {
+		body := url.Values{"grant_type": {"authorization_code"}, "code": {code}}
+		req, _ := http.NewRequest("POST", "/token", strings.NewReader(body.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("abc", "")
+		rr := httptest.NewRecorder()
+		handler.Token(rr, req)
+		return rr
+	}
+
+	rr := post("good")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "no-store", rr.Header().Get("Cache-Control"))
+	var res TokenResponse
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	assert.Equal(t, "at", res.AccessToken)
+
+	rr = post("bad")
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	var oe Error
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &oe))
+	assert.Equal(t, "invalid_grant", oe.Code)
+}
+
+func TestHandler_IntrospectAndRevoke(t *testing.T) {
+	svc := new(mockService)
+	handler := NewOAuthHandler(svc)
+
+	want := TokenHintRequest{ClientID: "gw", ClientSecret: "s", Token: "t1"}
+	svc.On("Introspect", mock.Anything, want).Return(&IntrospectionResponse{Active: true, AppID: 1}, nil)
+	svc.On("Revoke", mock.Anything, want).Return(nil)
+
+	post := func(path string, form url.Values) *http.Request {
+		req, _ := http.NewRequest("POST", path, strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth("gw", "s")
+		return req
+	}
+
+	rr := httptest.NewRecorder()
+	handler.Introspect(rr, post("/oauth/introspect", url.Values{"token": {"t1"}}))
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var res IntrospectionResponse
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	assert.True(t, res.Active)
+
+	rr = httptest.NewRecorder()
+	handler.Revoke(rr, post("/oauth/revoke", url.Values{"token": {"t1"}}))
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	handler.Introspect(rr, post("/oauth/introspect", url.Values{}))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
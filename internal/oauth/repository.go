@@ -0,0 +1,126 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/app"
+	"keeper/ent/authorizationcode"
+	"keeper/ent/refreshtoken"
+)
+
+// OAuthRepository handles database operations for OAuth clients and grants.
+type OAuthRepository struct {
+	client *ent.Client
+}
+
+// NewOAuthRepository creates a new OAuth repository.
+func NewOAuthRepository(client *ent.Client) *OAuthRepository {
+	return &OAuthRepository{client: client}
+}
+
+// GetAppByClientID retrieves an app by its OAuth client ID.
+func (r *OAuthRepository) GetAppByClientID(ctx context.Context, clientID string) (*ent.App, error) {
+	a, err := r.client.App.Query().
+		Where(app.ClientIDEQ(clientID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("oauth client not found in database", "client_id", clientID)
+			return nil, fmt.Errorf("client not found: %w", err)
+		}
+		slog.Error("database error: failed to get app by client id", "client_id", clientID, "error", err)
+		return nil, err
+	}
+	return a, nil
+}
+
+// CreateAuthorizationCode stores the hash of a newly issued authorization code.
+func (r *OAuthRepository) CreateAuthorizationCode(ctx context.Context, c *ent.AuthorizationCode) (*ent.AuthorizationCode, error) {
+	created, err := r.client.AuthorizationCode.
+		Create().
+		SetCodeHash(c.CodeHash).
+		SetAppID(c.AppID).
+		SetUserID(c.UserID).
+		SetRedirectURI(c.RedirectURI).
+		SetScope(c.Scope).
+		SetNonce(c.Nonce).
+		SetCodeChallenge(c.CodeChallenge).
+		SetCodeChallengeMethod(c.CodeChallengeMethod).
+		SetAuthTime(c.AuthTime).
+		SetExpiresAt(c.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create authorization code", "app_id", c.AppID, "user_id", c.UserID, "error", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetAuthorizationCodeByHash retrieves an authorization code by the hash of its value.
+func (r *OAuthRepository) GetAuthorizationCodeByHash(ctx context.Context, codeHash string) (*ent.AuthorizationCode, error) {
+	c, err := r.client.AuthorizationCode.Query().
+		Where(authorizationcode.CodeHashEQ(codeHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("authorization code not found in database")
+			return nil, fmt.Errorf("authorization code not found: %w", err)
+		}
+		slog.Error("database error: failed to get authorization code", "error", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// MarkAuthorizationCodeUsed flags an authorization code as redeemed. It reports
+// false when the code had already been redeemed by a concurrent request.
+func (r *OAuthRepository) MarkAuthorizationCodeUsed(ctx context.Context, id int) (bool, error) {
+	n, err := r.client.AuthorizationCode.Update().
+		Where(
+			authorizationcode.IDEQ(id),
+			authorizationcode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark authorization code used", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// GetRefreshTokenByHash retrieves a refresh token and its user by the hash of its value.
+func (r *OAuthRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*ent.RefreshToken, error) {
+	rt, err := r.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHashEQ(tokenHash)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("refresh token not found: %w", err)
+		}
+		slog.Error("database error: failed to get refresh token", "error", err)
+		return nil, err
+	}
+	return rt, nil
+}
+
+// RevokeRefreshTokenFamily revokes every refresh token that descends from the same login.
+func (r *OAuthRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	_, err := r.client.RefreshToken.Update().
+		Where(
+			refreshtoken.FamilyIDEQ(familyID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to revoke refresh token family", "family_id", familyID, "error", err)
+		return err
+	}
+	return nil
+}
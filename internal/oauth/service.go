@@ -0,0 +1,565 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"keeper/ent"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OAuthService defines the business logic of the OpenID Connect provider.
+type OAuthService interface {
+	Metadata() ProviderMetadata
+	ValidateAuthorize(ctx context.Context, req AuthorizeRequest) (*Client, error)
+	Authorize(ctx context.Context, req AuthorizeRequest, email, password string) (string, *user.MFAChallenge, error)
+	AuthorizeMFA(ctx context.Context, req AuthorizeRequest, mfaToken, code string) (string, error)
+	Token(ctx context.Context, req TokenRequest) (*TokenResponse, error)
+	UserInfo(ctx context.Context, claims *auth.UserClaims) (*UserInfo, error)
+	Introspect(ctx context.Context, req TokenHintRequest) (*IntrospectionResponse, error)
+	Revoke(ctx context.Context, req TokenHintRequest) error
+}
+
+// AuthorizationCodeExpiry is how long an authorization code can be redeemed.
+const AuthorizationCodeExpiry = time.Minute
+
+// Scopes understood by the provider.
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+var supportedScopes = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+
+type oauthService struct {
+	repo  *OAuthRepository
+	users user.UserService
+	jwt   *auth.JWTManager
+}
+
+// NewOAuthService creates a new OAuth service. Endpoint URLs are derived from
+// the issuer configured on the JWT manager.
+func NewOAuthService(repo *OAuthRepository, users user.UserService, jwt *auth.JWTManager) OAuthService {
+	return &oauthService{repo: repo, users: users, jwt: jwt}
+}
+
+func (s *oauthService) Metadata() ProviderMetadata {
+	issuer := s.jwt.Issuer()
+	return ProviderMetadata{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/authorize",
+		TokenEndpoint:                     issuer + "/token",
+		UserinfoEndpoint:                  issuer + "/userinfo",
+		JWKSURI:                           issuer + "/.well-known/jwks.json",
+		ScopesSupported:                   supportedScopes,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token", "client_credentials"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  s.signingAlgorithms(),
+		TokenEndpointAuthMethodsSupported: []string{"none", "client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		IntrospectionEndpoint:             issuer + "/oauth/introspect",
+		RevocationEndpoint:                issuer + "/oauth/revoke",
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "name", "given_name", "family_name", "email", "email_verified"},
+	}
+}
+
+// signingAlgorithms lists the algorithms of the keys that sign or may soon sign tokens.
+func (s *oauthService) signingAlgorithms() []string {
+	var algs []string
+	for _, k := range s.jwt.Keyring().States(time.Now()) {
+		if k.Status == auth.KeyStatusExpired {
+			continue
+		}
+		if alg := k.Signer.Method().Alg(); !slices.Contains(algs, alg) {
+			algs = append(algs, alg)
+		}
+	}
+	return algs
+}
+
+// ValidateAuthorize checks an authorization request. When the returned client is
+// nil the redirect URI could not be trusted and the error must be shown to the
+// user instead of being sent back to the client.
+func (s *oauthService) ValidateAuthorize(ctx context.Context, req AuthorizeRequest) (*Client, error) {
+	a, err := s.repo.GetAppByClientID(ctx, req.ClientID)
+	if err != nil || a.Status != 1 {
+		return nil, newError("invalid_client", "unknown client")
+	}
+	if req.RedirectURI == "" || !slices.Contains(a.RedirectUris, req.RedirectURI) {
+		return nil, newError("invalid_request", "redirect_uri is not registered for this client")
+	}
+	client := toClient(a)
+
+	if req.ResponseType != "code" {
+		return client, newError("unsupported_response_type", "only the code response type is supported")
+	}
+	for _, scope := range strings.Fields(req.Scope) {
+		if !slices.Contains(supportedScopes, scope) {
+			return client, newError("invalid_scope", fmt.Sprintf("unsupported scope %q", scope))
+		}
+	}
+	if req.CodeChallenge == "" {
+		return client, newError("invalid_request", "code_challenge is required")
+	}
+	if req.CodeChallengeMethod != "S256" {
+		return client, newError("invalid_request", "code_challenge_method must be S256")
+	}
+
+	return client, nil
+}
+
+// Authorize signs the user in and returns the client redirect URL carrying a
+// new authorization code. Users who need a second factor get an MFA challenge
+// instead, to complete with AuthorizeMFA.
+func (s *oauthService) Authorize(ctx context.Context, req AuthorizeRequest, email, password string) (string, *user.MFAChallenge, error) {
+	client, err := s.ValidateAuthorize(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	u, err := s.users.VerifyCredentials(ctx, email, password)
+	if err != nil {
+		return "", nil, err
+	}
+	if u.AppID != client.ID {
+		slog.Warn("authorization failed: user belongs to another app", "user_id", u.ID, "client_id", client.ClientID)
+		return "", nil, user.ErrInvalidCredentials
+	}
+
+	challenge, err := s.users.ChallengeMFA(ctx, u.ID)
+	if err != nil {
+		return "", nil, err
+	}
+	if challenge != nil {
+		if challenge.EnrollmentRequired {
+			slog.Warn("authorization failed: mfa enrolment required", "user_id", u.ID, "client_id", client.ClientID)
+			return "", nil, user.ErrMFAEnrollmentRequired
+		}
+		return "", challenge, nil
+	}
+
+	redirect, err := s.issueCode(ctx, client, req, u.ID)
+	return redirect, nil, err
+}
+
+// AuthorizeMFA completes a sign-in started by Authorize with the user's TOTP
+// or recovery code and returns the client redirect URL carrying a new
+// authorization code.
+func (s *oauthService) AuthorizeMFA(ctx context.Context, req AuthorizeRequest, mfaToken, code string) (string, error) {
+	client, err := s.ValidateAuthorize(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := s.users.VerifyMFA(ctx, mfaToken, code)
+	if err != nil {
+		return "", err
+	}
+	if u.AppID != client.ID {
+		slog.Warn("authorization failed: user belongs to another app", "user_id", u.ID, "client_id", client.ClientID)
+		return "", user.ErrInvalidMFAToken
+	}
+
+	return s.issueCode(ctx, client, req, u.ID)
+}
+
+// issueCode stores a new authorization code for userID and returns the
+// client redirect URL carrying it.
+func (s *oauthService) issueCode(ctx context.Context, client *Client, req AuthorizeRequest, userID int) (string, error) {
+	code, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("generate authorization code: %w", err)
+	}
+
+	now := time.Now()
+	_, err = s.repo.CreateAuthorizationCode(ctx, &ent.AuthorizationCode{
+		CodeHash:            auth.HashToken(code),
+		AppID:               client.ID,
+		UserID:              userID,
+		RedirectURI:         req.RedirectURI,
+		Scope:               req.Scope,
+		Nonce:               req.Nonce,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		AuthTime:            now,
+		ExpiresAt:           now.Add(AuthorizationCodeExpiry),
+	})
+	if err != nil {
+		return "", fmt.Errorf("store authorization code: %w", err)
+	}
+
+	slog.Info("authorization code issued", "user_id", userID, "client_id", client.ClientID)
+	return RedirectURL(req.RedirectURI, url.Values{
+		"code":  {code},
+		"state": {req.State},
+		"iss":   {s.jwt.Issuer()},
+	}), nil
+}
+
+func (s *oauthService) Token(ctx context.Context, req TokenRequest) (*TokenResponse, error) {
+	a, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GrantType {
+	case "authorization_code":
+		return s.exchangeCode(ctx, a, req)
+	case "refresh_token":
+		return s.refresh(ctx, a, req)
+	case "client_credentials":
+		return s.clientCredentials(a, req)
+	default:
+		return nil, newError("unsupported_grant_type", "")
+	}
+}
+
+// authenticateClient looks up the calling app. Apps with a client secret are
+// confidential clients and must present it; apps without one are public clients.
+func (s *oauthService) authenticateClient(ctx context.Context, clientID, clientSecret string) (*ent.App, error) {
+	a, err := s.repo.GetAppByClientID(ctx, clientID)
+	if err != nil || a.Status != 1 {
+		return nil, newError("invalid_client", "unknown client")
+	}
+
+	if a.ClientSecretHash != nil {
+		presented := auth.HashToken(clientSecret)
+		if clientSecret == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(*a.ClientSecretHash)) != 1 {
+			slog.Warn("client authentication failed", "client_id", a.ClientID)
+			return nil, newError("invalid_client", "client authentication failed")
+		}
+	}
+
+	return a, nil
+}
+
+// clientCredentials issues an access token that identifies the app itself.
+func (s *oauthService) clientCredentials(a *ent.App, req TokenRequest) (*TokenResponse, error) {
+	if a.ClientSecretHash == nil {
+		return nil, newError("unauthorized_client", "the client credentials grant requires a client secret")
+	}
+
+	scope := strings.Join(a.Scopes, " ")
+	if req.Scope != "" {
+		for _, sc := range strings.Fields(req.Scope) {
+			if !slices.Contains(a.Scopes, sc) {
+				return nil, newError("invalid_scope", fmt.Sprintf("scope %q is not allowed for this client", sc))
+			}
+		}
+		scope = strings.Join(strings.Fields(req.Scope), " ")
+	}
+
+	token, err := s.jwt.Issue(auth.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: a.ClientID},
+		AppID:            a.ID,
+		ClientID:         a.ClientID,
+		Scope:            scope,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate token: %w", err)
+	}
+
+	slog.Info("client credentials token issued", "client_id", a.ClientID, "scope", scope)
+	return &TokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(s.jwt.TokenDuration().Seconds()),
+		Scope:       scope,
+	}, nil
+}
+
+func (s *oauthService) exchangeCode(ctx context.Context, a *ent.App, req TokenRequest) (*TokenResponse, error) {
+	invalidGrant := newError("invalid_grant", "authorization code is invalid, expired or already used")
+
+	c, err := s.repo.GetAuthorizationCodeByHash(ctx, auth.HashToken(req.Code))
+	if err != nil {
+		return nil, invalidGrant
+	}
+	if c.UsedAt != nil || time.Now().After(c.ExpiresAt) || c.AppID != a.ID {
+		slog.Warn("token exchange failed: authorization code rejected", "client_id", a.ClientID, "code_id", c.ID)
+		return nil, invalidGrant
+	}
+	if c.RedirectURI != req.RedirectURI {
+		return nil, newError("invalid_grant", "redirect_uri does not match the authorization request")
+	}
+	if !verifyCodeChallenge(c.CodeChallenge, req.CodeVerifier) {
+		return nil, newError("invalid_grant", "code_verifier does not match the code challenge")
+	}
+
+	ok, err := s.repo.MarkAuthorizationCodeUsed(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("mark authorization code used: %w", err)
+	}
+	if !ok {
+		return nil, invalidGrant
+	}
+
+	res, err := s.users.IssueTokens(ctx, c.UserID, c.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("authorization code exchanged", "user_id", c.UserID, "client_id", a.ClientID)
+	return s.tokenResponse(a, res, c.Nonce, c.AuthTime)
+}
+
+func (s *oauthService) refresh(ctx context.Context, a *ent.App, req TokenRequest) (*TokenResponse, error) {
+	// The token must be of the client's users; that is checked before it is
+	// rotated, so that a client cannot burn another client's tokens.
+	res, err := s.users.Refresh(ctx, user.RefreshRequest{RefreshToken: req.RefreshToken, AppID: a.ID})
+	if err != nil {
+		slog.Warn("refresh failed", "client_id", a.ClientID, "error", err)
+		return nil, newError("invalid_grant", "refresh token is invalid, expired or was issued to another client")
+	}
+	return s.tokenResponse(a, res, "", time.Time{})
+}
+
+// tokenResponse adds an ID token to the issued tokens when the openid scope was granted.
+func (s *oauthService) tokenResponse(a *ent.App, res *user.AuthResponse, nonce string, authTime time.Time) (*TokenResponse, error) {
+	tr := &TokenResponse{
+		AccessToken:  res.Token,
+		TokenType:    "Bearer",
+		ExpiresIn:    res.ExpiresIn,
+		RefreshToken: res.RefreshToken,
+		Scope:        res.Scope,
+	}
+
+	scopes := strings.Fields(res.Scope)
+	if !slices.Contains(scopes, ScopeOpenID) {
+		return tr, nil
+	}
+
+	now := time.Now()
+	claims := idTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.jwt.Issuer(),
+			Subject:   strconv.Itoa(res.User.ID),
+			Audience:  jwt.ClaimStrings{a.ClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.jwt.TokenDuration())),
+		},
+		Nonce: nonce,
+	}
+	if !authTime.IsZero() {
+		claims.AuthTime = authTime.Unix()
+	}
+	info := userInfo(res.User, scopes)
+	claims.Name = info.Name
+	claims.GivenName = info.GivenName
+	claims.FamilyName = info.FamilyName
+	claims.Email = info.Email
+	claims.EmailVerified = info.EmailVerified
+
+	idToken, err := s.jwt.Sign(claims)
+	if err != nil {
+		return nil, fmt.Errorf("sign id token: %w", err)
+	}
+	tr.IDToken = idToken
+	return tr, nil
+}
+
+func (s *oauthService) UserInfo(ctx context.Context, claims *auth.UserClaims) (*UserInfo, error) {
+	if !claims.HasScope(ScopeOpenID) {
+		return nil, newError("insufficient_scope", "the openid scope is required")
+	}
+
+	u, err := s.users.GetByID(ctx, claims.UserID)
+	if err != nil {
+		return nil, newError("invalid_token", "unknown user")
+	}
+	return userInfo(u, strings.Fields(claims.Scope)), nil
+}
+
+// Introspect describes a token issued to the calling app. Only confidential
+// clients may introspect, and tokens of other apps are reported inactive.
+func (s *oauthService) Introspect(ctx context.Context, req TokenHintRequest) (*IntrospectionResponse, error) {
+	a, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	if a.ClientSecretHash == nil {
+		return nil, newError("invalid_client", "introspection requires client authentication")
+	}
+
+	inactive := &IntrospectionResponse{Active: false}
+
+	if req.TokenTypeHint != "refresh_token" {
+		if claims, err := s.jwt.Validate(ctx, req.Token); err == nil {
+			if claims.AppID != a.ID {
+				slog.Warn("introspection of another app's token", "client_id", a.ClientID, "app_id", claims.AppID)
+				return inactive, nil
+			}
+			return accessTokenIntrospection(a, claims), nil
+		}
+	}
+
+	rt, err := s.repo.GetRefreshTokenByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		return inactive, nil
+	}
+	u := rt.Edges.User
+	if u == nil || u.AppID != a.ID || u.Status != 1 || rt.UsedAt != nil || rt.RevokedAt != nil || time.Now().After(rt.ExpiresAt) {
+		return inactive, nil
+	}
+	if u.TokensValidAfter != nil && rt.CreatedAt.Before(*u.TokensValidAfter) {
+		return inactive, nil
+	}
+
+	return &IntrospectionResponse{
+		Active:    true,
+		Scope:     rt.Scope,
+		ClientID:  a.ClientID,
+		Sub:       strconv.Itoa(u.ID),
+		AppID:     u.AppID,
+		UserID:    u.ID,
+		TokenType: "refresh_token",
+		Exp:       rt.ExpiresAt.Unix(),
+		Iat:       rt.CreatedAt.Unix(),
+		Iss:       s.jwt.Issuer(),
+	}, nil
+}
+
+func accessTokenIntrospection(a *ent.App, claims *auth.UserClaims) *IntrospectionResponse {
+	res := &IntrospectionResponse{
+		Active:    true,
+		Scope:     claims.Scope,
+		ClientID:  a.ClientID,
+		Sub:       claims.Subject,
+		AppID:     claims.AppID,
+		UserID:    claims.UserID,
+		TokenType: "access_token",
+		Iss:       claims.Issuer,
+		Jti:       claims.ID,
+	}
+	if claims.ExpiresAt != nil {
+		res.Exp = claims.ExpiresAt.Unix()
+	}
+	if claims.IssuedAt != nil {
+		res.Iat = claims.IssuedAt.Unix()
+	}
+	return res
+}
+
+// Revoke revokes an access token, or a refresh token together with its family.
+// Unknown and already invalid tokens are ignored, as RFC 7009 requires.
+func (s *oauthService) Revoke(ctx context.Context, req TokenHintRequest) error {
+	a, err := s.authenticateClient(ctx, req.ClientID, req.ClientSecret)
+	if err != nil {
+		return err
+	}
+
+	if req.TokenTypeHint != "refresh_token" {
+		if claims, err := s.jwt.Verify(req.Token); err == nil {
+			if claims.AppID != a.ID {
+				return newError("unauthorized_client", "the token was not issued to this client")
+			}
+			if claims.ID == "" {
+				return newError("unsupported_token_type", "the token cannot be revoked")
+			}
+			if err := s.jwt.Revoke(ctx, claims); err != nil {
+				return fmt.Errorf("revoke access token: %w", err)
+			}
+			slog.Info("access token revoked", "client_id", a.ClientID, "user_id", claims.UserID)
+			return nil
+		}
+	}
+
+	rt, err := s.repo.GetRefreshTokenByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		return nil
+	}
+	if rt.Edges.User == nil || rt.Edges.User.AppID != a.ID {
+		return newError("unauthorized_client", "the token was not issued to this client")
+	}
+	if err := s.repo.RevokeRefreshTokenFamily(ctx, rt.FamilyID); err != nil {
+		return fmt.Errorf("revoke token family: %w", err)
+	}
+
+	slog.Info("refresh token revoked", "client_id", a.ClientID, "user_id", rt.UserID)
+	return nil
+}
+
+// idTokenClaims are the claims of an OpenID Connect ID token.
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	AuthTime      int64  `json:"auth_time,omitempty"`
+	Nonce         string `json:"nonce,omitempty"`
+	Name          string `json:"name,omitempty"`
+	GivenName     string `json:"given_name,omitempty"`
+	FamilyName    string `json:"family_name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+}
+
+// userInfo returns the claims about the user released by the granted scopes.
+func userInfo(u *user.User, scopes []string) *UserInfo {
+	info := &UserInfo{Sub: strconv.Itoa(u.ID)}
+	if slices.Contains(scopes, ScopeProfile) {
+		info.Name = strings.TrimSpace(u.Firstname + " " + u.Lastname)
+		info.GivenName = u.Firstname
+		info.FamilyName = u.Lastname
+	}
+	if slices.Contains(scopes, ScopeEmail) {
+		info.Email = u.Email
+		verified := u.EmailVerifiedAt != nil
+		info.EmailVerified = &verified
+	}
+	return info
+}
+
+// verifyCodeChallenge checks a PKCE code verifier against an S256 challenge (RFC 7636).
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// RedirectURL appends the non-empty params to the query of a redirect URI.
+func RedirectURL(redirectURI string, params url.Values) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	q := u.Query()
+	for k, v := range params {
+		if len(v) > 0 && v[0] != "" {
+			q.Set(k, v[0])
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// IsOAuthError reports whether err is an OAuth protocol error and returns it.
+func IsOAuthError(err error) (*Error, bool) {
+	var oe *Error
+	ok := errors.As(err, &oe)
+	return oe, ok
+}
+
+func toClient(a *ent.App) *Client {
+	return &Client{
+		ID:           a.ID,
+		ClientID:     a.ClientID,
+		Name:         a.Name,
+		RedirectURIs: a.RedirectUris,
+	}
+}
@@ -0,0 +1,410 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"testing"
+	"time"
+
+	"keeper/ent/enttest"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+const testVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk-verifier"
+
+func testChallenge() string {
+	sum := sha256.Sum256([]byte(testVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestService_AuthorizationCodeFlow(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_oauth?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	jwtManager.SetIssuer("https://keeper.example.com")
+	userSvc := user.NewUserService(user.NewUserRepository(client), jwtManager)
+	svc := NewOAuthService(NewOAuthRepository(client), userSvc, jwtManager)
+
+	ctx := context.Background()
+	redirectURI := "https://app.example.com/callback"
+
+	a, err := client.App.Create().SetName("OIDC App").SetRedirectUris([]string{redirectURI}).Save(ctx)
+	assert.NoError(t, err)
+	other, err := client.App.Create().SetName("Other App").Save(ctx)
+	assert.NoError(t, err)
+
+	u, err := userSvc.Create(ctx, user.CreateUserRequest{
+		AppID:     a.ID,
+		Firstname: "Ada",
+		Lastname:  "Lovelace",
+		Email:     "ada@example.com",
+		Password:  "password123",
+	})
+	assert.NoError(t, err)
+	_, err = userSvc.Create(ctx, user.CreateUserRequest{
+		AppID:     other.ID,
+		Firstname: "Other",
+		Lastname:  "User",
+		Email:     "other@example.com",
+		Password:  "password123",
+	})
+	assert.NoError(t, err)
+
+	req := AuthorizeRequest{
+		ResponseType:        "code",
+		ClientID:            a.ClientID,
+		RedirectURI:         redirectURI,
+		Scope:               "openid profile email",
+		State:               "xyz",
+		Nonce:               "n-0S6_WzA2Mj",
+		CodeChallenge:       testChallenge(),
+		CodeChallengeMethod: "S256",
+	}
+
+	authorize := func(t *testing.T) string {
+		redirect, challenge, err := svc.Authorize(ctx, req, "ada@example.com", "password123")
+		assert.NoError(t, err)
+		assert.Nil(t, challenge)
+		parsed, err := url.Parse(redirect)
+		assert.NoError(t, err)
+		assert.Equal(t, "xyz", parsed.Query().Get("state"))
+		return parsed.Query().Get("code")
+	}
+
+	t.Run("ValidateAuthorize", func(t *testing.T) {
+		bad := req
+		bad.RedirectURI = "https://evil.example.com/callback"
+		c, err := svc.ValidateAuthorize(ctx, bad)
+		assert.Nil(t, c)
+		assert.Error(t, err)
+
+		bad = req
+		bad.CodeChallengeMethod = "plain"
+		c, err = svc.ValidateAuthorize(ctx, bad)
+		assert.NotNil(t, c)
+		oe, ok := IsOAuthError(err)
+		assert.True(t, ok)
+		assert.Equal(t, "invalid_request", oe.Code)
+	})
+
+	t.Run("UserOfAnotherApp", func(t *testing.T) {
+		_, _, err := svc.Authorize(ctx, req, "other@example.com", "password123")
+		assert.ErrorIs(t, err, user.ErrInvalidCredentials)
+	})
+
+	t.Run("Exchange", func(t *testing.T) {
+		code := authorize(t)
+
+		res, err := svc.Token(ctx, TokenRequest{
+			GrantType:    "authorization_code",
+			ClientID:     a.ClientID,
+			Code:         code,
+			RedirectURI:  redirectURI,
+			CodeVerifier: testVerifier,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer", res.TokenType)
+		assert.NotEmpty(t, res.RefreshToken)
+		assert.Equal(t, req.Scope, res.Scope)
+
+		idClaims := &idTokenClaims{}
+		_, err = jwt.ParseWithClaims(res.IDToken, idClaims, func(*jwt.Token) (interface{}, error) {
+			return []byte("secret"), nil
+		}, jwt.WithAudience(a.ClientID), jwt.WithIssuer("https://keeper.example.com"))
+		assert.NoError(t, err)
+		assert.Equal(t, req.Nonce, idClaims.Nonce)
+		assert.Equal(t, "ada@example.com", idClaims.Email)
+
+		claims, err := jwtManager.Validate(ctx, res.AccessToken)
+		assert.NoError(t, err)
+		info, err := svc.UserInfo(ctx, claims)
+		assert.NoError(t, err)
+		assert.Equal(t, "Ada Lovelace", info.Name)
+		assert.Equal(t, "ada@example.com", info.Email)
+
+		t.Run("CodeReuse", func(t *testing.T) {
+			_, err := svc.Token(ctx, TokenRequest{
+				GrantType:    "authorization_code",
+				ClientID:     a.ClientID,
+				Code:         code,
+				RedirectURI:  redirectURI,
+				CodeVerifier: testVerifier,
+			})
+			oe, ok := IsOAuthError(err)
+			assert.True(t, ok)
+			assert.Equal(t, "invalid_grant", oe.Code)
+		})
+
+		t.Run("RefreshByAnotherClient", func(t *testing.T) {
+			_, err := svc.Token(ctx, TokenRequest{
+				GrantType:    "refresh_token",
+				ClientID:     other.ClientID,
+				RefreshToken: res.RefreshToken,
+			})
+			oe, ok := IsOAuthError(err)
+			assert.True(t, ok)
+			assert.Equal(t, "invalid_grant", oe.Code)
+			// The token was neither used nor revoked: its client refreshes
+			// with it below.
+		})
+
+		t.Run("RefreshKeepsScope", func(t *testing.T) {
+			refreshed, err := svc.Token(ctx, TokenRequest{
+				GrantType:    "refresh_token",
+				ClientID:     a.ClientID,
+				RefreshToken: res.RefreshToken,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, req.Scope, refreshed.Scope)
+			assert.NotEmpty(t, refreshed.IDToken)
+		})
+	})
+
+	t.Run("WrongVerifier", func(t *testing.T) {
+		code := authorize(t)
+		_, err := svc.Token(ctx, TokenRequest{
+			GrantType:    "authorization_code",
+			ClientID:     a.ClientID,
+			Code:         code,
+			RedirectURI:  redirectURI,
+			CodeVerifier: testVerifier + "x",
+		})
+		oe, ok := IsOAuthError(err)
+		assert.True(t, ok)
+		assert.Equal(t, "invalid_grant", oe.Code)
+	})
+
+	t.Run("UserInfoRequiresOpenID", func(t *testing.T) {
+		_, err := svc.UserInfo(ctx, &auth.UserClaims{AppID: a.ID, UserID: u.ID})
+		oe, ok := IsOAuthError(err)
+		assert.True(t, ok)
+		assert.Equal(t, "insufficient_scope", oe.Code)
+	})
+
+	t.Run("MFA", func(t *testing.T) {
+		g, err := userSvc.Create(ctx, user.CreateUserRequest{
+			AppID:     a.ID,
+			Firstname: "Grace",
+			Lastname:  "Hopper",
+			Email:     "grace@example.com",
+			Password:  "password123",
+		})
+		assert.NoError(t, err)
+		enrollment, err := userSvc.EnrollMFA(ctx, g.ID)
+		assert.NoError(t, err)
+		totp, err := auth.TOTPCode(enrollment.Secret, auth.TOTPStep(time.Now()))
+		assert.NoError(t, err)
+		recovery, err := userSvc.ConfirmMFA(ctx, g.ID, user.MFACodeRequest{Code: totp})
+		assert.NoError(t, err)
+
+		redirect, challenge, err := svc.Authorize(ctx, req, "grace@example.com", "password123")
+		assert.NoError(t, err)
+		assert.Empty(t, redirect)
+		assert.NotNil(t, challenge)
+
+		_, err = svc.AuthorizeMFA(ctx, req, challenge.Token, "000000")
+		assert.ErrorIs(t, err, user.ErrInvalidMFACode)
+
+		// The TOTP code was spent confirming the enrolment.
+		_, err = svc.AuthorizeMFA(ctx, req, challenge.Token, totp)
+		assert.ErrorIs(t, err, user.ErrInvalidMFACode)
+
+		redirect, err = svc.AuthorizeMFA(ctx, req, challenge.Token, recovery.RecoveryCodes[0])
+		assert.NoError(t, err)
+		parsed, err := url.Parse(redirect)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, parsed.Query().Get("code"))
+
+		_, err = svc.AuthorizeMFA(ctx, req, challenge.Token, recovery.RecoveryCodes[1])
+		assert.ErrorIs(t, err, user.ErrInvalidMFAToken)
+
+		t.Run("EnrollmentRequired", func(t *testing.T) {
+			_, err := client.App.UpdateOneID(a.ID).SetRequireMfa(true).Save(ctx)
+			assert.NoError(t, err)
+			defer func() {
+				_, err := client.App.UpdateOneID(a.ID).SetRequireMfa(false).Save(ctx)
+				assert.NoError(t, err)
+			}()
+
+			_, _, err = svc.Authorize(ctx, req, "ada@example.com", "password123")
+			assert.ErrorIs(t, err, user.ErrMFAEnrollmentRequired)
+		})
+	})
+}
+
+func TestService_ClientCredentials(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_oauth_cc?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	repo := NewOAuthRepository(client)
+	jwtManager.SetClientStatusChecker(NewClientStatusChecker(repo))
+	userSvc := user.NewUserService(user.NewUserRepository(client), jwtManager)
+	svc := NewOAuthService(repo, userSvc, jwtManager)
+
+	ctx := context.Background()
+	secret := "s3cr3t"
+
+	a, err := client.App.Create().
+		SetName("Billing Service").
+		SetScopes([]string{"users:read", "apps:read"}).
+		SetClientSecretHash(auth.HashToken(secret)).
+		Save(ctx)
+	assert.NoError(t, err)
+	public, err := client.App.Create().SetName("SPA").Save(ctx)
+	assert.NoError(t, err)
+
+	t.Run("DefaultsToAllScopes", func(t *testing.T) {
+		res, err := svc.Token(ctx, TokenRequest{GrantType: "client_credentials", ClientID: a.ClientID, ClientSecret: secret})
+		assert.NoError(t, err)
+		assert.Equal(t, "users:read apps:read", res.Scope)
+		assert.Empty(t, res.RefreshToken)
+
+		claims, err := jwtManager.Validate(ctx, res.AccessToken)
+		assert.NoError(t, err)
+		assert.True(t, claims.IsClientToken())
+		assert.Equal(t, a.ID, claims.AppID)
+		assert.Equal(t, a.ClientID, claims.Subject)
+
+		t.Run("RejectedOnceAppIsDisabled", func(t *testing.T) {
+			_, err := client.App.UpdateOneID(a.ID).SetStatus(0).Save(ctx)
+			assert.NoError(t, err)
+			defer client.App.UpdateOneID(a.ID).SetStatus(1).ExecX(ctx)
+
+			_, err = jwtManager.Validate(ctx, res.AccessToken)
+			assert.ErrorIs(t, err, ErrClientInactive)
+		})
+	})
+
+	t.Run("NarrowedScope", func(t *testing.T) {
+		res, err := svc.Token(ctx, TokenRequest{GrantType: "client_credentials", ClientID: a.ClientID, ClientSecret: secret, Scope: "users:read"})
+		assert.NoError(t, err)
+		assert.Equal(t, "users:read", res.Scope)
+	})
+
+	tests := []struct {
+		name string
+		req  TokenRequest
+		code string
+	}{
+		{"WrongSecret", TokenRequest{GrantType: "client_credentials", ClientID: a.ClientID, ClientSecret: "nope"}, "invalid_client"},
+		{"MissingSecret", TokenRequest{GrantType: "client_credentials", ClientID: a.ClientID}, "invalid_client"},
+		{"ScopeNotAllowed", TokenRequest{GrantType: "client_credentials", ClientID: a.ClientID, ClientSecret: secret, Scope: "keys:write"}, "invalid_scope"},
+		{"PublicClient", TokenRequest{GrantType: "client_credentials", ClientID: public.ClientID}, "unauthorized_client"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.Token(ctx, tt.req)
+			oe, ok := IsOAuthError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.code, oe.Code)
+		})
+	}
+}
+
+func TestService_IntrospectAndRevoke(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_oauth_introspect?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	jwtManager.SetDenylist(auth.NewMemoryDenylist())
+	userSvc := user.NewUserService(user.NewUserRepository(client), jwtManager)
+	svc := NewOAuthService(NewOAuthRepository(client), userSvc, jwtManager)
+
+	ctx := context.Background()
+	secret := "gateway-secret"
+
+	a, err := client.App.Create().SetName("Gateway").SetClientSecretHash(auth.HashToken(secret)).Save(ctx)
+	assert.NoError(t, err)
+	other, err := client.App.Create().SetName("Other").SetClientSecretHash(auth.HashToken(secret)).Save(ctx)
+	assert.NoError(t, err)
+	public, err := client.App.Create().SetName("Public").Save(ctx)
+	assert.NoError(t, err)
+
+	u, err := userSvc.Create(ctx, user.CreateUserRequest{
+		AppID:     a.ID,
+		Firstname: "Grace",
+		Lastname:  "Hopper",
+		Email:     "grace@example.com",
+		Password:  "password123",
+	})
+	assert.NoError(t, err)
+
+	tokens, err := userSvc.IssueTokens(ctx, u.ID, "openid")
+	assert.NoError(t, err)
+
+	introspect := func(req TokenHintRequest) *IntrospectionResponse {
+		res, err := svc.Introspect(ctx, req)
+		assert.NoError(t, err)
+		return res
+	}
+
+	t.Run("AccessToken", func(t *testing.T) {
+		res := introspect(TokenHintRequest{ClientID: a.ClientID, ClientSecret: secret, Token: tokens.Token})
+		assert.True(t, res.Active)
+		assert.Equal(t, "access_token", res.TokenType)
+		assert.Equal(t, a.ID, res.AppID)
+		assert.Equal(t, u.ID, res.UserID)
+		assert.Equal(t, "openid", res.Scope)
+		assert.NotZero(t, res.Exp)
+	})
+
+	t.Run("RefreshToken", func(t *testing.T) {
+		res := introspect(TokenHintRequest{ClientID: a.ClientID, ClientSecret: secret, Token: tokens.RefreshToken, TokenTypeHint: "refresh_token"})
+		assert.True(t, res.Active)
+		assert.Equal(t, "refresh_token", res.TokenType)
+	})
+
+	t.Run("OtherAppSeesInactive", func(t *testing.T) {
+		res := introspect(TokenHintRequest{ClientID: other.ClientID, ClientSecret: secret, Token: tokens.Token})
+		assert.False(t, res.Active)
+		assert.Zero(t, res.UserID)
+	})
+
+	t.Run("PublicClientCannotIntrospect", func(t *testing.T) {
+		_, err := svc.Introspect(ctx, TokenHintRequest{ClientID: public.ClientID, Token: tokens.Token})
+		oe, ok := IsOAuthError(err)
+		assert.True(t, ok)
+		assert.Equal(t, "invalid_client", oe.Code)
+	})
+
+	t.Run("OtherAppCannotRevoke", func(t *testing.T) {
+		err := svc.Revoke(ctx, TokenHintRequest{ClientID: other.ClientID, ClientSecret: secret, Token: tokens.Token})
+		oe, ok := IsOAuthError(err)
+		assert.True(t, ok)
+		assert.Equal(t, "unauthorized_client", oe.Code)
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		err := svc.Revoke(ctx, TokenHintRequest{ClientID: a.ClientID, ClientSecret: secret, Token: tokens.Token})
+		assert.NoError(t, err)
+		res := introspect(TokenHintRequest{ClientID: a.ClientID, ClientSecret: secret, Token: tokens.Token})
+		assert.False(t, res.Active)
+
+		err = svc.Revoke(ctx, TokenHintRequest{ClientID: a.ClientID, ClientSecret: secret, Token: tokens.RefreshToken})
+		assert.NoError(t, err)
+		res = introspect(TokenHintRequest{ClientID: a.ClientID, ClientSecret: secret, Token: tokens.RefreshToken})
+		assert.False(t, res.Active)
+
+		// Unknown tokens are accepted silently.
+		err = svc.Revoke(ctx, TokenHintRequest{ClientID: a.ClientID, ClientSecret: secret, Token: "unknown"})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,36 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"keeper/pkg/auth"
+)
+
+// ErrClientInactive is returned when a client token belongs to a deleted or deactivated app.
+var ErrClientInactive = errors.New("client is not active")
+
+// clientChecker rejects client credentials tokens whose app has been deleted or deactivated.
+type clientChecker struct {
+	repo *OAuthRepository
+}
+
+// NewClientStatusChecker creates an auth.ClientStatusChecker backed by the OAuth repository.
+func NewClientStatusChecker(repo *OAuthRepository) auth.ClientStatusChecker {
+	return &clientChecker{repo: repo}
+}
+
+func (c *clientChecker) CheckClient(ctx context.Context, claims *auth.UserClaims) error {
+	a, err := c.repo.GetAppByClientID(ctx, claims.ClientID)
+	if err != nil {
+		return ErrClientInactive
+	}
+
+	if a.Status != 1 || a.ID != claims.AppID {
+		slog.Warn("token rejected: client inactive", "client_id", claims.ClientID)
+		return ErrClientInactive
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"keeper/pkg/auth"
+)
+
+// JWKSHandler publishes the public keys used to sign access tokens.
+// The key set is served as a bare JWK Set document, as expected by JWT libraries.
+// @Summary Get JSON Web Key Set
+// @Description Get the public keys that can be used to verify issued tokens
+// @Tags auth
+// @Produce json
+// @Success 200 {object} auth.JWKSet
+// @Router /.well-known/jwks.json [get]
+func JWKSHandler(jwtManager *auth.JWTManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(jwtManager.JWKS())
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"keeper/pkg/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		key     crypto.Signer
+		wantKty string
+		wantAlg string
+	}{
+		{"RSA", rsaKey, "RSA", "RS256"},
+		{"ECDSA", ecKey, "EC", "ES256"},
+		{"Ed25519", edKey, "OKP", "EdDSA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			der, err := x509.MarshalPKCS8PrivateKey(tt.key)
+			assert.NoError(t, err)
+			pemBytes := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+			signer, err := auth.NewSignerFromPEM("", pemBytes)
+			assert.NoError(t, err)
+			jwtManager := auth.NewJWTManagerWithSigner(signer, time.Hour)
+
+			token, err := jwtManager.Generate(1, 2)
+			assert.NoError(t, err)
+
+			claims, err := jwtManager.Verify(token)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, claims.UserID)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &auth.UserClaims{})
+			assert.NoError(t, err)
+			assert.Equal(t, signer.KeyID(), parsed.Header["kid"])
+
+			req, _ := http.NewRequest("GET", "/.well-known/jwks.json", nil)
+			rr := httptest.NewRecorder()
+			JWKSHandler(jwtManager).ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+
+			var set auth.JWKSet
+			err = json.Unmarshal(rr.Body.Bytes(), &set)
+			assert.NoError(t, err)
+			if assert.Len(t, set.Keys, 1) {
+				assert.Equal(t, tt.wantKty, set.Keys[0].Kty)
+				assert.Equal(t, tt.wantAlg, set.Keys[0].Alg)
+				assert.Equal(t, signer.KeyID(), set.Keys[0].Kid)
+			}
+		})
+	}
+
+	t.Run("HMACNotPublished", func(t *testing.T) {
+		jwtManager := auth.NewJWTManager("secret", time.Hour)
+
+		req, _ := http.NewRequest("GET", "/.well-known/jwks.json", nil)
+		rr := httptest.NewRecorder()
+		JWKSHandler(jwtManager).ServeHTTP(rr, req)
+
+		var set auth.JWKSet
+		err := json.Unmarshal(rr.Body.Bytes(), &set)
+		assert.NoError(t, err)
+		assert.Empty(t, set.Keys)
+	})
+}
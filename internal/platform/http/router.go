@@ -5,7 +5,15 @@ import (
 
 	_ "keeper/docs" // Import generated docs
 	"keeper/internal/app"
+	"keeper/internal/audit"
+	"keeper/internal/authz"
+	"keeper/internal/key"
+	"keeper/internal/mail"
+	"keeper/internal/oauth"
+	"keeper/internal/role"
+	"keeper/internal/scim"
 	"keeper/internal/user"
+	"keeper/internal/webhook"
 	"keeper/pkg/auth"
 	"keeper/pkg/config"
 
@@ -16,12 +24,31 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 )
 
+// Handlers groups the domain handlers mounted by the router.
+type Handlers struct {
+	User    *user.UserHandler
+	App     *app.AppHandler
+	Role    *role.RoleHandler
+	Authz   *authz.AuthzHandler
+	Key     *key.KeyHandler
+	Mail    *mail.MailHandler
+	Audit   *audit.AuditHandler
+	Webhook *webhook.WebhookHandler
+	OAuth   *oauth.OAuthHandler
+	SCIM    *scim.SCIMHandler
+}
+
 // NewRouter creates a new chi router with default middleware and application routes.
-func NewRouter(userHandler *user.UserHandler, appHandler *app.AppHandler, jwtManager *auth.JWTManager, cfg *config.Config) *chi.Mux {
+func NewRouter(h Handlers, jwtManager *auth.JWTManager, cfg *config.Config) *chi.Mux {
 	r := chi.NewRouter()
 
+	if cfg.Server.TrustProxy {
+		r.Use(middleware.RealIP)
+	}
+	r.Use(middleware.RequestID)
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
+	r.Use(auth.ClientIP)
 
 	// Add CORS middleware
 	corsMiddleware := cors.New(cors.Options{
@@ -41,9 +68,26 @@ func NewRouter(userHandler *user.UserHandler, appHandler *app.AppHandler, jwtMan
 	))
 
 	r.Get("/health", HealthHandler)
+	r.Get("/.well-known/jwks.json", JWKSHandler(jwtManager))
+
+	// OpenID Connect provider
+	r.Get("/.well-known/openid-configuration", h.OAuth.Discovery)
+	r.Get("/authorize", h.OAuth.Authorize)
+	r.Post("/authorize", h.OAuth.Login)
+	r.Post("/token", h.OAuth.Token)
+	r.With(auth.Middleware(jwtManager)).Get("/userinfo", h.OAuth.UserInfo)
+	r.With(auth.Middleware(jwtManager)).Post("/userinfo", h.OAuth.UserInfo)
 
-	r.Mount("/users", userHandler.Routes(jwtManager))
-	r.Mount("/apps", appHandler.Routes(jwtManager))
+	r.Mount("/users", h.User.Routes(jwtManager))
+	r.Mount("/apps", h.App.Routes(jwtManager))
+	r.Mount("/roles", h.Role.Routes(jwtManager))
+	r.Mount("/authz", h.Authz.Routes(jwtManager))
+	r.Mount("/keys", h.Key.Routes(jwtManager))
+	r.Mount("/mail", h.Mail.Routes(jwtManager))
+	r.Mount("/audit", h.Audit.Routes(jwtManager))
+	r.Mount("/webhooks", h.Webhook.Routes(jwtManager))
+	r.Mount("/oauth", h.OAuth.Routes())
+	r.Mount("/scim/v2", h.SCIM.Routes())
 
 	return r
 }
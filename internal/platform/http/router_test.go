@@ -9,7 +9,15 @@ import (
 	"time"
 
 	"keeper/internal/app"
+	"keeper/internal/audit"
+	"keeper/internal/authz"
+	"keeper/internal/key"
+	"keeper/internal/mail"
+	"keeper/internal/oauth"
+	"keeper/internal/role"
+	"keeper/internal/scim"
 	"keeper/internal/user"
+	"keeper/internal/webhook"
 	"keeper/pkg/auth"
 	"keeper/pkg/config"
 
@@ -20,20 +28,72 @@ type mockUserService struct {
 	user.UserService
 }
 
-func (m *mockUserService) List(ctx context.Context) ([]*user.User, error) {
-	return []*user.User{}, nil
+func (m *mockUserService) List(ctx context.Context, req user.ListUsersRequest) (*user.UserPage, error) {
+	return &user.UserPage{Users: []*user.User{}}, nil
 }
 
 func (m *mockUserService) Authenticate(ctx context.Context, req user.AuthRequest) (*user.AuthResponse, error) {
 	return &user.AuthResponse{}, nil
 }
 
+type mockKeyService struct {
+	key.KeyService
+}
+
+func (m *mockKeyService) List(ctx context.Context) ([]*key.Key, error) {
+	return []*key.Key{}, nil
+}
+
+type mockOAuthService struct {
+	oauth.OAuthService
+}
+
+func (m *mockOAuthService) Metadata() oauth.ProviderMetadata {
+	return oauth.ProviderMetadata{}
+}
+
+type mockRoleService struct {
+	role.RoleService
+}
+
+func (m *mockRoleService) List(ctx context.Context) ([]*role.Role, error) {
+	return []*role.Role{}, nil
+}
+
+type mockAuthzService struct {
+	authz.AuthzService
+}
+
+type mockMailService struct {
+	mail.MailService
+}
+
+type mockAuditService struct {
+	audit.AuditService
+}
+
+func (m *mockAuditService) List(ctx context.Context, req audit.ListRequest) (*audit.Page, error) {
+	return &audit.Page{}, nil
+}
+
+type mockWebhookService struct {
+	webhook.WebhookService
+}
+
+type mockSCIMService struct {
+	scim.SCIMService
+}
+
+func (m *mockSCIMService) Authenticate(ctx context.Context, token string) (*auth.UserClaims, error) {
+	return nil, scim.ErrInvalidToken
+}
+
 type mockAppService struct {
 	app.AppService
 }
 
-func (m *mockAppService) List(ctx context.Context) ([]*app.App, error) {
-	return []*app.App{}, nil
+func (m *mockAppService) List(ctx context.Context, req app.ListAppsRequest) (*app.AppPage, error) {
+	return &app.AppPage{Apps: []*app.App{}}, nil
 }
 
 func TestRouterAuthentication(t *testing.T) {
@@ -50,7 +110,16 @@ func TestRouterAuthentication(t *testing.T) {
 			AllowedOrigins: []string{"*"},
 		},
 	}
-	router := NewRouter(userHandler, appHandler, jwtManager, cfg)
+	roleHandler := role.NewRoleHandler(&mockRoleService{})
+	authzHandler := authz.NewAuthzHandler(&mockAuthzService{})
+	keyHandler := key.NewKeyHandler(&mockKeyService{})
+	mailHandler := mail.NewMailHandler(&mockMailService{})
+	auditHandler := audit.NewAuditHandler(&mockAuditService{})
+	webhookHandler := webhook.NewWebhookHandler(&mockWebhookService{})
+	oauthHandler := oauth.NewOAuthHandler(&mockOAuthService{})
+	scimHandler := scim.NewSCIMHandler(&mockSCIMService{})
+
+	router := NewRouter(Handlers{User: userHandler, App: appHandler, Role: roleHandler, Authz: authzHandler, Key: keyHandler, Mail: mailHandler, Audit: auditHandler, Webhook: webhookHandler, OAuth: oauthHandler, SCIM: scimHandler}, jwtManager, cfg)
 
 	tests := []struct {
 		name           string
@@ -59,9 +128,29 @@ func TestRouterAuthentication(t *testing.T) {
 		wantStatusCode int
 	}{
 		{"Health public", "GET", "/health", http.StatusOK},
+		{"JWKS public", "GET", "/.well-known/jwks.json", http.StatusOK},
 		{"Users Auth public", "POST", "/users/auth", http.StatusBadRequest}, // 400 because of empty body
+		{"Users Token Refresh public", "POST", "/users/token/refresh", http.StatusBadRequest},
+		{"Users Password Reset public", "POST", "/users/password/reset", http.StatusBadRequest},
+		{"Users Password Change public", "POST", "/users/password/change", http.StatusBadRequest},
+		{"Users MFA Auth public", "POST", "/users/auth/mfa", http.StatusBadRequest},
+		{"Users MFA Enrol protected", "POST", "/users/mfa/totp", http.StatusUnauthorized},
+		{"Users Passkey Auth public", "POST", "/users/auth/passkey/options", http.StatusBadRequest},
+		{"Users Passkeys protected", "GET", "/users/passkeys", http.StatusUnauthorized},
+		{"Users Magic Link public", "POST", "/users/auth/magic-link", http.StatusBadRequest},
+		{"Users Magic Link Verify public", "GET", "/users/auth/magic-link/verify", http.StatusBadRequest},
+		{"Users Unlock protected", "POST", "/users/1/unlock", http.StatusUnauthorized},
 		{"Users List protected", "GET", "/users", http.StatusUnauthorized},
 		{"Users Create protected", "POST", "/users", http.StatusUnauthorized},
+		{"Keys List protected", "GET", "/keys", http.StatusUnauthorized},
+		{"Roles List protected", "GET", "/roles", http.StatusUnauthorized},
+		{"Authz Check protected", "POST", "/authz/check", http.StatusUnauthorized},
+		{"Mail Templates protected", "GET", "/mail/templates", http.StatusUnauthorized},
+		{"Audit protected", "GET", "/audit", http.StatusUnauthorized},
+		{"Webhooks protected", "GET", "/webhooks", http.StatusUnauthorized},
+		{"OpenID configuration public", "GET", "/.well-known/openid-configuration", http.StatusOK},
+		{"UserInfo protected", "GET", "/userinfo", http.StatusUnauthorized},
+		{"SCIM protected", "GET", "/scim/v2/Users", http.StatusUnauthorized},
 	}
 
 	for _, tt := range tests {
@@ -85,30 +174,157 @@ func TestRouterAuthentication(t *testing.T) {
 	}
 }
 
-func TestRouterAuthentication_ValidToken(t *testing.T) {
+func TestRouterAuthentication_Permissions(t *testing.T) {
 	jwtManager := auth.NewJWTManager("secret", 1*time.Hour)
-	userSvc := &mockUserService{}
-	userHandler := user.NewUserHandler(userSvc)
+	cfg := &config.Config{
+		CORS: config.CORSConfig{
+			AllowedOrigins: []string{"*"},
+		},
+	}
 
-	appSvc := &mockAppService{}
-	appHandler := app.NewAppHandler(appSvc)
+	router := NewRouter(Handlers{
+		User:    user.NewUserHandler(&mockUserService{}),
+		App:     app.NewAppHandler(&mockAppService{}),
+		Role:    role.NewRoleHandler(&mockRoleService{}),
+		Authz:   authz.NewAuthzHandler(&mockAuthzService{}),
+		Key:     key.NewKeyHandler(&mockKeyService{}),
+		Mail:    mail.NewMailHandler(&mockMailService{}),
+		Audit:   audit.NewAuditHandler(&mockAuditService{}),
+		Webhook: webhook.NewWebhookHandler(&mockWebhookService{}),
+		OAuth:   oauth.NewOAuthHandler(&mockOAuthService{}),
+		SCIM:    scim.NewSCIMHandler(&mockSCIMService{}),
+	}, jwtManager, cfg)
+
+	token, _ := jwtManager.Issue(auth.UserClaims{
+		AppID:       1,
+		UserID:      1,
+		Roles:       []string{"viewer"},
+		Permissions: []string{user.PermissionRead, role.PermissionRead},
+	})
+	noRoleToken, _ := jwtManager.Generate(1, 1)
+
+	tests := []struct {
+		name           string
+		token          string
+		method         string
+		url            string
+		wantStatusCode int
+	}{
+		{"Granted permission", token, "GET", "/users", http.StatusOK},
+		{"Granted role permission", token, "GET", "/roles", http.StatusOK},
+		{"Missing permission", token, "POST", "/users", http.StatusForbidden},
+		{"Other resource", token, "GET", "/apps", http.StatusForbidden},
+		{"Authz check without permission", token, "POST", "/authz/check", http.StatusForbidden},
+		{"Mail templates without permission", token, "GET", "/mail/templates", http.StatusForbidden},
+		{"Audit without permission", token, "GET", "/audit", http.StatusForbidden},
+		{"Webhooks without permission", token, "POST", "/webhooks/1/deliveries/2/redeliver", http.StatusForbidden},
+		{"No roles", noRoleToken, "GET", "/users", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, tt.url, nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+		})
+	}
+}
+
+func TestRouterAuthentication_ClientToken(t *testing.T) {
+	jwtManager := auth.NewJWTManager("secret", 1*time.Hour)
+	cfg := &config.Config{
+		CORS: config.CORSConfig{
+			AllowedOrigins: []string{"*"},
+		},
+	}
+
+	router := NewRouter(Handlers{
+		User:    user.NewUserHandler(&mockUserService{}),
+		App:     app.NewAppHandler(&mockAppService{}),
+		Role:    role.NewRoleHandler(&mockRoleService{}),
+		Authz:   authz.NewAuthzHandler(&mockAuthzService{}),
+		Key:     key.NewKeyHandler(&mockKeyService{}),
+		Mail:    mail.NewMailHandler(&mockMailService{}),
+		Audit:   audit.NewAuditHandler(&mockAuditService{}),
+		Webhook: webhook.NewWebhookHandler(&mockWebhookService{}),
+		OAuth:   oauth.NewOAuthHandler(&mockOAuthService{}),
+		SCIM:    scim.NewSCIMHandler(&mockSCIMService{}),
+	}, jwtManager, cfg)
+
+	token, _ := jwtManager.Issue(auth.UserClaims{AppID: 1, ClientID: "billing", Scope: user.PermissionRead})
+
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		wantStatusCode int
+	}{
+		{"Granted scope", "GET", "/users", http.StatusOK},
+		{"Missing scope", "POST", "/users", http.StatusForbidden},
+		{"Other resource", "GET", "/apps", http.StatusForbidden},
+		{"User only route", "POST", "/users/logout", http.StatusForbidden},
+		{"User only MFA route", "POST", "/users/mfa/totp", http.StatusForbidden},
+		{"User only passkey route", "GET", "/users/passkeys", http.StatusForbidden},
+	}
 
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, tt.url, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			rr := httptest.NewRecorder()
+
+			router.ServeHTTP(rr, req)
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+		})
+	}
+}
+
+func TestRouterAuthentication_PlatformAdmin(t *testing.T) {
+	jwtManager := auth.NewJWTManager("secret", 1*time.Hour)
 	cfg := &config.Config{
 		CORS: config.CORSConfig{
 			AllowedOrigins: []string{"*"},
 		},
 	}
-	router := NewRouter(userHandler, appHandler, jwtManager, cfg)
 
-	token, _ := jwtManager.Generate(1, 1)
+	router := NewRouter(Handlers{
+		User:    user.NewUserHandler(&mockUserService{}),
+		App:     app.NewAppHandler(&mockAppService{}),
+		Role:    role.NewRoleHandler(&mockRoleService{}),
+		Authz:   authz.NewAuthzHandler(&mockAuthzService{}),
+		Key:     key.NewKeyHandler(&mockKeyService{}),
+		Mail:    mail.NewMailHandler(&mockMailService{}),
+		Audit:   audit.NewAuditHandler(&mockAuditService{}),
+		Webhook: webhook.NewWebhookHandler(&mockWebhookService{}),
+		OAuth:   oauth.NewOAuthHandler(&mockOAuthService{}),
+		SCIM:    scim.NewSCIMHandler(&mockSCIMService{}),
+	}, jwtManager, cfg)
+
+	userToken, _ := jwtManager.Generate(1, 1)
+	adminToken, _ := jwtManager.Issue(auth.UserClaims{AppID: 1, UserID: 2, PlatformAdmin: true})
+	clientToken, _ := jwtManager.Issue(auth.UserClaims{AppID: 1, ClientID: "billing", Scope: "keys:read"})
 
-	req, _ := http.NewRequest("GET", "/users", nil)
-	req.Header.Set("Authorization", "Bearer "+token)
-	rr := httptest.NewRecorder()
+	tests := []struct {
+		name           string
+		token          string
+		wantStatusCode int
+	}{
+		{"Platform admin", adminToken, http.StatusOK},
+		{"Tenant user", userToken, http.StatusForbidden},
+		{"App token", clientToken, http.StatusForbidden},
+	}
 
-	router.ServeHTTP(rr, req)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/keys", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rr := httptest.NewRecorder()
 
-	// If middleware works, it shouldn't be StatusUnauthorized (401).
-	// It will be 500 because s.List is nil and it panics, and Recoverer catches it.
-	assert.NotEqual(t, http.StatusUnauthorized, rr.Code)
+			router.ServeHTTP(rr, req)
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+		})
+	}
 }
@@ -0,0 +1,304 @@
+package role
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// RoleHandler handles HTTP requests for roles.
+type RoleHandler struct {
+	svc      RoleService
+	validate *validator.Validate
+}
+
+// NewRoleHandler creates a new role handler.
+func NewRoleHandler(svc RoleService) *RoleHandler {
+	return &RoleHandler{
+		svc:      svc,
+		validate: validator.New(),
+	}
+}
+
+// Permissions a token needs to call the role endpoints. App tokens are
+// granted them as scopes.
+const (
+	PermissionRead  = "roles:read"
+	PermissionWrite = "roles:write"
+)
+
+// Routes returns the chi router for role endpoints.
+func (h *RoleHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
+	r := chi.NewRouter()
+
+	// All routes are protected
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Middleware(jwtManager))
+
+		r.With(auth.RequirePermission(PermissionWrite)).Post("/", h.CreateRole)
+		r.With(auth.RequirePermission(PermissionRead)).Get("/", h.ListRoles)
+		r.With(auth.RequirePermission(PermissionRead)).Get("/permissions", h.ListPermissions)
+		r.With(auth.RequirePermission(PermissionWrite)).Post("/permissions", h.CreatePermission)
+
+		r.Route("/{id}", func(r chi.Router) {
+			r.With(auth.RequirePermission(PermissionRead)).Get("/", h.GetRoleByID)
+			r.With(auth.RequirePermission(PermissionWrite)).Put("/", h.UpdateRole)
+			r.With(auth.RequirePermission(PermissionWrite)).Delete("/", h.DeleteRole)
+		})
+	})
+
+	return r
+}
+
+// CreateRole godoc
+// @Summary Create a new role
+// @Description Create a role for an app, granting the listed permissions
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param role body CreateRoleRequest true "Role details"
+// @Success 201 {object} render.Response{data=Role}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /roles [post]
+func (h *RoleHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var req CreateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode create role request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid create role request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ro, err := h.svc.Create(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, ro)
+}
+
+// ListRoles godoc
+// @Summary List all roles
+// @Description Get the roles of the caller's app, or of every app for platform admins
+// @Tags roles
+// @Produce json
+// @Success 200 {object} render.Response{data=[]Role}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /roles [get]
+func (h *RoleHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	roles, err := h.svc.List(r.Context())
+	if err != nil {
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, roles)
+}
+
+// ListPermissions godoc
+// @Summary List permissions
+// @Description Get the permissions roles can grant
+// @Tags roles
+// @Produce json
+// @Success 200 {object} render.Response{data=[]Permission}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /roles/permissions [get]
+func (h *RoleHandler) ListPermissions(w http.ResponseWriter, r *http.Request) {
+	perms, err := h.svc.ListPermissions(r.Context())
+	if err != nil {
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, perms)
+}
+
+// CreatePermission godoc
+// @Summary Register a permission
+// @Description Add a permission to the catalog so roles can grant it. Platform admins only.
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param permission body CreatePermissionRequest true "Permission details"
+// @Success 201 {object} render.Response{data=Permission}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /roles/permissions [post]
+func (h *RoleHandler) CreatePermission(w http.ResponseWriter, r *http.Request) {
+	var req CreatePermissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode create permission request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid create permission request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	p, err := h.svc.CreatePermission(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, p)
+}
+
+// GetRoleByID godoc
+// @Summary Get role by ID
+// @Description Get a single role by its unique ID
+// @Tags roles
+// @Produce json
+// @Param id path int true "Role ID"
+// @Success 200 {object} render.Response{data=Role}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Security Bearer
+// @Router /roles/{id} [get]
+func (h *RoleHandler) GetRoleByID(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid role id in request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid role id")
+		return
+	}
+
+	ro, err := h.svc.GetByID(r.Context(), id)
+	if err != nil {
+		slog.Warn("role not found", "id", id)
+		render.Error(w, http.StatusNotFound, "role not found")
+		return
+	}
+
+	render.JSON(w, http.StatusOK, ro)
+}
+
+// UpdateRole godoc
+// @Summary Update role
+// @Description Update a role's name, description or permissions
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param id path int true "Role ID"
+// @Param role body UpdateRoleRequest true "Updated role details"
+// @Success 200 {object} render.Response{data=Role}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /roles/{id} [put]
+func (h *RoleHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid role id in update request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid role id")
+		return
+	}
+
+	var req UpdateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode update role request", "id", id, "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid update role request", "id", id, "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ro, err := h.svc.Update(r.Context(), id, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, ro)
+}
+
+// DeleteRole godoc
+// @Summary Delete role
+// @Description Remove a role, taking it away from every user holding it
+// @Tags roles
+// @Produce json
+// @Param id path int true "Role ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /roles/{id} [delete]
+func (h *RoleHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid role id in delete request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid role id")
+		return
+	}
+
+	if err := h.svc.Delete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// writeError renders a role service error with its HTTP status.
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUnknownPermission):
+		render.Error(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrForbidden):
+		render.Error(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrRoleNotFound):
+		render.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrRoleExists), errors.Is(err, ErrPermissionExists):
+		render.Error(w, http.StatusConflict, err.Error())
+	default:
+		render.Error(w, http.StatusInternalServerError, err.Error())
+	}
+}
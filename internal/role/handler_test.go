@@ -0,0 +1,208 @@
+package role
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"keeper/pkg/render"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockRoleService struct {
+	mock.Mock
+}
+
+func (m *mockRoleService) Create(ctx context.Context, req CreateRoleRequest) (*Role, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Role), args.Error(1)
+}
+
+func (m *mockRoleService) GetByID(ctx context.Context, id int) (*Role, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Role), args.Error(1)
+}
+
+func (m *mockRoleService) List(ctx context.Context) ([]*Role, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Role), args.Error(1)
+}
+
+func (m *mockRoleService) ListPage(ctx context.Context, offset, limit int) (*RolePage, error) {
+	args := m.Called(ctx, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RolePage), args.Error(1)
+}
+
+func (m *mockRoleService) Update(ctx context.Context, id int, req UpdateRoleRequest) (*Role, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Role), args.Error(1)
+}
+
+func (m *mockRoleService) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockRoleService) ListPermissions(ctx context.Context) ([]*Permission, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Permission), args.Error(1)
+}
+
+func (m *mockRoleService) CreatePermission(ctx context.Context, req CreatePermissionRequest) (*Permission, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Permission), args.Error(1)
+}
+
+func (m *mockRoleService) SyncPermissions(ctx context.Context, names []string) error {
+	args := m.Called(ctx, names)
+	return args.Error(0)
+}
+
+func TestHandler_Create(t *testing.T) {
+	svc := new(mockRoleService)
+	handler := NewRoleHandler(svc)
+
+	reqBody := CreateRoleRequest{
+		AppID:       1,
+		Name:        "viewer",
+		Permissions: []string{"users:read"},
+	}
+
+	expectedRole := &Role{
+		ID:          1,
+		AppID:       reqBody.AppID,
+		Name:        reqBody.Name,
+		Permissions: reqBody.Permissions,
+	}
+
+	svc.On("Create", mock.Anything, reqBody).Return(expectedRole, nil)
+
+	body, _ := json.Marshal(reqBody)
+	req, _ := http.NewRequest("POST", "/roles", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	handler.CreateRole(rr, req)
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+
+	var resp render.Response
+	err := json.Unmarshal(rr.Body.Bytes(), &resp)
+	assert.NoError(t, err)
+
+	dataMap := resp.Data.(map[string]interface{})
+	assert.Equal(t, expectedRole.Name, dataMap["name"])
+}
+
+func TestHandler_CreateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"UnknownPermission", ErrUnknownPermission, http.StatusBadRequest},
+		{"Forbidden", ErrForbidden, http.StatusForbidden},
+		{"Exists", ErrRoleExists, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockRoleService)
+			handler := NewRoleHandler(svc)
+
+			reqBody := CreateRoleRequest{AppID: 1, Name: "admin"}
+			svc.On("Create", mock.Anything, reqBody).Return(nil, tt.err)
+
+			body, _ := json.Marshal(reqBody)
+			req, _ := http.NewRequest("POST", "/roles", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			handler.CreateRole(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
+
+func TestHandler_ListPermissions(t *testing.T) {
+	svc := new(mockRoleService)
+	handler := NewRoleHandler(svc)
+
+	svc.On("ListPermissions", mock.Anything).Return([]*Permission{
+		{ID: 1, Name: "users:read"},
+		{ID: 2, Name: "users:write"},
+	}, nil)
+
+	req, _ := http.NewRequest("GET", "/roles/permissions", nil)
+	rr := httptest.NewRecorder()
+
+	handler.ListPermissions(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp render.Response
+	err := json.Unmarshal(rr.Body.Bytes(), &resp)
+	assert.NoError(t, err)
+
+	dataList := resp.Data.([]interface{})
+	assert.Len(t, dataList, 2)
+}
+
+func TestHandler_CreatePermission(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"Created", nil, http.StatusCreated},
+		{"Forbidden", ErrForbidden, http.StatusForbidden},
+		{"Exists", ErrPermissionExists, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockRoleService)
+			handler := NewRoleHandler(svc)
+
+			reqBody := CreatePermissionRequest{Name: "documents:edit"}
+			if tt.err != nil {
+				svc.On("CreatePermission", mock.Anything, reqBody).Return(nil, tt.err)
+			} else {
+				svc.On("CreatePermission", mock.Anything, reqBody).Return(&Permission{ID: 1, Name: reqBody.Name}, nil)
+			}
+
+			body, _ := json.Marshal(reqBody)
+			req, _ := http.NewRequest("POST", "/roles/permissions", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			handler.CreatePermission(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
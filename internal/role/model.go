@@ -0,0 +1,52 @@
+package role
+
+import (
+	"time"
+)
+
+// Role represents the domain model for a role. A role belongs to one app and
+// grants its users a set of permissions.
+type Role struct {
+	ID          int       `json:"id"`
+	AppID       int       `json:"app_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// RolePage is a page of roles.
+type RolePage struct {
+	Roles []*Role
+	// Total is the number of roles, across all pages.
+	Total int
+}
+
+// Permission represents a permission that roles can grant.
+type Permission struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// CreateRoleRequest defines the payload for creating a role.
+type CreateRoleRequest struct {
+	AppID       int      `json:"app_id" validate:"required"`
+	Name        string   `json:"name" validate:"required,max=64"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions" validate:"omitempty,dive,required"`
+}
+
+// UpdateRoleRequest defines the payload for updating a role. A non-nil
+// Permissions replaces every permission of the role.
+type UpdateRoleRequest struct {
+	Name        *string  `json:"name" validate:"omitempty,min=1,max=64"`
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions" validate:"omitempty,dive,required"`
+}
+
+// CreatePermissionRequest defines the payload for adding a permission to the
+// catalog, such as one enforced by a downstream service.
+type CreatePermissionRequest struct {
+	Name string `json:"name" validate:"required,max=128"`
+}
@@ -0,0 +1,154 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"keeper/ent"
+	"keeper/ent/permission"
+	entrole "keeper/ent/role"
+)
+
+// RoleRepository handles database operations for roles and permissions.
+type RoleRepository struct {
+	client *ent.Client
+}
+
+// NewRoleRepository creates a new role repository.
+func NewRoleRepository(client *ent.Client) *RoleRepository {
+	return &RoleRepository{client: client}
+}
+
+// Create creates a new role granting the given permissions.
+func (r *RoleRepository) Create(ctx context.Context, ro *ent.Role, permissionIDs []int) (*ent.Role, error) {
+	created, err := r.client.Role.
+		Create().
+		SetAppID(ro.AppID).
+		SetName(ro.Name).
+		SetDescription(ro.Description).
+		AddPermissionIDs(permissionIDs...).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create role", "app_id", ro.AppID, "name", ro.Name, "error", err)
+		return nil, err
+	}
+	return r.GetByID(ctx, created.ID)
+}
+
+// GetByID retrieves a role and its permissions by its ID.
+func (r *RoleRepository) GetByID(ctx context.Context, id int) (*ent.Role, error) {
+	ro, err := r.client.Role.Query().
+		Where(entrole.IDEQ(id)).
+		WithPermissions().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("role not found in database", "id", id)
+			return nil, fmt.Errorf("role not found: %w", err)
+		}
+		slog.Error("database error: failed to get role by id", "id", id, "error", err)
+		return nil, err
+	}
+	return ro, nil
+}
+
+// List retrieves all roles with their permissions.
+func (r *RoleRepository) List(ctx context.Context) ([]*ent.Role, error) {
+	roles, err := r.client.Role.Query().WithPermissions().All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list roles", "error", err)
+		return nil, err
+	}
+	return roles, nil
+}
+
+// ListPage retrieves up to limit roles with their permissions, in the order
+// of their IDs, after skipping offset of them, and the number of roles.
+func (r *RoleRepository) ListPage(ctx context.Context, offset, limit int) ([]*ent.Role, int, error) {
+	total, err := r.client.Role.Query().Count(ctx)
+	if err != nil {
+		slog.Error("database error: failed to count roles", "error", err)
+		return nil, 0, err
+	}
+	// ent reads every row for a limit of zero.
+	if limit <= 0 {
+		return nil, total, nil
+	}
+	roles, err := r.client.Role.Query().
+		WithPermissions().
+		Order(ent.Asc(entrole.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list roles", "error", err)
+		return nil, 0, err
+	}
+	return roles, total, nil
+}
+
+// Update updates an existing role. A non-nil permissionIDs replaces every
+// permission of the role.
+func (r *RoleRepository) Update(ctx context.Context, id int, ro *ent.Role, permissionIDs []int) (*ent.Role, error) {
+	update := r.client.Role.UpdateOneID(id).
+		SetName(ro.Name).
+		SetDescription(ro.Description)
+	if permissionIDs != nil {
+		update.ClearPermissions().AddPermissionIDs(permissionIDs...)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to update role", "id", id, "error", err)
+		return nil, err
+	}
+	return r.GetByID(ctx, updated.ID)
+}
+
+// Delete deletes a role by its ID, removing it from every user.
+func (r *RoleRepository) Delete(ctx context.Context, id int) error {
+	err := r.client.Role.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("role not found for deletion", "id", id)
+			return fmt.Errorf("role not found: %w", err)
+		}
+		slog.Error("database error: failed to delete role", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// ListPermissions retrieves the permission catalog.
+func (r *RoleRepository) ListPermissions(ctx context.Context) ([]*ent.Permission, error) {
+	perms, err := r.client.Permission.Query().
+		Order(ent.Asc(permission.FieldName)).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list permissions", "error", err)
+		return nil, err
+	}
+	return perms, nil
+}
+
+// GetPermissionsByName retrieves the permissions with the given names.
+func (r *RoleRepository) GetPermissionsByName(ctx context.Context, names []string) ([]*ent.Permission, error) {
+	perms, err := r.client.Permission.Query().
+		Where(permission.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to get permissions by name", "error", err)
+		return nil, err
+	}
+	return perms, nil
+}
+
+// CreatePermission adds a permission to the catalog.
+func (r *RoleRepository) CreatePermission(ctx context.Context, name string) (*ent.Permission, error) {
+	p, err := r.client.Permission.Create().SetName(name).Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create permission", "name", name, "error", err)
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,266 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"keeper/ent"
+	"keeper/ent/privacy"
+)
+
+// RoleService defines the business logic for roles.
+type RoleService interface {
+	Create(ctx context.Context, req CreateRoleRequest) (*Role, error)
+	GetByID(ctx context.Context, id int) (*Role, error)
+	List(ctx context.Context) ([]*Role, error)
+	ListPage(ctx context.Context, offset, limit int) (*RolePage, error)
+	Update(ctx context.Context, id int, req UpdateRoleRequest) (*Role, error)
+	Delete(ctx context.Context, id int) error
+	ListPermissions(ctx context.Context) ([]*Permission, error)
+	CreatePermission(ctx context.Context, req CreatePermissionRequest) (*Permission, error)
+	SyncPermissions(ctx context.Context, names []string) error
+}
+
+var (
+	// ErrRoleNotFound is returned when the requested role does not exist.
+	ErrRoleNotFound = errors.New("role not found")
+	// ErrRoleExists is returned when the app already has a role with the same name.
+	ErrRoleExists = errors.New("role already exists")
+	// ErrUnknownPermission is returned when a role would grant a permission
+	// that is not in the catalog.
+	ErrUnknownPermission = errors.New("unknown permission")
+	// ErrPermissionExists is returned when the catalog already has the permission.
+	ErrPermissionExists = errors.New("permission already exists")
+	// ErrForbidden is returned when the caller may not make a change, such as
+	// creating a role for another app.
+	ErrForbidden = errors.New("operation not permitted")
+)
+
+type roleService struct {
+	repo *RoleRepository
+}
+
+// NewRoleService creates a new role service.
+func NewRoleService(repo *RoleRepository) RoleService {
+	return &roleService{repo: repo}
+}
+
+func (s *roleService) Create(ctx context.Context, req CreateRoleRequest) (*Role, error) {
+	slog.Info("creating role", "app_id", req.AppID, "name", req.Name)
+	permissionIDs, err := s.permissionIDs(ctx, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	ro := &ent.Role{
+		AppID:       req.AppID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	created, err := s.repo.Create(ctx, ro, permissionIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, privacy.Deny):
+			slog.Warn("role creation denied", "app_id", req.AppID, "error", err)
+			return nil, ErrForbidden
+		case ent.IsConstraintError(err):
+			return nil, ErrRoleExists
+		}
+		return nil, fmt.Errorf("repository create: %w", err)
+	}
+
+	slog.Info("role created successfully", "id", created.ID, "name", created.Name)
+	return s.toDomain(created), nil
+}
+
+func (s *roleService) GetByID(ctx context.Context, id int) (*Role, error) {
+	slog.Info("getting role by id", "id", id)
+	ro, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, err
+	}
+	return s.toDomain(ro), nil
+}
+
+func (s *roleService) List(ctx context.Context) ([]*Role, error) {
+	slog.Info("listing roles")
+	roles, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainRoles := make([]*Role, len(roles))
+	for i, ro := range roles {
+		domainRoles[i] = s.toDomain(ro)
+	}
+	return domainRoles, nil
+}
+
+// ListPage lists up to limit roles in the order of their IDs, after skipping
+// offset of them, for callers that page by position.
+func (s *roleService) ListPage(ctx context.Context, offset, limit int) (*RolePage, error) {
+	slog.Info("listing roles", "offset", offset, "limit", limit)
+	roles, total, err := s.repo.ListPage(ctx, max(offset, 0), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &RolePage{Roles: make([]*Role, len(roles)), Total: total}
+	for i, ro := range roles {
+		page.Roles[i] = s.toDomain(ro)
+	}
+	return page, nil
+}
+
+func (s *roleService) Update(ctx context.Context, id int, req UpdateRoleRequest) (*Role, error) {
+	slog.Info("updating role", "id", id)
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRoleNotFound
+		}
+		return nil, err
+	}
+
+	if req.Name != nil {
+		existing.Name = *req.Name
+	}
+	if req.Description != nil {
+		existing.Description = *req.Description
+	}
+
+	var permissionIDs []int
+	if req.Permissions != nil {
+		if permissionIDs, err = s.permissionIDs(ctx, req.Permissions); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.repo.Update(ctx, id, existing, permissionIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, privacy.Deny):
+			slog.Warn("role update denied", "id", id, "error", err)
+			return nil, ErrForbidden
+		case ent.IsConstraintError(err):
+			return nil, ErrRoleExists
+		}
+		return nil, err
+	}
+
+	slog.Info("role updated successfully", "id", id)
+	return s.toDomain(updated), nil
+}
+
+func (s *roleService) Delete(ctx context.Context, id int) error {
+	slog.Info("deleting role", "id", id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrRoleNotFound
+		}
+		return err
+	}
+	slog.Info("role deleted successfully", "id", id)
+	return nil
+}
+
+func (s *roleService) ListPermissions(ctx context.Context) ([]*Permission, error) {
+	perms, err := s.repo.ListPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainPerms := make([]*Permission, len(perms))
+	for i, p := range perms {
+		domainPerms[i] = &Permission{ID: p.ID, Name: p.Name}
+	}
+	return domainPerms, nil
+}
+
+func (s *roleService) CreatePermission(ctx context.Context, req CreatePermissionRequest) (*Permission, error) {
+	slog.Info("creating permission", "name", req.Name)
+	p, err := s.repo.CreatePermission(ctx, req.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, privacy.Deny):
+			slog.Warn("permission creation denied", "name", req.Name, "error", err)
+			return nil, ErrForbidden
+		case ent.IsConstraintError(err):
+			return nil, ErrPermissionExists
+		}
+		return nil, fmt.Errorf("repository create permission: %w", err)
+	}
+
+	slog.Info("permission created successfully", "id", p.ID, "name", p.Name)
+	return &Permission{ID: p.ID, Name: p.Name}, nil
+}
+
+// SyncPermissions adds the permissions enforced by the server's routes to the
+// catalog. It is called at startup; permissions are never removed so roles
+// keep working across versions.
+func (s *roleService) SyncPermissions(ctx context.Context, names []string) error {
+	existing, err := s.repo.GetPermissionsByName(ctx, names)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if slices.ContainsFunc(existing, func(p *ent.Permission) bool { return p.Name == name }) {
+			continue
+		}
+		if _, err := s.repo.CreatePermission(ctx, name); err != nil {
+			return fmt.Errorf("create permission %q: %w", name, err)
+		}
+		slog.Info("permission added to catalog", "name", name)
+	}
+	return nil
+}
+
+// permissionIDs resolves permission names against the catalog.
+func (s *roleService) permissionIDs(ctx context.Context, names []string) ([]int, error) {
+	if len(names) == 0 {
+		return []int{}, nil
+	}
+
+	perms, err := s.repo.GetPermissionsByName(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(perms))
+	for _, name := range names {
+		i := slices.IndexFunc(perms, func(p *ent.Permission) bool { return p.Name == name })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownPermission, name)
+		}
+		if !slices.Contains(ids, perms[i].ID) {
+			ids = append(ids, perms[i].ID)
+		}
+	}
+	return ids, nil
+}
+
+func (s *roleService) toDomain(ro *ent.Role) *Role {
+	perms := make([]string, len(ro.Edges.Permissions))
+	for i, p := range ro.Edges.Permissions {
+		perms[i] = p.Name
+	}
+	slices.Sort(perms)
+
+	return &Role{
+		ID:          ro.ID,
+		AppID:       ro.AppID,
+		Name:        ro.Name,
+		Description: ro.Description,
+		Permissions: perms,
+		CreatedAt:   ro.CreatedAt,
+		UpdatedAt:   ro.UpdatedAt,
+	}
+}
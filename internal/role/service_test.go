@@ -0,0 +1,202 @@
+package role
+
+import (
+	"context"
+	"testing"
+
+	"keeper/ent/enttest"
+	"keeper/pkg/auth"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPermissions = []string{"users:read", "users:write", "roles:read", "roles:write"}
+
+func TestService_SyncPermissions(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_role_sync?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewRoleService(NewRoleRepository(client))
+	ctx := context.Background()
+
+	assert.NoError(t, svc.SyncPermissions(ctx, testPermissions[:2]))
+	assert.NoError(t, svc.SyncPermissions(ctx, testPermissions))
+
+	perms, err := svc.ListPermissions(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, perms, len(testPermissions))
+	assert.Equal(t, "roles:read", perms[0].Name)
+
+	// Tenants cannot extend the catalog.
+	tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: 1, UserID: 1})
+	err = svc.SyncPermissions(tenantCtx, []string{"keys:write"})
+	assert.Error(t, err)
+
+	t.Run("CreatePermission", func(t *testing.T) {
+		p, err := svc.CreatePermission(ctx, CreatePermissionRequest{Name: "documents:edit"})
+		assert.NoError(t, err)
+		assert.Equal(t, "documents:edit", p.Name)
+
+		_, err = svc.CreatePermission(ctx, CreatePermissionRequest{Name: "documents:edit"})
+		assert.ErrorIs(t, err, ErrPermissionExists)
+
+		_, err = svc.CreatePermission(tenantCtx, CreatePermissionRequest{Name: "documents:read"})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_Create(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_role_create?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewRoleService(NewRoleRepository(client))
+	ctx := context.Background()
+	assert.NoError(t, svc.SyncPermissions(ctx, testPermissions))
+
+	a, err := client.App.Create().SetName("Role App").Save(ctx)
+	assert.NoError(t, err)
+
+	req := CreateRoleRequest{
+		AppID:       a.ID,
+		Name:        "support",
+		Description: "Helps users",
+		Permissions: []string{"users:write", "users:read", "users:read"},
+	}
+
+	ro, err := svc.Create(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, req.Name, ro.Name)
+	assert.Equal(t, a.ID, ro.AppID)
+	assert.Equal(t, []string{"users:read", "users:write"}, ro.Permissions)
+
+	t.Run("Duplicate", func(t *testing.T) {
+		_, err := svc.Create(ctx, req)
+		assert.ErrorIs(t, err, ErrRoleExists)
+	})
+
+	t.Run("UnknownPermission", func(t *testing.T) {
+		_, err := svc.Create(ctx, CreateRoleRequest{AppID: a.ID, Name: "ops", Permissions: []string{"keys:write"}})
+		assert.ErrorIs(t, err, ErrUnknownPermission)
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_role_update?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewRoleService(NewRoleRepository(client))
+	ctx := context.Background()
+	assert.NoError(t, svc.SyncPermissions(ctx, testPermissions))
+
+	a, err := client.App.Create().SetName("Role App").Save(ctx)
+	assert.NoError(t, err)
+	ro, err := svc.Create(ctx, CreateRoleRequest{AppID: a.ID, Name: "viewer", Permissions: []string{"users:read"}})
+	assert.NoError(t, err)
+
+	name := "reader"
+	updated, err := svc.Update(ctx, ro.ID, UpdateRoleRequest{Name: &name})
+	assert.NoError(t, err)
+	assert.Equal(t, name, updated.Name)
+	assert.Equal(t, []string{"users:read"}, updated.Permissions)
+
+	updated, err = svc.Update(ctx, ro.ID, UpdateRoleRequest{Permissions: []string{"roles:read"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"roles:read"}, updated.Permissions)
+
+	updated, err = svc.Update(ctx, ro.ID, UpdateRoleRequest{Permissions: []string{}})
+	assert.NoError(t, err)
+	assert.Empty(t, updated.Permissions)
+
+	_, err = svc.Update(ctx, 9999, UpdateRoleRequest{Name: &name})
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+}
+
+func TestService_Delete(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_role_delete?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewRoleService(NewRoleRepository(client))
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("Role App").Save(ctx)
+	assert.NoError(t, err)
+	ro, err := svc.Create(ctx, CreateRoleRequest{AppID: a.ID, Name: "admin"})
+	assert.NoError(t, err)
+	u, err := client.User.Create().
+		SetAppID(a.ID).
+		SetFirstname("Role").
+		SetLastname("Holder").
+		SetEmail("holder@example.com").
+		SetPassword("hash").
+		AddRoleIDs(ro.ID).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.Delete(ctx, ro.ID))
+
+	n, err := client.User.QueryRoles(u).Count(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	err = svc.Delete(ctx, ro.ID)
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+}
+
+func TestService_TenantIsolation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_role_tenant?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewRoleService(NewRoleRepository(client))
+	ctx := context.Background()
+	assert.NoError(t, svc.SyncPermissions(ctx, testPermissions))
+
+	appA, err := client.App.Create().SetName("Tenant A").Save(ctx)
+	assert.NoError(t, err)
+	appB, err := client.App.Create().SetName("Tenant B").Save(ctx)
+	assert.NoError(t, err)
+
+	roleA, err := svc.Create(ctx, CreateRoleRequest{AppID: appA.ID, Name: "admin"})
+	assert.NoError(t, err)
+	roleB, err := svc.Create(ctx, CreateRoleRequest{AppID: appB.ID, Name: "admin"})
+	assert.NoError(t, err)
+
+	tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: appA.ID, UserID: 1})
+
+	roles, err := svc.List(tenantCtx)
+	assert.NoError(t, err)
+	assert.Len(t, roles, 1)
+	assert.Equal(t, roleA.ID, roles[0].ID)
+
+	_, err = svc.GetByID(tenantCtx, roleB.ID)
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+
+	name := "hijacked"
+	_, err = svc.Update(tenantCtx, roleB.ID, UpdateRoleRequest{Name: &name})
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+
+	err = svc.Delete(tenantCtx, roleB.ID)
+	assert.ErrorIs(t, err, ErrRoleNotFound)
+
+	_, err = svc.Create(tenantCtx, CreateRoleRequest{AppID: appB.ID, Name: "support"})
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	ro, err := svc.Create(tenantCtx, CreateRoleRequest{AppID: appA.ID, Name: "support", Permissions: []string{"users:read"}})
+	assert.NoError(t, err)
+	assert.Equal(t, appA.ID, ro.AppID)
+}
@@ -0,0 +1,160 @@
+package scim
+
+// Mutability, returned and uniqueness values of schema attributes.
+const (
+	readWrite = "readWrite"
+	readOnly  = "readOnly"
+	writeOnly = "writeOnly"
+
+	returnedDefault = "default"
+	returnedNever   = "never"
+
+	uniqueNone   = "none"
+	uniqueServer = "server"
+)
+
+// serviceProviderConfig describes the features served under baseURL.
+func serviceProviderConfig(baseURL string) *ServiceProviderConfig {
+	return &ServiceProviderConfig{
+		Schemas:        []string{SchemaServiceProviderConfig},
+		Patch:          Supported{Supported: true},
+		Bulk:           BulkConfig{Supported: false},
+		Filter:         FilterConfig{Supported: true, MaxResults: MaxCount},
+		ChangePassword: Supported{Supported: true},
+		Sort:           Supported{Supported: true},
+		ETag:           Supported{Supported: false},
+		AuthenticationSchemes: []AuthenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "The app's SCIM token, generated with POST /apps/{id}/scim-token, sent in the Authorization header.",
+			Primary:     true,
+		}},
+		Meta: &Meta{ResourceType: "ServiceProviderConfig", Location: baseURL + "/ServiceProviderConfig"},
+	}
+}
+
+// resourceTypes describes the User and Group endpoints served under baseURL.
+func resourceTypes(baseURL string) []*ResourceType {
+	return []*ResourceType{
+		{
+			Schemas:     []string{SchemaResourceType},
+			ID:          "User",
+			Name:        "User",
+			Endpoint:    "/Users",
+			Description: "Users of the app",
+			Schema:      SchemaUser,
+			Meta:        &Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/User"},
+		},
+		{
+			Schemas:     []string{SchemaResourceType},
+			ID:          "Group",
+			Name:        "Group",
+			Endpoint:    "/Groups",
+			Description: "Roles of the app",
+			Schema:      SchemaGroup,
+			Meta:        &Meta{ResourceType: "ResourceType", Location: baseURL + "/ResourceTypes/Group"},
+		},
+	}
+}
+
+// schemas describes the attributes of Users and Groups that are served
+// under baseURL.
+func schemas(baseURL string) []*Schema {
+	return []*Schema{
+		{
+			Schemas:     []string{SchemaSchema},
+			ID:          SchemaUser,
+			Name:        "User",
+			Description: "User Account",
+			Attributes: []Attribute{
+				stringAttr("userName", "Unique identifier of the user, their email address.", true, readWrite, uniqueServer),
+				{
+					Name:        "name",
+					Type:        "complex",
+					Description: "The components of the user's name.",
+					Mutability:  readWrite,
+					Returned:    returnedDefault,
+					Uniqueness:  uniqueNone,
+					SubAttributes: []Attribute{
+						stringAttr("formatted", "The full name.", false, readOnly, uniqueNone),
+						stringAttr("familyName", "The family name of the user.", false, readWrite, uniqueNone),
+						stringAttr("givenName", "The given name of the user.", false, readWrite, uniqueNone),
+					},
+				},
+				stringAttr("displayName", "The name of the user, suitable for display.", false, readWrite, uniqueNone),
+				{
+					Name:        "emails",
+					Type:        "complex",
+					MultiValued: true,
+					Description: "Email addresses of the user. The only one is userName.",
+					Mutability:  readOnly,
+					Returned:    returnedDefault,
+					Uniqueness:  uniqueNone,
+					SubAttributes: []Attribute{
+						stringAttr("value", "Email address.", false, readOnly, uniqueNone),
+						stringAttr("type", "Always work.", false, readOnly, uniqueNone),
+						{Name: "primary", Type: "boolean", Description: "Always true.", Mutability: readOnly, Returned: returnedDefault, Uniqueness: uniqueNone},
+					},
+				},
+				{
+					Name:        "active",
+					Type:        "boolean",
+					Description: "Whether the user may log in.",
+					Mutability:  readWrite,
+					Returned:    returnedDefault,
+					Uniqueness:  uniqueNone,
+				},
+				{
+					Name:        "password",
+					Type:        "string",
+					Description: "The user's new password. Users created without one get a random password.",
+					Mutability:  writeOnly,
+					Returned:    returnedNever,
+					Uniqueness:  uniqueNone,
+				},
+				memberAttr("groups", "Groups (roles) the user belongs to. Change them through the Group's members.", readOnly, "Group"),
+			},
+			Meta: &Meta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + SchemaUser},
+		},
+		{
+			Schemas:     []string{SchemaSchema},
+			ID:          SchemaGroup,
+			Name:        "Group",
+			Description: "Group, backed by a role of the app",
+			Attributes: []Attribute{
+				stringAttr("displayName", "The name of the role.", true, readWrite, uniqueServer),
+				memberAttr("members", "Users with the role.", readWrite, "User"),
+			},
+			Meta: &Meta{ResourceType: "Schema", Location: baseURL + "/Schemas/" + SchemaGroup},
+		},
+	}
+}
+
+func stringAttr(name, description string, required bool, mutability, uniqueness string) Attribute {
+	return Attribute{
+		Name:        name,
+		Type:        "string",
+		Description: description,
+		Required:    required,
+		Mutability:  mutability,
+		Returned:    returnedDefault,
+		Uniqueness:  uniqueness,
+	}
+}
+
+func memberAttr(name, description, mutability, refType string) Attribute {
+	return Attribute{
+		Name:        name,
+		Type:        "complex",
+		MultiValued: true,
+		Description: description,
+		Mutability:  mutability,
+		Returned:    returnedDefault,
+		Uniqueness:  uniqueNone,
+		SubAttributes: []Attribute{
+			{Name: "value", Type: "string", Description: "ID of the " + refType + ".", CaseExact: true, Mutability: "immutable", Returned: returnedDefault, Uniqueness: uniqueNone},
+			{Name: "$ref", Type: "reference", Description: "URI of the " + refType + ".", CaseExact: true, Mutability: "immutable", Returned: returnedDefault, Uniqueness: uniqueNone, ReferenceTypes: []string{refType}},
+			{Name: "display", Type: "string", Description: "Name of the " + refType + ".", Mutability: readOnly, Returned: returnedDefault, Uniqueness: uniqueNone},
+		},
+	}
+}
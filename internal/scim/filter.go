@@ -0,0 +1,547 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// filter is a parsed SCIM filter (RFC 7644, section 3.4.2.2). It is matched
+// against resources, or the elements of a multi-valued attribute, in their
+// JSON form. Attribute names and string values compare case-insensitively.
+type filter interface {
+	match(v map[string]any) bool
+}
+
+type (
+	andFilter struct{ left, right filter }
+	orFilter  struct{ left, right filter }
+	notFilter struct{ f filter }
+	// presentFilter matches resources with a value for path.
+	presentFilter struct{ path attrPath }
+	// compareFilter matches resources with a value for path that compares to
+	// value with op.
+	compareFilter struct {
+		path  attrPath
+		op    string
+		value any
+	}
+	// valuePathFilter matches resources with an element of the multi-valued
+	// attribute attr that matches f, e.g. emails[type eq "work"].
+	valuePathFilter struct {
+		attr string
+		f    filter
+	}
+)
+
+func (f andFilter) match(v map[string]any) bool { return f.left.match(v) && f.right.match(v) }
+func (f orFilter) match(v map[string]any) bool  { return f.left.match(v) || f.right.match(v) }
+func (f notFilter) match(v map[string]any) bool { return !f.f.match(v) }
+
+func (f presentFilter) match(v map[string]any) bool {
+	for _, x := range f.path.values(v) {
+		if !empty(x) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f compareFilter) match(v map[string]any) bool {
+	values := f.path.values(v)
+	switch f.op {
+	case "eq":
+		if f.value == nil {
+			return len(values) == 0
+		}
+	case "ne":
+		if f.value == nil {
+			return len(values) > 0
+		}
+		for _, x := range values {
+			if compare("eq", x, f.value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, x := range values {
+		if compare(f.op, x, f.value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f valuePathFilter) match(v map[string]any) bool {
+	for _, e := range elements(lookup(v, f.attr)) {
+		if m, ok := e.(map[string]any); ok && f.f.match(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// userKey returns the id or userName, as attr, that f requires users to
+// have, if any, so that they can be looked up rather than filtered.
+func userKey(f filter) (attr, value string, ok bool) {
+	switch f := f.(type) {
+	case compareFilter:
+		value, ok = f.value.(string)
+		if !ok || f.op != "eq" || f.path.sub != "" {
+			return "", "", false
+		}
+		for _, attr := range []string{"id", "userName"} {
+			if strings.EqualFold(f.path.attr, attr) {
+				return attr, value, true
+			}
+		}
+	case andFilter:
+		if attr, value, ok = userKey(f.left); ok {
+			return attr, value, true
+		}
+		return userKey(f.right)
+	}
+	return "", "", false
+}
+
+// attrPath is an attribute with an optional sub-attribute, such as
+// name.givenName. The schema URI prefix of a fully qualified path is
+// dropped.
+type attrPath struct {
+	attr string
+	sub  string
+}
+
+func parseAttrPath(s string) attrPath {
+	if strings.HasPrefix(strings.ToLower(s), "urn:") {
+		s = s[strings.LastIndex(s, ":")+1:]
+	}
+	attr, sub, _ := strings.Cut(s, ".")
+	return attrPath{attr: attr, sub: sub}
+}
+
+func (p attrPath) String() string {
+	if p.sub == "" {
+		return p.attr
+	}
+	return p.attr + "." + p.sub
+}
+
+// values returns the values p has in v. The elements of multi-valued
+// attributes are values of their own, and complex values without a
+// sub-attribute compare by their value sub-attribute, when they have one.
+func (p attrPath) values(v map[string]any) []any {
+	var values []any
+	for _, e := range elements(lookup(v, p.attr)) {
+		m, complex := e.(map[string]any)
+		switch {
+		case p.sub != "" && complex:
+			values = append(values, elements(lookup(m, p.sub))...)
+		case p.sub != "":
+		case complex:
+			if x := lookup(m, "value"); x != nil {
+				values = append(values, x)
+			} else {
+				values = append(values, m)
+			}
+		default:
+			values = append(values, e)
+		}
+	}
+	return values
+}
+
+// lookup returns the attribute of v named name, ignoring case.
+func lookup(v map[string]any, name string) any {
+	if x, ok := v[name]; ok {
+		return x
+	}
+	for k, x := range v {
+		if strings.EqualFold(k, name) {
+			return x
+		}
+	}
+	return nil
+}
+
+// key returns the key of the attribute of v named name, ignoring case, or
+// name when v has no such attribute.
+func key(v map[string]any, name string) string {
+	if _, ok := v[name]; ok {
+		return name
+	}
+	for k := range v {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+// elements returns the elements of a multi-valued attribute, or a single
+// value on its own.
+func elements(x any) []any {
+	switch x := x.(type) {
+	case nil:
+		return nil
+	case []any:
+		return x
+	default:
+		return []any{x}
+	}
+}
+
+func empty(x any) bool {
+	switch x := x.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case []any:
+		return len(x) == 0
+	case map[string]any:
+		return len(x) == 0
+	}
+	return false
+}
+
+// compare reports whether a compares to b with op. Strings that are both
+// timestamps compare as times, other strings case-insensitively.
+func compare(op string, a, b any) bool {
+	var c int
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return false
+		}
+		ta, errA := time.Parse(time.RFC3339Nano, a)
+		tb, errB := time.Parse(time.RFC3339Nano, b)
+		if errA == nil && errB == nil {
+			c = ta.Compare(tb)
+			break
+		}
+		a, b = strings.ToLower(a), strings.ToLower(b)
+		switch op {
+		case "co":
+			return strings.Contains(a, b)
+		case "sw":
+			return strings.HasPrefix(a, b)
+		case "ew":
+			return strings.HasSuffix(a, b)
+		}
+		c = strings.Compare(a, b)
+	case float64:
+		b, ok := b.(float64)
+		if !ok {
+			return false
+		}
+		switch {
+		case a < b:
+			c = -1
+		case a > b:
+			c = 1
+		}
+	case bool:
+		b, ok := b.(bool)
+		return ok && op == "eq" && a == b
+	default:
+		return false
+	}
+	switch op {
+	case "eq":
+		return c == 0
+	case "gt":
+		return c > 0
+	case "ge":
+		return c >= 0
+	case "lt":
+		return c < 0
+	case "le":
+		return c <= 0
+	}
+	return false
+}
+
+// compareOps are the comparison operators of filters. "pr" takes no value.
+var compareOps = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString
+	tokLParen
+	tokRParen
+	tokLBracket
+	tokRBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// tokenize splits a filter or PATCH path into words, JSON strings and
+// brackets.
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		switch c := s[i]; c {
+		case ' ', '\t', '\n', '\r':
+			i++
+		case '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "("})
+			i++
+		case ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")"})
+			i++
+		case '[':
+			tokens = append(tokens, token{kind: tokLBracket, text: "["})
+			i++
+		case ']':
+			tokens = append(tokens, token{kind: tokRBracket, text: "]"})
+			i++
+		case '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			var str string
+			if err := json.Unmarshal([]byte(s[i:j+1]), &str); err != nil {
+				return nil, fmt.Errorf("invalid string at %d", i)
+			}
+			tokens = append(tokens, token{kind: tokString, text: str})
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t\n\r()[]\"", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokWord, text: s[i:j]})
+			i = j
+		}
+	}
+	return append(tokens, token{kind: tokEOF}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// keyword reports whether the next token is the word kw, and consumes it
+// if so.
+func (p *parser) keyword(kw string) bool {
+	if t := p.peek(); t.kind == tokWord && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(kind tokenKind, what string) error {
+	if t := p.next(); t.kind != kind {
+		return fmt.Errorf("expected %s, got %q", what, t.text)
+	}
+	return nil
+}
+
+// parseFilter parses a filter. Operators are case-insensitive; "not" binds
+// tighter than "and", which binds tighter than "or".
+func parseFilter(s string) (filter, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q", t.text)
+	}
+	return f, nil
+}
+
+func (p *parser) parseOr() (filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (filter, error) {
+	if !p.keyword("not") {
+		return p.parseAtom()
+	}
+	if err := p.expect(tokLParen, `"("`); err != nil {
+		return nil, err
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(tokRParen, `")"`); err != nil {
+		return nil, err
+	}
+	return notFilter{f}, nil
+}
+
+func (p *parser) parseAtom() (filter, error) {
+	t := p.next()
+	switch t.kind {
+	case tokLParen:
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokRParen, `")"`); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case tokWord:
+	default:
+		return nil, fmt.Errorf("expected attribute, got %q", t.text)
+	}
+
+	path := parseAttrPath(t.text)
+	if p.peek().kind == tokLBracket {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokRBracket, `"]"`); err != nil {
+			return nil, err
+		}
+		return valuePathFilter{attr: path.attr, f: f}, nil
+	}
+
+	opTok := p.next()
+	op := strings.ToLower(opTok.text)
+	if opTok.kind != tokWord || (op != "pr" && !compareOps[op]) {
+		return nil, fmt.Errorf("expected operator after %s, got %q", path, opTok.text)
+	}
+	if op == "pr" {
+		return presentFilter{path: path}, nil
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return compareFilter{path: path, op: op, value: value}, nil
+}
+
+// parseValue parses a comparison value: a string, number, boolean or null.
+func (p *parser) parseValue() (any, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		return t.text, nil
+	case tokWord:
+		switch strings.ToLower(t.text) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+		if n, err := strconv.ParseFloat(t.text, 64); err == nil {
+			return n, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid comparison value %q", t.text)
+}
+
+// patchPath is the target of a PATCH operation: an attribute, optionally
+// narrowed to the elements matching filter, and an optional sub-attribute,
+// e.g. emails[type eq "work"].value.
+type patchPath struct {
+	attr   string
+	filter filter
+	sub    string
+}
+
+func parsePatchPath(s string) (patchPath, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return patchPath{}, err
+	}
+	p := &parser{tokens: tokens}
+	t := p.next()
+	if t.kind != tokWord {
+		return patchPath{}, fmt.Errorf("expected attribute, got %q", t.text)
+	}
+	path := parseAttrPath(t.text)
+	pp := patchPath{attr: path.attr, sub: path.sub}
+	if p.peek().kind == tokLBracket {
+		if pp.sub != "" {
+			return patchPath{}, fmt.Errorf("unexpected filter after %s", path)
+		}
+		p.next()
+		if pp.filter, err = p.parseOr(); err != nil {
+			return patchPath{}, err
+		}
+		if err := p.expect(tokRBracket, `"]"`); err != nil {
+			return patchPath{}, err
+		}
+		if t := p.peek(); t.kind == tokWord && strings.HasPrefix(t.text, ".") {
+			pp.sub = p.next().text[1:]
+		}
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return patchPath{}, fmt.Errorf("unexpected %q", t.text)
+	}
+	if pp.attr == "" {
+		return patchPath{}, fmt.Errorf("empty attribute in %q", s)
+	}
+	return pp, nil
+}
@@ -0,0 +1,682 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"keeper/pkg/auth"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SCIMHandler handles the SCIM 2.0 endpoints. Responses are SCIM messages
+// rather than render.Response envelopes.
+type SCIMHandler struct {
+	svc SCIMService
+}
+
+// NewSCIMHandler creates a new SCIM handler.
+func NewSCIMHandler(svc SCIMService) *SCIMHandler {
+	return &SCIMHandler{svc: svc}
+}
+
+// Routes returns the chi router for the SCIM endpoints. Every endpoint
+// authenticates with the bearer SCIM token of an app.
+func (h *SCIMHandler) Routes() chi.Router {
+	r := chi.NewRouter()
+	r.Use(h.authenticate)
+
+	r.Get("/ServiceProviderConfig", h.GetServiceProviderConfig)
+	r.Get("/ResourceTypes", h.ListResourceTypes)
+	r.Get("/ResourceTypes/{id}", h.GetResourceType)
+	r.Get("/Schemas", h.ListSchemas)
+	r.Get("/Schemas/{id}", h.GetSchema)
+
+	r.Route("/Users", func(r chi.Router) {
+		r.Get("/", h.ListUsers)
+		r.Post("/", h.CreateUser)
+		r.Get("/{id}", h.GetUser)
+		r.Put("/{id}", h.ReplaceUser)
+		r.Patch("/{id}", h.PatchUser)
+		r.Delete("/{id}", h.DeleteUser)
+	})
+
+	r.Route("/Groups", func(r chi.Router) {
+		r.Get("/", h.ListGroups)
+		r.Post("/", h.CreateGroup)
+		r.Get("/{id}", h.GetGroup)
+		r.Put("/{id}", h.ReplaceGroup)
+		r.Patch("/{id}", h.PatchGroup)
+		r.Delete("/{id}", h.DeleteGroup)
+	})
+
+	return r
+}
+
+// authenticate resolves the bearer SCIM token to its app and acts for it
+// with claims confined to the app.
+func (h *SCIMHandler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !strings.EqualFold(scheme, "bearer") {
+			token = ""
+		}
+
+		claims, err := h.svc.Authenticate(r.Context(), strings.TrimSpace(token))
+		if err != nil {
+			if errors.Is(err, ErrInvalidToken) {
+				slog.Warn("invalid scim token", "path", r.URL.Path, "remote_addr", r.RemoteAddr)
+				w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			}
+			writeError(w, err)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), auth.UserClaimsKey, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ListUsers godoc
+// @Summary List SCIM users
+// @Description List the users of the token's app, with SCIM filter syntax such as userName eq "ada@example.com", sorting and 1-based pagination.
+// @Tags scim
+// @Produce json
+// @Param filter query string false "SCIM filter"
+// @Param startIndex query int false "1-based index of the first result"
+// @Param count query int false "Page size, at most 200"
+// @Param sortBy query string false "Attribute to sort by"
+// @Param sortOrder query string false "ascending or descending"
+// @Param attributes query string false "Comma-separated attributes to return"
+// @Param excludedAttributes query string false "Comma-separated attributes not to return"
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Users [get]
+func (h *SCIMHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	req, err := listRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res, err := h.svc.ListUsers(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeList(w, r, res)
+}
+
+// GetUser godoc
+// @Summary Get a SCIM user
+// @Description Get a user of the token's app
+// @Tags scim
+// @Produce json
+// @Param id path string true "User ID"
+// @Param attributes query string false "Comma-separated attributes to return"
+// @Param excludedAttributes query string false "Comma-separated attributes not to return"
+// @Success 200 {object} User
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Users/{id} [get]
+func (h *SCIMHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	u, err := h.svc.GetUser(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResource(w, r, http.StatusOK, u)
+}
+
+// CreateUser godoc
+// @Summary Provision a SCIM user
+// @Description Create a user in the token's app. userName is the user's email; users created without a password get a random one.
+// @Tags scim
+// @Accept json
+// @Produce json
+// @Param user body User true "User"
+// @Success 201 {object} User
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Failure 409 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Users [post]
+func (h *SCIMHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var in User
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	u, err := h.svc.CreateUser(r.Context(), &in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", u.Meta.Location)
+	writeResource(w, r, http.StatusCreated, u)
+}
+
+// ReplaceUser godoc
+// @Summary Replace a SCIM user
+// @Description Replace the name, userName, active state and, when given, the password of a user
+// @Tags scim
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param user body User true "User"
+// @Success 200 {object} User
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Failure 409 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Users/{id} [put]
+func (h *SCIMHandler) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	var in User
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	u, err := h.svc.ReplaceUser(r.Context(), chi.URLParam(r, "id"), &in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResource(w, r, http.StatusOK, u)
+}
+
+// PatchUser godoc
+// @Summary Patch a SCIM user
+// @Description Apply add, replace and remove operations to a user
+// @Tags scim
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param patch body PatchRequest true "Patch operations"
+// @Success 200 {object} User
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Failure 409 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Users/{id} [patch]
+func (h *SCIMHandler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	var req PatchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	u, err := h.svc.PatchUser(r.Context(), chi.URLParam(r, "id"), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResource(w, r, http.StatusOK, u)
+}
+
+// DeleteUser godoc
+// @Summary Deprovision a SCIM user
+// @Description Delete a user of the token's app
+// @Tags scim
+// @Param id path string true "User ID"
+// @Success 204
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Users/{id} [delete]
+func (h *SCIMHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.svc.DeleteUser(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroups godoc
+// @Summary List SCIM groups
+// @Description List the roles of the token's app as groups, with SCIM filter syntax such as displayName eq "admin", sorting and 1-based pagination.
+// @Tags scim
+// @Produce json
+// @Param filter query string false "SCIM filter"
+// @Param startIndex query int false "1-based index of the first result"
+// @Param count query int false "Page size, at most 200"
+// @Param sortBy query string false "Attribute to sort by"
+// @Param sortOrder query string false "ascending or descending"
+// @Param attributes query string false "Comma-separated attributes to return"
+// @Param excludedAttributes query string false "Comma-separated attributes not to return"
+// @Success 200 {object} ListResponse
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Groups [get]
+func (h *SCIMHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+	req, err := listRequest(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res, err := h.svc.ListGroups(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeList(w, r, res)
+}
+
+// GetGroup godoc
+// @Summary Get a SCIM group
+// @Description Get a role of the token's app as a group
+// @Tags scim
+// @Produce json
+// @Param id path string true "Group ID"
+// @Param attributes query string false "Comma-separated attributes to return"
+// @Param excludedAttributes query string false "Comma-separated attributes not to return"
+// @Success 200 {object} Group
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Groups/{id} [get]
+func (h *SCIMHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+	g, err := h.svc.GetGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResource(w, r, http.StatusOK, g)
+}
+
+// CreateGroup godoc
+// @Summary Provision a SCIM group
+// @Description Create a role in the token's app and give it to the members
+// @Tags scim
+// @Accept json
+// @Produce json
+// @Param group body Group true "Group"
+// @Success 201 {object} Group
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Failure 409 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Groups [post]
+func (h *SCIMHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var in Group
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	g, err := h.svc.CreateGroup(r.Context(), &in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", g.Meta.Location)
+	writeResource(w, r, http.StatusCreated, g)
+}
+
+// ReplaceGroup godoc
+// @Summary Replace a SCIM group
+// @Description Rename a role and give it to exactly the members
+// @Tags scim
+// @Accept json
+// @Produce json
+// @Param id path string true "Group ID"
+// @Param group body Group true "Group"
+// @Success 200 {object} Group
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Failure 409 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Groups/{id} [put]
+func (h *SCIMHandler) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	var in Group
+	if err := decode(r, &in); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	g, err := h.svc.ReplaceGroup(r.Context(), chi.URLParam(r, "id"), &in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResource(w, r, http.StatusOK, g)
+}
+
+// PatchGroup godoc
+// @Summary Patch a SCIM group
+// @Description Apply add, replace and remove operations to a group, such as adding or removing members
+// @Tags scim
+// @Accept json
+// @Produce json
+// @Param id path string true "Group ID"
+// @Param patch body PatchRequest true "Patch operations"
+// @Success 200 {object} Group
+// @Failure 400 {object} Error
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Failure 409 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Groups/{id} [patch]
+func (h *SCIMHandler) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	var req PatchRequest
+	if err := decode(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	g, err := h.svc.PatchGroup(r.Context(), chi.URLParam(r, "id"), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResource(w, r, http.StatusOK, g)
+}
+
+// DeleteGroup godoc
+// @Summary Deprovision a SCIM group
+// @Description Delete a role of the token's app
+// @Tags scim
+// @Param id path string true "Group ID"
+// @Success 204
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Groups/{id} [delete]
+func (h *SCIMHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	if err := h.svc.DeleteGroup(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetServiceProviderConfig godoc
+// @Summary SCIM service provider configuration
+// @Description Describe the SCIM features supported
+// @Tags scim
+// @Produce json
+// @Success 200 {object} ServiceProviderConfig
+// @Failure 401 {object} Error
+// @Security Bearer
+// @Router /scim/v2/ServiceProviderConfig [get]
+func (h *SCIMHandler) GetServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.svc.ServiceProviderConfig())
+}
+
+// ListResourceTypes godoc
+// @Summary List SCIM resource types
+// @Description Describe the User and Group endpoints
+// @Tags scim
+// @Produce json
+// @Success 200 {object} ListResponse
+// @Failure 401 {object} Error
+// @Security Bearer
+// @Router /scim/v2/ResourceTypes [get]
+func (h *SCIMHandler) ListResourceTypes(w http.ResponseWriter, r *http.Request) {
+	types := h.svc.ResourceTypes()
+	resources := make([]any, len(types))
+	for i, t := range types {
+		resources[i] = t
+	}
+	writeJSON(w, http.StatusOK, listOf(resources))
+}
+
+// GetResourceType godoc
+// @Summary Get a SCIM resource type
+// @Description Describe the User or Group endpoint
+// @Tags scim
+// @Produce json
+// @Param id path string true "User or Group"
+// @Success 200 {object} ResourceType
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Security Bearer
+// @Router /scim/v2/ResourceTypes/{id} [get]
+func (h *SCIMHandler) GetResourceType(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	for _, t := range h.svc.ResourceTypes() {
+		if t.ID == id {
+			writeJSON(w, http.StatusOK, t)
+			return
+		}
+	}
+	writeError(w, ErrNotFound)
+}
+
+// ListSchemas godoc
+// @Summary List SCIM schemas
+// @Description Describe the attributes of Users and Groups
+// @Tags scim
+// @Produce json
+// @Success 200 {object} ListResponse
+// @Failure 401 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Schemas [get]
+func (h *SCIMHandler) ListSchemas(w http.ResponseWriter, r *http.Request) {
+	schemas := h.svc.Schemas()
+	resources := make([]any, len(schemas))
+	for i, s := range schemas {
+		resources[i] = s
+	}
+	writeJSON(w, http.StatusOK, listOf(resources))
+}
+
+// GetSchema godoc
+// @Summary Get a SCIM schema
+// @Description Describe the attributes of the User or Group schema with the given URI
+// @Tags scim
+// @Produce json
+// @Param id path string true "Schema URI"
+// @Success 200 {object} Schema
+// @Failure 401 {object} Error
+// @Failure 404 {object} Error
+// @Security Bearer
+// @Router /scim/v2/Schemas/{id} [get]
+func (h *SCIMHandler) GetSchema(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	for _, s := range h.svc.Schemas() {
+		if s.ID == id {
+			writeJSON(w, http.StatusOK, s)
+			return
+		}
+	}
+	writeError(w, ErrNotFound)
+}
+
+// listRequest reads the query parameters of a list request.
+func listRequest(r *http.Request) (ListRequest, error) {
+	q := r.URL.Query()
+	req := ListRequest{
+		Filter:     q.Get("filter"),
+		SortBy:     q.Get("sortBy"),
+		Descending: strings.EqualFold(q.Get("sortOrder"), "descending"),
+	}
+	if s := q.Get("startIndex"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return ListRequest{}, badRequest("invalidValue", "startIndex must be an integer")
+		}
+		req.StartIndex = n
+	}
+	if s := q.Get("count"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return ListRequest{}, badRequest("invalidValue", "count must be an integer")
+		}
+		req.Count = &n
+	}
+	return req, nil
+}
+
+// listOf returns the discovery resources as a single page.
+func listOf(resources []any) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func decode(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		slog.Warn("failed to decode scim request", "path", r.URL.Path, "error", err)
+		return badRequest("invalidSyntax", "invalid request body")
+	}
+	return nil
+}
+
+// writeResource writes a resource, narrowed to the attributes and
+// excludedAttributes query parameters.
+func writeResource(w http.ResponseWriter, r *http.Request, status int, v any) {
+	attributes, excluded := projection(r)
+	if attributes == nil && excluded == nil {
+		writeJSON(w, status, v)
+		return
+	}
+	m, err := toMap(v)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, status, project(m, attributes, excluded))
+}
+
+func writeList(w http.ResponseWriter, r *http.Request, res *ListResponse) {
+	attributes, excluded := projection(r)
+	if attributes != nil || excluded != nil {
+		for i, v := range res.Resources {
+			if m, ok := v.(map[string]any); ok {
+				res.Resources[i] = project(m, attributes, excluded)
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", MediaType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("failed to encode scim response", "error", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var scimErr *Error
+	if !errors.As(err, &scimErr) {
+		scimErr = &Error{Schemas: []string{SchemaError}, Detail: err.Error()}
+		switch {
+		case errors.Is(err, ErrInvalidToken):
+			scimErr.Status = "401"
+		case errors.Is(err, ErrForbidden):
+			scimErr.Status = "403"
+		case errors.Is(err, ErrNotFound):
+			scimErr.Status = "404"
+		case errors.Is(err, ErrConflict):
+			scimErr.Status, scimErr.ScimType = "409", "uniqueness"
+		default:
+			slog.Error("scim request failed", "error", err)
+			scimErr.Status, scimErr.Detail = "500", "internal server error"
+		}
+	}
+	status, _ := strconv.Atoi(scimErr.Status)
+	writeJSON(w, status, scimErr)
+}
+
+// projection returns the attribute paths of the attributes and
+// excludedAttributes query parameters.
+func projection(r *http.Request) (attributes, excluded []attrPath) {
+	parse := func(s string) []attrPath {
+		var paths []attrPath
+		for _, a := range strings.Split(s, ",") {
+			if a = strings.TrimSpace(a); a != "" {
+				paths = append(paths, parseAttrPath(a))
+			}
+		}
+		return paths
+	}
+	q := r.URL.Query()
+	return parse(q.Get("attributes")), parse(q.Get("excludedAttributes"))
+}
+
+// project narrows a resource to attributes, when given, and drops excluded.
+// The id and schemas are always returned.
+func project(m map[string]any, attributes, excluded []attrPath) map[string]any {
+	always := func(k string) bool {
+		return strings.EqualFold(k, "id") || strings.EqualFold(k, "schemas")
+	}
+
+	if attributes != nil {
+		out := make(map[string]any)
+		for k, v := range m {
+			if always(k) {
+				out[k] = v
+				continue
+			}
+			for _, p := range attributes {
+				if !strings.EqualFold(p.attr, k) {
+					continue
+				}
+				sub, complex := v.(map[string]any)
+				if p.sub == "" || !complex {
+					out[k] = v
+					break
+				}
+				selected, _ := out[k].(map[string]any)
+				if selected == nil {
+					selected = make(map[string]any)
+					out[k] = selected
+				}
+				if x := lookup(sub, p.sub); x != nil {
+					selected[key(sub, p.sub)] = x
+				}
+			}
+		}
+		m = out
+	}
+
+	for _, p := range excluded {
+		k := key(m, p.attr)
+		if always(k) {
+			continue
+		}
+		if p.sub == "" {
+			delete(m, k)
+			continue
+		}
+		for _, e := range elements(m[k]) {
+			if sub, ok := e.(map[string]any); ok {
+				delete(sub, key(sub, p.sub))
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,405 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"keeper/pkg/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockSCIMService struct {
+	mock.Mock
+}
+
+func (m *mockSCIMService) Authenticate(ctx context.Context, token string) (*auth.UserClaims, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth.UserClaims), args.Error(1)
+}
+
+func (m *mockSCIMService) ListUsers(ctx context.Context, req ListRequest) (*ListResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ListResponse), args.Error(1)
+}
+
+func (m *mockSCIMService) GetUser(ctx context.Context, id string) (*User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *mockSCIMService) CreateUser(ctx context.Context, u *User) (*User, error) {
+	args := m.Called(ctx, u)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *mockSCIMService) ReplaceUser(ctx context.Context, id string, u *User) (*User, error) {
+	args := m.Called(ctx, id, u)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *mockSCIMService) PatchUser(ctx context.Context, id string, req PatchRequest) (*User, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *mockSCIMService) DeleteUser(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockSCIMService) ListGroups(ctx context.Context, req ListRequest) (*ListResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ListResponse), args.Error(1)
+}
+
+func (m *mockSCIMService) GetGroup(ctx context.Context, id string) (*Group, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Group), args.Error(1)
+}
+
+func (m *mockSCIMService) CreateGroup(ctx context.Context, g *Group) (*Group, error) {
+	args := m.Called(ctx, g)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Group), args.Error(1)
+}
+
+func (m *mockSCIMService) ReplaceGroup(ctx context.Context, id string, g *Group) (*Group, error) {
+	args := m.Called(ctx, id, g)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Group), args.Error(1)
+}
+
+func (m *mockSCIMService) PatchGroup(ctx context.Context, id string, req PatchRequest) (*Group, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Group), args.Error(1)
+}
+
+func (m *mockSCIMService) DeleteGroup(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockSCIMService) ServiceProviderConfig() *ServiceProviderConfig {
+	return serviceProviderConfig(testBaseURL)
+}
+
+func (m *mockSCIMService) ResourceTypes() []*ResourceType {
+	return resourceTypes(testBaseURL)
+}
+
+func (m *mockSCIMService) Schemas() []*Schema {
+	return schemas(testBaseURL)
+}
+
+// newAuthenticatedService returns a mock service that accepts the token
+// "scim-token" for app 1.
+func newAuthenticatedService() *mockSCIMService {
+	svc := &mockSCIMService{}
+	svc.On("Authenticate", mock.Anything, "scim-token").Return(&auth.UserClaims{AppID: 1, ClientID: "client"}, nil)
+	svc.On("Authenticate", mock.Anything, mock.Anything).Return(nil, ErrInvalidToken)
+	return svc
+}
+
+func serve(svc SCIMService, method, url, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer scim-token")
+	req.Header.Set("Content-Type", MediaType)
+	rr := httptest.NewRecorder()
+	NewSCIMHandler(svc).Routes().ServeHTTP(rr, req)
+	return rr
+}
+
+func TestHandler_Authenticate(t *testing.T) {
+	svc := newAuthenticatedService()
+	h := NewSCIMHandler(svc).Routes()
+
+	tests := []struct {
+		name           string
+		header         string
+		wantStatusCode int
+	}{
+		{"Valid token", "Bearer scim-token", http.StatusOK},
+		{"Wrong token", "Bearer other-token", http.StatusUnauthorized},
+		{"Missing token", "", http.StatusUnauthorized},
+		{"Wrong scheme", "Basic scim-token", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/ServiceProviderConfig", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			assert.Equal(t, MediaType, rr.Header().Get("Content-Type"))
+			if tt.wantStatusCode == http.StatusUnauthorized {
+				assert.Equal(t, `Bearer realm="scim"`, rr.Header().Get("WWW-Authenticate"))
+				var body Error
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+				assert.Equal(t, []string{SchemaError}, body.Schemas)
+				assert.Equal(t, "401", body.Status)
+			}
+		})
+	}
+}
+
+func TestHandler_ListUsers(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(svc *mockSCIMService)
+		wantStatusCode int
+	}{
+		{
+			name:  "Success",
+			query: "?filter=userName+eq+%22ada%40example.com%22&startIndex=2&count=10&sortBy=userName&sortOrder=descending",
+			mockSetup: func(svc *mockSCIMService) {
+				svc.On("ListUsers", mock.Anything, ListRequest{
+					Filter:     `userName eq "ada@example.com"`,
+					SortBy:     "userName",
+					Descending: true,
+					StartIndex: 2,
+					Count:      ptr(10),
+				}).Return(&ListResponse{Schemas: []string{SchemaListResponse}, StartIndex: 2, Resources: []any{}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "Invalid count",
+			query:          "?count=ten",
+			mockSetup:      func(svc *mockSCIMService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Invalid filter",
+			query: "?filter=userName",
+			mockSetup: func(svc *mockSCIMService) {
+				svc.On("ListUsers", mock.Anything, ListRequest{Filter: "userName"}).
+					Return(nil, badRequest("invalidFilter", "unexpected end of filter"))
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newAuthenticatedService()
+			tt.mockSetup(svc)
+
+			rr := serve(svc, http.MethodGet, "/Users"+tt.query, "")
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_GetUser(t *testing.T) {
+	active := true
+	u := &User{
+		Schemas:  []string{SchemaUser},
+		ID:       "1",
+		UserName: "ada@example.com",
+		Name:     &Name{GivenName: "Ada", FamilyName: "Lovelace"},
+		Active:   &active,
+		Meta:     &Meta{ResourceType: "User", Location: testBaseURL + "/Users/1"},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		svc := newAuthenticatedService()
+		svc.On("GetUser", mock.Anything, "1").Return(u, nil)
+
+		rr := serve(svc, http.MethodGet, "/Users/1", "")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var body User
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.Equal(t, "ada@example.com", body.UserName)
+	})
+
+	t.Run("Attributes", func(t *testing.T) {
+		svc := newAuthenticatedService()
+		svc.On("GetUser", mock.Anything, "1").Return(u, nil)
+
+		rr := serve(svc, http.MethodGet, "/Users/1?attributes=userName,name.givenName", "")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.Equal(t, map[string]any{
+			"schemas":  []any{SchemaUser},
+			"id":       "1",
+			"userName": "ada@example.com",
+			"name":     map[string]any{"givenName": "Ada"},
+		}, body)
+	})
+
+	t.Run("ExcludedAttributes", func(t *testing.T) {
+		svc := newAuthenticatedService()
+		svc.On("GetUser", mock.Anything, "1").Return(u, nil)
+
+		rr := serve(svc, http.MethodGet, "/Users/1?excludedAttributes=name,meta,id", "")
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.NotContains(t, body, "name")
+		assert.NotContains(t, body, "meta")
+		assert.Contains(t, body, "id")
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		svc := newAuthenticatedService()
+		svc.On("GetUser", mock.Anything, "2").Return(nil, ErrNotFound)
+
+		rr := serve(svc, http.MethodGet, "/Users/2", "")
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		var body Error
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.Equal(t, "404", body.Status)
+	})
+}
+
+func TestHandler_CreateUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(svc *mockSCIMService)
+		wantStatusCode int
+	}{
+		{
+			name: "Success",
+			body: `{"schemas":["` + SchemaUser + `"],"userName":"ada@example.com","active":true}`,
+			mockSetup: func(svc *mockSCIMService) {
+				svc.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *User) bool {
+					return u.UserName == "ada@example.com" && *u.Active
+				})).Return(&User{ID: "1", UserName: "ada@example.com", Meta: &Meta{Location: testBaseURL + "/Users/1"}}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "Invalid body",
+			body:           `{"userName":`,
+			mockSetup:      func(svc *mockSCIMService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Conflict",
+			body: `{"userName":"ada@example.com"}`,
+			mockSetup: func(svc *mockSCIMService) {
+				svc.On("CreateUser", mock.Anything, mock.Anything).Return(nil, ErrConflict)
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newAuthenticatedService()
+			tt.mockSetup(svc)
+
+			rr := serve(svc, http.MethodPost, "/Users", tt.body)
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			if tt.wantStatusCode == http.StatusCreated {
+				assert.Equal(t, testBaseURL+"/Users/1", rr.Header().Get("Location"))
+			}
+			if tt.wantStatusCode == http.StatusConflict {
+				var body Error
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+				assert.Equal(t, "uniqueness", body.ScimType)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_PatchGroup(t *testing.T) {
+	body := `{"schemas":["` + SchemaPatchOp + `"],"Operations":[{"op":"add","path":"members","value":[{"value":"2"}]}]}`
+
+	svc := newAuthenticatedService()
+	svc.On("PatchGroup", mock.Anything, "1", PatchRequest{
+		Schemas:    []string{SchemaPatchOp},
+		Operations: []PatchOperation{{Op: "add", Path: "members", Value: []any{map[string]any{"value": "2"}}}},
+	}).Return(&Group{ID: "1", DisplayName: "engineers", Members: []Member{{Value: "2"}}}, nil)
+
+	rr := serve(svc, http.MethodPatch, "/Groups/1", body)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	svc.AssertExpectations(t)
+}
+
+func TestHandler_DeleteGroup(t *testing.T) {
+	svc := newAuthenticatedService()
+	svc.On("DeleteGroup", mock.Anything, "1").Return(nil)
+	svc.On("DeleteGroup", mock.Anything, "2").Return(ErrForbidden)
+
+	assert.Equal(t, http.StatusNoContent, serve(svc, http.MethodDelete, "/Groups/1", "").Code)
+	assert.Equal(t, http.StatusForbidden, serve(svc, http.MethodDelete, "/Groups/2", "").Code)
+}
+
+func TestHandler_Discovery(t *testing.T) {
+	svc := newAuthenticatedService()
+
+	rr := serve(svc, http.MethodGet, "/ServiceProviderConfig", "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var config ServiceProviderConfig
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &config))
+	assert.True(t, config.Patch.Supported)
+	assert.True(t, config.Filter.Supported)
+	assert.False(t, config.Bulk.Supported)
+
+	rr = serve(svc, http.MethodGet, "/ResourceTypes", "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var list ListResponse
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+	assert.Equal(t, 2, list.TotalResults)
+
+	rr = serve(svc, http.MethodGet, "/ResourceTypes/Group", "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var rt ResourceType
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &rt))
+	assert.Equal(t, "/Groups", rt.Endpoint)
+
+	rr = serve(svc, http.MethodGet, "/Schemas/"+SchemaUser, "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var schema Schema
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &schema))
+	assert.Equal(t, "User", schema.Name)
+
+	assert.Equal(t, http.StatusNotFound, serve(svc, http.MethodGet, "/Schemas/unknown", "").Code)
+}
@@ -0,0 +1,249 @@
+package scim
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// applyPatch applies the operations of a PATCH request, in order, to a
+// resource in its JSON form (RFC 7644, section 3.5.2). Attributes the
+// resource does not have are added as given; unknown and read-only ones are
+// ignored by the caller when it reads the result back.
+func applyPatch(res map[string]any, ops []PatchOperation) error {
+	if len(ops) == 0 {
+		return badRequest("invalidValue", "patch requests need at least one operation")
+	}
+	for _, op := range ops {
+		name := strings.ToLower(op.Op)
+		switch name {
+		case "add", "replace", "remove":
+		default:
+			return badRequest("invalidSyntax", "unknown patch operation %q", op.Op)
+		}
+
+		if op.Path == "" {
+			if name == "remove" {
+				return badRequest("noTarget", "remove operations need a path")
+			}
+			values, ok := op.Value.(map[string]any)
+			if !ok {
+				return badRequest("invalidValue", "operations without a path need an object value")
+			}
+			// Each attribute, which may be a path such as name.givenName,
+			// is its own operation.
+			for k, v := range values {
+				p, err := parsePatchPath(k)
+				if err != nil {
+					return badRequest("invalidPath", "%v", err)
+				}
+				if err := applyOp(res, name, p, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		p, err := parsePatchPath(op.Path)
+		if err != nil {
+			return badRequest("invalidPath", "%v", err)
+		}
+		if name != "remove" && op.Value == nil {
+			return badRequest("invalidValue", "%s operations need a value", name)
+		}
+		if err := applyOp(res, name, p, op.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyOp(res map[string]any, op string, p patchPath, value any) error {
+	k := key(res, p.attr)
+	if p.filter != nil {
+		return applyFiltered(res, k, op, p, value)
+	}
+
+	if p.sub != "" {
+		switch current := res[k].(type) {
+		case map[string]any:
+			setOrRemove(current, op, p.sub, value)
+		case []any:
+			for _, e := range current {
+				if m, ok := e.(map[string]any); ok {
+					setOrRemove(m, op, p.sub, value)
+				}
+			}
+		case nil:
+			if op != "remove" {
+				res[k] = map[string]any{p.sub: value}
+			}
+		default:
+			return badRequest("invalidPath", "%s has no sub-attributes", p.attr)
+		}
+		return nil
+	}
+
+	current := res[k]
+	switch op {
+	case "remove":
+		// A value narrows the removal of a multi-valued attribute to the
+		// elements with the same value, as some clients send members.
+		if arr, ok := current.([]any); ok && value != nil {
+			res[k] = removeElements(arr, elements(value))
+			return nil
+		}
+		delete(res, k)
+	case "add":
+		switch cur := current.(type) {
+		case []any:
+			res[k] = addElements(cur, elements(value))
+		case map[string]any:
+			if v, ok := value.(map[string]any); ok {
+				maps.Copy(cur, v)
+				return nil
+			}
+			res[k] = value
+		default:
+			res[k] = value
+		}
+	case "replace":
+		// Complex attributes keep the sub-attributes value leaves out.
+		cur, complex := current.(map[string]any)
+		if v, ok := value.(map[string]any); ok && complex {
+			maps.Copy(cur, v)
+			return nil
+		}
+		res[k] = value
+	}
+	return nil
+}
+
+// applyFiltered applies an operation to the elements of a multi-valued
+// attribute that match the filter of p.
+func applyFiltered(res map[string]any, k, op string, p patchPath, value any) error {
+	arr, _ := res[k].([]any)
+	var matched bool
+	kept := arr[:0:0]
+	for _, e := range arr {
+		m, ok := e.(map[string]any)
+		if !ok || !p.filter.match(m) {
+			kept = append(kept, e)
+			continue
+		}
+		matched = true
+		switch {
+		case p.sub != "":
+			setOrRemove(m, op, p.sub, value)
+			kept = append(kept, m)
+		case op == "remove":
+		default:
+			if v, ok := value.(map[string]any); ok && op == "add" {
+				maps.Copy(m, v)
+				kept = append(kept, m)
+			} else {
+				kept = append(kept, value)
+			}
+		}
+	}
+	if matched || op == "remove" {
+		res[k] = kept
+		return nil
+	}
+
+	// Nothing matched: a filter naming one attribute, such as
+	// emails[type eq "work"].value, adds the element it describes.
+	eq, ok := p.filter.(compareFilter)
+	if !ok || eq.op != "eq" || eq.path.sub != "" {
+		return badRequest("noTarget", "no %s matches the filter", p.attr)
+	}
+	e := map[string]any{eq.path.attr: eq.value}
+	if p.sub != "" {
+		e[p.sub] = value
+	} else if v, ok := value.(map[string]any); ok {
+		maps.Copy(e, v)
+	} else {
+		return badRequest("invalidValue", "%s elements must be objects", p.attr)
+	}
+	res[k] = append(arr, e)
+	return nil
+}
+
+func setOrRemove(m map[string]any, op, sub string, value any) {
+	k := key(m, sub)
+	if op == "remove" {
+		delete(m, k)
+		return
+	}
+	m[k] = value
+}
+
+// addElements appends the values not already in arr.
+func addElements(arr, values []any) []any {
+	for _, v := range values {
+		if !containsElement(arr, v) {
+			arr = append(arr, v)
+		}
+	}
+	return arr
+}
+
+// removeElements drops the elements of arr equal to one of values.
+func removeElements(arr, values []any) []any {
+	kept := arr[:0:0]
+	for _, e := range arr {
+		if !containsElement(values, e) {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// containsElement reports whether arr holds v. Complex values are the same
+// when their value sub-attributes are.
+func containsElement(arr []any, v any) bool {
+	for _, e := range arr {
+		if sameElement(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameElement(a, b any) bool {
+	ma, okA := a.(map[string]any)
+	mb, okB := b.(map[string]any)
+	if okA && okB {
+		va, vb := lookup(ma, "value"), lookup(mb, "value")
+		if va != nil || vb != nil {
+			return reflect.DeepEqual(va, vb)
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toMap returns the JSON form of a resource.
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// fromMap reads a resource back from its JSON form.
+func fromMap(m map[string]any, v any) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return badRequest("invalidValue", "%v", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"keeper/ent"
+	"keeper/ent/app"
+)
+
+// SCIMRepository handles the database lookups of SCIM authentication. Users
+// and groups are read and changed through the user and role services.
+type SCIMRepository struct {
+	client *ent.Client
+}
+
+// NewSCIMRepository creates a new SCIM repository.
+func NewSCIMRepository(client *ent.Client) *SCIMRepository {
+	return &SCIMRepository{client: client}
+}
+
+// GetAppBySCIMTokenHash retrieves the app whose SCIM token has the given hash.
+func (r *SCIMRepository) GetAppBySCIMTokenHash(ctx context.Context, tokenHash string) (*ent.App, error) {
+	a, err := r.client.App.Query().
+		Where(app.ScimTokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("scim token not found in database")
+			return nil, fmt.Errorf("app not found: %w", err)
+		}
+		slog.Error("database error: failed to get app by scim token", "error", err)
+		return nil, err
+	}
+	return a, nil
+}
@@ -0,0 +1,898 @@
+package scim
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"keeper/ent"
+	entuser "keeper/ent/user"
+	"keeper/internal/role"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// SCIMService provisions the users and roles of an app over SCIM 2.0 (RFC
+// 7643, RFC 7644), through the user and role services. Every method but
+// Authenticate and the discovery methods acts for the app in the claims of
+// its context, as returned by Authenticate.
+type SCIMService interface {
+	Authenticate(ctx context.Context, token string) (*auth.UserClaims, error)
+	ListUsers(ctx context.Context, req ListRequest) (*ListResponse, error)
+	GetUser(ctx context.Context, id string) (*User, error)
+	CreateUser(ctx context.Context, u *User) (*User, error)
+	ReplaceUser(ctx context.Context, id string, u *User) (*User, error)
+	PatchUser(ctx context.Context, id string, req PatchRequest) (*User, error)
+	DeleteUser(ctx context.Context, id string) error
+	ListGroups(ctx context.Context, req ListRequest) (*ListResponse, error)
+	GetGroup(ctx context.Context, id string) (*Group, error)
+	CreateGroup(ctx context.Context, g *Group) (*Group, error)
+	ReplaceGroup(ctx context.Context, id string, g *Group) (*Group, error)
+	PatchGroup(ctx context.Context, id string, req PatchRequest) (*Group, error)
+	DeleteGroup(ctx context.Context, id string) error
+	ServiceProviderConfig() *ServiceProviderConfig
+	ResourceTypes() []*ResourceType
+	Schemas() []*Schema
+}
+
+var (
+	// ErrInvalidToken is returned when a SCIM token is unknown or belongs to
+	// an inactive app.
+	ErrInvalidToken = errors.New("invalid scim token")
+	// ErrNotFound is returned when the requested user or group does not
+	// exist in the caller's app.
+	ErrNotFound = errors.New("resource not found")
+	// ErrConflict is returned when a userName or group displayName is taken.
+	ErrConflict = errors.New("resource already exists")
+	// ErrForbidden is returned when the caller may not make a change.
+	ErrForbidden = errors.New("operation not permitted")
+)
+
+// DefaultCount is the page size of lists that do not ask for one, and
+// MaxCount the largest page returned.
+const (
+	DefaultCount = 100
+	MaxCount     = 200
+)
+
+// userSortFields maps the attributes users are sorted by in the database,
+// in lower case, to the user fields they sort by. Users sorted by other
+// attributes are sorted in memory; strings would not compare the same way.
+var userSortFields = map[string]string{
+	"":                  entuser.FieldID,
+	"id":                entuser.FieldID,
+	"meta.created":      entuser.FieldCreatedAt,
+	"meta.lastmodified": entuser.FieldUpdatedAt,
+}
+
+// inactiveStatus is the status of users provisioned, or changed, with
+// active set to false.
+const inactiveStatus int8 = 0
+
+type scimService struct {
+	repo     *SCIMRepository
+	users    user.UserService
+	roles    role.RoleService
+	baseURL  string
+	validate *validator.Validate
+}
+
+// NewSCIMService creates a new SCIM service. baseURL is the public URL the
+// SCIM endpoints are served under, e.g. https://keeper.example.com/scim/v2,
+// and is used in resource locations.
+func NewSCIMService(repo *SCIMRepository, users user.UserService, roles role.RoleService, baseURL string) SCIMService {
+	return &scimService{
+		repo:     repo,
+		users:    users,
+		roles:    roles,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		validate: validator.New(),
+	}
+}
+
+// badRequest returns a SCIM error with status 400.
+func badRequest(scimType, format string, args ...any) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   "400",
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+	}
+}
+
+func (s *scimService) Authenticate(ctx context.Context, token string) (*auth.UserClaims, error) {
+	if token == "" {
+		return nil, ErrInvalidToken
+	}
+	a, err := s.repo.GetAppBySCIMTokenHash(ctx, auth.HashToken(token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+	if a.Status != 1 {
+		slog.Warn("scim token rejected: app inactive", "app_id", a.ID)
+		return nil, ErrInvalidToken
+	}
+	return &auth.UserClaims{AppID: a.ID, ClientID: a.ClientID}, nil
+}
+
+func (s *scimService) ListUsers(ctx context.Context, req ListRequest) (*ListResponse, error) {
+	f, err := compileFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs, err := s.roleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if sort, ok := userSortFields[strings.ToLower(req.SortBy)]; ok && f == nil {
+		return s.pageUsers(ctx, req, sort, roleIDs)
+	}
+	users, err := s.filterUsers(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(users, func(a, b *user.User) int { return cmp.Compare(a.ID, b.ID) })
+	resources := make([]map[string]any, 0, len(users))
+	for _, u := range users {
+		m, err := toMap(s.toUser(u, roleIDs))
+		if err != nil {
+			return nil, err
+		}
+		if f == nil || f.match(m) {
+			resources = append(resources, m)
+		}
+	}
+	return page(resources, req), nil
+}
+
+func (s *scimService) GetUser(ctx context.Context, id string) (*User, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.userResource(ctx, u)
+}
+
+func (s *scimService) CreateUser(ctx context.Context, in *User) (*User, error) {
+	claims, ok := auth.GetClaimsFromContext(ctx)
+	if !ok {
+		return nil, ErrForbidden
+	}
+	if err := s.validateUser(in); err != nil {
+		return nil, err
+	}
+
+	pw := in.Password
+	if pw == "" {
+		var err error
+		if pw, err = randomPassword(); err != nil {
+			return nil, err
+		}
+	}
+	first, last := names(in)
+	created, err := s.users.Create(ctx, user.CreateUserRequest{
+		AppID:     claims.AppID,
+		Firstname: first,
+		Lastname:  last,
+		Email:     in.UserName,
+		Password:  pw,
+	})
+	if err != nil {
+		return nil, userError(err)
+	}
+	slog.Info("user provisioned over scim", "id", created.ID, "app_id", claims.AppID)
+
+	if in.Active != nil && !*in.Active {
+		status := inactiveStatus
+		if created, err = s.users.Update(ctx, created.ID, user.UpdateUserRequest{Status: &status}); err != nil {
+			return nil, userError(err)
+		}
+	}
+	return s.userResource(ctx, created)
+}
+
+func (s *scimService) ReplaceUser(ctx context.Context, id string, in *User) (*User, error) {
+	current, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateUser(ctx, current, in)
+}
+
+func (s *scimService) PatchUser(ctx context.Context, id string, req PatchRequest) (*User, error) {
+	current, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs, err := s.roleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := toMap(s.toUser(current, roleIDs))
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPatch(m, req.Operations); err != nil {
+		return nil, err
+	}
+	// Some identity providers send booleans as "True" and "False".
+	if v, ok := lookup(m, "active").(string); ok {
+		if b, err := strconv.ParseBool(v); err == nil {
+			m[key(m, "active")] = b
+		}
+	}
+	var patched User
+	if err := fromMap(m, &patched); err != nil {
+		return nil, err
+	}
+	return s.updateUser(ctx, current, &patched)
+}
+
+func (s *scimService) DeleteUser(ctx context.Context, id string) error {
+	uid, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.users.Delete(ctx, uid); err != nil {
+		return userError(err)
+	}
+	slog.Info("user deprovisioned over scim", "id", uid)
+	return nil
+}
+
+func (s *scimService) ListGroups(ctx context.Context, req ListRequest) (*ListResponse, error) {
+	f, err := compileFilter(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil && req.SortBy == "" {
+		return s.pageGroups(ctx, req)
+	}
+	roles, err := s.roles.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, len(roles))
+	for i, r := range roles {
+		ids[i] = r.ID
+	}
+	members, err := s.members(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(roles, func(a, b *role.Role) int { return cmp.Compare(a.ID, b.ID) })
+	resources := make([]map[string]any, 0, len(roles))
+	for _, r := range roles {
+		m, err := toMap(s.toGroup(r, members))
+		if err != nil {
+			return nil, err
+		}
+		if f == nil || f.match(m) {
+			resources = append(resources, m)
+		}
+	}
+	return page(resources, req), nil
+}
+
+func (s *scimService) GetGroup(ctx context.Context, id string) (*Group, error) {
+	r, err := s.getRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.groupResource(ctx, r)
+}
+
+func (s *scimService) CreateGroup(ctx context.Context, in *Group) (*Group, error) {
+	claims, ok := auth.GetClaimsFromContext(ctx)
+	if !ok {
+		return nil, ErrForbidden
+	}
+	if err := s.validateGroup(in); err != nil {
+		return nil, err
+	}
+	members, err := s.memberUsers(ctx, in.Members, nil)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs, err := s.roleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.roles.Create(ctx, role.CreateRoleRequest{AppID: claims.AppID, Name: in.DisplayName})
+	if err != nil {
+		return nil, roleError(err)
+	}
+	slog.Info("group provisioned over scim", "id", created.ID, "app_id", claims.AppID)
+
+	if err := s.setMembers(ctx, created.ID, nil, members, roleIDs); err != nil {
+		return nil, err
+	}
+	return s.groupResource(ctx, created)
+}
+
+func (s *scimService) ReplaceGroup(ctx context.Context, id string, in *Group) (*Group, error) {
+	current, err := s.getRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateGroup(ctx, current, in)
+}
+
+func (s *scimService) PatchGroup(ctx context.Context, id string, req PatchRequest) (*Group, error) {
+	current, err := s.getRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.members(ctx, current.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := toMap(s.toGroup(current, members))
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPatch(m, req.Operations); err != nil {
+		return nil, err
+	}
+	var patched Group
+	if err := fromMap(m, &patched); err != nil {
+		return nil, err
+	}
+	return s.updateGroup(ctx, current, &patched)
+}
+
+func (s *scimService) DeleteGroup(ctx context.Context, id string) error {
+	rid, err := parseID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.roles.Delete(ctx, rid); err != nil {
+		return roleError(err)
+	}
+	slog.Info("group deprovisioned over scim", "id", rid)
+	return nil
+}
+
+func (s *scimService) ServiceProviderConfig() *ServiceProviderConfig {
+	return serviceProviderConfig(s.baseURL)
+}
+
+func (s *scimService) ResourceTypes() []*ResourceType {
+	return resourceTypes(s.baseURL)
+}
+
+func (s *scimService) Schemas() []*Schema {
+	return schemas(s.baseURL)
+}
+
+func (s *scimService) getUser(ctx context.Context, id string) (*user.User, error) {
+	uid, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.users.GetByID(ctx, uid)
+	if err != nil {
+		return nil, userError(err)
+	}
+	return u, nil
+}
+
+func (s *scimService) getRole(ctx context.Context, id string) (*role.Role, error) {
+	rid, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.roles.GetByID(ctx, rid)
+	if err != nil {
+		return nil, roleError(err)
+	}
+	return r, nil
+}
+
+// updateUser changes the user to match in, leaving out what did not change.
+func (s *scimService) updateUser(ctx context.Context, current *user.User, in *User) (*User, error) {
+	if err := s.validateUser(in); err != nil {
+		return nil, err
+	}
+
+	var (
+		req     user.UpdateUserRequest
+		changed bool
+	)
+	first, last := names(in)
+	if in.UserName != current.Email {
+		req.Email, changed = &in.UserName, true
+	}
+	if first != current.Firstname {
+		req.Firstname, changed = &first, true
+	}
+	if last != current.Lastname {
+		req.Lastname, changed = &last, true
+	}
+	if in.Active != nil && *in.Active != (current.Status == 1) {
+		status := inactiveStatus
+		if *in.Active {
+			status = 1
+		}
+		req.Status, changed = &status, true
+	}
+	if in.Password != "" {
+		req.Password, changed = &in.Password, true
+	}
+	if !changed {
+		return s.userResource(ctx, current)
+	}
+
+	updated, err := s.users.Update(ctx, current.ID, req)
+	if err != nil {
+		return nil, userError(err)
+	}
+	slog.Info("user updated over scim", "id", updated.ID)
+	return s.userResource(ctx, updated)
+}
+
+// updateGroup renames the role to match in and gives it to exactly the
+// members of in.
+func (s *scimService) updateGroup(ctx context.Context, current *role.Role, in *Group) (*Group, error) {
+	if err := s.validateGroup(in); err != nil {
+		return nil, err
+	}
+	previous, err := s.members(ctx, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.memberUsers(ctx, in.Members, previous)
+	if err != nil {
+		return nil, err
+	}
+	// The roles of the users are named as they were before the rename.
+	roleIDs, err := s.roleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := current
+	if in.DisplayName != current.Name {
+		if updated, err = s.roles.Update(ctx, current.ID, role.UpdateRoleRequest{Name: &in.DisplayName}); err != nil {
+			return nil, roleError(err)
+		}
+	}
+	if err := s.setMembers(ctx, updated.ID, previous, members, roleIDs); err != nil {
+		return nil, err
+	}
+	slog.Info("group updated over scim", "id", updated.ID)
+	return s.groupResource(ctx, updated)
+}
+
+// setMembers gives the role with the given ID to the users in members and
+// takes it from those in previous, its members so far, who are not in
+// members. Only the users whose membership changes are updated. roleIDs maps
+// the names of the roles of the users to their IDs.
+func (s *scimService) setMembers(ctx context.Context, roleID int, previous []*user.User, members map[int]*user.User, roleIDs map[string]int) error {
+	wasMember := make(map[int]bool, len(previous))
+	for _, u := range previous {
+		wasMember[u.ID] = true
+	}
+	for _, id := range slices.Sorted(maps.Keys(members)) {
+		if !wasMember[id] {
+			if err := s.setRole(ctx, members[id], roleID, true, roleIDs); err != nil {
+				return err
+			}
+		}
+	}
+	for _, u := range previous {
+		if members[u.ID] == nil {
+			if err := s.setRole(ctx, u, roleID, false, roleIDs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setRole gives the role with the given ID to u, or takes it from u,
+// keeping the other roles of the user.
+func (s *scimService) setRole(ctx context.Context, u *user.User, roleID int, member bool, roleIDs map[string]int) error {
+	var ids []int
+	for _, name := range u.Roles {
+		if id := roleIDs[name]; id != roleID {
+			ids = append(ids, id)
+		}
+	}
+	if member {
+		ids = append(ids, roleID)
+	}
+	if _, err := s.users.SetRoles(ctx, u.ID, user.SetRolesRequest{RoleIDs: ids}); err != nil {
+		return userError(err)
+	}
+	return nil
+}
+
+// memberUsers returns the users in members by ID. They must all be users of
+// the app; those in previous, the members of the group so far, are not
+// looked up again.
+func (s *scimService) memberUsers(ctx context.Context, members []Member, previous []*user.User) (map[int]*user.User, error) {
+	known := make(map[int]*user.User, len(previous))
+	for _, u := range previous {
+		known[u.ID] = u
+	}
+	users := make(map[int]*user.User, len(members))
+	for _, m := range members {
+		if m.Type != "" && !strings.EqualFold(m.Type, "User") {
+			return nil, badRequest("invalidValue", "members of type %s are not supported", m.Type)
+		}
+		id, err := strconv.Atoi(m.Value)
+		if err != nil {
+			return nil, badRequest("invalidValue", "no user with id %q", m.Value)
+		}
+		u, ok := known[id]
+		if !ok {
+			if u, err = s.users.GetByID(ctx, id); err != nil {
+				if errors.Is(userError(err), ErrNotFound) {
+					return nil, badRequest("invalidValue", "no user with id %q", m.Value)
+				}
+				return nil, err
+			}
+		}
+		users[id] = u
+	}
+	return users, nil
+}
+
+// pageUsers returns the page of the users of the caller's app that req asks
+// for, sorted by sort in the database, reading only that page.
+func (s *scimService) pageUsers(ctx context.Context, req ListRequest, sort string, roleIDs map[string]int) (*ListResponse, error) {
+	start, count := pageRange(req)
+	// A page of no users still needs the total.
+	p, err := s.users.List(ctx, user.ListUsersRequest{Sort: sort, Desc: req.Descending, Offset: start - 1, Limit: max(count, 1)})
+	if err != nil {
+		return nil, err
+	}
+
+	users := p.Users[:min(count, len(p.Users))]
+	items := make([]any, 0, len(users))
+	for _, u := range users {
+		m, err := toMap(s.toUser(u, roleIDs))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, m)
+	}
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: p.Total,
+		StartIndex:   start,
+		ItemsPerPage: len(items),
+		Resources:    items,
+	}, nil
+}
+
+// pageGroups returns the page of the roles of the caller's app that req asks
+// for, in the order of their IDs, reading only that page and its members.
+func (s *scimService) pageGroups(ctx context.Context, req ListRequest) (*ListResponse, error) {
+	start, count := pageRange(req)
+	p, err := s.roles.ListPage(ctx, start-1, count)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(p.Roles))
+	for i, r := range p.Roles {
+		ids[i] = r.ID
+	}
+	members, err := s.members(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]any, 0, len(p.Roles))
+	for _, r := range p.Roles {
+		m, err := toMap(s.toGroup(r, members))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, m)
+	}
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: p.Total,
+		StartIndex:   start,
+		ItemsPerPage: len(items),
+		Resources:    items,
+	}, nil
+}
+
+// filterUsers returns the users of the caller's app that f may match. Users
+// filtered by id or userName are looked up; other filters have to be
+// matched against every user.
+func (s *scimService) filterUsers(ctx context.Context, f filter) ([]*user.User, error) {
+	attr, value, ok := userKey(f)
+	switch {
+	case !ok:
+		return s.listUsers(ctx, user.ListUsersRequest{})
+	case attr == "userName":
+		return s.listUsers(ctx, user.ListUsersRequest{Email: value})
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, nil
+	}
+	u, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(userError(err), ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return []*user.User{u}, nil
+}
+
+// members returns the users who have any of the roles with the given IDs,
+// read in one query.
+func (s *scimService) members(ctx context.Context, roleIDs ...int) ([]*user.User, error) {
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+	return s.listUsers(ctx, user.ListUsersRequest{RoleIDs: roleIDs})
+}
+
+// listUsers returns every user of the caller's app that req matches, listed
+// a page at a time.
+func (s *scimService) listUsers(ctx context.Context, req user.ListUsersRequest) ([]*user.User, error) {
+	var users []*user.User
+	req.Limit = user.MaxListLimit
+	for {
+		page, err := s.users.List(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, page.Users...)
+		if page.NextCursor == "" {
+			return users, nil
+		}
+		req.Cursor = page.NextCursor
+	}
+}
+
+// roleIDs maps the names of the roles of the caller's app to their IDs.
+func (s *scimService) roleIDs(ctx context.Context) (map[string]int, error) {
+	roles, err := s.roles.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]int, len(roles))
+	for _, r := range roles {
+		ids[r.Name] = r.ID
+	}
+	return ids, nil
+}
+
+func (s *scimService) userResource(ctx context.Context, u *user.User) (*User, error) {
+	roleIDs, err := s.roleIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.toUser(u, roleIDs), nil
+}
+
+func (s *scimService) groupResource(ctx context.Context, r *role.Role) (*Group, error) {
+	members, err := s.members(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toGroup(r, members), nil
+}
+
+func (s *scimService) toUser(u *user.User, roleIDs map[string]int) *User {
+	id := strconv.Itoa(u.ID)
+	active := u.Status == 1
+	name := strings.TrimSpace(u.Firstname + " " + u.Lastname)
+	res := &User{
+		Schemas:     []string{SchemaUser},
+		ID:          id,
+		UserName:    u.Email,
+		Name:        &Name{Formatted: name, GivenName: u.Firstname, FamilyName: u.Lastname},
+		DisplayName: name,
+		Emails:      []Email{{Value: u.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      &u.CreatedAt,
+			LastModified: &u.UpdatedAt,
+			Location:     s.baseURL + "/Users/" + id,
+		},
+	}
+	for _, r := range u.Roles {
+		gid := strconv.Itoa(roleIDs[r])
+		res.Groups = append(res.Groups, Member{Value: gid, Ref: s.baseURL + "/Groups/" + gid, Display: r, Type: "direct"})
+	}
+	return res
+}
+
+func (s *scimService) toGroup(r *role.Role, users []*user.User) *Group {
+	id := strconv.Itoa(r.ID)
+	res := &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          id,
+		DisplayName: r.Name,
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      &r.CreatedAt,
+			LastModified: &r.UpdatedAt,
+			Location:     s.baseURL + "/Groups/" + id,
+		},
+	}
+	var members []*user.User
+	for _, u := range users {
+		if slices.Contains(u.Roles, r.Name) {
+			members = append(members, u)
+		}
+	}
+	slices.SortFunc(members, func(a, b *user.User) int { return cmp.Compare(a.ID, b.ID) })
+	for _, u := range members {
+		uid := strconv.Itoa(u.ID)
+		res.Members = append(res.Members, Member{Value: uid, Ref: s.baseURL + "/Users/" + uid, Display: u.Email, Type: "User"})
+	}
+	return res
+}
+
+func (s *scimService) validateUser(u *User) error {
+	if err := s.validate.Var(u.UserName, "required,email"); err != nil {
+		return badRequest("invalidValue", "userName must be an email address")
+	}
+	return nil
+}
+
+func (s *scimService) validateGroup(g *Group) error {
+	if err := s.validate.Var(g.DisplayName, "required,max=64"); err != nil {
+		return badRequest("invalidValue", "displayName is required and at most 64 characters long")
+	}
+	return nil
+}
+
+// names returns the first and last name of a user, taken from displayName
+// when the user has no name.
+func names(u *User) (first, last string) {
+	if u.Name != nil && (u.Name.GivenName != "" || u.Name.FamilyName != "") {
+		return u.Name.GivenName, u.Name.FamilyName
+	}
+	first, last, _ = strings.Cut(strings.TrimSpace(u.DisplayName), " ")
+	return first, strings.TrimSpace(last)
+}
+
+// parseID parses the ID of a resource. IDs that are not numbers belong to
+// no resource.
+func parseID(id string) (int, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, ErrNotFound
+	}
+	return n, nil
+}
+
+// randomPassword returns the password of users provisioned without one. It
+// has every character class a password policy can require; the user sets
+// their own through a password reset, or logs in another way.
+func randomPassword() (string, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("generate password: %w", err)
+	}
+	return token[:32] + "aA1-", nil
+}
+
+func compileFilter(s string) (filter, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	f, err := parseFilter(s)
+	if err != nil {
+		return nil, badRequest("invalidFilter", "%v", err)
+	}
+	return f, nil
+}
+
+// page sorts resources as req asks and returns the page it asks for.
+func page(resources []map[string]any, req ListRequest) *ListResponse {
+	if req.SortBy != "" {
+		path := parseAttrPath(req.SortBy)
+		slices.SortStableFunc(resources, func(a, b map[string]any) int {
+			c := compareSortValues(path.values(a), path.values(b))
+			if req.Descending {
+				return -c
+			}
+			return c
+		})
+	}
+
+	start, count := pageRange(req)
+	from := min(start-1, len(resources))
+	to := min(from+count, len(resources))
+
+	items := make([]any, 0, to-from)
+	for _, r := range resources[from:to] {
+		items = append(items, r)
+	}
+	return &ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   start,
+		ItemsPerPage: len(items),
+		Resources:    items,
+	}
+}
+
+// pageRange returns the 1-based index of the first resource req asks for
+// and the number of resources it asks for.
+func pageRange(req ListRequest) (start, count int) {
+	start, count = max(req.StartIndex, 1), DefaultCount
+	if req.Count != nil {
+		count = min(max(*req.Count, 0), MaxCount)
+	}
+	return start, count
+}
+
+// compareSortValues orders resources by the first value of the sort
+// attribute. Resources without one come last.
+func compareSortValues(a, b []any) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	case compare("lt", a[0], b[0]):
+		return -1
+	case compare("gt", a[0], b[0]):
+		return 1
+	}
+	return 0
+}
+
+func userError(err error) error {
+	var policyErr *user.PasswordPolicyError
+	switch {
+	case errors.Is(err, user.ErrUserNotFound), ent.IsNotFound(err):
+		return ErrNotFound
+	case errors.Is(err, user.ErrForbidden):
+		return ErrForbidden
+	case errors.As(err, &policyErr):
+		messages := make([]string, len(policyErr.Violations))
+		for i, v := range policyErr.Violations {
+			messages[i] = v.Message
+		}
+		return badRequest("invalidValue", "password %s", strings.Join(messages, ", "))
+	case ent.IsConstraintError(err):
+		return ErrConflict
+	}
+	return err
+}
+
+func roleError(err error) error {
+	switch {
+	case errors.Is(err, role.ErrRoleNotFound), ent.IsNotFound(err):
+		return ErrNotFound
+	case errors.Is(err, role.ErrRoleExists):
+		return ErrConflict
+	case errors.Is(err, role.ErrForbidden):
+		return ErrForbidden
+	}
+	return err
+}
@@ -0,0 +1,502 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/enttest"
+	"keeper/internal/role"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBaseURL = "https://keeper.example.com/scim/v2"
+
+// newTestService returns a SCIM service backed by the real user and role
+// services.
+func newTestService(client *ent.Client) SCIMService {
+	users := user.NewUserService(user.NewUserRepository(client), auth.NewJWTManager("secret", time.Hour))
+	roles := role.NewRoleService(role.NewRoleRepository(client))
+	return NewSCIMService(NewSCIMRepository(client), users, roles, testBaseURL)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestService_Authenticate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_scim_auth?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := newTestService(client)
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("SCIM App").SetScimTokenHash(auth.HashToken("scim-token")).Save(ctx)
+	assert.NoError(t, err)
+
+	claims, err := svc.Authenticate(ctx, "scim-token")
+	assert.NoError(t, err)
+	assert.Equal(t, a.ID, claims.AppID)
+	assert.Equal(t, a.ClientID, claims.ClientID)
+	assert.Zero(t, claims.UserID)
+
+	_, err = svc.Authenticate(ctx, "wrong-token")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = svc.Authenticate(ctx, "")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// The tokens of inactive apps are rejected.
+	_, err = client.App.UpdateOne(a).SetStatus(0).Save(ctx)
+	assert.NoError(t, err)
+	_, err = svc.Authenticate(ctx, "scim-token")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestService_Users(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_scim_users?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := newTestService(client)
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("SCIM App").Save(ctx)
+	assert.NoError(t, err)
+	other, err := client.App.Create().SetName("Other App").Save(ctx)
+	assert.NoError(t, err)
+	_, err = client.User.Create().SetApp(other).SetFirstname("Eve").SetLastname("Other").
+		SetEmail("eve@example.com").SetPassword("hash").Save(ctx)
+	assert.NoError(t, err)
+
+	ctx = context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: a.ID, ClientID: a.ClientID})
+
+	var created []*User
+	for _, u := range []*User{
+		{UserName: "ada@example.com", Name: &Name{GivenName: "Ada", FamilyName: "Lovelace"}},
+		{UserName: "alan@example.com", DisplayName: "Alan Turing", Password: "Sup3r-secret!"},
+		{UserName: "grace@example.com", Name: &Name{GivenName: "Grace", FamilyName: "Hopper"}, Active: ptr(false)},
+	} {
+		c, err := svc.CreateUser(ctx, u)
+		assert.NoError(t, err)
+		created = append(created, c)
+	}
+	assert.Equal(t, []string{SchemaUser}, created[0].Schemas)
+	assert.Equal(t, "ada@example.com", created[0].UserName)
+	assert.Equal(t, "Ada Lovelace", created[0].DisplayName)
+	assert.Equal(t, []Email{{Value: "ada@example.com", Type: "work", Primary: true}}, created[0].Emails)
+	assert.Equal(t, testBaseURL+"/Users/"+created[0].ID, created[0].Meta.Location)
+	assert.Equal(t, "Alan", created[1].Name.GivenName)
+	assert.Equal(t, "Turing", created[1].Name.FamilyName)
+	assert.True(t, *created[0].Active)
+	assert.False(t, *created[2].Active)
+
+	t.Run("Conflict", func(t *testing.T) {
+		_, err := svc.CreateUser(ctx, &User{UserName: "ada@example.com"})
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("InvalidUserName", func(t *testing.T) {
+		_, err := svc.CreateUser(ctx, &User{UserName: "not-an-email"})
+		var scimErr *Error
+		assert.ErrorAs(t, err, &scimErr)
+		assert.Equal(t, "invalidValue", scimErr.ScimType)
+	})
+
+	t.Run("ListScopedToApp", func(t *testing.T) {
+		res, err := svc.ListUsers(ctx, ListRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.TotalResults)
+		assert.Equal(t, 1, res.StartIndex)
+		assert.Len(t, res.Resources, 3)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		res, err := svc.ListUsers(ctx, ListRequest{Filter: `userName eq "ALAN@example.com"`})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.TotalResults)
+		assert.Equal(t, created[1].ID, res.Resources[0].(map[string]any)["id"])
+
+		res, err = svc.ListUsers(ctx, ListRequest{Filter: `userName eq "alan@example.com" and active eq false`})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, res.TotalResults)
+
+		res, err = svc.ListUsers(ctx, ListRequest{Filter: `id eq "` + created[2].ID + `"`})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.TotalResults)
+		assert.Equal(t, "grace@example.com", res.Resources[0].(map[string]any)["userName"])
+
+		// Users of other apps are not found by id or userName.
+		eve, err := client.User.Query().Where().First(context.Background())
+		assert.NoError(t, err)
+		for _, filter := range []string{`id eq "` + strconv.Itoa(eve.ID) + `"`, `userName eq "eve@example.com"`, `id eq "eve"`} {
+			res, err = svc.ListUsers(ctx, ListRequest{Filter: filter})
+			assert.NoError(t, err)
+			assert.Equal(t, 0, res.TotalResults, filter)
+			assert.Empty(t, res.Resources, filter)
+		}
+
+		res, err = svc.ListUsers(ctx, ListRequest{Filter: `active eq false or name.givenName sw "ad"`})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.TotalResults)
+
+		res, err = svc.ListUsers(ctx, ListRequest{Filter: `emails[type eq "work" and value co "grace"]`})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.TotalResults)
+
+		_, err = svc.ListUsers(ctx, ListRequest{Filter: `userName eq`})
+		var scimErr *Error
+		assert.ErrorAs(t, err, &scimErr)
+		assert.Equal(t, "invalidFilter", scimErr.ScimType)
+	})
+
+	t.Run("PaginateAndSort", func(t *testing.T) {
+		res, err := svc.ListUsers(ctx, ListRequest{SortBy: "userName", Descending: true, StartIndex: 2, Count: ptr(1)})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.TotalResults)
+		assert.Equal(t, 2, res.StartIndex)
+		assert.Equal(t, 1, res.ItemsPerPage)
+		assert.Equal(t, "alan@example.com", res.Resources[0].(map[string]any)["userName"])
+
+		res, err = svc.ListUsers(ctx, ListRequest{StartIndex: 2, Count: ptr(5)})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.TotalResults)
+		assert.Equal(t, 2, res.ItemsPerPage)
+		assert.Equal(t, created[1].ID, res.Resources[0].(map[string]any)["id"])
+		assert.Equal(t, created[2].ID, res.Resources[1].(map[string]any)["id"])
+
+		res, err = svc.ListUsers(ctx, ListRequest{SortBy: "meta.created", Descending: true, Count: ptr(1)})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.TotalResults)
+		assert.Equal(t, created[2].ID, res.Resources[0].(map[string]any)["id"])
+
+		res, err = svc.ListUsers(ctx, ListRequest{Count: ptr(0)})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.TotalResults)
+		assert.Empty(t, res.Resources)
+
+		res, err = svc.ListUsers(ctx, ListRequest{StartIndex: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Resources)
+	})
+
+	t.Run("Patch", func(t *testing.T) {
+		u, err := svc.PatchUser(ctx, created[0].ID, PatchRequest{Operations: []PatchOperation{
+			{Op: "Replace", Path: "active", Value: "False"},
+			{Op: "replace", Path: "name.givenName", Value: "Augusta"},
+		}})
+		assert.NoError(t, err)
+		assert.False(t, *u.Active)
+		assert.Equal(t, "Augusta", u.Name.GivenName)
+		assert.Equal(t, "Lovelace", u.Name.FamilyName)
+
+		u, err = svc.PatchUser(ctx, created[0].ID, PatchRequest{Operations: []PatchOperation{
+			{Op: "replace", Value: map[string]any{"active": true, "userName": "augusta@example.com"}},
+		}})
+		assert.NoError(t, err)
+		assert.True(t, *u.Active)
+		assert.Equal(t, "augusta@example.com", u.UserName)
+
+		_, err = svc.PatchUser(ctx, created[0].ID, PatchRequest{Operations: []PatchOperation{{Op: "move", Path: "active"}}})
+		var scimErr *Error
+		assert.ErrorAs(t, err, &scimErr)
+		assert.Equal(t, "invalidSyntax", scimErr.ScimType)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		u, err := svc.ReplaceUser(ctx, created[2].ID, &User{
+			UserName: "grace@example.com",
+			Name:     &Name{GivenName: "Grace", FamilyName: "Murray"},
+			Active:   ptr(true),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "Murray", u.Name.FamilyName)
+		assert.True(t, *u.Active)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, svc.DeleteUser(ctx, created[1].ID))
+		_, err := svc.GetUser(ctx, created[1].ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = svc.GetUser(ctx, "not-a-number")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("OtherAppHidden", func(t *testing.T) {
+		eve, err := client.User.Query().Where().First(context.Background())
+		assert.NoError(t, err)
+		_, err = svc.GetUser(ctx, strconv.Itoa(eve.ID))
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestService_Groups(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_scim_groups?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := newTestService(client)
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("SCIM App").Save(ctx)
+	assert.NoError(t, err)
+	ctx = context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: a.ID, ClientID: a.ClientID})
+
+	ada, err := svc.CreateUser(ctx, &User{UserName: "ada@example.com", DisplayName: "Ada Lovelace"})
+	assert.NoError(t, err)
+	alan, err := svc.CreateUser(ctx, &User{UserName: "alan@example.com", DisplayName: "Alan Turing"})
+	assert.NoError(t, err)
+
+	g, err := svc.CreateGroup(ctx, &Group{DisplayName: "engineers", Members: []Member{{Value: ada.ID}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{SchemaGroup}, g.Schemas)
+	assert.Equal(t, []Member{{Value: ada.ID, Ref: testBaseURL + "/Users/" + ada.ID, Display: "ada@example.com", Type: "User"}}, g.Members)
+
+	u, err := svc.GetUser(ctx, ada.ID)
+	assert.NoError(t, err)
+	assert.Len(t, u.Groups, 1)
+	assert.Equal(t, g.ID, u.Groups[0].Value)
+	assert.Equal(t, "engineers", u.Groups[0].Display)
+
+	t.Run("Conflict", func(t *testing.T) {
+		_, err := svc.CreateGroup(ctx, &Group{DisplayName: "engineers"})
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("UnknownMember", func(t *testing.T) {
+		_, err := svc.CreateGroup(ctx, &Group{DisplayName: "ghosts", Members: []Member{{Value: "9999"}}})
+		var scimErr *Error
+		assert.ErrorAs(t, err, &scimErr)
+		assert.Equal(t, "invalidValue", scimErr.ScimType)
+	})
+
+	t.Run("PatchMembers", func(t *testing.T) {
+		g, err := svc.PatchGroup(ctx, g.ID, PatchRequest{Operations: []PatchOperation{
+			{Op: "add", Path: "members", Value: []any{map[string]any{"value": alan.ID}}},
+		}})
+		assert.NoError(t, err)
+		assert.Len(t, g.Members, 2)
+
+		g, err = svc.PatchGroup(ctx, g.ID, PatchRequest{Operations: []PatchOperation{
+			{Op: "remove", Path: `members[value eq "` + ada.ID + `"]`},
+			{Op: "replace", Path: "displayName", Value: "engineering"},
+		}})
+		assert.NoError(t, err)
+		assert.Equal(t, "engineering", g.DisplayName)
+		assert.Len(t, g.Members, 1)
+		assert.Equal(t, alan.ID, g.Members[0].Value)
+
+		u, err := svc.GetUser(ctx, ada.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, u.Groups)
+	})
+
+	t.Run("KeepsOtherRoles", func(t *testing.T) {
+		reviewers, err := svc.CreateGroup(ctx, &Group{DisplayName: "reviewers", Members: []Member{{Value: ada.ID}, {Value: alan.ID}}})
+		assert.NoError(t, err)
+		assert.Len(t, reviewers.Members, 2)
+
+		// Replacing the members and the name of a group changes only the
+		// users joining and leaving it.
+		g, err := svc.ReplaceGroup(ctx, g.ID, &Group{DisplayName: "engineers", Members: []Member{{Value: ada.ID}, {Value: alan.ID}}})
+		assert.NoError(t, err)
+		assert.Len(t, g.Members, 2)
+		u, err := svc.GetUser(ctx, ada.ID)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"engineers", "reviewers"}, []string{u.Groups[0].Display, u.Groups[1].Display})
+
+		g, err = svc.ReplaceGroup(ctx, g.ID, &Group{DisplayName: "engineering", Members: []Member{{Value: alan.ID}}})
+		assert.NoError(t, err)
+		assert.Len(t, g.Members, 1)
+		assert.Equal(t, alan.ID, g.Members[0].Value)
+		u, err = svc.GetUser(ctx, ada.ID)
+		assert.NoError(t, err)
+		assert.Len(t, u.Groups, 1)
+		assert.Equal(t, reviewers.ID, u.Groups[0].Value)
+		u, err = svc.GetUser(ctx, alan.ID)
+		assert.NoError(t, err)
+		assert.Len(t, u.Groups, 2)
+
+		assert.NoError(t, svc.DeleteGroup(ctx, reviewers.ID))
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		res, err := svc.ListGroups(ctx, ListRequest{Filter: `displayName eq "engineering"`})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.TotalResults)
+
+		res, err = svc.ListGroups(ctx, ListRequest{Filter: `members[value eq "` + ada.ID + `"]`})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, res.TotalResults)
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		designers, err := svc.CreateGroup(ctx, &Group{DisplayName: "designers", Members: []Member{{Value: ada.ID}}})
+		assert.NoError(t, err)
+		testers, err := svc.CreateGroup(ctx, &Group{DisplayName: "testers", Members: []Member{{Value: ada.ID}, {Value: alan.ID}}})
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, svc.DeleteGroup(ctx, designers.ID))
+			assert.NoError(t, svc.DeleteGroup(ctx, testers.ID))
+		}()
+
+		res, err := svc.ListGroups(ctx, ListRequest{StartIndex: 2, Count: ptr(1)})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.TotalResults)
+		assert.Equal(t, 2, res.StartIndex)
+		if assert.Equal(t, 1, res.ItemsPerPage) {
+			assert.Equal(t, designers.ID, res.Resources[0].(map[string]any)["id"])
+			assert.Len(t, res.Resources[0].(map[string]any)["members"], 1)
+		}
+
+		// Each group lists only its own members.
+		res, err = svc.ListGroups(ctx, ListRequest{Count: ptr(5)})
+		assert.NoError(t, err)
+		if assert.Equal(t, 3, res.ItemsPerPage) {
+			for i, want := range []*Group{g, designers, testers} {
+				group := res.Resources[i].(map[string]any)
+				assert.Equal(t, want.ID, group["id"])
+				assert.Len(t, group["members"], len(want.Members))
+			}
+		}
+
+		res, err = svc.ListGroups(ctx, ListRequest{Count: ptr(0)})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.TotalResults)
+		assert.Empty(t, res.Resources)
+
+		res, err = svc.ListGroups(ctx, ListRequest{StartIndex: 10})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Resources)
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		g, err := svc.ReplaceGroup(ctx, g.ID, &Group{DisplayName: "engineering", Members: []Member{{Value: ada.ID}}})
+		assert.NoError(t, err)
+		assert.Len(t, g.Members, 1)
+		assert.Equal(t, ada.ID, g.Members[0].Value)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.NoError(t, svc.DeleteGroup(ctx, g.ID))
+		_, err := svc.GetGroup(ctx, g.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestParseFilter(t *testing.T) {
+	res := map[string]any{
+		"userName": "ada@example.com",
+		"active":   true,
+		"name":     map[string]any{"givenName": "Ada", "familyName": "Lovelace"},
+		"emails":   []any{map[string]any{"value": "ada@example.com", "type": "work"}},
+		"meta":     map[string]any{"created": "2026-01-02T03:04:05Z"},
+	}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{`userName eq "ADA@example.com"`, true},
+		{`userName ne "ada@example.com"`, false},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName sw "ada"`, true},
+		{`name.familyName ew "lace" and active eq true`, true},
+		{`not (active eq true) or userName co "turing"`, false},
+		{`title pr`, false},
+		{`name pr`, true},
+		{`emails[type eq "work" and value ew "example.com"]`, true},
+		{`emails.type eq "home"`, false},
+		{`meta.created gt "2026-01-01T00:00:00Z"`, true},
+		{`meta.created lt "2026-01-01T00:00:00+01:00"`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			f, err := parseFilter(tt.filter)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, f.match(res))
+		})
+	}
+
+	for _, bad := range []string{`userName`, `userName eq`, `userName xx "a"`, `(userName pr`, `userName eq "a" and`, `emails[type eq "work"`} {
+		_, err := parseFilter(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	newRes := func() map[string]any {
+		return map[string]any{
+			"displayName": "admins",
+			"members": []any{
+				map[string]any{"value": "1", "display": "ada"},
+				map[string]any{"value": "2", "display": "alan"},
+			},
+		}
+	}
+
+	t.Run("AddSkipsDuplicates", func(t *testing.T) {
+		res := newRes()
+		err := applyPatch(res, []PatchOperation{{Op: "add", Path: "members", Value: []any{
+			map[string]any{"value": "2"},
+			map[string]any{"value": "3"},
+		}}})
+		assert.NoError(t, err)
+		assert.Len(t, res["members"], 3)
+	})
+
+	t.Run("RemoveByValue", func(t *testing.T) {
+		res := newRes()
+		err := applyPatch(res, []PatchOperation{{Op: "remove", Path: "members", Value: []any{map[string]any{"value": "1"}}}})
+		assert.NoError(t, err)
+		assert.Equal(t, []any{map[string]any{"value": "2", "display": "alan"}}, res["members"])
+	})
+
+	t.Run("ReplaceFilteredSubAttribute", func(t *testing.T) {
+		res := newRes()
+		err := applyPatch(res, []PatchOperation{{Op: "replace", Path: `members[value eq "2"].display`, Value: "turing"}})
+		assert.NoError(t, err)
+		assert.Equal(t, "turing", res["members"].([]any)[1].(map[string]any)["display"])
+	})
+
+	t.Run("AddFilteredCreatesElement", func(t *testing.T) {
+		res := map[string]any{}
+		err := applyPatch(res, []PatchOperation{{Op: "add", Path: `emails[type eq "work"].value`, Value: "ada@example.com"}})
+		assert.NoError(t, err)
+		assert.Equal(t, []any{map[string]any{"type": "work", "value": "ada@example.com"}}, res["emails"])
+	})
+
+	t.Run("RemoveAll", func(t *testing.T) {
+		res := newRes()
+		err := applyPatch(res, []PatchOperation{{Op: "remove", Path: "members"}})
+		assert.NoError(t, err)
+		assert.NotContains(t, res, "members")
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for name, ops := range map[string][]PatchOperation{
+			"invalidValue":  nil,
+			"invalidSyntax": {{Op: "copy", Path: "displayName"}},
+			"noTarget":      {{Op: "remove"}},
+			"invalidPath":   {{Op: "replace", Path: "members[value", Value: "x"}},
+		} {
+			err := applyPatch(newRes(), ops)
+			var scimErr *Error
+			if assert.True(t, errors.As(err, &scimErr), name) {
+				assert.Equal(t, name, scimErr.ScimType)
+			}
+		}
+	})
+}
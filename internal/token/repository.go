@@ -0,0 +1,66 @@
+package token
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/revokedtoken"
+	"keeper/pkg/auth"
+)
+
+// DenylistRepository is an auth.Denylist stored in the database, so revocations
+// survive restarts and are shared by every instance using the same database.
+type DenylistRepository struct {
+	client *ent.Client
+}
+
+var _ auth.Denylist = (*DenylistRepository)(nil)
+
+// NewDenylistRepository creates a new denylist repository.
+func NewDenylistRepository(client *ent.Client) *DenylistRepository {
+	return &DenylistRepository{client: client}
+}
+
+// Revoke adds a token ID to the denylist until expiresAt.
+func (r *DenylistRepository) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	err := r.client.RevokedToken.
+		Create().
+		SetJti(jti).
+		SetExpiresAt(expiresAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			// Already revoked.
+			return nil
+		}
+		slog.Error("database error: failed to revoke token", "error", err)
+		return err
+	}
+	return nil
+}
+
+// IsRevoked reports whether a token ID has been revoked.
+func (r *DenylistRepository) IsRevoked(ctx context.Context, jti string) (bool, error) {
+	exists, err := r.client.RevokedToken.Query().
+		Where(revokedtoken.JtiEQ(jti)).
+		Exist(ctx)
+	if err != nil {
+		slog.Error("database error: failed to check revoked token", "error", err)
+		return false, err
+	}
+	return exists, nil
+}
+
+// PurgeExpired removes entries whose tokens have expired.
+func (r *DenylistRepository) PurgeExpired(ctx context.Context) (int, error) {
+	n, err := r.client.RevokedToken.Delete().
+		Where(revokedtoken.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to purge revoked tokens", "error", err)
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,54 @@
+package token
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"keeper/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDenylistRepository(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_denylist?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewDenylistRepository(client)
+	ctx := context.Background()
+
+	revoked, err := repo.IsRevoked(ctx, "live")
+	assert.NoError(t, err)
+	assert.False(t, revoked)
+
+	assert.NoError(t, repo.Revoke(ctx, "live", time.Now().Add(time.Hour)))
+	assert.NoError(t, repo.Revoke(ctx, "expired", time.Now().Add(-time.Minute)))
+
+	t.Run("revoking twice is a no-op", func(t *testing.T) {
+		assert.NoError(t, repo.Revoke(ctx, "live", time.Now().Add(time.Hour)))
+	})
+
+	t.Run("revoked token is reported", func(t *testing.T) {
+		revoked, err := repo.IsRevoked(ctx, "live")
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+	})
+
+	t.Run("purge removes only expired entries", func(t *testing.T) {
+		n, err := repo.PurgeExpired(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		revoked, err := repo.IsRevoked(ctx, "live")
+		assert.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = repo.IsRevoked(ctx, "expired")
+		assert.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"keeper/ent"
+)
+
+// Login methods and failure reasons reported to the Auditor.
+const (
+	LoginMethodPassword  = "password"
+	LoginMethodMFA       = "mfa"
+	LoginMethodPasskey   = "passkey"
+	LoginMethodMagicLink = "magic_link"
+	LoginMethodOIDC      = "oidc"
+
+	LoginFailureUnknownUser      = "unknown_user"
+	LoginFailureThrottled        = "throttled"
+	LoginFailureInvalidPassword  = "invalid_password"
+	LoginFailureInactive         = "inactive"
+	LoginFailureEmailNotVerified = "email_not_verified"
+	LoginFailureInvalidMFACode   = "invalid_mfa_code"
+)
+
+// LoginEvent is a login attempt. UserID and AppID are nil when the email
+// matches no user; Failure is empty when the login succeeded.
+type LoginEvent struct {
+	AppID   *int
+	UserID  *int
+	Email   string
+	Method  string
+	Failure string
+}
+
+// Auditor is told of login attempts, to record them in the audit trail or
+// send them to webhooks. Changes to users are picked up by ent hooks and
+// need no call.
+type Auditor interface {
+	RecordLogin(ctx context.Context, e LoginEvent) error
+}
+
+// auditLogin reports a login attempt of u, which is nil when email matches
+// no user, to the auditors. An attempt they fail to record fails with their
+// error, so that no login goes unrecorded.
+func (s *userService) auditLogin(ctx context.Context, u *ent.User, email, method, failure string) error {
+	if len(s.auditors) == 0 {
+		return nil
+	}
+	e := LoginEvent{Email: email, Method: method, Failure: failure}
+	if u != nil {
+		e.AppID, e.UserID, e.Email = &u.AppID, &u.ID, u.Email
+	}
+	for _, a := range s.auditors {
+		if err := a.RecordLogin(ctx, e); err != nil {
+			slog.Error("failed to record login attempt", "email", e.Email, "method", method, "error", err)
+			return fmt.Errorf("record login: %w", err)
+		}
+	}
+	return nil
+}
@@ -2,10 +2,17 @@ package user
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
+	"keeper/internal/role"
 	"keeper/pkg/auth"
 	"keeper/pkg/render"
 
@@ -27,24 +34,58 @@ func NewUserHandler(svc UserService) *UserHandler {
 	}
 }
 
+// Permissions a token needs to call the user endpoints. App tokens are
+// granted them as scopes.
+const (
+	PermissionRead  = "users:read"
+	PermissionWrite = "users:write"
+)
+
 // Routes returns the chi router for user endpoints.
 func (h *UserHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
 	r := chi.NewRouter()
 
 	// Public routes
 	r.Post("/auth", h.AuthenticateUser)
+	r.Post("/auth/mfa", h.AuthenticateMFA)
+	r.Post("/auth/mfa/enroll", h.EnrollMFAChallenge)
+	r.Post("/auth/passkey/options", h.BeginPasskeyLogin)
+	r.Post("/auth/passkey", h.AuthenticatePasskey)
+	r.Post("/auth/magic-link", h.RequestMagicLink)
+	r.Get("/auth/magic-link/verify", h.AuthenticateMagicLink)
+	r.Post("/token/refresh", h.RefreshToken)
+	r.Post("/password/forgot", h.ForgotPassword)
+	r.Post("/password/reset", h.ResetPassword)
+	r.Post("/password/change", h.ChangePassword)
+	r.Post("/verify-email", h.VerifyEmail)
+	r.Post("/verify-email/resend", h.ResendVerification)
 
 	// Protected routes
 	r.Group(func(r chi.Router) {
 		r.Use(auth.Middleware(jwtManager))
 
-		r.Post("/", h.CreateUser)
-		r.Get("/", h.ListUsers)
+		r.With(auth.RequirePermission(PermissionWrite)).Post("/", h.CreateUser)
+		r.With(auth.RequirePermission(PermissionRead)).Get("/", h.ListUsers)
+		r.With(auth.RequirePermission(PermissionRead)).Get("/search", h.SearchUsers)
+		r.With(auth.RequireUser).Post("/logout", h.Logout)
+		r.With(auth.RequireUser).Post("/mfa/totp", h.EnrollMFA)
+		r.With(auth.RequireUser).Post("/mfa/totp/confirm", h.ConfirmMFA)
+		r.With(auth.RequireUser).Post("/mfa/recovery-codes", h.RegenerateRecoveryCodes)
+		r.With(auth.RequireUser).Post("/mfa/disable", h.DisableMFA)
+		r.With(auth.RequireUser).Get("/passkeys", h.ListPasskeys)
+		r.With(auth.RequireUser).Post("/passkeys/options", h.BeginPasskeyRegistration)
+		r.With(auth.RequireUser).Post("/passkeys", h.FinishPasskeyRegistration)
+		r.With(auth.RequireUser).Delete("/passkeys/{passkeyID}", h.DeletePasskey)
 
 		r.Route("/{id}", func(r chi.Router) {
-			r.Get("/", h.GetUserByID)
-			r.Put("/", h.UpdateUser)
-			r.Delete("/", h.DeleteUser)
+			r.With(auth.RequirePermission(PermissionRead)).Get("/", h.GetUserByID)
+			r.With(auth.RequirePermission(PermissionWrite)).Put("/", h.UpdateUser)
+			r.With(auth.RequirePermission(PermissionWrite)).Delete("/", h.DeleteUser)
+			r.With(auth.RequirePermission(PermissionWrite)).Post("/sessions/revoke", h.RevokeSessions)
+			r.With(auth.RequirePermission(PermissionWrite)).Delete("/mfa", h.ResetMFA)
+			r.With(auth.RequirePermission(PermissionWrite)).Post("/unlock", h.UnlockUser)
+			r.With(auth.RequirePermission(PermissionRead)).Get("/lockouts", h.ListLockouts)
+			r.With(auth.RequirePermission(role.PermissionWrite)).Put("/roles", h.SetUserRoles)
 		})
 	})
 
@@ -53,14 +94,15 @@ func (h *UserHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
 
 // CreateUser godoc
 // @Summary Create a new user
-// @Description Create a new user with the provided details
+// @Description Create a new user with the provided details. The password must meet the password policy of the app.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param user body CreateUserRequest true "User details"
 // @Success 201 {object} render.Response{data=User}
-// @Failure 400 {object} render.Response
+// @Failure 400 {object} render.Response{data=PasswordViolations}
 // @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /users [post]
@@ -81,6 +123,13 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	u, err := h.svc.Create(r.Context(), req)
 	if err != nil {
 		// slog.Error is already called in service
+		if renderPasswordPolicyError(w, err) {
+			return
+		}
+		if errors.Is(err, ErrForbidden) {
+			render.Error(w, http.StatusForbidden, err.Error())
+			return
+		}
 		render.Error(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -89,23 +138,172 @@ func (h *UserHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 }
 
 // ListUsers godoc
-// @Summary List all users
-// @Description Get a list of all registered users
+// @Summary List users
+// @Description Get a page of the users of the caller's app, or of every app for platform admins, with filters, sorting and cursor pagination. The meta of the response holds the total number of matching users and the cursor of the next page.
 // @Tags users
 // @Produce json
-// @Success 200 {object} render.Response{data=[]User}
+// @Param app_id query int false "App ID"
+// @Param status query int false "Status: 0 or 1"
+// @Param email_domain query string false "Email domain, such as example.com"
+// @Param created_after query string false "Oldest creation time, RFC 3339"
+// @Param created_before query string false "Time before the newest creation time, RFC 3339"
+// @Param q query string false "Words to find in the first name, last name or email"
+// @Param sort query string false "Sort field: id, firstname, lastname, email, status, created_at or updated_at" default(id)
+// @Param order query string false "asc or desc" default(asc)
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, at most 200" default(50)
+// @Success 200 {object} render.Response{data=[]User,meta=render.Meta}
+// @Failure 400 {object} render.Response
 // @Failure 401 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /users [get]
 func (h *UserHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
-	users, err := h.svc.List(r.Context())
+	req, err := listUsersRequestFromQuery(r.URL.Query())
+	if err != nil {
+		slog.Warn("invalid list users request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.svc.List(r.Context(), req)
 	if err != nil {
+		if errors.Is(err, ErrInvalidSort) || errors.Is(err, ErrInvalidCursor) {
+			render.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		render.Error(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	render.JSON(w, http.StatusOK, users)
+	render.Page(w, http.StatusOK, page.Users, render.Meta{Total: page.Total, NextCursor: page.NextCursor})
+}
+
+func listUsersRequestFromQuery(q url.Values) (ListUsersRequest, error) {
+	req := ListUsersRequest{
+		EmailDomain: q.Get("email_domain"),
+		Q:           q.Get("q"),
+		Sort:        q.Get("sort"),
+		Cursor:      q.Get("cursor"),
+	}
+	var err error
+	if req.AppID, err = intParam(q, "app_id"); err != nil {
+		return req, err
+	}
+	if status, err := intParam(q, "status"); err != nil {
+		return req, err
+	} else if status != nil {
+		if *status != 0 && *status != 1 {
+			return req, errors.New("status must be 0 or 1")
+		}
+		s := int8(*status)
+		req.Status = &s
+	}
+	if req.CreatedAfter, err = timeParam(q, "created_after"); err != nil {
+		return req, err
+	}
+	if req.CreatedBefore, err = timeParam(q, "created_before"); err != nil {
+		return req, err
+	}
+	switch q.Get("order") {
+	case "", "asc":
+	case "desc":
+		req.Desc = true
+	default:
+		return req, errors.New("order must be asc or desc")
+	}
+	if limit, err := intParam(q, "limit"); err != nil {
+		return req, err
+	} else if limit != nil {
+		if *limit < 1 || *limit > MaxListLimit {
+			return req, fmt.Errorf("limit must be between 1 and %d", MaxListLimit)
+		}
+		req.Limit = *limit
+	}
+	return req, nil
+}
+
+// SearchUsers godoc
+// @Summary Search users
+// @Description Find the users of the caller's app, or of every app for platform admins, whose first name, last name or email have words starting with each word of q, ignoring case and accents. Results come best match first, with the matched words of each field in <mark> tags. The meta of the response holds the total number of matching users.
+// @Tags users
+// @Produce json
+// @Param q query string true "Words, or starts of words, to find"
+// @Param app_id query int false "App ID"
+// @Param limit query int false "Number of results, at most 100" default(20)
+// @Success 200 {object} render.Response{data=[]UserSearchResult,meta=render.Meta}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Failure 503 {object} render.Response
+// @Security Bearer
+// @Router /users/search [get]
+func (h *UserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	req, err := searchUsersRequestFromQuery(r.URL.Query())
+	if err != nil {
+		slog.Warn("invalid search users request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := h.svc.Search(r.Context(), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidSearch):
+			render.Error(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrSearchUnavailable):
+			render.Error(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	render.Page(w, http.StatusOK, page.Results, render.Meta{Total: page.Total})
+}
+
+func searchUsersRequestFromQuery(q url.Values) (SearchUsersRequest, error) {
+	req := SearchUsersRequest{Q: q.Get("q")}
+	if strings.TrimSpace(req.Q) == "" {
+		return req, errors.New("q is required")
+	}
+	var err error
+	if req.AppID, err = intParam(q, "app_id"); err != nil {
+		return req, err
+	}
+	if limit, err := intParam(q, "limit"); err != nil {
+		return req, err
+	} else if limit != nil {
+		if *limit < 1 || *limit > MaxSearchLimit {
+			return req, fmt.Errorf("limit must be between 1 and %d", MaxSearchLimit)
+		}
+		req.Limit = *limit
+	}
+	return req, nil
+}
+
+func intParam(q url.Values, name string) (*int, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", name)
+	}
+	return &n, nil
+}
+
+func timeParam(q url.Values, name string) (*time.Time, error) {
+	v := q.Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected RFC 3339 time", name)
+	}
+	return &t, nil
 }
 
 // GetUserByID godoc
@@ -141,15 +339,17 @@ func (h *UserHandler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 
 // UpdateUser godoc
 // @Summary Update user
-// @Description Update an existing user's details
+// @Description Update an existing user's details. A new password must meet the password policy of the app.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
 // @Param user body UpdateUserRequest true "Updated user details"
 // @Success 200 {object} render.Response{data=User}
-// @Failure 400 {object} render.Response
+// @Failure 400 {object} render.Response{data=PasswordViolations}
 // @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /users/{id} [put]
@@ -177,7 +377,17 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 	u, err := h.svc.Update(r.Context(), id, req)
 	if err != nil {
-		render.Error(w, http.StatusInternalServerError, err.Error())
+		if renderPasswordPolicyError(w, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			render.Error(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrForbidden):
+			render.Error(w, http.StatusForbidden, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
@@ -193,6 +403,8 @@ func (h *UserHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 // @Success 204 "No Content"
 // @Failure 400 {object} render.Response
 // @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
 // @Failure 500 {object} render.Response
 // @Security Bearer
 // @Router /users/{id} [delete]
@@ -206,7 +418,14 @@ func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.svc.Delete(r.Context(), id); err != nil {
-		render.Error(w, http.StatusInternalServerError, err.Error())
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			render.Error(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, ErrForbidden):
+			render.Error(w, http.StatusForbidden, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
@@ -215,7 +434,7 @@ func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 
 // AuthenticateUser godoc
 // @Summary Authenticate user
-// @Description Login with email and password to receive a JWT token
+// @Description Login with email and password to receive a JWT token. Users with MFA, or of apps requiring it, instead receive an mfa challenge to complete at /users/auth/mfa.
 // @Tags users
 // @Accept json
 // @Produce json
@@ -223,6 +442,7 @@ func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} render.Response{data=AuthResponse}
 // @Failure 400 {object} render.Response
 // @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response "Email not verified or password expired"
 // @Router /users/auth [post]
 func (h *UserHandler) AuthenticateUser(w http.ResponseWriter, r *http.Request) {
 	var req AuthRequest
@@ -239,6 +459,44 @@ func (h *UserHandler) AuthenticateUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res, err := h.svc.Authenticate(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ErrEmailNotVerified) || errors.Is(err, ErrPasswordExpired) {
+			render.Error(w, http.StatusForbidden, err.Error())
+			return
+		}
+		render.Error(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, res)
+}
+
+// RefreshToken godoc
+// @Summary Refresh access token
+// @Description Exchange a refresh token for a new access token and a rotated refresh token
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param refresh body RefreshRequest true "Refresh token"
+// @Success 200 {object} render.Response{data=AuthResponse}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Router /users/token/refresh [post]
+func (h *UserHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var req RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode refresh request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid refresh request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.svc.Refresh(r.Context(), req)
 	if err != nil {
 		render.Error(w, http.StatusUnauthorized, err.Error())
 		return
@@ -246,3 +504,969 @@ func (h *UserHandler) AuthenticateUser(w http.ResponseWriter, r *http.Request) {
 
 	render.JSON(w, http.StatusOK, res)
 }
+
+// ForgotPassword godoc
+// @Summary Request a password reset
+// @Description Send a single-use password reset token to the user with the given email. The response is the same whether or not the email has an account.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param forgot body ForgotPasswordRequest true "Account email"
+// @Success 202 {object} render.Response
+// @Failure 400 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Router /users/password/forgot [post]
+func (h *UserHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode forgot password request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid forgot password request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.svc.ForgotPassword(r.Context(), req); err != nil {
+		render.Error(w, http.StatusInternalServerError, "failed to process request")
+		return
+	}
+
+	render.JSON(w, http.StatusAccepted, "if the email has an account, a reset token has been sent")
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password with a reset token. The token is used up and every session of the user is revoked.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param reset body ResetPasswordRequest true "Reset token and new password"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response{data=PasswordViolations}
+// @Failure 500 {object} render.Response
+// @Router /users/password/reset [post]
+func (h *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode reset password request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid reset password request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.svc.ResetPassword(r.Context(), req); err != nil {
+		if renderPasswordPolicyError(w, err) {
+			return
+		}
+		if errors.Is(err, ErrInvalidResetToken) {
+			render.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// ChangePassword godoc
+// @Summary Change password
+// @Description Replace the password with the current one, as required once it has expired, and log in like /users/auth. The new password must meet the app's password policy and differ from the current one. Every other session of the user is revoked.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param change body ChangePasswordRequest true "Credentials and new password"
+// @Success 200 {object} render.Response{data=AuthResponse}
+// @Failure 400 {object} render.Response{data=PasswordViolations}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response "Email not verified"
+// @Router /users/password/change [post]
+func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode change password request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid change password request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.svc.ChangePassword(r.Context(), req)
+	if err != nil {
+		if renderPasswordPolicyError(w, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, ErrEmailNotVerified):
+			render.Error(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, ErrInvalidCredentials):
+			render.Error(w, http.StatusUnauthorized, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, res)
+}
+
+// VerifyEmail godoc
+// @Summary Verify email
+// @Description Confirm the user's email with the token sent on signup or email change
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param verify body VerifyEmailRequest true "Verification token"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Router /users/verify-email [post]
+func (h *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode verify email request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid verify email request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.svc.VerifyEmail(r.Context(), req); err != nil {
+		if errors.Is(err, ErrInvalidVerificationToken) {
+			render.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// ResendVerification godoc
+// @Summary Resend email verification
+// @Description Send a new verification token to an unverified email. The response is the same whether or not the email has an account.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param resend body ResendVerificationRequest true "Account email"
+// @Success 202 {object} render.Response
+// @Failure 400 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Router /users/verify-email/resend [post]
+func (h *UserHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	var req ResendVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode resend verification request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid resend verification request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.svc.ResendVerification(r.Context(), req); err != nil {
+		render.Error(w, http.StatusInternalServerError, "failed to process request")
+		return
+	}
+
+	render.JSON(w, http.StatusAccepted, "if the email has an unverified account, a verification token has been sent")
+}
+
+// Logout godoc
+// @Summary Log out
+// @Description Revoke the access token used for this request and, when given, the refresh token issued with it
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param logout body LogoutRequest false "Refresh token to revoke"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/logout [post]
+func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	var req LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		slog.Warn("failed to decode logout request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.svc.Logout(r.Context(), claims, req); err != nil {
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// RevokeSessions godoc
+// @Summary Revoke all sessions of a user
+// @Description Invalidate every access and refresh token issued to the user so far
+// @Tags users
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/{id}/sessions/revoke [post]
+func (h *UserHandler) RevokeSessions(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid user id in revoke sessions request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	if err := h.svc.RevokeSessions(r.Context(), id); err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			render.Error(w, http.StatusNotFound, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// UnlockUser godoc
+// @Summary Unlock a user
+// @Description Lift the lockout of a user locked out by failed logins and forget their failed attempts
+// @Tags users
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/{id}/unlock [post]
+func (h *UserHandler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid user id in unlock request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	if err := h.svc.Unlock(r.Context(), id); err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			render.Error(w, http.StatusNotFound, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// ListLockouts godoc
+// @Summary List lockouts of a user
+// @Description Get the times failed logins locked the user, or the IP they came from, out, newest first
+// @Tags users
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} render.Response{data=[]Lockout}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/{id}/lockouts [get]
+func (h *UserHandler) ListLockouts(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid user id in list lockouts request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	lockouts, err := h.svc.ListLockouts(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			render.Error(w, http.StatusNotFound, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, lockouts)
+}
+
+// SetUserRoles godoc
+// @Summary Set the roles of a user
+// @Description Replace the roles of a user with roles of the user's app. The change applies to tokens issued afterwards.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param roles body SetRolesRequest true "Role IDs"
+// @Success 200 {object} render.Response{data=User}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/{id}/roles [put]
+func (h *UserHandler) SetUserRoles(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid user id in set roles request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req SetRolesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode set roles request", "id", id, "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid set roles request", "id", id, "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	u, err := h.svc.SetRoles(r.Context(), id, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidRole):
+			render.Error(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, ErrUserNotFound):
+			render.Error(w, http.StatusNotFound, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	render.JSON(w, http.StatusOK, u)
+}
+
+// AuthenticateMFA godoc
+// @Summary Complete a login with MFA
+// @Description Exchange the mfa token returned by /users/auth and a TOTP or recovery code for tokens. When completing an enrolment started at /users/auth/mfa/enroll, the response also carries the user's recovery codes.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param mfa body MFAAuthRequest true "MFA token and code"
+// @Success 200 {object} render.Response{data=AuthResponse}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response "Enrollment required"
+// @Failure 500 {object} render.Response
+// @Router /users/auth/mfa [post]
+func (h *UserHandler) AuthenticateMFA(w http.ResponseWriter, r *http.Request) {
+	var req MFAAuthRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode mfa auth request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid mfa auth request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := h.svc.AuthenticateMFA(r.Context(), req)
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, res)
+}
+
+// EnrollMFAChallenge godoc
+// @Summary Enrol in MFA during login
+// @Description Start TOTP enrolment with the mfa token of a login that requires it. Complete it at /users/auth/mfa with the first code.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param enroll body MFAEnrollChallengeRequest true "MFA token"
+// @Success 200 {object} render.Response{data=MFAEnrollment}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Router /users/auth/mfa/enroll [post]
+func (h *UserHandler) EnrollMFAChallenge(w http.ResponseWriter, r *http.Request) {
+	var req MFAEnrollChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode mfa enroll request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid mfa enroll request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	enrollment, err := h.svc.EnrollMFAChallenge(r.Context(), req)
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, enrollment)
+}
+
+// EnrollMFA godoc
+// @Summary Start MFA enrolment
+// @Description Generate a TOTP secret for the signed-in user, replacing any unconfirmed one. It is enforced once confirmed at /users/mfa/totp/confirm.
+// @Tags users
+// @Produce json
+// @Success 200 {object} render.Response{data=MFAEnrollment}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/mfa/totp [post]
+func (h *UserHandler) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	enrollment, err := h.svc.EnrollMFA(r.Context(), claims.UserID)
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, enrollment)
+}
+
+// ConfirmMFA godoc
+// @Summary Confirm MFA enrolment
+// @Description Enable the pending TOTP secret of the signed-in user with its first code. The response holds recovery codes, which are only shown once.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param confirm body MFACodeRequest true "TOTP code"
+// @Success 200 {object} render.Response{data=RecoveryCodesResponse}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/mfa/totp/confirm [post]
+func (h *UserHandler) ConfirmMFA(w http.ResponseWriter, r *http.Request) {
+	h.mfaCodeAction(w, r, "confirm mfa", func(id int, req MFACodeRequest) (any, error) {
+		return h.svc.ConfirmMFA(r.Context(), id, req)
+	})
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate MFA recovery codes
+// @Description Replace the recovery codes of the signed-in user after checking a TOTP code
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param regenerate body MFACodeRequest true "TOTP code"
+// @Success 200 {object} render.Response{data=RecoveryCodesResponse}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/mfa/recovery-codes [post]
+func (h *UserHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	h.mfaCodeAction(w, r, "regenerate recovery codes", func(id int, req MFACodeRequest) (any, error) {
+		return h.svc.RegenerateRecoveryCodes(r.Context(), id, req)
+	})
+}
+
+// DisableMFA godoc
+// @Summary Disable MFA
+// @Description Turn MFA off for the signed-in user after checking a TOTP or recovery code
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param disable body MFACodeRequest true "TOTP or recovery code"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/mfa/disable [post]
+func (h *UserHandler) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	h.mfaCodeAction(w, r, "disable mfa", func(id int, req MFACodeRequest) (any, error) {
+		return nil, h.svc.DisableMFA(r.Context(), id, req)
+	})
+}
+
+// mfaCodeAction decodes the code of an MFA change by the signed-in user and
+// renders the result of action, or 204 when it returns nothing.
+func (h *UserHandler) mfaCodeAction(w http.ResponseWriter, r *http.Request, name string, action func(id int, req MFACodeRequest) (any, error)) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	var req MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode "+name+" request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid "+name+" request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := action(claims.UserID, req)
+	if err != nil {
+		writeMFAError(w, err)
+		return
+	}
+	if res == nil {
+		render.JSON(w, http.StatusNoContent, nil)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, res)
+}
+
+// ResetMFA godoc
+// @Summary Reset MFA of a user
+// @Description Turn MFA off for a user who lost their authenticator and recovery codes
+// @Tags users
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/{id}/mfa [delete]
+func (h *UserHandler) ResetMFA(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid user id in reset mfa request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	if err := h.svc.ResetMFA(r.Context(), id); err != nil {
+		writeMFAError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// writeMFAError renders an MFA error with its HTTP status.
+func writeMFAError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidMFAToken), errors.Is(err, ErrInvalidMFACode):
+		render.Error(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, ErrMFAEnrollmentRequired), errors.Is(err, ErrForbidden):
+		render.Error(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrMFAAlreadyEnabled), errors.Is(err, ErrMFANotEnabled):
+		render.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, ErrUserNotFound):
+		render.Error(w, http.StatusNotFound, err.Error())
+	default:
+		render.Error(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// BeginPasskeyLogin godoc
+// @Summary Start a passkey login
+// @Description Get the options to pass to navigator.credentials.get for signing in to an app with a passkey. With an email, only that user's passkeys are offered.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param login body PasskeyLoginOptionsRequest true "App and optional email"
+// @Success 200 {object} render.Response{data=PasskeyRequestOptions}
+// @Failure 400 {object} render.Response
+// @Failure 409 {object} render.Response "Passkeys not configured"
+// @Failure 500 {object} render.Response
+// @Router /users/auth/passkey/options [post]
+func (h *UserHandler) BeginPasskeyLogin(w http.ResponseWriter, r *http.Request) {
+	var req PasskeyLoginOptionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode passkey login options request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid passkey login options request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	opts, err := h.svc.BeginPasskeyLogin(r.Context(), req)
+	if err != nil {
+		writePasskeyError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, opts)
+}
+
+// AuthenticatePasskey godoc
+// @Summary Complete a passkey login
+// @Description Exchange the credential returned by navigator.credentials.get for tokens. No MFA challenge follows, as passkeys verify the user themselves.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param login body PasskeyAuthRequest true "Assertion credential"
+// @Success 200 {object} render.Response{data=AuthResponse}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response "Email not verified"
+// @Failure 409 {object} render.Response "Passkeys not configured"
+// @Failure 500 {object} render.Response
+// @Router /users/auth/passkey [post]
+func (h *UserHandler) AuthenticatePasskey(w http.ResponseWriter, r *http.Request) {
+	var req PasskeyAuthRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode passkey auth request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	res, err := h.svc.AuthenticatePasskey(r.Context(), req)
+	if err != nil {
+		writePasskeyError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, res)
+}
+
+// magicLinkCookie holds the nonce binding a login link to the browser that
+// asked for it.
+const (
+	magicLinkCookie     = "keeper_magic_link"
+	magicLinkCookiePath = "/users/auth/magic-link"
+)
+
+// RequestMagicLink godoc
+// @Summary Request a magic link
+// @Description Email a single-use login link to the user with the given email, if the app allows magic link logins. The link only works in the browser that asked for it, which is given a nonce cookie. The response is the same whether or not the email has an account.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body MagicLinkRequest true "App and account email"
+// @Success 202 {object} render.Response
+// @Failure 400 {object} render.Response
+// @Failure 403 {object} render.Response "Magic links disabled for app"
+// @Failure 500 {object} render.Response
+// @Router /users/auth/magic-link [post]
+func (h *UserHandler) RequestMagicLink(w http.ResponseWriter, r *http.Request) {
+	var req MagicLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode magic link request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid magic link request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	session, err := h.svc.RequestMagicLink(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, ErrMagicLinkDisabled) {
+			render.Error(w, http.StatusForbidden, err.Error())
+			return
+		}
+		render.Error(w, http.StatusInternalServerError, "failed to process request")
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     magicLinkCookie,
+		Value:    session.Nonce,
+		Path:     magicLinkCookiePath,
+		Expires:  session.ExpiresAt,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	render.JSON(w, http.StatusAccepted, "if the email has an account, a login link has been sent")
+}
+
+// AuthenticateMagicLink godoc
+// @Summary Log in with a magic link
+// @Description Redeem a login link in the browser that asked for it, sending its nonce cookie, for tokens. Users with MFA, or of apps requiring it, instead receive an mfa challenge to complete at /users/auth/mfa.
+// @Tags users
+// @Produce json
+// @Param token query string true "Token of the login link"
+// @Success 200 {object} render.Response{data=AuthResponse}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response "Email not verified or magic links disabled for app"
+// @Failure 500 {object} render.Response
+// @Router /users/auth/magic-link/verify [get]
+func (h *UserHandler) AuthenticateMagicLink(w http.ResponseWriter, r *http.Request) {
+	req := MagicLinkAuthRequest{Token: r.URL.Query().Get("token")}
+	if req.Token == "" {
+		render.Error(w, http.StatusBadRequest, "missing token")
+		return
+	}
+	if c, err := r.Cookie(magicLinkCookie); err == nil {
+		req.Nonce = c.Value
+	}
+	if req.Nonce == "" {
+		slog.Warn("magic link login failed: no nonce cookie")
+		render.Error(w, http.StatusUnauthorized, ErrInvalidMagicLink.Error())
+		return
+	}
+
+	res, err := h.svc.AuthenticateMagicLink(r.Context(), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidMagicLink):
+			render.Error(w, http.StatusUnauthorized, err.Error())
+		case errors.Is(err, ErrMagicLinkDisabled), errors.Is(err, ErrEmailNotVerified):
+			render.Error(w, http.StatusForbidden, err.Error())
+		default:
+			render.Error(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     magicLinkCookie,
+		Path:     magicLinkCookiePath,
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	render.JSON(w, http.StatusOK, res)
+}
+
+// ListPasskeys godoc
+// @Summary List passkeys
+// @Description Get the passkeys of the signed-in user
+// @Tags users
+// @Produce json
+// @Success 200 {object} render.Response{data=[]Passkey}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/passkeys [get]
+func (h *UserHandler) ListPasskeys(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	passkeys, err := h.svc.ListPasskeys(r.Context(), claims.UserID)
+	if err != nil {
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, passkeys)
+}
+
+// BeginPasskeyRegistration godoc
+// @Summary Start a passkey registration
+// @Description Get the options to pass to navigator.credentials.create for adding a passkey to the signed-in user. Complete it at POST /users/passkeys.
+// @Tags users
+// @Produce json
+// @Success 200 {object} render.Response{data=PasskeyCreationOptions}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response "Passkeys not configured"
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/passkeys/options [post]
+func (h *UserHandler) BeginPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	opts, err := h.svc.BeginPasskeyRegistration(r.Context(), claims.UserID)
+	if err != nil {
+		writePasskeyError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, opts)
+}
+
+// FinishPasskeyRegistration godoc
+// @Summary Register a passkey
+// @Description Store the credential returned by navigator.credentials.create as a passkey of the signed-in user
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param passkey body PasskeyRegistrationRequest true "Passkey name and attestation credential"
+// @Success 201 {object} render.Response{data=Passkey}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 409 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/passkeys [post]
+func (h *UserHandler) FinishPasskeyRegistration(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	var req PasskeyRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode passkey registration request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid passkey registration request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	passkey, err := h.svc.FinishPasskeyRegistration(r.Context(), claims.UserID, req)
+	if err != nil {
+		if errors.Is(err, ErrInvalidPasskey) {
+			render.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writePasskeyError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, passkey)
+}
+
+// DeletePasskey godoc
+// @Summary Remove a passkey
+// @Description Remove a passkey of the signed-in user
+// @Tags users
+// @Produce json
+// @Param passkeyID path int true "Passkey ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /users/passkeys/{passkeyID} [delete]
+func (h *UserHandler) DeletePasskey(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.GetClaimsFromContext(r.Context())
+	if !ok {
+		render.Error(w, http.StatusUnauthorized, "missing token claims")
+		return
+	}
+
+	idStr := chi.URLParam(r, "passkeyID")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid passkey id in delete request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid passkey id")
+		return
+	}
+
+	if err := h.svc.DeletePasskey(r.Context(), claims.UserID, id); err != nil {
+		writePasskeyError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// writePasskeyError renders a passkey error with its HTTP status.
+func writePasskeyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidPasskey):
+		render.Error(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, ErrEmailNotVerified):
+		render.Error(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrPasskeysNotConfigured), errors.Is(err, ErrPasskeyAlreadyRegistered):
+		render.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, ErrPasskeyNotFound), errors.Is(err, ErrUserNotFound):
+		render.Error(w, http.StatusNotFound, err.Error())
+	default:
+		render.Error(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// renderPasswordPolicyError answers 400 with the broken rules when err is a
+// PasswordPolicyError, and reports whether it was one.
+func renderPasswordPolicyError(w http.ResponseWriter, err error) bool {
+	var perr *PasswordPolicyError
+	if !errors.As(err, &perr) {
+		return false
+	}
+	render.ErrorWithData(w, http.StatusBadRequest, perr.Error(), PasswordViolations{Violations: perr.Violations})
+	return true
+}
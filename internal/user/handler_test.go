@@ -7,9 +7,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"keeper/pkg/auth"
+	"keeper/pkg/password"
 	"keeper/pkg/render"
+	"keeper/pkg/webauthn"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -34,12 +39,20 @@ func (m *mockService) GetByID(ctx context.Context, id int) (*User, error) {
 	return args.Get(0).(*User), args.Error(1)
 }
 
-func (m *mockService) List(ctx context.Context) ([]*User, error) {
-	args := m.Called(ctx)
+func (m *mockService) List(ctx context.Context, req ListUsersRequest) (*UserPage, error) {
+	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*User), args.Error(1)
+	return args.Get(0).(*UserPage), args.Error(1)
+}
+
+func (m *mockService) Search(ctx context.Context, req SearchUsersRequest) (*UserSearchPage, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*UserSearchPage), args.Error(1)
 }
 
 func (m *mockService) Update(ctx context.Context, id int, req UpdateUserRequest) (*User, error) {
@@ -63,6 +76,216 @@ func (m *mockService) Authenticate(ctx context.Context, req AuthRequest) (*AuthR
 	return args.Get(0).(*AuthResponse), args.Error(1)
 }
 
+func (m *mockService) VerifyCredentials(ctx context.Context, email, password string) (*User, error) {
+	args := m.Called(ctx, email, password)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *mockService) IssueTokens(ctx context.Context, id int, scope string) (*AuthResponse, error) {
+	args := m.Called(ctx, id, scope)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AuthResponse), args.Error(1)
+}
+
+func (m *mockService) Refresh(ctx context.Context, req RefreshRequest) (*AuthResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AuthResponse), args.Error(1)
+}
+
+func (m *mockService) Logout(ctx context.Context, claims *auth.UserClaims, req LogoutRequest) error {
+	args := m.Called(ctx, claims, req)
+	return args.Error(0)
+}
+
+func (m *mockService) RevokeSessions(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockService) SetRoles(ctx context.Context, id int, req SetRolesRequest) (*User, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *mockService) ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *mockService) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *mockService) ChangePassword(ctx context.Context, req ChangePasswordRequest) (*AuthResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AuthResponse), args.Error(1)
+}
+
+func (m *mockService) VerifyEmail(ctx context.Context, req VerifyEmailRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *mockService) ResendVerification(ctx context.Context, req ResendVerificationRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *mockService) AuthenticateMFA(ctx context.Context, req MFAAuthRequest) (*AuthResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AuthResponse), args.Error(1)
+}
+
+func (m *mockService) ChallengeMFA(ctx context.Context, id int) (*MFAChallenge, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MFAChallenge), args.Error(1)
+}
+
+func (m *mockService) VerifyMFA(ctx context.Context, token, code string) (*User, error) {
+	args := m.Called(ctx, token, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *mockService) EnrollMFAChallenge(ctx context.Context, req MFAEnrollChallengeRequest) (*MFAEnrollment, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MFAEnrollment), args.Error(1)
+}
+
+func (m *mockService) EnrollMFA(ctx context.Context, id int) (*MFAEnrollment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MFAEnrollment), args.Error(1)
+}
+
+func (m *mockService) ConfirmMFA(ctx context.Context, id int, req MFACodeRequest) (*RecoveryCodesResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RecoveryCodesResponse), args.Error(1)
+}
+
+func (m *mockService) RegenerateRecoveryCodes(ctx context.Context, id int, req MFACodeRequest) (*RecoveryCodesResponse, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RecoveryCodesResponse), args.Error(1)
+}
+
+func (m *mockService) DisableMFA(ctx context.Context, id int, req MFACodeRequest) error {
+	args := m.Called(ctx, id, req)
+	return args.Error(0)
+}
+
+func (m *mockService) ResetMFA(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockService) Unlock(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockService) ListLockouts(ctx context.Context, id int) ([]*Lockout, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Lockout), args.Error(1)
+}
+
+func (m *mockService) BeginPasskeyRegistration(ctx context.Context, id int) (*PasskeyCreationOptions, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PasskeyCreationOptions), args.Error(1)
+}
+
+func (m *mockService) FinishPasskeyRegistration(ctx context.Context, id int, req PasskeyRegistrationRequest) (*Passkey, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Passkey), args.Error(1)
+}
+
+func (m *mockService) ListPasskeys(ctx context.Context, id int) ([]*Passkey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Passkey), args.Error(1)
+}
+
+func (m *mockService) DeletePasskey(ctx context.Context, id, passkeyID int) error {
+	args := m.Called(ctx, id, passkeyID)
+	return args.Error(0)
+}
+
+func (m *mockService) BeginPasskeyLogin(ctx context.Context, req PasskeyLoginOptionsRequest) (*PasskeyRequestOptions, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PasskeyRequestOptions), args.Error(1)
+}
+
+func (m *mockService) AuthenticatePasskey(ctx context.Context, req PasskeyAuthRequest) (*AuthResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AuthResponse), args.Error(1)
+}
+
+func (m *mockService) RequestMagicLink(ctx context.Context, req MagicLinkRequest) (*MagicLinkSession, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*MagicLinkSession), args.Error(1)
+}
+
+func (m *mockService) AuthenticateMagicLink(ctx context.Context, req MagicLinkAuthRequest) (*AuthResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AuthResponse), args.Error(1)
+}
+
 func TestHandler_Create(t *testing.T) {
 	svc := new(mockService)
 	handler := NewUserHandler(svc)
@@ -104,6 +327,143 @@ func TestHandler_Create(t *testing.T) {
 	assert.Equal(t, expectedUser.Email, dataMap["email"])
 }
 
+func TestHandler_ListUsers(t *testing.T) {
+	appID, status := 2, int8(1)
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(svc *mockService)
+		wantStatusCode int
+	}{
+		{
+			name:  "Success",
+			query: "?app_id=2&status=1&email_domain=example.com&created_after=2026-01-01T00:00:00Z&q=ada&sort=email&order=desc&cursor=abc&limit=20",
+			mockSetup: func(svc *mockService) {
+				svc.On("List", mock.Anything, ListUsersRequest{
+					AppID:        &appID,
+					Status:       &status,
+					EmailDomain:  "example.com",
+					CreatedAfter: &after,
+					Q:            "ada",
+					Sort:         "email",
+					Desc:         true,
+					Cursor:       "abc",
+					Limit:        20,
+				}).Return(&UserPage{Users: []*User{{ID: 1, Email: "ada@example.com"}}, Total: 21, NextCursor: "next"}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{"Invalid app ID", "?app_id=x", func(svc *mockService) {}, http.StatusBadRequest},
+		{"Invalid status", "?status=5", func(svc *mockService) {}, http.StatusBadRequest},
+		{"Invalid time", "?created_before=2026-01-01", func(svc *mockService) {}, http.StatusBadRequest},
+		{"Invalid order", "?order=random", func(svc *mockService) {}, http.StatusBadRequest},
+		{"Invalid limit", "?limit=0", func(svc *mockService) {}, http.StatusBadRequest},
+		{
+			name:  "Invalid sort",
+			query: "?sort=password",
+			mockSetup: func(svc *mockService) {
+				svc.On("List", mock.Anything, ListUsersRequest{Sort: "password"}).Return(nil, ErrInvalidSort)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Invalid cursor",
+			query: "?cursor=abc",
+			mockSetup: func(svc *mockService) {
+				svc.On("List", mock.Anything, ListUsersRequest{Cursor: "abc"}).Return(nil, ErrInvalidCursor)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockService)
+			tt.mockSetup(svc)
+			handler := NewUserHandler(svc)
+
+			req, _ := http.NewRequest("GET", "/users"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler.ListUsers(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			svc.AssertExpectations(t)
+			if tt.wantStatusCode == http.StatusOK {
+				var resp render.Response
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				assert.Len(t, resp.Data, 1)
+				assert.Equal(t, &render.Meta{Total: 21, NextCursor: "next"}, resp.Meta)
+			}
+		})
+	}
+}
+
+func TestHandler_SearchUsers(t *testing.T) {
+	appID := 2
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(svc *mockService)
+		wantStatusCode int
+	}{
+		{
+			name:  "Success",
+			query: "?q=ada+lov&app_id=2&limit=5",
+			mockSetup: func(svc *mockService) {
+				svc.On("Search", mock.Anything, SearchUsersRequest{Q: "ada lov", AppID: &appID, Limit: 5}).
+					Return(&UserSearchPage{Results: []*UserSearchResult{{
+						User:      &User{ID: 1, Email: "ada@example.com"},
+						Score:     1.5,
+						Highlight: UserHighlight{Firstname: "<mark>Ada</mark>"},
+					}}, Total: 1}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{"Missing query", "?q=+", func(svc *mockService) {}, http.StatusBadRequest},
+		{"Invalid limit", "?q=ada&limit=101", func(svc *mockService) {}, http.StatusBadRequest},
+		{
+			name:  "No words",
+			query: "?q=%2A",
+			mockSetup: func(svc *mockService) {
+				svc.On("Search", mock.Anything, SearchUsersRequest{Q: "*"}).Return(nil, ErrInvalidSearch)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Unavailable",
+			query: "?q=ada",
+			mockSetup: func(svc *mockService) {
+				svc.On("Search", mock.Anything, SearchUsersRequest{Q: "ada"}).Return(nil, ErrSearchUnavailable)
+			},
+			wantStatusCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockService)
+			tt.mockSetup(svc)
+			handler := NewUserHandler(svc)
+
+			req, _ := http.NewRequest("GET", "/users/search"+tt.query, nil)
+			rr := httptest.NewRecorder()
+			handler.SearchUsers(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			svc.AssertExpectations(t)
+			if tt.wantStatusCode == http.StatusOK {
+				var resp render.Response
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+				assert.Len(t, resp.Data, 1)
+				assert.Equal(t, &render.Meta{Total: 1}, resp.Meta)
+			}
+		})
+	}
+}
+
 func TestHandler_Authenticate(t *testing.T) {
 	svc := new(mockService)
 	handler := NewUserHandler(svc)
@@ -115,7 +475,7 @@ func TestHandler_Authenticate(t *testing.T) {
 
 	expectedResp := &AuthResponse{
 		Token: "fake-jwt-token",
-		User: User{
+		User: &User{
 			ID:    1,
 			Email: reqBody.Email,
 		},
@@ -138,3 +498,529 @@ func TestHandler_Authenticate(t *testing.T) {
 	dataMap := resp.Data.(map[string]interface{})
 	assert.Equal(t, expectedResp.Token, dataMap["token"])
 }
+
+func TestHandler_RefreshToken(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	reqBody := RefreshRequest{RefreshToken: "old-refresh-token"}
+
+	expectedResp := &AuthResponse{
+		Token:        "new-jwt-token",
+		RefreshToken: "new-refresh-token",
+	}
+
+	svc.On("Refresh", mock.Anything, reqBody).Return(expectedResp, nil)
+
+	body, _ := json.Marshal(reqBody)
+	req, _ := http.NewRequest("POST", "/users/token/refresh", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	handler.RefreshToken(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp render.Response
+	err := json.Unmarshal(rr.Body.Bytes(), &resp)
+	assert.NoError(t, err)
+
+	dataMap := resp.Data.(map[string]interface{})
+	assert.Equal(t, expectedResp.RefreshToken, dataMap["refresh_token"])
+}
+
+func TestHandler_Logout(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	claims := &auth.UserClaims{UserID: 1}
+	svc.On("Logout", mock.Anything, claims, LogoutRequest{}).Return(nil)
+
+	t.Run("WithoutBody", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/logout", http.NoBody)
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserClaimsKey, claims))
+		rr := httptest.NewRecorder()
+
+		handler.Logout(rr, req)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("MissingClaims", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/logout", http.NoBody)
+		rr := httptest.NewRecorder()
+
+		handler.Logout(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	svc.AssertNumberOfCalls(t, "Logout", 1)
+}
+
+func TestHandler_Update(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	r := chi.NewRouter()
+	r.Put("/users/{id}", handler.UpdateUser)
+
+	appID := 2
+	reqBody := UpdateUserRequest{AppID: &appID}
+	svc.On("Update", mock.Anything, 1, reqBody).Return(nil, ErrForbidden)
+	svc.On("Update", mock.Anything, 2, reqBody).Return(nil, ErrUserNotFound)
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{"Forbidden", "/users/1", http.StatusForbidden},
+		{"OtherTenant", "/users/2", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(reqBody)
+			req, _ := http.NewRequest("PUT", tt.path, bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
+
+func TestHandler_ForgotPassword(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	reqBody := ForgotPasswordRequest{Email: "forgot@example.com"}
+	svc.On("ForgotPassword", mock.Anything, reqBody).Return(nil)
+
+	body, _ := json.Marshal(reqBody)
+	req, _ := http.NewRequest("POST", "/users/password/forgot", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+
+	handler.ForgotPassword(rr, req)
+
+	assert.Equal(t, http.StatusAccepted, rr.Code)
+	svc.AssertExpectations(t)
+}
+
+func TestHandler_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"Reset", nil, http.StatusNoContent},
+		{"InvalidToken", ErrInvalidResetToken, http.StatusBadRequest},
+		{"PolicyViolation", &PasswordPolicyError{Violations: []password.Violation{{Code: password.TooShort}}}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockService)
+			handler := NewUserHandler(svc)
+
+			reqBody := ResetPasswordRequest{Token: "token", Password: "newpassword123"}
+			svc.On("ResetPassword", mock.Anything, reqBody).Return(tt.err)
+
+			body, _ := json.Marshal(reqBody)
+			req, _ := http.NewRequest("POST", "/users/password/reset", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			handler.ResetPassword(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
+
+func TestHandler_ChangePassword(t *testing.T) {
+	reqBody := ChangePasswordRequest{Email: "john@example.com", Password: "password123", NewPassword: "newpassword123"}
+
+	t.Run("Changed", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewUserHandler(svc)
+		svc.On("ChangePassword", mock.Anything, reqBody).Return(&AuthResponse{Token: "jwt-token"}, nil)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest("POST", "/users/password/change", bytes.NewBuffer(body))
+		rr := httptest.NewRecorder()
+
+		handler.ChangePassword(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("PolicyViolation", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewUserHandler(svc)
+		violations := []password.Violation{
+			{Code: password.MissingDigit, Message: "must contain a digit"},
+			{Code: password.RecentlyUsed, Message: "must not be one of your last 3 passwords"},
+		}
+		svc.On("ChangePassword", mock.Anything, reqBody).Return(nil, &PasswordPolicyError{Violations: violations})
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest("POST", "/users/password/change", bytes.NewBuffer(body))
+		rr := httptest.NewRecorder()
+
+		handler.ChangePassword(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		var resp struct {
+			Data PasswordViolations `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+		assert.Equal(t, violations, resp.Data.Violations)
+	})
+
+	t.Run("InvalidCredentials", func(t *testing.T) {
+		svc := new(mockService)
+		handler := NewUserHandler(svc)
+		svc.On("ChangePassword", mock.Anything, reqBody).Return(nil, ErrInvalidCredentials)
+
+		body, _ := json.Marshal(reqBody)
+		req, _ := http.NewRequest("POST", "/users/password/change", bytes.NewBuffer(body))
+		rr := httptest.NewRecorder()
+
+		handler.ChangePassword(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestHandler_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"Verified", nil, http.StatusNoContent},
+		{"InvalidToken", ErrInvalidVerificationToken, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockService)
+			handler := NewUserHandler(svc)
+
+			reqBody := VerifyEmailRequest{Token: "token"}
+			svc.On("VerifyEmail", mock.Anything, reqBody).Return(tt.err)
+
+			body, _ := json.Marshal(reqBody)
+			req, _ := http.NewRequest("POST", "/users/verify-email", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			handler.VerifyEmail(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
+
+func TestHandler_AuthenticateMFA(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"Authenticated", nil, http.StatusOK},
+		{"InvalidCode", ErrInvalidMFACode, http.StatusUnauthorized},
+		{"InvalidToken", ErrInvalidMFAToken, http.StatusUnauthorized},
+		{"EnrollmentRequired", ErrMFAEnrollmentRequired, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockService)
+			handler := NewUserHandler(svc)
+
+			reqBody := MFAAuthRequest{MFAToken: "token", Code: "123456"}
+			if tt.err != nil {
+				svc.On("AuthenticateMFA", mock.Anything, reqBody).Return(nil, tt.err)
+			} else {
+				svc.On("AuthenticateMFA", mock.Anything, reqBody).Return(&AuthResponse{Token: "jwt"}, nil)
+			}
+
+			body, _ := json.Marshal(reqBody)
+			req, _ := http.NewRequest("POST", "/users/auth/mfa", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			handler.AuthenticateMFA(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+
+	t.Run("MissingCode", func(t *testing.T) {
+		handler := NewUserHandler(new(mockService))
+
+		req, _ := http.NewRequest("POST", "/users/auth/mfa", bytes.NewBufferString(`{"mfa_token":"token"}`))
+		rr := httptest.NewRecorder()
+
+		handler.AuthenticateMFA(rr, req)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandler_MFA(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	claims := &auth.UserClaims{UserID: 1}
+	reqBody := MFACodeRequest{Code: "123456"}
+	svc.On("EnrollMFA", mock.Anything, 1).Return(nil, ErrMFAAlreadyEnabled)
+	svc.On("ConfirmMFA", mock.Anything, 1, reqBody).Return(&RecoveryCodesResponse{RecoveryCodes: []string{"abcd-efgh-ijkl-mnop"}}, nil)
+	svc.On("DisableMFA", mock.Anything, 1, reqBody).Return(ErrInvalidMFACode)
+
+	newRequest := func(body string) *http.Request {
+		req, _ := http.NewRequest("POST", "/users/mfa", bytes.NewBufferString(body))
+		return req.WithContext(context.WithValue(req.Context(), auth.UserClaimsKey, claims))
+	}
+
+	t.Run("EnrollAlreadyEnabled", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.EnrollMFA(rr, newRequest(""))
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ConfirmMFA(rr, newRequest(`{"code":"123456"}`))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "abcd-efgh-ijkl-mnop")
+	})
+
+	t.Run("DisableInvalidCode", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.DisableMFA(rr, newRequest(`{"code":"123456"}`))
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("MissingCode", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.RegenerateRecoveryCodes(rr, newRequest(`{}`))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("MissingClaims", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/mfa/totp", http.NoBody)
+		rr := httptest.NewRecorder()
+		handler.EnrollMFA(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
+
+func TestHandler_PasskeyLogin(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	svc.On("BeginPasskeyLogin", mock.Anything, PasskeyLoginOptionsRequest{AppID: 1}).
+		Return(&PasskeyRequestOptions{PublicKey: webauthn.RequestOptions{Challenge: []byte{1, 2, 3}, RPID: "example.com"}}, nil)
+	svc.On("BeginPasskeyLogin", mock.Anything, PasskeyLoginOptionsRequest{AppID: 2}).Return(nil, ErrPasskeysNotConfigured)
+	svc.On("AuthenticatePasskey", mock.Anything, mock.Anything).Return(nil, ErrInvalidPasskey)
+
+	t.Run("Options", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/passkey/options", bytes.NewBufferString(`{"app_id":1}`))
+		rr := httptest.NewRecorder()
+		handler.BeginPasskeyLogin(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"challenge":"AQID"`)
+		assert.Contains(t, rr.Body.String(), `"rpId":"example.com"`)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/passkey/options", bytes.NewBufferString(`{"app_id":2}`))
+		rr := httptest.NewRecorder()
+		handler.BeginPasskeyLogin(rr, req)
+		assert.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("MissingApp", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/passkey/options", bytes.NewBufferString(`{}`))
+		rr := httptest.NewRecorder()
+		handler.BeginPasskeyLogin(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("InvalidPasskey", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/passkey", bytes.NewBufferString(`{"credential":{"id":"AQID","rawId":"AQID","type":"public-key"}}`))
+		rr := httptest.NewRecorder()
+		handler.AuthenticatePasskey(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("MalformedCredential", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/passkey", bytes.NewBufferString(`{"credential":{"rawId":"not base64!"}}`))
+		rr := httptest.NewRecorder()
+		handler.AuthenticatePasskey(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandler_MagicLink(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	svc.On("RequestMagicLink", mock.Anything, MagicLinkRequest{AppID: 1, Email: "magic@example.com"}).
+		Return(&MagicLinkSession{Nonce: "nonce", ExpiresAt: time.Now().Add(time.Minute)}, nil)
+	svc.On("RequestMagicLink", mock.Anything, MagicLinkRequest{AppID: 2, Email: "magic@example.com"}).Return(nil, ErrMagicLinkDisabled)
+	svc.On("AuthenticateMagicLink", mock.Anything, MagicLinkAuthRequest{Token: "good", Nonce: "nonce"}).
+		Return(&AuthResponse{Token: "access-token"}, nil)
+	svc.On("AuthenticateMagicLink", mock.Anything, MagicLinkAuthRequest{Token: "bad", Nonce: "nonce"}).Return(nil, ErrInvalidMagicLink)
+
+	t.Run("Request", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/magic-link", bytes.NewBufferString(`{"app_id":1,"email":"magic@example.com"}`))
+		rr := httptest.NewRecorder()
+		handler.RequestMagicLink(rr, req)
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "nonce")
+
+		cookies := rr.Result().Cookies()
+		if assert.Len(t, cookies, 1) {
+			assert.Equal(t, magicLinkCookie, cookies[0].Name)
+			assert.Equal(t, "nonce", cookies[0].Value)
+			assert.Equal(t, magicLinkCookiePath, cookies[0].Path)
+			assert.True(t, cookies[0].HttpOnly)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/magic-link", bytes.NewBufferString(`{"app_id":2,"email":"magic@example.com"}`))
+		rr := httptest.NewRecorder()
+		handler.RequestMagicLink(rr, req)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		assert.Empty(t, rr.Result().Cookies())
+	})
+
+	t.Run("InvalidEmail", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/users/auth/magic-link", bytes.NewBufferString(`{"app_id":1,"email":"nope"}`))
+		rr := httptest.NewRecorder()
+		handler.RequestMagicLink(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Redeem", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/auth/magic-link/verify?token=good", nil)
+		req.AddCookie(&http.Cookie{Name: magicLinkCookie, Value: "nonce"})
+		rr := httptest.NewRecorder()
+		handler.AuthenticateMagicLink(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "access-token")
+	})
+
+	t.Run("InvalidLink", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/auth/magic-link/verify?token=bad", nil)
+		req.AddCookie(&http.Cookie{Name: magicLinkCookie, Value: "nonce"})
+		rr := httptest.NewRecorder()
+		handler.AuthenticateMagicLink(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("NoCookie", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/auth/magic-link/verify?token=good", nil)
+		rr := httptest.NewRecorder()
+		handler.AuthenticateMagicLink(rr, req)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("MissingToken", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/users/auth/magic-link/verify", nil)
+		rr := httptest.NewRecorder()
+		handler.AuthenticateMagicLink(rr, req)
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandler_Passkeys(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	claims := &auth.UserClaims{UserID: 1}
+	svc.On("ListPasskeys", mock.Anything, 1).Return([]*Passkey{{ID: 7, Name: "Laptop"}}, nil)
+	svc.On("FinishPasskeyRegistration", mock.Anything, 1, mock.Anything).Return(nil, ErrInvalidPasskey)
+	svc.On("DeletePasskey", mock.Anything, 1, 7).Return(nil)
+	svc.On("DeletePasskey", mock.Anything, 1, 8).Return(ErrPasskeyNotFound)
+
+	newRequest := func(method, passkeyID, body string) *http.Request {
+		req, _ := http.NewRequest(method, "/users/passkeys", bytes.NewBufferString(body))
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("passkeyID", passkeyID)
+		ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+		return req.WithContext(context.WithValue(ctx, auth.UserClaimsKey, claims))
+	}
+
+	t.Run("List", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.ListPasskeys(rr, newRequest("GET", "", ""))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), "Laptop")
+	})
+
+	t.Run("RegisterInvalidCredential", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.FinishPasskeyRegistration(rr, newRequest("POST", "", `{"name":"Laptop","credential":{}}`))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.DeletePasskey(rr, newRequest("DELETE", "7", ""))
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+	})
+
+	t.Run("DeleteNotFound", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.DeletePasskey(rr, newRequest("DELETE", "8", ""))
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("DeleteInvalidID", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		handler.DeletePasskey(rr, newRequest("DELETE", "abc", ""))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestHandler_Lockouts(t *testing.T) {
+	svc := new(mockService)
+	handler := NewUserHandler(svc)
+
+	r := chi.NewRouter()
+	r.Post("/users/{id}/unlock", handler.UnlockUser)
+	r.Get("/users/{id}/lockouts", handler.ListLockouts)
+
+	svc.On("Unlock", mock.Anything, 1).Return(nil)
+	svc.On("Unlock", mock.Anything, 2).Return(ErrUserNotFound)
+	svc.On("ListLockouts", mock.Anything, 1).Return([]*Lockout{{ID: 3, Kind: "account", Failures: 10}}, nil)
+	svc.On("ListLockouts", mock.Anything, 2).Return(nil, ErrUserNotFound)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+	}{
+		{"Unlock", "POST", "/users/1/unlock", http.StatusNoContent},
+		{"UnlockOtherTenant", "POST", "/users/2/unlock", http.StatusNotFound},
+		{"UnlockInvalidID", "POST", "/users/abc/unlock", http.StatusBadRequest},
+		{"List", "GET", "/users/1/lockouts", http.StatusOK},
+		{"ListOtherTenant", "GET", "/users/2/lockouts", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			rr := httptest.NewRecorder()
+
+			r.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	entapp "keeper/ent/app"
+	"keeper/ent/lockout"
+)
+
+// freeLoginAttempts is how many failed logins in a row a user can make
+// before further attempts are delayed.
+const freeLoginAttempts = 3
+
+// loginPolicy is how an app throttles failed password logins.
+type loginPolicy struct {
+	maxAttempts   int
+	lockout       time.Duration
+	delay         time.Duration
+	maxIPAttempts int
+}
+
+// loginPolicyOf returns the login policy of an app. Logins with an email of
+// no user get the defaults of new apps.
+func loginPolicyOf(a *ent.App) loginPolicy {
+	if a == nil {
+		return loginPolicy{
+			maxAttempts:   entapp.DefaultMaxLoginAttempts,
+			lockout:       time.Duration(entapp.DefaultLockoutDuration) * time.Second,
+			delay:         time.Duration(entapp.DefaultLoginDelay) * time.Second,
+			maxIPAttempts: entapp.DefaultMaxIPLoginAttempts,
+		}
+	}
+	return loginPolicy{
+		maxAttempts:   a.MaxLoginAttempts,
+		lockout:       time.Duration(a.LockoutDuration) * time.Second,
+		delay:         time.Duration(a.LoginDelay) * time.Second,
+		maxIPAttempts: a.MaxIPLoginAttempts,
+	}
+}
+
+// loginDelay returns how long after the last of failures failed logins in a
+// row the next attempt has to wait. It doubles with every failure and never
+// exceeds the lockout duration.
+func (p loginPolicy) loginDelay(failures int) time.Duration {
+	if p.delay <= 0 || failures < freeLoginAttempts {
+		return 0
+	}
+	d := p.delay
+	for i := freeLoginAttempts; i < failures && d < p.lockout; i++ {
+		d *= 2
+	}
+	return min(d, p.lockout)
+}
+
+// checkLoginThrottle refuses a password login, before the password is
+// checked, while the user or the client IP is locked out or the user has to
+// wait after recent failures. u is nil when the email matches no user.
+func (s *userService) checkLoginThrottle(ctx context.Context, u *ent.User, ip string, p loginPolicy) error {
+	now := time.Now()
+	if ip != "" && p.maxIPAttempts > 0 {
+		t, err := s.repo.GetLoginThrottle(ctx, ip)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+		if t != nil && t.Failures >= p.maxIPAttempts && now.Before(t.LastFailureAt.Add(p.lockout)) {
+			slog.Warn("authentication failed: ip locked out", "ip", ip)
+			return ErrInvalidCredentials
+		}
+	}
+
+	if u == nil {
+		return nil
+	}
+	if u.LockedUntil != nil && now.Before(*u.LockedUntil) {
+		slog.Warn("authentication failed: user locked out", "id", u.ID, "ip", ip)
+		return ErrInvalidCredentials
+	}
+	if u.LastFailedLoginAt != nil && now.Before(u.LastFailedLoginAt.Add(p.loginDelay(u.FailedLogins))) {
+		slog.Warn("authentication failed: login delayed after failed attempts", "id", u.ID, "ip", ip, "failures", u.FailedLogins)
+		return ErrInvalidCredentials
+	}
+	return nil
+}
+
+// recordLoginFailure counts a wrong password against the user and the client
+// IP, locking them out when they reach the limits of p. u is nil when the
+// email matches no user. Errors are logged, as the login fails either way.
+func (s *userService) recordLoginFailure(ctx context.Context, u *ent.User, ip string, p loginPolicy) {
+	now := time.Now()
+	lockedUntil := now.Add(p.lockout)
+
+	var appID, userID *int
+	if u != nil {
+		appID, userID = &u.AppID, &u.ID
+	}
+
+	if ip != "" {
+		failures, err := s.repo.RecordIPLoginFailure(ctx, ip, now.Add(-p.lockout))
+		if err != nil {
+			slog.Warn("recording ip login failure failed", "ip", ip, "error", err)
+		} else if p.maxIPAttempts > 0 && failures == p.maxIPAttempts {
+			slog.Warn("ip locked out after failed logins", "ip", ip, "failures", failures, "locked_until", lockedUntil)
+			if _, err := s.repo.CreateLockout(ctx, lockout.KindIP, appID, userID, ip, failures, lockedUntil); err != nil {
+				slog.Warn("recording ip lockout failed", "ip", ip, "error", err)
+			}
+		}
+	}
+
+	if u == nil {
+		return
+	}
+	failures, err := s.repo.RecordLoginFailure(ctx, u.ID, now.Add(-p.lockout))
+	if err != nil {
+		slog.Warn("recording login failure failed", "id", u.ID, "error", err)
+		return
+	}
+	if p.maxAttempts <= 0 || failures < p.maxAttempts {
+		return
+	}
+	if err := s.repo.LockUser(ctx, u.ID, lockedUntil); err != nil {
+		slog.Warn("locking user failed", "id", u.ID, "error", err)
+		return
+	}
+	slog.Warn("user locked out after failed logins", "id", u.ID, "ip", ip, "failures", failures, "locked_until", lockedUntil)
+	if _, err := s.repo.CreateLockout(ctx, lockout.KindAccount, appID, userID, ip, failures, lockedUntil); err != nil {
+		slog.Warn("recording lockout failed", "id", u.ID, "error", err)
+	}
+}
+
+// clearLoginFailures forgets the failed logins of a user after a successful
+// login.
+func (s *userService) clearLoginFailures(ctx context.Context, u *ent.User) {
+	if u.FailedLogins == 0 && u.LockedUntil == nil {
+		return
+	}
+	if err := s.repo.ResetLoginFailures(ctx, u.ID); err != nil {
+		slog.Warn("resetting login failures failed", "id", u.ID, "error", err)
+	}
+}
+
+// Unlock lifts the lockout of a user and forgets their failed logins.
+func (s *userService) Unlock(ctx context.Context, id int) error {
+	slog.Info("unlocking user", "id", id)
+	if err := s.repo.UnlockUser(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	slog.Info("user unlocked", "id", id)
+	return nil
+}
+
+// ListLockouts returns the lockouts caused by failed logins to a user,
+// newest first.
+func (s *userService) ListLockouts(ctx context.Context, id int) ([]*Lockout, error) {
+	if _, err := s.getUser(ctx, id); err != nil {
+		return nil, err
+	}
+	lockouts, err := s.repo.ListLockouts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*Lockout, len(lockouts))
+	for i, l := range lockouts {
+		res[i] = &Lockout{
+			ID:          l.ID,
+			Kind:        string(l.Kind),
+			IP:          l.IP,
+			Failures:    l.Failures,
+			LockedUntil: l.LockedUntil,
+			UnlockedAt:  l.UnlockedAt,
+			CreatedAt:   l.CreatedAt,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,129 @@
+package user
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"keeper/ent"
+	"keeper/pkg/auth"
+)
+
+// DefaultMagicLinkExpiry is the login link lifetime used when none is configured.
+const DefaultMagicLinkExpiry = 15 * time.Minute
+
+// DefaultMagicLinkURL is where login links point when no URL is configured.
+const DefaultMagicLinkURL = "http://localhost:8080/users/auth/magic-link/verify"
+
+// RequestMagicLink emails a single-use login link to the user with the given
+// email in the given app, and returns the nonce the link is bound to. The
+// nonce must be kept by the requesting browser only, as a cookie, and is
+// returned whether or not the email has an account so the response does not
+// tell.
+func (s *userService) RequestMagicLink(ctx context.Context, req MagicLinkRequest) (*MagicLinkSession, error) {
+	slog.Info("magic link requested", "app_id", req.AppID, "email", req.Email)
+	a, err := s.repo.GetApp(ctx, req.AppID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrMagicLinkDisabled
+		}
+		return nil, err
+	}
+	if !a.MagicLinkEnabled {
+		slog.Warn("magic link refused: disabled for app", "app_id", a.ID)
+		return nil, ErrMagicLinkDisabled
+	}
+
+	nonce, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate magic link nonce: %w", err)
+	}
+	expiresAt := time.Now().Add(s.magicLinkExpiry)
+	session := &MagicLinkSession{Nonce: nonce, ExpiresAt: expiresAt}
+
+	u, err := s.repo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return session, nil
+		}
+		return nil, err
+	}
+	if u.AppID != a.ID || u.Status != 1 {
+		slog.Warn("magic link skipped: user inactive or of another app", "id", u.ID, "app_id", a.ID)
+		return session, nil
+	}
+
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate magic link token: %w", err)
+	}
+	if _, err := s.repo.CreateMagicLinkToken(ctx, u.ID, auth.HashToken(token), auth.HashToken(nonce), expiresAt); err != nil {
+		return nil, fmt.Errorf("store magic link token: %w", err)
+	}
+
+	// A delivery failure is only logged: failing the request would reveal
+	// that the account exists.
+	link := s.magicLinkURL + "?token=" + url.QueryEscape(token)
+	if err := s.notifier.MagicLink(ctx, s.toDomain(u), link, expiresAt); err != nil {
+		slog.Error("failed to send magic link", "id", u.ID, "error", err)
+		return session, nil
+	}
+
+	slog.Info("magic link sent", "id", u.ID)
+	return session, nil
+}
+
+// AuthenticateMagicLink logs a user in with the token of a login link and the
+// nonce of the browser that asked for it, like Authenticate. A link opened in
+// another browser is refused without being used up.
+func (s *userService) AuthenticateMagicLink(ctx context.Context, req MagicLinkAuthRequest) (*AuthResponse, error) {
+	mlt, err := s.repo.GetMagicLinkTokenByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidMagicLink
+		}
+		return nil, err
+	}
+	if mlt.UsedAt != nil || time.Now().After(mlt.ExpiresAt) {
+		slog.Warn("magic link login failed: link used or expired", "user_id", mlt.UserID)
+		return nil, ErrInvalidMagicLink
+	}
+	if subtle.ConstantTimeCompare([]byte(auth.HashToken(req.Nonce)), []byte(mlt.NonceHash)) != 1 {
+		slog.Warn("magic link login failed: opened in another browser", "user_id", mlt.UserID)
+		return nil, ErrInvalidMagicLink
+	}
+
+	u, err := s.repo.GetByID(ctx, mlt.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidMagicLink
+		}
+		return nil, err
+	}
+	if u.Status != 1 {
+		slog.Warn("magic link login failed: user inactive", "id", u.ID)
+		return nil, ErrInvalidMagicLink
+	}
+	if u.Edges.App == nil || !u.Edges.App.MagicLinkEnabled {
+		slog.Warn("magic link login failed: disabled for app", "id", u.ID)
+		return nil, ErrMagicLinkDisabled
+	}
+	if u.EmailVerifiedAt == nil && u.Edges.App.RequireVerifiedEmail {
+		slog.Warn("magic link login failed: email not verified", "id", u.ID)
+		return nil, ErrEmailNotVerified
+	}
+
+	ok, err := s.repo.MarkMagicLinkTokenUsed(ctx, mlt.ID)
+	if err != nil {
+		return nil, fmt.Errorf("mark magic link token used: %w", err)
+	}
+	if !ok {
+		return nil, ErrInvalidMagicLink
+	}
+
+	slog.Info("magic link redeemed", "id", u.ID)
+	return s.completeLogin(ctx, u, LoginMethodMagicLink)
+}
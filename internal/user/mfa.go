@@ -0,0 +1,395 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"keeper/ent"
+	"keeper/pkg/auth"
+)
+
+// DefaultMFAChallengeExpiry is the MFA challenge lifetime used when none is configured.
+const DefaultMFAChallengeExpiry = 5 * time.Minute
+
+const (
+	// maxMFAAttempts is how many wrong codes a challenge survives.
+	maxMFAAttempts = 5
+	// recoveryCodeCount is how many recovery codes a user is given.
+	recoveryCodeCount = 10
+	// defaultTOTPIssuer names the account in authenticator apps when the
+	// user's app is not loaded.
+	defaultTOTPIssuer = "Keeper"
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// mfaRequired reports whether u must present a second factor after their password.
+func mfaRequired(u *ent.User) bool {
+	return u.TotpConfirmedAt != nil || (u.Edges.App != nil && u.Edges.App.RequireMfa)
+}
+
+// ChallengeMFA starts an MFA challenge for a user who has passed their
+// password check. It returns nil when the user does not need one.
+func (s *userService) ChallengeMFA(ctx context.Context, id int) (*MFAChallenge, error) {
+	u, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if !mfaRequired(u) {
+		return nil, nil
+	}
+	return s.beginMFA(ctx, u)
+}
+
+// beginMFA issues a challenge token that can be exchanged for tokens with a
+// TOTP or recovery code.
+func (s *userService) beginMFA(ctx context.Context, u *ent.User) (*MFAChallenge, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate mfa token: %w", err)
+	}
+	if _, err := s.repo.CreateMFAChallenge(ctx, u.ID, auth.HashToken(token), time.Now().Add(s.mfaExpiry)); err != nil {
+		return nil, fmt.Errorf("store mfa challenge: %w", err)
+	}
+
+	slog.Info("mfa challenge issued", "id", u.ID)
+	return &MFAChallenge{
+		Token:              token,
+		ExpiresIn:          int(s.mfaExpiry.Seconds()),
+		EnrollmentRequired: u.TotpConfirmedAt == nil,
+	}, nil
+}
+
+// AuthenticateMFA completes a login with the code of an enrolled user, or
+// completes the enrolment started with EnrollMFAChallenge, which also returns
+// the user's recovery codes.
+func (s *userService) AuthenticateMFA(ctx context.Context, req MFAAuthRequest) (*AuthResponse, error) {
+	c, u, err := s.loadChallenge(ctx, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	enrolling := u.TotpConfirmedAt == nil
+	var step int64
+	if enrolling {
+		if u.TotpSecret == nil {
+			return nil, ErrMFAEnrollmentRequired
+		}
+		var ok bool
+		if step, ok = auth.ValidateTOTP(*u.TotpSecret, req.Code, time.Now(), 0); !ok {
+			return nil, s.failChallenge(ctx, c, u)
+		}
+	} else if err := s.checkCode(ctx, u, req.Code, true); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			return nil, s.failChallenge(ctx, c, u)
+		}
+		return nil, err
+	}
+
+	ok, err := s.repo.MarkMFAChallengeUsed(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("mark mfa challenge used: %w", err)
+	}
+	if !ok {
+		return nil, ErrInvalidMFAToken
+	}
+
+	var recoveryCodes []string
+	if enrolling {
+		if recoveryCodes, err = s.confirmTOTP(ctx, u.ID, step); err != nil {
+			return nil, err
+		}
+		if u, err = s.repo.GetByID(ctx, u.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.issueTokens(ctx, u, "", "")
+	if err != nil {
+		return nil, err
+	}
+	res.RecoveryCodes = recoveryCodes
+
+	if err := s.auditLogin(ctx, u, u.Email, LoginMethodMFA, ""); err != nil {
+		return nil, err
+	}
+	slog.Info("user authenticated successfully with mfa", "id", u.ID, "email", u.Email)
+	return res, nil
+}
+
+// VerifyMFA checks the code of an enrolled user against a challenge and
+// returns the user. It is used by login flows that issue their own tokens.
+func (s *userService) VerifyMFA(ctx context.Context, token, code string) (*User, error) {
+	c, u, err := s.loadChallenge(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpConfirmedAt == nil {
+		return nil, ErrMFAEnrollmentRequired
+	}
+
+	if err := s.checkCode(ctx, u, code, true); err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			return nil, s.failChallenge(ctx, c, u)
+		}
+		return nil, err
+	}
+
+	ok, err := s.repo.MarkMFAChallengeUsed(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("mark mfa challenge used: %w", err)
+	}
+	if !ok {
+		return nil, ErrInvalidMFAToken
+	}
+	return s.toDomain(u), nil
+}
+
+// EnrollMFAChallenge starts TOTP enrolment for a user whose app requires MFA
+// and who has not enrolled yet, using the challenge issued at login.
+func (s *userService) EnrollMFAChallenge(ctx context.Context, req MFAEnrollChallengeRequest) (*MFAEnrollment, error) {
+	_, u, err := s.loadChallenge(ctx, req.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpConfirmedAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	return s.enroll(ctx, u)
+}
+
+// EnrollMFA starts TOTP enrolment for a signed-in user. It is completed with ConfirmMFA.
+func (s *userService) EnrollMFA(ctx context.Context, id int) (*MFAEnrollment, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpConfirmedAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	return s.enroll(ctx, u)
+}
+
+// ConfirmMFA enables the TOTP secret of a pending enrolment with its first
+// code and returns a new set of recovery codes.
+func (s *userService) ConfirmMFA(ctx context.Context, id int, req MFACodeRequest) (*RecoveryCodesResponse, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpConfirmedAt != nil {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if u.TotpSecret == nil {
+		return nil, ErrMFANotEnabled
+	}
+
+	step, ok := auth.ValidateTOTP(*u.TotpSecret, req.Code, time.Now(), 0)
+	if !ok {
+		slog.Warn("mfa confirmation failed: invalid code", "id", id)
+		return nil, ErrInvalidMFACode
+	}
+	codes, err := s.confirmTOTP(ctx, id, step)
+	if err != nil {
+		return nil, err
+	}
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of an enrolled user
+// after checking a TOTP code.
+func (s *userService) RegenerateRecoveryCodes(ctx context.Context, id int, req MFACodeRequest) (*RecoveryCodesResponse, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCode(ctx, u, req.Code, false); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.newRecoveryCodes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("recovery codes regenerated", "id", id)
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableMFA turns MFA off for a signed-in user after checking a TOTP or
+// recovery code. Users of apps that require MFA will have to enrol again at
+// their next login.
+func (s *userService) DisableMFA(ctx context.Context, id int, req MFACodeRequest) error {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkCode(ctx, u, req.Code, true); err != nil {
+		return err
+	}
+
+	if err := s.repo.ClearMFA(ctx, id); err != nil {
+		return err
+	}
+	slog.Info("mfa disabled", "id", id)
+	return nil
+}
+
+// ResetMFA turns MFA off for a user without a code, for administrators
+// helping users who lost their authenticator and recovery codes.
+func (s *userService) ResetMFA(ctx context.Context, id int) error {
+	slog.Info("resetting mfa", "id", id)
+	if err := s.repo.ClearMFA(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	slog.Info("mfa reset", "id", id)
+	return nil
+}
+
+func (s *userService) getUser(ctx context.Context, id int) (*ent.User, error) {
+	u, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+// loadChallenge returns a usable challenge and its active user.
+func (s *userService) loadChallenge(ctx context.Context, token string) (*ent.MFAChallenge, *ent.User, error) {
+	c, err := s.repo.GetMFAChallengeByHash(ctx, auth.HashToken(token))
+	if err != nil {
+		slog.Warn("mfa failed: challenge not found")
+		return nil, nil, ErrInvalidMFAToken
+	}
+	if c.UsedAt != nil || time.Now().After(c.ExpiresAt) || c.Attempts >= maxMFAAttempts {
+		slog.Warn("mfa failed: challenge used, expired or exhausted", "user_id", c.UserID)
+		return nil, nil, ErrInvalidMFAToken
+	}
+
+	u, err := s.repo.GetByID(ctx, c.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrInvalidMFAToken
+		}
+		return nil, nil, err
+	}
+	if u.Status != 1 {
+		slog.Warn("mfa failed: user inactive", "id", u.ID)
+		return nil, nil, ErrInvalidMFAToken
+	}
+	return c, u, nil
+}
+
+// failChallenge counts a wrong code of u against c and returns ErrInvalidMFACode.
+func (s *userService) failChallenge(ctx context.Context, c *ent.MFAChallenge, u *ent.User) error {
+	slog.Warn("mfa failed: invalid code", "user_id", c.UserID, "attempts", c.Attempts+1)
+	if err := s.auditLogin(ctx, u, u.Email, LoginMethodMFA, LoginFailureInvalidMFACode); err != nil {
+		return err
+	}
+	if err := s.repo.FailMFAChallenge(ctx, c.ID); err != nil {
+		return err
+	}
+	return ErrInvalidMFACode
+}
+
+// checkCode accepts a TOTP code of an enrolled user, or one of their unused
+// recovery codes when allowRecovery is set. Each code is only accepted once.
+func (s *userService) checkCode(ctx context.Context, u *ent.User, code string, allowRecovery bool) error {
+	if u.TotpConfirmedAt == nil || u.TotpSecret == nil {
+		return ErrMFANotEnabled
+	}
+
+	if step, ok := auth.ValidateTOTP(*u.TotpSecret, code, time.Now(), u.TotpLastStep); ok {
+		used, err := s.repo.UseTOTPStep(ctx, u.ID, step)
+		if err != nil {
+			return err
+		}
+		if used {
+			return nil
+		}
+	}
+
+	if allowRecovery {
+		used, err := s.repo.UseRecoveryCode(ctx, u.ID, auth.HashToken(normalizeRecoveryCode(code)))
+		if err != nil {
+			return err
+		}
+		if used {
+			slog.Info("recovery code used", "id", u.ID)
+			return nil
+		}
+	}
+	return ErrInvalidMFACode
+}
+
+// enroll gives u a new, unconfirmed TOTP secret.
+func (s *userService) enroll(ctx context.Context, u *ent.User) (*MFAEnrollment, error) {
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, fmt.Errorf("generate totp secret: %w", err)
+	}
+	if err := s.repo.SetTOTPSecret(ctx, u.ID, secret); err != nil {
+		return nil, err
+	}
+
+	issuer := defaultTOTPIssuer
+	if u.Edges.App != nil {
+		issuer = u.Edges.App.Name
+	}
+	slog.Info("mfa enrolment started", "id", u.ID)
+	return &MFAEnrollment{Secret: secret, URI: auth.TOTPURI(issuer, u.Email, secret)}, nil
+}
+
+// confirmTOTP enables the user's pending TOTP secret and returns their
+// first recovery codes.
+func (s *userService) confirmTOTP(ctx context.Context, id int, step int64) ([]string, error) {
+	if err := s.repo.ConfirmTOTP(ctx, id, step); err != nil {
+		return nil, err
+	}
+	codes, err := s.newRecoveryCodes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("mfa enabled", "id", id)
+	return codes, nil
+}
+
+// newRecoveryCodes replaces the user's recovery codes and returns them. Only
+// their hashes are stored.
+func (s *userService) newRecoveryCodes(ctx context.Context, id int) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		raw := strings.ToLower(recoveryCodeEncoding.EncodeToString(b))
+		codes[i] = raw[0:4] + "-" + raw[4:8] + "-" + raw[8:12] + "-" + raw[12:16]
+		hashes[i] = auth.HashToken(raw)
+	}
+	if err := s.repo.ReplaceRecoveryCodes(ctx, id, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// normalizeRecoveryCode strips the separators and case users may type a
+// recovery code with.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
@@ -2,20 +2,28 @@ package user
 
 import (
 	"time"
+
+	"keeper/pkg/password"
+	"keeper/pkg/webauthn"
 )
 
 // User represents the domain model for a user.
 type User struct {
-	ID        int       `json:"id"`
-	AppID     int       `json:"app_id"`
-	AppName   string    `json:"app_name"`
-	Firstname string    `json:"firstname"`
-	Lastname  string    `json:"lastname"`
-	Email     string    `json:"email"`
-	Password  string    `json:"password,omitempty"`
-	Status    int8      `json:"status"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              int        `json:"id"`
+	AppID           int        `json:"app_id"`
+	AppName         string     `json:"app_name"`
+	Firstname       string     `json:"firstname"`
+	Lastname        string     `json:"lastname"`
+	Email           string     `json:"email"`
+	Password        string     `json:"password,omitempty"`
+	Status          int8       `json:"status"`
+	PlatformAdmin   bool       `json:"platform_admin"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	MFAEnabled      bool       `json:"mfa_enabled"`
+	LockedUntil     *time.Time `json:"locked_until,omitempty"`
+	Roles           []string   `json:"roles"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
 // CreateUserRequest defines the payload for creating a user.
@@ -24,17 +32,95 @@ type CreateUserRequest struct {
 	Firstname string `json:"firstname" validate:"required"`
 	Lastname  string `json:"lastname" validate:"required"`
 	Email     string `json:"email" validate:"required,email"`
-	Password  string `json:"password" validate:"required,min=8"`
+	Password  string `json:"password" validate:"required"`
 }
 
 // UpdateUserRequest defines the payload for updating a user.
 type UpdateUserRequest struct {
-	AppID     *int    `json:"app_id"`
-	Firstname *string `json:"firstname"`
-	Lastname  *string `json:"lastname"`
-	Email     *string `json:"email" validate:"omitempty,email"`
-	Password  *string `json:"password" validate:"omitempty,min=8"`
-	Status    *int8   `json:"status"`
+	AppID         *int    `json:"app_id"`
+	Firstname     *string `json:"firstname"`
+	Lastname      *string `json:"lastname"`
+	Email         *string `json:"email" validate:"omitempty,email"`
+	Password      *string `json:"password" validate:"omitempty"`
+	Status        *int8   `json:"status"`
+	PlatformAdmin *bool   `json:"platform_admin"`
+	// EmailVerified lets an administrator mark the email as verified, or
+	// unverified, without a verification token.
+	EmailVerified *bool `json:"email_verified"`
+}
+
+// ListUsersRequest filters, sorts and pages the users returned by List.
+// Users are sorted by Sort, one of SortFields, then by ID. Cursor is the
+// NextCursor of the previous page, which must have had the same sort.
+type ListUsersRequest struct {
+	AppID         *int
+	Status        *int8
+	EmailDomain   string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Email matches the user with that email, ignoring case.
+	Email string
+	// RoleIDs matches the users who have any of those roles.
+	RoleIDs []int
+	// Q matches users whose first name, last name or email contain each of
+	// its words, ignoring case.
+	Q      string
+	Sort   string
+	Desc   bool
+	Cursor string
+	// Offset skips that many users, for callers that page by position
+	// rather than with a cursor. It is ignored along with a cursor.
+	Offset int
+	Limit  int
+}
+
+// UserPage is a page of users.
+type UserPage struct {
+	Users []*User
+	// Total is the number of users matching the filters, across all pages.
+	Total int
+	// NextCursor fetches the next page. It is empty on the last page.
+	NextCursor string
+}
+
+// SearchUsersRequest finds users by the words of their first name, last
+// name or email. Each word of Q matches the words it starts, ignoring case
+// and accents, and every word must match.
+type SearchUsersRequest struct {
+	Q     string
+	AppID *int
+	Limit int
+}
+
+// UserSearchResult is a user found by Search. Highlight holds its fields as
+// HTML, with the matched words in <mark> tags.
+type UserSearchResult struct {
+	User *User `json:"user"`
+	// Score ranks the results: the higher, the better the match.
+	Score     float64       `json:"score"`
+	Highlight UserHighlight `json:"highlight"`
+}
+
+// UserHighlight holds the searched fields of a user, HTML-escaped, with the
+// matched words in <mark> tags.
+type UserHighlight struct {
+	Firstname string `json:"firstname"`
+	Lastname  string `json:"lastname"`
+	Email     string `json:"email"`
+}
+
+// UserSearchPage holds the best results of a search.
+type UserSearchPage struct {
+	Results []*UserSearchResult
+	// Total is the number of users matching the search, of which Results
+	// are the best.
+	Total int
+}
+
+// SetRolesRequest defines the payload for replacing the roles of a user.
+// Every role must belong to the user's app.
+type SetRolesRequest struct {
+	RoleIDs []int `json:"role_ids" validate:"dive,gt=0"`
 }
 
 // AuthRequest defines the payload for user authentication.
@@ -43,8 +129,187 @@ type AuthRequest struct {
 	Password string `json:"password" validate:"required"`
 }
 
-// AuthResponse defines the response after successful authentication.
+// AuthResponse defines the response after successful authentication. When the
+// user must also pass multi-factor authentication, only MFA is set and its
+// token has to be exchanged at /users/auth/mfa for the other fields.
 type AuthResponse struct {
-	Token string `json:"token"`
-	User  User   `json:"user"`
+	Token        string        `json:"token,omitempty"`
+	RefreshToken string        `json:"refresh_token,omitempty"`
+	ExpiresIn    int           `json:"expires_in,omitempty"`
+	Scope        string        `json:"scope,omitempty"`
+	User         *User         `json:"user,omitempty"`
+	MFA          *MFAChallenge `json:"mfa,omitempty"`
+	// RecoveryCodes are returned once, when enrolment is completed at login.
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+// MFAChallenge is returned instead of tokens when a password check succeeds
+// for a user who must also present a TOTP or recovery code.
+type MFAChallenge struct {
+	Token     string `json:"token"`
+	ExpiresIn int    `json:"expires_in"`
+	// EnrollmentRequired is set when the user's app requires MFA and the user
+	// has not enrolled yet. The token must then first be passed to
+	// /users/auth/mfa/enroll.
+	EnrollmentRequired bool `json:"enrollment_required"`
+}
+
+// MFAAuthRequest defines the payload for completing a login with a TOTP or
+// recovery code.
+type MFAAuthRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+// MFAEnrollChallengeRequest defines the payload for enrolling in TOTP during
+// a login that requires it.
+type MFAEnrollChallengeRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+}
+
+// MFAEnrollment holds a new TOTP secret. URI is the otpauth:// URI that
+// authenticator apps enrol from, usually shown as a QR code.
+type MFAEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+// MFACodeRequest defines the payload of MFA changes that must be confirmed
+// with a TOTP code, or a recovery code where noted.
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// RecoveryCodesResponse holds newly generated recovery codes. They are only
+// shown once.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// Passkey describes a WebAuthn credential a user signs in with.
+type Passkey struct {
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	Transports []string `json:"transports"`
+	// Synced is set for passkeys backed up by a password manager or platform
+	// account, which can be used from the user's other devices.
+	Synced     bool       `json:"synced"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// PasskeyCreationOptions holds the argument of navigator.credentials.create
+// for registering a passkey.
+type PasskeyCreationOptions struct {
+	PublicKey webauthn.CreationOptions `json:"publicKey"`
+}
+
+// PasskeyRequestOptions holds the argument of navigator.credentials.get for
+// signing in with a passkey.
+type PasskeyRequestOptions struct {
+	PublicKey webauthn.RequestOptions `json:"publicKey"`
+}
+
+// PasskeyRegistrationRequest defines the payload for completing a passkey
+// registration with the credential returned by navigator.credentials.create.
+type PasskeyRegistrationRequest struct {
+	Name       string                          `json:"name" validate:"max=64"`
+	Credential webauthn.RegistrationCredential `json:"credential"`
+}
+
+// PasskeyLoginOptionsRequest defines the payload for starting a passkey login.
+// Without an email, the browser offers every passkey it has for the app.
+type PasskeyLoginOptionsRequest struct {
+	AppID int    `json:"app_id" validate:"required"`
+	Email string `json:"email" validate:"omitempty,email"`
+}
+
+// PasskeyAuthRequest defines the payload for completing a passkey login with
+// the credential returned by navigator.credentials.get.
+type PasskeyAuthRequest struct {
+	Credential webauthn.AssertionCredential `json:"credential"`
+}
+
+// Lockout records a time too many failed logins locked a user, or the client
+// IP they were made from, out.
+type Lockout struct {
+	ID int `json:"id"`
+	// Kind is "account" for user lockouts and "ip" for client IP lockouts.
+	Kind        string     `json:"kind"`
+	IP          string     `json:"ip,omitempty"`
+	Failures    int        `json:"failures"`
+	LockedUntil time.Time  `json:"locked_until"`
+	UnlockedAt  *time.Time `json:"unlocked_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// RefreshRequest defines the payload for exchanging a refresh token.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+	// AppID, when set, is the app the token must have been issued to. A token
+	// of another app's user is rejected before it is used.
+	AppID int `json:"-"`
+}
+
+// LogoutRequest defines the optional payload for logging out. When a refresh
+// token is given, it and every token rotated from the same login are revoked.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ForgotPasswordRequest defines the payload for requesting a password reset.
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// MagicLinkRequest defines the payload for requesting a login link by email.
+type MagicLinkRequest struct {
+	AppID int    `json:"app_id" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+// MagicLinkSession is the nonce a login link is bound to. It is handed to the
+// requesting browser as a cookie and never in a response body.
+type MagicLinkSession struct {
+	Nonce     string
+	ExpiresAt time.Time
+}
+
+// MagicLinkAuthRequest holds the token of a login link and the nonce cookie of
+// the browser it was opened in.
+type MagicLinkAuthRequest struct {
+	Token string `validate:"required"`
+	Nonce string `validate:"required"`
+}
+
+// ResetPasswordRequest defines the payload for choosing a new password with a
+// reset token.
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+// ChangePasswordRequest defines the payload for changing a password with the
+// current one, as required once it has expired.
+type ChangePasswordRequest struct {
+	Email       string `json:"email" validate:"required,email"`
+	Password    string `json:"password" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required"`
+}
+
+// PasswordViolations lists the rules of the app's password policy a new
+// password breaks.
+type PasswordViolations struct {
+	Violations []password.Violation `json:"violations"`
+}
+
+// VerifyEmailRequest defines the payload for confirming an email address.
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ResendVerificationRequest defines the payload for requesting a new
+// verification token.
+type ResendVerificationRequest struct {
+	Email string `json:"email" validate:"required,email"`
 }
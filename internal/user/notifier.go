@@ -0,0 +1,39 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Notifier delivers account messages, such as password reset tokens, to users.
+type Notifier interface {
+	// PasswordReset sends u the token to pass to POST /users/password/reset.
+	PasswordReset(ctx context.Context, u *User, token string, expiresAt time.Time) error
+	// EmailVerification sends u the token to pass to POST /users/verify-email.
+	EmailVerification(ctx context.Context, u *User, token string, expiresAt time.Time) error
+	// MagicLink sends u a link that logs them in.
+	MagicLink(ctx context.Context, u *User, link string, expiresAt time.Time) error
+}
+
+// LogNotifier writes messages to the log instead of sending them. It is meant
+// for development; anyone who can read the log can reset passwords.
+type LogNotifier struct{}
+
+// PasswordReset logs the reset token.
+func (LogNotifier) PasswordReset(_ context.Context, u *User, token string, expiresAt time.Time) error {
+	slog.Info("password reset token issued", "user_id", u.ID, "email", u.Email, "token", token, "expires_at", expiresAt)
+	return nil
+}
+
+// EmailVerification logs the verification token.
+func (LogNotifier) EmailVerification(_ context.Context, u *User, token string, expiresAt time.Time) error {
+	slog.Info("email verification token issued", "user_id", u.ID, "email", u.Email, "token", token, "expires_at", expiresAt)
+	return nil
+}
+
+// MagicLink logs the login link.
+func (LogNotifier) MagicLink(_ context.Context, u *User, link string, expiresAt time.Time) error {
+	slog.Info("magic link issued", "user_id", u.ID, "email", u.Email, "link", link, "expires_at", expiresAt)
+	return nil
+}
@@ -0,0 +1,327 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/webauthnchallenge"
+	"keeper/pkg/auth"
+	"keeper/pkg/webauthn"
+)
+
+// DefaultWebAuthnChallengeExpiry is the passkey ceremony lifetime used when none is configured.
+const DefaultWebAuthnChallengeExpiry = 5 * time.Minute
+
+// defaultPasskeyName names passkeys registered without a name.
+const defaultPasskeyName = "Passkey"
+
+// relyingParty returns the WebAuthn relying party of an app.
+func relyingParty(a *ent.App) (webauthn.RelyingParty, error) {
+	if a == nil || a.WebauthnRpID == "" {
+		return webauthn.RelyingParty{}, ErrPasskeysNotConfigured
+	}
+	return webauthn.RelyingParty{ID: a.WebauthnRpID, Name: a.Name, Origins: a.WebauthnOrigins}, nil
+}
+
+// userHandle is the WebAuthn user handle of a user. It identifies the account
+// to authenticators without carrying personal information.
+func userHandle(id int) []byte {
+	return []byte(strconv.Itoa(id))
+}
+
+// BeginPasskeyRegistration starts registering a passkey for a signed-in user
+// and returns the options to pass to navigator.credentials.create.
+func (s *userService) BeginPasskeyRegistration(ctx context.Context, id int) (*PasskeyCreationOptions, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := relyingParty(u.Edges.App)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := s.repo.ListWebAuthnCredentials(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	exclude := make([]webauthn.CredentialDescriptor, len(creds))
+	for i, c := range creds {
+		exclude[i] = webauthn.NewCredentialDescriptor(c.CredentialID, c.Transports)
+	}
+
+	challenge, err := s.newWebAuthnChallenge(ctx, u.AppID, &u.ID, webauthnchallenge.CeremonyRegistration)
+	if err != nil {
+		return nil, err
+	}
+
+	user := webauthn.UserEntity{
+		ID:          userHandle(u.ID),
+		Name:        u.Email,
+		DisplayName: strings.TrimSpace(u.Firstname + " " + u.Lastname),
+	}
+	slog.Info("passkey registration started", "id", u.ID)
+	return &PasskeyCreationOptions{PublicKey: rp.CreationOptions(challenge, user, exclude, s.webauthnExpiry)}, nil
+}
+
+// FinishPasskeyRegistration verifies the credential created with the options
+// of BeginPasskeyRegistration and stores it as a passkey of the user.
+func (s *userService) FinishPasskeyRegistration(ctx context.Context, id int, req PasskeyRegistrationRequest) (*Passkey, error) {
+	u, err := s.getUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := relyingParty(u.Edges.App)
+	if err != nil {
+		return nil, err
+	}
+
+	c, challenge, err := s.loadWebAuthnChallenge(ctx, req.Credential.Response.ClientDataJSON, webauthnchallenge.CeremonyRegistration)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserID == nil || *c.UserID != u.ID {
+		slog.Warn("passkey registration failed: challenge issued to another user", "id", u.ID)
+		return nil, ErrInvalidPasskey
+	}
+
+	cred, err := rp.VerifyRegistration(challenge, req.Credential)
+	if err != nil {
+		slog.Warn("passkey registration failed: invalid credential", "id", u.ID, "error", err)
+		return nil, ErrInvalidPasskey
+	}
+
+	ok, err := s.repo.MarkWebAuthnChallengeUsed(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("mark webauthn challenge used: %w", err)
+	}
+	if !ok {
+		return nil, ErrInvalidPasskey
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = defaultPasskeyName
+	}
+	created, err := s.repo.CreateWebAuthnCredential(ctx, u.ID, name, cred)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrPasskeyAlreadyRegistered
+		}
+		return nil, err
+	}
+
+	slog.Info("passkey registered", "id", u.ID, "passkey_id", created.ID)
+	return toPasskey(created), nil
+}
+
+// ListPasskeys returns the passkeys of a user.
+func (s *userService) ListPasskeys(ctx context.Context, id int) ([]*Passkey, error) {
+	creds, err := s.repo.ListWebAuthnCredentials(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	passkeys := make([]*Passkey, len(creds))
+	for i, c := range creds {
+		passkeys[i] = toPasskey(c)
+	}
+	return passkeys, nil
+}
+
+// DeletePasskey removes a passkey of a user.
+func (s *userService) DeletePasskey(ctx context.Context, id, passkeyID int) error {
+	ok, err := s.repo.DeleteWebAuthnCredential(ctx, id, passkeyID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrPasskeyNotFound
+	}
+	slog.Info("passkey removed", "id", id, "passkey_id", passkeyID)
+	return nil
+}
+
+// BeginPasskeyLogin starts a passkey login to an app and returns the options
+// to pass to navigator.credentials.get. When an email is given, only the
+// passkeys of that user are allowed; an unknown email gets the same options as
+// no email, so the result does not reveal which emails have accounts.
+func (s *userService) BeginPasskeyLogin(ctx context.Context, req PasskeyLoginOptionsRequest) (*PasskeyRequestOptions, error) {
+	a, err := s.repo.GetApp(ctx, req.AppID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrPasskeysNotConfigured
+		}
+		return nil, err
+	}
+	rp, err := relyingParty(a)
+	if err != nil {
+		return nil, err
+	}
+
+	var userID *int
+	var allow []webauthn.CredentialDescriptor
+	if req.Email != "" {
+		u, err := s.repo.GetByEmail(ctx, req.Email)
+		switch {
+		case err == nil && u.AppID == a.ID:
+			creds, err := s.repo.ListWebAuthnCredentials(ctx, u.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range creds {
+				allow = append(allow, webauthn.NewCredentialDescriptor(c.CredentialID, c.Transports))
+			}
+			userID = &u.ID
+		case err != nil && !ent.IsNotFound(err):
+			return nil, err
+		}
+	}
+
+	challenge, err := s.newWebAuthnChallenge(ctx, a.ID, userID, webauthnchallenge.CeremonyLogin)
+	if err != nil {
+		return nil, err
+	}
+	return &PasskeyRequestOptions{PublicKey: rp.RequestOptions(challenge, allow, s.webauthnExpiry)}, nil
+}
+
+// AuthenticatePasskey completes a passkey login and issues tokens like a
+// password login. Passkeys verify the user on the authenticator, so no TOTP
+// code is asked for even when the user or their app requires MFA.
+func (s *userService) AuthenticatePasskey(ctx context.Context, req PasskeyAuthRequest) (*AuthResponse, error) {
+	c, challenge, err := s.loadWebAuthnChallenge(ctx, req.Credential.Response.ClientDataJSON, webauthnchallenge.CeremonyLogin)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := relyingParty(c.Edges.App)
+	if err != nil {
+		return nil, err
+	}
+
+	cred, err := s.repo.GetWebAuthnCredentialByCredentialID(ctx, req.Credential.RawID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("passkey login failed: unknown credential", "app_id", c.AppID)
+			return nil, ErrInvalidPasskey
+		}
+		return nil, err
+	}
+	if c.UserID != nil && *c.UserID != cred.UserID {
+		slog.Warn("passkey login failed: credential of another user", "user_id", cred.UserID)
+		return nil, ErrInvalidPasskey
+	}
+	if h := req.Credential.Response.UserHandle; len(h) > 0 && !bytes.Equal(h, userHandle(cred.UserID)) {
+		slog.Warn("passkey login failed: user handle mismatch", "user_id", cred.UserID)
+		return nil, ErrInvalidPasskey
+	}
+
+	u, err := s.repo.GetByID(ctx, cred.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidPasskey
+		}
+		return nil, err
+	}
+	if u.AppID != c.AppID {
+		slog.Warn("passkey login failed: user of another app", "id", u.ID, "app_id", c.AppID)
+		return nil, ErrInvalidPasskey
+	}
+	if u.Status != 1 {
+		slog.Warn("passkey login failed: user inactive", "id", u.ID)
+		return nil, ErrInvalidPasskey
+	}
+
+	signCount, err := rp.VerifyAssertion(challenge, req.Credential, cred.PublicKey, cred.SignCount)
+	if err != nil {
+		slog.Warn("passkey login failed: invalid assertion", "id", u.ID, "error", err)
+		return nil, ErrInvalidPasskey
+	}
+
+	ok, err := s.repo.MarkWebAuthnChallengeUsed(ctx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("mark webauthn challenge used: %w", err)
+	}
+	if !ok {
+		return nil, ErrInvalidPasskey
+	}
+	if ok, err = s.repo.UseWebAuthnCredential(ctx, cred.ID, cred.SignCount, signCount); err != nil {
+		return nil, err
+	}
+	if !ok {
+		slog.Warn("passkey login failed: concurrent use of credential", "id", u.ID, "passkey_id", cred.ID)
+		return nil, ErrInvalidPasskey
+	}
+
+	if u.EmailVerifiedAt == nil && u.Edges.App != nil && u.Edges.App.RequireVerifiedEmail {
+		slog.Warn("passkey login failed: email not verified", "id", u.ID)
+		return nil, ErrEmailNotVerified
+	}
+
+	res, err := s.issueTokens(ctx, u, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.auditLogin(ctx, u, u.Email, LoginMethodPasskey, ""); err != nil {
+		return nil, err
+	}
+	slog.Info("user authenticated successfully with passkey", "id", u.ID, "passkey_id", cred.ID)
+	return res, nil
+}
+
+// newWebAuthnChallenge stores a new ceremony challenge and returns it. Only
+// its hash is kept.
+func (s *userService) newWebAuthnChallenge(ctx context.Context, appID int, userID *int, ceremony webauthnchallenge.Ceremony) ([]byte, error) {
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, fmt.Errorf("generate webauthn challenge: %w", err)
+	}
+	_, err = s.repo.CreateWebAuthnChallenge(ctx, appID, userID, ceremony, hashChallenge(challenge), time.Now().Add(s.webauthnExpiry))
+	if err != nil {
+		return nil, fmt.Errorf("store webauthn challenge: %w", err)
+	}
+	return challenge, nil
+}
+
+// loadWebAuthnChallenge returns the usable challenge the client data was
+// signed for, with the raw challenge to verify the credential against.
+func (s *userService) loadWebAuthnChallenge(ctx context.Context, clientDataJSON []byte, ceremony webauthnchallenge.Ceremony) (*ent.WebAuthnChallenge, []byte, error) {
+	challenge, err := webauthn.Challenge(clientDataJSON)
+	if err != nil {
+		slog.Warn("passkey ceremony failed: malformed client data", "error", err)
+		return nil, nil, ErrInvalidPasskey
+	}
+	c, err := s.repo.GetWebAuthnChallengeByHash(ctx, hashChallenge(challenge))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrInvalidPasskey
+		}
+		return nil, nil, err
+	}
+	if c.Ceremony != ceremony || c.UsedAt != nil || time.Now().After(c.ExpiresAt) {
+		slog.Warn("passkey ceremony failed: challenge used, expired or of another ceremony", "app_id", c.AppID)
+		return nil, nil, ErrInvalidPasskey
+	}
+	return c, challenge, nil
+}
+
+func hashChallenge(challenge []byte) string {
+	return auth.HashToken(base64.RawURLEncoding.EncodeToString(challenge))
+}
+
+func toPasskey(c *ent.WebAuthnCredential) *Passkey {
+	return &Passkey{
+		ID:         c.ID,
+		Name:       c.Name,
+		Transports: c.Transports,
+		Synced:     c.BackedUp,
+		LastUsedAt: c.LastUsedAt,
+		CreatedAt:  c.CreatedAt,
+	}
+}
@@ -0,0 +1,192 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/pkg/password"
+)
+
+// PasswordPolicyError is returned when a new password breaks the password
+// policy of the user's app.
+type PasswordPolicyError struct {
+	Violations []password.Violation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return "password does not meet the password policy"
+}
+
+// BreachedPasswords tells whether a password is known from data breaches.
+type BreachedPasswords interface {
+	Contains(password string) (bool, error)
+}
+
+// passwordPolicyOf returns the password policy of an app. Users of no known
+// app get the default policy.
+func passwordPolicyOf(a *ent.App) password.Policy {
+	if a == nil {
+		return password.DefaultPolicy()
+	}
+	return password.PolicyOrDefault(a.PasswordPolicy)
+}
+
+// passwordOwner returns the personal information of a user a password must
+// not contain.
+func passwordOwner(u *ent.User) password.Owner {
+	return password.Owner{Email: u.Email, Firstname: u.Firstname, Lastname: u.Lastname}
+}
+
+// hashPassword checks a new password against policy p and the breached
+// password corpus, and returns its hash. u is the user whose password changes,
+// nil for a new user; the new password must then not be their current one or
+// one of the previous ones the policy remembers.
+func (s *userService) hashPassword(ctx context.Context, p password.Policy, pw string, owner password.Owner, u *ent.User) (string, error) {
+	violations := p.Check(pw, owner)
+	if s.breached != nil {
+		breached, err := s.breached.Contains(pw)
+		if err != nil {
+			slog.Error("failed to screen password against breached passwords", "email", owner.Email, "error", err)
+			return "", fmt.Errorf("screen password: %w", err)
+		}
+		if breached {
+			violations = append(violations, password.BreachedViolation())
+		}
+	}
+	if u != nil && p.History > 0 {
+		reused, err := s.passwordReused(ctx, u, pw, p.History)
+		if err != nil {
+			return "", err
+		}
+		if reused {
+			violations = append(violations, p.ReuseViolation())
+		}
+	}
+	if len(violations) > 0 {
+		slog.Warn("password rejected by policy", "email", owner.Email, "violations", len(violations))
+		return "", &PasswordPolicyError{Violations: violations}
+	}
+
+	hashedPassword, err := s.hasher.Hash(pw)
+	if err != nil {
+		slog.Error("failed to hash password", "email", owner.Email, "error", err)
+		return "", fmt.Errorf("hash password: %w", err)
+	}
+	return hashedPassword, nil
+}
+
+// rehashPassword replaces the password hash of u after a successful login
+// when it was made with another algorithm or cost than the configured one,
+// so the cost can be raised without resetting passwords. Errors are logged,
+// as the login itself succeeded.
+func (s *userService) rehashPassword(ctx context.Context, u *ent.User, pw string) {
+	if !s.hasher.NeedsRehash(u.Password) {
+		return
+	}
+	hash, err := s.hasher.Hash(pw)
+	if err != nil {
+		slog.Warn("password rehash failed", "id", u.ID, "error", err)
+		return
+	}
+	if err := s.repo.UpdatePasswordHash(ctx, u.ID, hash); err != nil {
+		slog.Warn("password rehash failed", "id", u.ID, "error", err)
+		return
+	}
+	u.Password = hash
+	slog.Info("password rehashed", "id", u.ID)
+}
+
+// passwordReused reports whether pw is the current password of u or one of
+// the previous ones, up to history passwords in all.
+func (s *userService) passwordReused(ctx context.Context, u *ent.User, pw string, history int) (bool, error) {
+	if ok, _ := s.hasher.Verify(u.Password, pw); ok {
+		return true, nil
+	}
+	if history < 2 {
+		return false, nil
+	}
+	previous, err := s.repo.ListPasswordHistory(ctx, u.ID, history-1)
+	if err != nil {
+		return false, err
+	}
+	for _, h := range previous {
+		if ok, _ := s.hasher.Verify(h.PasswordHash, pw); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rememberPassword keeps the replaced password hash of a user for as long as
+// policy p forbids reusing it. Errors are logged, as the new password is
+// already set.
+func (s *userService) rememberPassword(ctx context.Context, userID int, oldHash string, p password.Policy) {
+	if err := s.repo.AddPasswordHistory(ctx, userID, oldHash, max(p.History-1, 0)); err != nil {
+		slog.Warn("recording password history failed", "id", userID, "error", err)
+	}
+}
+
+// passwordExpired reports whether the password of u is older than policy p
+// allows.
+func passwordExpired(u *ent.User, p password.Policy) bool {
+	if p.MaxAgeDays <= 0 {
+		return false
+	}
+	changedAt := u.CreatedAt
+	if u.PasswordChangedAt != nil {
+		changedAt = *u.PasswordChangedAt
+	}
+	return time.Now().After(changedAt.AddDate(0, 0, p.MaxAgeDays))
+}
+
+// checkPasswordAge refuses a password login once the password has expired;
+// the user has to change it with ChangePassword first.
+func checkPasswordAge(u *ent.User) error {
+	if passwordExpired(u, passwordPolicyOf(u.Edges.App)) {
+		slog.Warn("authentication failed: password expired", "id", u.ID)
+		return ErrPasswordExpired
+	}
+	return nil
+}
+
+// ChangePassword replaces the password of a user who proves they know the
+// current one, even when it has expired, and logs them in like Authenticate.
+// The new password must meet the app's policy and differ from the current
+// one; every other session of the user is revoked.
+func (s *userService) ChangePassword(ctx context.Context, req ChangePasswordRequest) (*AuthResponse, error) {
+	u, err := s.verifyCredentials(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	p := passwordPolicyOf(u.Edges.App)
+	// A change must not keep the current password even when the policy
+	// remembers no previous ones.
+	check := p
+	check.History = max(check.History, 1)
+	hash, err := s.hashPassword(ctx, check, req.NewPassword, passwordOwner(u), u)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetPassword(ctx, u.ID, hash); err != nil {
+		return nil, err
+	}
+	s.rememberPassword(ctx, u.ID, u.Password, p)
+
+	cutoff := revocationCutoff()
+	if err := s.repo.RevokeSessions(ctx, u.ID, cutoff); err != nil {
+		return nil, fmt.Errorf("revoke sessions: %w", err)
+	}
+	// The new session has to be issued after the cut-off to be valid.
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Until(cutoff)):
+	}
+	slog.Info("password changed", "id", u.ID)
+
+	return s.completeLogin(ctx, u, LoginMethodPassword)
+}
@@ -4,9 +4,25 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"keeper/ent"
+	"keeper/ent/emailverificationtoken"
+	"keeper/ent/lockout"
+	"keeper/ent/loginthrottle"
+	"keeper/ent/magiclinktoken"
+	"keeper/ent/mfachallenge"
+	"keeper/ent/mfarecoverycode"
+	"keeper/ent/passwordhistory"
+	"keeper/ent/passwordresettoken"
+	"keeper/ent/predicate"
+	"keeper/ent/refreshtoken"
+	"keeper/ent/role"
 	"keeper/ent/user"
+	"keeper/ent/webauthnchallenge"
+	"keeper/ent/webauthncredential"
+	"keeper/internal/db"
+	"keeper/pkg/webauthn"
 )
 
 // UserRepository handles database operations for users.
@@ -19,17 +35,24 @@ func NewUserRepository(client *ent.Client) *UserRepository {
 	return &UserRepository{client: client}
 }
 
-// Create creates a new user in the database.
+// Create creates a new user in the database. Changes to users are made in a
+// transaction, so that the webhook deliveries they queue are stored with them.
 func (r *UserRepository) Create(ctx context.Context, u *ent.User) (*ent.User, error) {
-	created, err := r.client.User.
-		Create().
-		SetAppID(u.AppID).
-		SetFirstname(u.Firstname).
-		SetLastname(u.Lastname).
-		SetEmail(u.Email).
-		SetPassword(u.Password).
-		SetStatus(u.Status).
-		Save(ctx)
+	var created *ent.User
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		var err error
+		created, err = tx.User.
+			Create().
+			SetAppID(u.AppID).
+			SetFirstname(u.Firstname).
+			SetLastname(u.Lastname).
+			SetEmail(u.Email).
+			SetPassword(u.Password).
+			SetNillablePasswordChangedAt(u.PasswordChangedAt).
+			SetStatus(u.Status).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		slog.Error("database error: failed to create user", "email", u.Email, "error", err)
 		return nil, err
@@ -42,6 +65,7 @@ func (r *UserRepository) GetByID(ctx context.Context, id int) (*ent.User, error)
 	u, err := r.client.User.Query().
 		Where(user.IDEQ(id)).
 		WithApp().
+		WithRoles().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -59,6 +83,7 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*ent.Use
 	u, err := r.client.User.Query().
 		Where(user.EmailEQ(email)).
 		WithApp().
+		WithRoles().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -71,36 +96,190 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*ent.Use
 	return u, nil
 }
 
-// List retrieves all users.
-func (r *UserRepository) List(ctx context.Context) ([]*ent.User, error) {
-	users, err := r.client.User.Query().WithApp().All(ctx)
+// List retrieves up to limit users matching the predicates, sorted by the
+// sort column and then by ID, starting after the cursor when there is one
+// and skipping the first offset users. It also returns the number of users
+// matching the predicates.
+func (r *UserRepository) List(ctx context.Context, where []predicate.User, sort string, desc bool, after *db.Cursor, offset, limit int) ([]*ent.User, int, error) {
+	q := r.client.User.Query().Where(where...)
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		slog.Error("database error: failed to count users", "error", err)
+		return nil, 0, err
+	}
+	if after != nil {
+		q.Where(predicate.User(after.After()))
+	}
+	users, err := q.
+		Order(user.OrderOption(db.Order(sort, desc))).
+		Offset(offset).
+		Limit(limit).
+		WithApp().
+		WithRoles().
+		All(ctx)
 	if err != nil {
 		slog.Error("database error: failed to list users", "error", err)
-		return nil, err
+		return nil, 0, err
 	}
-	return users, nil
+	return users, total, nil
+}
+
+// SearchHit is a user found in the search index, with its score and its
+// fields with the matched words between db.HighlightStart and
+// db.HighlightEnd.
+type SearchHit struct {
+	User      *ent.User
+	Score     float64
+	Firstname string
+	Lastname  string
+	Email     string
+}
+
+// Search finds the users matching an FTS5 query, best matches first, and
+// counts all of them. The index is read without ent, so its rows are not
+// scoped by the privacy rules: appIDs, which must all match, scope them
+// instead. The users themselves are then loaded through ent.
+func (r *UserRepository) Search(ctx context.Context, match string, appIDs []int, limit int) ([]SearchHit, int, error) {
+	where := "kpr_user_fts MATCH ?"
+	args := []any{match}
+	for _, id := range appIDs {
+		where += " AND app_id = ?"
+		args = append(args, id)
+	}
+
+	var total int
+	rows, err := r.client.QueryContext(ctx, "SELECT count(*) FROM kpr_user_fts WHERE "+where, args...)
+	if err == nil {
+		if rows.Next() {
+			err = rows.Scan(&total)
+		}
+		if err == nil {
+			err = rows.Err()
+		}
+		rows.Close()
+	}
+	if err != nil {
+		return nil, 0, searchError("failed to count user search results", err)
+	}
+
+	// Names weigh more than emails, whose domain many users share.
+	rows, err = r.client.QueryContext(ctx, `SELECT rowid, -bm25(kpr_user_fts, 2.0, 2.0, 1.0),
+			highlight(kpr_user_fts, 0, ?, ?),
+			highlight(kpr_user_fts, 1, ?, ?),
+			highlight(kpr_user_fts, 2, ?, ?)
+		FROM kpr_user_fts WHERE `+where+` ORDER BY 2 DESC, rowid LIMIT ?`,
+		append([]any{
+			db.HighlightStart, db.HighlightEnd,
+			db.HighlightStart, db.HighlightEnd,
+			db.HighlightStart, db.HighlightEnd,
+		}, append(args, limit)...)...)
+	if err != nil {
+		return nil, 0, searchError("failed to search users", err)
+	}
+	defer rows.Close()
+	var (
+		hits []SearchHit
+		ids  []int
+	)
+	for rows.Next() {
+		var h SearchHit
+		var id int
+		if err := rows.Scan(&id, &h.Score, &h.Firstname, &h.Lastname, &h.Email); err != nil {
+			return nil, 0, searchError("failed to read user search results", err)
+		}
+		h.User = &ent.User{ID: id}
+		hits = append(hits, h)
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, searchError("failed to read user search results", err)
+	}
+	if len(ids) == 0 {
+		return nil, total, nil
+	}
+
+	users, err := r.client.User.Query().
+		Where(user.IDIn(ids...)).
+		WithApp().
+		WithRoles().
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to load user search results", "error", err)
+		return nil, 0, err
+	}
+	byID := make(map[int]*ent.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+	found := hits[:0]
+	for _, h := range hits {
+		if u, ok := byID[h.User.ID]; ok {
+			h.User = u
+			found = append(found, h)
+		}
+	}
+	return found, total, nil
+}
+
+func searchError(msg string, err error) error {
+	if db.IsSearchUnavailable(err) {
+		slog.Warn("user search unavailable", "error", err)
+		return db.ErrSearchUnavailable
+	}
+	slog.Error("database error: "+msg, "error", err)
+	return err
 }
 
 // Update updates an existing user.
 func (r *UserRepository) Update(ctx context.Context, id int, u *ent.User) (*ent.User, error) {
-	updated, err := r.client.User.UpdateOneID(id).
-		SetAppID(u.AppID).
-		SetFirstname(u.Firstname).
-		SetLastname(u.Lastname).
-		SetEmail(u.Email).
-		SetPassword(u.Password).
-		SetStatus(u.Status).
-		Save(ctx)
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		current, err := tx.User.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		upd := tx.User.UpdateOneID(id).
+			SetAppID(u.AppID).
+			SetFirstname(u.Firstname).
+			SetLastname(u.Lastname).
+			SetEmail(u.Email).
+			SetPassword(u.Password).
+			SetNillablePasswordChangedAt(u.PasswordChangedAt).
+			SetStatus(u.Status).
+			SetPlatformAdmin(u.PlatformAdmin).
+			SetNillableEmailVerifiedAt(u.EmailVerifiedAt)
+		if u.EmailVerifiedAt == nil {
+			upd.ClearEmailVerifiedAt()
+		}
+		// Roles and sessions belong to an app, so a user moved to another one
+		// loses them.
+		moved := current.AppID != u.AppID
+		if moved {
+			upd.ClearRoles().SetTokensValidAfter(revocationCutoff())
+		}
+		if err := upd.Exec(ctx); err != nil || !moved {
+			return err
+		}
+		_, err = tx.RefreshToken.Update().
+			Where(
+				refreshtoken.UserIDEQ(id),
+				refreshtoken.RevokedAtIsNil(),
+			).
+			SetRevokedAt(time.Now()).
+			Save(ctx)
+		return err
+	})
 	if err != nil {
 		slog.Error("database error: failed to update user", "id", id, "error", err)
 		return nil, err
 	}
-	return r.GetByID(ctx, updated.ID)
+	return r.GetByID(ctx, id)
 }
 
 // Delete deletes a user by their ID.
 func (r *UserRepository) Delete(ctx context.Context, id int) error {
-	err := r.client.User.DeleteOneID(id).Exec(ctx)
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.DeleteOneID(id).Exec(ctx)
+	})
 	if err != nil {
 		if ent.IsNotFound(err) {
 			slog.Warn("user not found for deletion", "id", id)
@@ -111,3 +290,943 @@ func (r *UserRepository) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// GetApp retrieves an app by its ID.
+func (r *UserRepository) GetApp(ctx context.Context, id int) (*ent.App, error) {
+	a, err := r.client.App.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("app not found in database", "id", id)
+			return nil, fmt.Errorf("app not found: %w", err)
+		}
+		slog.Error("database error: failed to get app", "id", id, "error", err)
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetRoles retrieves the roles a user holds in the app, with the permissions
+// they grant.
+func (r *UserRepository) GetRoles(ctx context.Context, id, appID int) ([]*ent.Role, error) {
+	roles, err := r.client.Role.Query().
+		Where(role.HasUsersWith(user.IDEQ(id)), role.AppIDEQ(appID)).
+		WithPermissions().
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to get user roles", "id", id, "error", err)
+		return nil, err
+	}
+	return roles, nil
+}
+
+// CountAppRoles counts how many of the roles with the given IDs belong to the app.
+func (r *UserRepository) CountAppRoles(ctx context.Context, appID int, roleIDs []int) (int, error) {
+	n, err := r.client.Role.Query().
+		Where(role.IDIn(roleIDs...), role.AppIDEQ(appID)).
+		Count(ctx)
+	if err != nil {
+		slog.Error("database error: failed to count app roles", "app_id", appID, "error", err)
+		return 0, err
+	}
+	return n, nil
+}
+
+// SetRoles replaces the roles of a user.
+func (r *UserRepository) SetRoles(ctx context.Context, id int, roleIDs []int) (*ent.User, error) {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.UpdateOneID(id).
+			ClearRoles().
+			AddRoleIDs(roleIDs...).
+			Exec(ctx)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("user not found for role assignment", "id", id)
+			return nil, fmt.Errorf("user not found: %w", err)
+		}
+		slog.Error("database error: failed to set user roles", "id", id, "error", err)
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+// CreateRefreshToken stores the hash of a newly issued refresh token.
+func (r *UserRepository) CreateRefreshToken(ctx context.Context, userID int, tokenHash, familyID, scope string, expiresAt time.Time) (*ent.RefreshToken, error) {
+	rt, err := r.client.RefreshToken.
+		Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetFamilyID(familyID).
+		SetScope(scope).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create refresh token", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return rt, nil
+}
+
+// GetRefreshTokenByHash retrieves a refresh token by the hash of its value.
+func (r *UserRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*ent.RefreshToken, error) {
+	rt, err := r.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("refresh token not found in database")
+			return nil, fmt.Errorf("refresh token not found: %w", err)
+		}
+		slog.Error("database error: failed to get refresh token", "error", err)
+		return nil, err
+	}
+	return rt, nil
+}
+
+// MarkRefreshTokenUsed flags a refresh token as used. It reports false when the
+// token had already been used or revoked by a concurrent request.
+func (r *UserRepository) MarkRefreshTokenUsed(ctx context.Context, id int) (bool, error) {
+	n, err := r.client.RefreshToken.Update().
+		Where(
+			refreshtoken.IDEQ(id),
+			refreshtoken.UsedAtIsNil(),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark refresh token used", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// RevokeRefreshTokenFamily revokes every refresh token that descends from the same login.
+func (r *UserRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	_, err := r.client.RefreshToken.Update().
+		Where(
+			refreshtoken.FamilyIDEQ(familyID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to revoke refresh token family", "family_id", familyID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// RevokeSessions rejects every access token issued to the user before validAfter
+// and revokes all of the user's outstanding refresh tokens.
+func (r *UserRepository) RevokeSessions(ctx context.Context, id int, validAfter time.Time) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		if err := tx.User.UpdateOneID(id).SetTokensValidAfter(validAfter).Exec(ctx); err != nil {
+			return err
+		}
+		_, err := tx.RefreshToken.Update().
+			Where(
+				refreshtoken.UserIDEQ(id),
+				refreshtoken.RevokedAtIsNil(),
+			).
+			SetRevokedAt(time.Now()).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("user not found for session revocation", "id", id)
+			return fmt.Errorf("user not found: %w", err)
+		}
+		slog.Error("database error: failed to revoke user sessions", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// SetPassword replaces the password hash of a user.
+func (r *UserRepository) SetPassword(ctx context.Context, id int, passwordHash string) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.UpdateOneID(id).
+			SetPassword(passwordHash).
+			SetPasswordChangedAt(time.Now()).
+			Exec(ctx)
+	})
+	if err != nil {
+		slog.Error("database error: failed to set password", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// UpdatePasswordHash replaces the stored hash of an unchanged password.
+func (r *UserRepository) UpdatePasswordHash(ctx context.Context, id int, passwordHash string) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.UpdateOneID(id).
+			SetPassword(passwordHash).
+			Exec(ctx)
+	})
+	if err != nil {
+		slog.Error("database error: failed to update password hash", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// CreatePasswordResetToken stores the hash of a new reset token, invalidating
+// the user's outstanding ones so only the latest email works.
+func (r *UserRepository) CreatePasswordResetToken(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) (*ent.PasswordResetToken, error) {
+	_, err := r.client.PasswordResetToken.Delete().
+		Where(
+			passwordresettoken.UserIDEQ(userID),
+			passwordresettoken.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to delete outstanding reset tokens", "user_id", userID, "error", err)
+		return nil, err
+	}
+
+	prt, err := r.client.PasswordResetToken.
+		Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create reset token", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return prt, nil
+}
+
+// GetPasswordResetTokenByHash retrieves a reset token by the hash of its value.
+func (r *UserRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*ent.PasswordResetToken, error) {
+	prt, err := r.client.PasswordResetToken.Query().
+		Where(passwordresettoken.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("reset token not found in database")
+			return nil, fmt.Errorf("reset token not found: %w", err)
+		}
+		slog.Error("database error: failed to get reset token", "error", err)
+		return nil, err
+	}
+	return prt, nil
+}
+
+// MarkPasswordResetTokenUsed flags a reset token as used. It reports false when
+// the token had already been used by a concurrent request.
+func (r *UserRepository) MarkPasswordResetTokenUsed(ctx context.Context, id int) (bool, error) {
+	n, err := r.client.PasswordResetToken.Update().
+		Where(
+			passwordresettoken.IDEQ(id),
+			passwordresettoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark reset token used", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// SetEmailVerified records when a user verified their email.
+func (r *UserRepository) SetEmailVerified(ctx context.Context, id int, at time.Time) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.UpdateOneID(id).
+			SetEmailVerifiedAt(at).
+			Exec(ctx)
+	})
+	if err != nil {
+		slog.Error("database error: failed to set email verified", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// CreateEmailVerificationToken stores the hash of a new verification token for
+// email, invalidating the user's outstanding ones.
+func (r *UserRepository) CreateEmailVerificationToken(ctx context.Context, userID int, email, tokenHash string, expiresAt time.Time) (*ent.EmailVerificationToken, error) {
+	_, err := r.client.EmailVerificationToken.Delete().
+		Where(
+			emailverificationtoken.UserIDEQ(userID),
+			emailverificationtoken.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to delete outstanding verification tokens", "user_id", userID, "error", err)
+		return nil, err
+	}
+
+	evt, err := r.client.EmailVerificationToken.
+		Create().
+		SetUserID(userID).
+		SetEmail(email).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create verification token", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return evt, nil
+}
+
+// GetEmailVerificationTokenByHash retrieves a verification token by the hash of its value.
+func (r *UserRepository) GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*ent.EmailVerificationToken, error) {
+	evt, err := r.client.EmailVerificationToken.Query().
+		Where(emailverificationtoken.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("verification token not found in database")
+			return nil, fmt.Errorf("verification token not found: %w", err)
+		}
+		slog.Error("database error: failed to get verification token", "error", err)
+		return nil, err
+	}
+	return evt, nil
+}
+
+// MarkEmailVerificationTokenUsed flags a verification token as used. It reports
+// false when the token had already been used by a concurrent request.
+func (r *UserRepository) MarkEmailVerificationTokenUsed(ctx context.Context, id int) (bool, error) {
+	n, err := r.client.EmailVerificationToken.Update().
+		Where(
+			emailverificationtoken.IDEQ(id),
+			emailverificationtoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark verification token used", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// CreateMagicLinkToken stores the hashes of a new login link token and the
+// nonce it is bound to, invalidating the user's outstanding links so only the
+// latest email works.
+func (r *UserRepository) CreateMagicLinkToken(ctx context.Context, userID int, tokenHash, nonceHash string, expiresAt time.Time) (*ent.MagicLinkToken, error) {
+	_, err := r.client.MagicLinkToken.Delete().
+		Where(
+			magiclinktoken.UserIDEQ(userID),
+			magiclinktoken.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to delete outstanding magic link tokens", "user_id", userID, "error", err)
+		return nil, err
+	}
+
+	mlt, err := r.client.MagicLinkToken.
+		Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetNonceHash(nonceHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create magic link token", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return mlt, nil
+}
+
+// GetMagicLinkTokenByHash retrieves a login link token by the hash of its value.
+func (r *UserRepository) GetMagicLinkTokenByHash(ctx context.Context, tokenHash string) (*ent.MagicLinkToken, error) {
+	mlt, err := r.client.MagicLinkToken.Query().
+		Where(magiclinktoken.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("magic link token not found in database")
+			return nil, fmt.Errorf("magic link token not found: %w", err)
+		}
+		slog.Error("database error: failed to get magic link token", "error", err)
+		return nil, err
+	}
+	return mlt, nil
+}
+
+// MarkMagicLinkTokenUsed flags a login link token as used. It reports false
+// when the token had already been used by a concurrent request.
+func (r *UserRepository) MarkMagicLinkTokenUsed(ctx context.Context, id int) (bool, error) {
+	n, err := r.client.MagicLinkToken.Update().
+		Where(
+			magiclinktoken.IDEQ(id),
+			magiclinktoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark magic link token used", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// SetTOTPSecret starts a TOTP enrolment, replacing any previous secret. The
+// secret is not enforced until ConfirmTOTP is called.
+func (r *UserRepository) SetTOTPSecret(ctx context.Context, id int, secret string) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.UpdateOneID(id).
+			SetTotpSecret(secret).
+			ClearTotpConfirmedAt().
+			SetTotpLastStep(0).
+			Exec(ctx)
+	})
+	if err != nil {
+		slog.Error("database error: failed to set totp secret", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// ConfirmTOTP enables the user's TOTP secret, recording the time step of the
+// code that confirmed it.
+func (r *UserRepository) ConfirmTOTP(ctx context.Context, id int, step int64) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.UpdateOneID(id).
+			SetTotpConfirmedAt(time.Now()).
+			SetTotpLastStep(step).
+			Exec(ctx)
+	})
+	if err != nil {
+		slog.Error("database error: failed to confirm totp", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// UseTOTPStep records that a code from the given time step was accepted. It
+// reports false when a code from that step or a later one was already used.
+func (r *UserRepository) UseTOTPStep(ctx context.Context, id int, step int64) (bool, error) {
+	n, err := r.client.User.Update().
+		Where(
+			user.IDEQ(id),
+			user.TotpLastStepLT(step),
+		).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to record totp step", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// ClearMFA removes the user's TOTP secret and recovery codes.
+func (r *UserRepository) ClearMFA(ctx context.Context, id int) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		err := tx.User.UpdateOneID(id).
+			ClearTotpSecret().
+			ClearTotpConfirmedAt().
+			SetTotpLastStep(0).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = tx.MFARecoveryCode.Delete().Where(mfarecoverycode.UserIDEQ(id)).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("user not found for mfa reset", "id", id)
+			return fmt.Errorf("user not found: %w", err)
+		}
+		slog.Error("database error: failed to clear mfa", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// ReplaceRecoveryCodes stores the hashes of a new set of recovery codes,
+// invalidating the previous set.
+func (r *UserRepository) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	if _, err := r.client.MFARecoveryCode.Delete().Where(mfarecoverycode.UserIDEQ(userID)).Exec(ctx); err != nil {
+		slog.Error("database error: failed to delete recovery codes", "user_id", userID, "error", err)
+		return err
+	}
+
+	builders := make([]*ent.MFARecoveryCodeCreate, len(codeHashes))
+	for i, h := range codeHashes {
+		builders[i] = r.client.MFARecoveryCode.Create().SetUserID(userID).SetCodeHash(h)
+	}
+	if _, err := r.client.MFARecoveryCode.CreateBulk(builders...).Save(ctx); err != nil {
+		slog.Error("database error: failed to create recovery codes", "user_id", userID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// UseRecoveryCode flags the user's recovery code with the given hash as used.
+// It reports false when the user has no such unused code.
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error) {
+	n, err := r.client.MFARecoveryCode.Update().
+		Where(
+			mfarecoverycode.UserIDEQ(userID),
+			mfarecoverycode.CodeHashEQ(codeHash),
+			mfarecoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to use recovery code", "user_id", userID, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// CreateMFAChallenge stores the hash of a new MFA challenge token.
+func (r *UserRepository) CreateMFAChallenge(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) (*ent.MFAChallenge, error) {
+	c, err := r.client.MFAChallenge.
+		Create().
+		SetUserID(userID).
+		SetTokenHash(tokenHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create mfa challenge", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetMFAChallengeByHash retrieves an MFA challenge by the hash of its token.
+func (r *UserRepository) GetMFAChallengeByHash(ctx context.Context, tokenHash string) (*ent.MFAChallenge, error) {
+	c, err := r.client.MFAChallenge.Query().
+		Where(mfachallenge.TokenHashEQ(tokenHash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("mfa challenge not found in database")
+			return nil, fmt.Errorf("mfa challenge not found: %w", err)
+		}
+		slog.Error("database error: failed to get mfa challenge", "error", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// FailMFAChallenge counts a wrong code against a challenge.
+func (r *UserRepository) FailMFAChallenge(ctx context.Context, id int) error {
+	err := r.client.MFAChallenge.UpdateOneID(id).
+		AddAttempts(1).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to record mfa attempt", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// MarkMFAChallengeUsed flags a challenge as used. It reports false when the
+// challenge had already been used by a concurrent request.
+func (r *UserRepository) MarkMFAChallengeUsed(ctx context.Context, id int) (bool, error) {
+	n, err := r.client.MFAChallenge.Update().
+		Where(
+			mfachallenge.IDEQ(id),
+			mfachallenge.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark mfa challenge used", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// CreateWebAuthnChallenge stores the hash of a new passkey ceremony challenge.
+// userID is nil for logins that did not name a user.
+func (r *UserRepository) CreateWebAuthnChallenge(ctx context.Context, appID int, userID *int, ceremony webauthnchallenge.Ceremony, challengeHash string, expiresAt time.Time) (*ent.WebAuthnChallenge, error) {
+	c, err := r.client.WebAuthnChallenge.
+		Create().
+		SetAppID(appID).
+		SetNillableUserID(userID).
+		SetCeremony(ceremony).
+		SetChallengeHash(challengeHash).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create webauthn challenge", "app_id", appID, "error", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetWebAuthnChallengeByHash retrieves a passkey ceremony challenge by its hash.
+func (r *UserRepository) GetWebAuthnChallengeByHash(ctx context.Context, challengeHash string) (*ent.WebAuthnChallenge, error) {
+	c, err := r.client.WebAuthnChallenge.Query().
+		Where(webauthnchallenge.ChallengeHashEQ(challengeHash)).
+		WithApp().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("webauthn challenge not found in database")
+			return nil, fmt.Errorf("webauthn challenge not found: %w", err)
+		}
+		slog.Error("database error: failed to get webauthn challenge", "error", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// MarkWebAuthnChallengeUsed flags a challenge as used. It reports false when
+// the challenge had already been used by a concurrent request.
+func (r *UserRepository) MarkWebAuthnChallengeUsed(ctx context.Context, id int) (bool, error) {
+	n, err := r.client.WebAuthnChallenge.Update().
+		Where(
+			webauthnchallenge.IDEQ(id),
+			webauthnchallenge.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark webauthn challenge used", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// ListWebAuthnCredentials retrieves the passkeys of a user, oldest first.
+func (r *UserRepository) ListWebAuthnCredentials(ctx context.Context, userID int) ([]*ent.WebAuthnCredential, error) {
+	creds, err := r.client.WebAuthnCredential.Query().
+		Where(webauthncredential.UserIDEQ(userID)).
+		Order(ent.Asc(webauthncredential.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list webauthn credentials", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return creds, nil
+}
+
+// GetWebAuthnCredentialByCredentialID retrieves a passkey by the ID the
+// authenticator assigned to it.
+func (r *UserRepository) GetWebAuthnCredentialByCredentialID(ctx context.Context, credentialID []byte) (*ent.WebAuthnCredential, error) {
+	c, err := r.client.WebAuthnCredential.Query().
+		Where(webauthncredential.CredentialIDEQ(credentialID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("webauthn credential not found in database")
+			return nil, fmt.Errorf("webauthn credential not found: %w", err)
+		}
+		slog.Error("database error: failed to get webauthn credential", "error", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// CreateWebAuthnCredential stores a verified new passkey of a user.
+func (r *UserRepository) CreateWebAuthnCredential(ctx context.Context, userID int, name string, cred *webauthn.Credential) (*ent.WebAuthnCredential, error) {
+	c, err := r.client.WebAuthnCredential.
+		Create().
+		SetUserID(userID).
+		SetName(name).
+		SetCredentialID(cred.ID).
+		SetPublicKey(cred.PublicKey).
+		SetSignCount(cred.SignCount).
+		SetTransports(cred.Transports).
+		SetAaguid(cred.AAGUID).
+		SetBackupEligible(cred.BackupEligible).
+		SetBackedUp(cred.BackedUp).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create webauthn credential", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return c, nil
+}
+
+// UseWebAuthnCredential records a sign-in with a passkey. It reports false
+// when a concurrent sign-in already moved the sign count past signCount.
+func (r *UserRepository) UseWebAuthnCredential(ctx context.Context, id int, oldSignCount, signCount uint32) (bool, error) {
+	n, err := r.client.WebAuthnCredential.Update().
+		Where(
+			webauthncredential.IDEQ(id),
+			webauthncredential.SignCountEQ(oldSignCount),
+		).
+		SetSignCount(signCount).
+		SetLastUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to record webauthn credential use", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// DeleteWebAuthnCredential removes a passkey of a user. It reports false when
+// the user has no passkey with that ID.
+func (r *UserRepository) DeleteWebAuthnCredential(ctx context.Context, userID, id int) (bool, error) {
+	n, err := r.client.WebAuthnCredential.Delete().
+		Where(
+			webauthncredential.IDEQ(id),
+			webauthncredential.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to delete webauthn credential", "id", id, "user_id", userID, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// RecordLoginFailure counts a failed password login against a user and
+// returns the number of failures in a row. A previous failure made before
+// since no longer counts.
+func (r *UserRepository) RecordLoginFailure(ctx context.Context, id int, since time.Time) (int, error) {
+	_, err := r.client.User.Update().
+		Where(
+			user.IDEQ(id),
+			user.LastFailedLoginAtLT(since),
+		).
+		SetFailedLogins(0).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to reset stale login failures", "id", id, "error", err)
+		return 0, err
+	}
+
+	u, err := r.client.User.UpdateOneID(id).
+		AddFailedLogins(1).
+		SetLastFailedLoginAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to record login failure", "id", id, "error", err)
+		return 0, err
+	}
+	return u.FailedLogins, nil
+}
+
+// LockUser refuses password logins of a user until the given time.
+func (r *UserRepository) LockUser(ctx context.Context, id int, until time.Time) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return tx.User.UpdateOneID(id).
+			SetLockedUntil(until).
+			Exec(ctx)
+	})
+	if err != nil {
+		slog.Error("database error: failed to lock user", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// ResetLoginFailures forgets the failed logins of a user and lifts their
+// lockout. Lockout records are kept.
+func (r *UserRepository) ResetLoginFailures(ctx context.Context, id int) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		return resetLoginFailures(ctx, tx, id)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("user not found for login failure reset", "id", id)
+			return fmt.Errorf("user not found: %w", err)
+		}
+		slog.Error("database error: failed to reset login failures", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// UnlockUser lifts the lockout of a user and flags their current account
+// lockouts as unlocked.
+func (r *UserRepository) UnlockUser(ctx context.Context, id int) error {
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		if err := resetLoginFailures(ctx, tx, id); err != nil {
+			return err
+		}
+		now := time.Now()
+		_, err := tx.Lockout.Update().
+			Where(
+				lockout.UserIDEQ(id),
+				lockout.KindEQ(lockout.KindAccount),
+				lockout.LockedUntilGT(now),
+				lockout.UnlockedAtIsNil(),
+			).
+			SetUnlockedAt(now).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("user not found for unlock", "id", id)
+			return fmt.Errorf("user not found: %w", err)
+		}
+		slog.Error("database error: failed to unlock user", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// resetLoginFailures clears the failed logins and lockout of a user with
+// the client of a transaction.
+func resetLoginFailures(ctx context.Context, tx *ent.Client, id int) error {
+	return tx.User.UpdateOneID(id).
+		SetFailedLogins(0).
+		ClearLastFailedLoginAt().
+		ClearLockedUntil().
+		Exec(ctx)
+}
+
+// GetLoginThrottle retrieves the failed login count of a client IP.
+func (r *UserRepository) GetLoginThrottle(ctx context.Context, ip string) (*ent.LoginThrottle, error) {
+	t, err := r.client.LoginThrottle.Query().
+		Where(loginthrottle.IPEQ(ip)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("login throttle not found: %w", err)
+		}
+		slog.Error("database error: failed to get login throttle", "ip", ip, "error", err)
+		return nil, err
+	}
+	return t, nil
+}
+
+// RecordIPLoginFailure counts a failed password login against a client IP
+// and returns the number of failures. A previous failure made before since
+// no longer counts.
+func (r *UserRepository) RecordIPLoginFailure(ctx context.Context, ip string, since time.Time) (int, error) {
+	now := time.Now()
+	_, err := r.client.LoginThrottle.Update().
+		Where(
+			loginthrottle.IPEQ(ip),
+			loginthrottle.LastFailureAtLT(since),
+		).
+		SetFailures(0).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to reset stale ip login failures", "ip", ip, "error", err)
+		return 0, err
+	}
+
+	n, err := r.client.LoginThrottle.Update().
+		Where(loginthrottle.IPEQ(ip)).
+		AddFailures(1).
+		SetLastFailureAt(now).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to record ip login failure", "ip", ip, "error", err)
+		return 0, err
+	}
+	if n == 0 {
+		_, err = r.client.LoginThrottle.Create().
+			SetIP(ip).
+			SetFailures(1).
+			SetLastFailureAt(now).
+			Save(ctx)
+		if err == nil {
+			return 1, nil
+		}
+		if !ent.IsConstraintError(err) {
+			slog.Error("database error: failed to create login throttle", "ip", ip, "error", err)
+			return 0, err
+		}
+		// A concurrent request created the row first.
+		if err = r.client.LoginThrottle.Update().Where(loginthrottle.IPEQ(ip)).AddFailures(1).SetLastFailureAt(now).Exec(ctx); err != nil {
+			slog.Error("database error: failed to record ip login failure", "ip", ip, "error", err)
+			return 0, err
+		}
+	}
+
+	t, err := r.GetLoginThrottle(ctx, ip)
+	if err != nil {
+		return 0, err
+	}
+	return t.Failures, nil
+}
+
+// CreateLockout records that failed logins locked a user or a client IP out.
+// appID and userID are nil when the account the logins targeted is unknown.
+func (r *UserRepository) CreateLockout(ctx context.Context, kind lockout.Kind, appID, userID *int, ip string, failures int, lockedUntil time.Time) (*ent.Lockout, error) {
+	var l *ent.Lockout
+	err := db.WithTx(ctx, r.client, func(tx *ent.Client) error {
+		var err error
+		l, err = tx.Lockout.
+			Create().
+			SetKind(kind).
+			SetNillableAppID(appID).
+			SetNillableUserID(userID).
+			SetIP(ip).
+			SetFailures(failures).
+			SetLockedUntil(lockedUntil).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		slog.Error("database error: failed to create lockout", "kind", kind, "user_id", userID, "ip", ip, "error", err)
+		return nil, err
+	}
+	return l, nil
+}
+
+// ListLockouts retrieves the lockouts caused by failed logins to a user,
+// newest first.
+func (r *UserRepository) ListLockouts(ctx context.Context, userID int) ([]*ent.Lockout, error) {
+	lockouts, err := r.client.Lockout.Query().
+		Where(lockout.UserIDEQ(userID)).
+		Order(ent.Desc(lockout.FieldCreatedAt), ent.Desc(lockout.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list lockouts", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return lockouts, nil
+}
+
+// ListPasswordHistory retrieves the hashes of a user's previous passwords,
+// newest first.
+func (r *UserRepository) ListPasswordHistory(ctx context.Context, userID, limit int) ([]*ent.PasswordHistory, error) {
+	history, err := r.client.PasswordHistory.Query().
+		Where(passwordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list password history", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return history, nil
+}
+
+// AddPasswordHistory remembers a previous password hash of a user and forgets
+// all but the newest keep ones. Nothing is added when keep is zero.
+func (r *UserRepository) AddPasswordHistory(ctx context.Context, userID int, passwordHash string, keep int) error {
+	if keep > 0 {
+		err := r.client.PasswordHistory.Create().
+			SetUserID(userID).
+			SetPasswordHash(passwordHash).
+			Exec(ctx)
+		if err != nil {
+			slog.Error("database error: failed to add password history", "user_id", userID, "error", err)
+			return err
+		}
+	}
+
+	kept, err := r.client.PasswordHistory.Query().
+		Where(passwordhistory.UserIDEQ(userID)).
+		Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+		Limit(keep).
+		IDs(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list password history", "user_id", userID, "error", err)
+		return err
+	}
+	_, err = r.client.PasswordHistory.Delete().
+		Where(passwordhistory.UserIDEQ(userID), passwordhistory.IDNotIn(kept...)).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to prune password history", "user_id", userID, "error", err)
+		return err
+	}
+	return nil
+}
@@ -4,58 +4,352 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"keeper/ent"
+	"keeper/ent/predicate"
+	"keeper/ent/privacy"
+	entrole "keeper/ent/role"
+	entuser "keeper/ent/user"
+	"keeper/internal/db"
 	"keeper/pkg/auth"
+	"keeper/pkg/password"
 
-	"golang.org/x/crypto/bcrypt"
+	"entgo.io/ent/dialect/sql"
 )
 
 // UserService defines the business logic for users.
 type UserService interface {
 	Create(ctx context.Context, req CreateUserRequest) (*User, error)
 	GetByID(ctx context.Context, id int) (*User, error)
-	List(ctx context.Context) ([]*User, error)
+	List(ctx context.Context, req ListUsersRequest) (*UserPage, error)
+	Search(ctx context.Context, req SearchUsersRequest) (*UserSearchPage, error)
 	Update(ctx context.Context, id int, req UpdateUserRequest) (*User, error)
 	Delete(ctx context.Context, id int) error
 	Authenticate(ctx context.Context, req AuthRequest) (*AuthResponse, error)
+	VerifyCredentials(ctx context.Context, email, password string) (*User, error)
+	IssueTokens(ctx context.Context, id int, scope string) (*AuthResponse, error)
+	Refresh(ctx context.Context, req RefreshRequest) (*AuthResponse, error)
+	Logout(ctx context.Context, claims *auth.UserClaims, req LogoutRequest) error
+	RevokeSessions(ctx context.Context, id int) error
+	SetRoles(ctx context.Context, id int, req SetRolesRequest) (*User, error)
+	ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, req ChangePasswordRequest) (*AuthResponse, error)
+	VerifyEmail(ctx context.Context, req VerifyEmailRequest) error
+	ResendVerification(ctx context.Context, req ResendVerificationRequest) error
+	AuthenticateMFA(ctx context.Context, req MFAAuthRequest) (*AuthResponse, error)
+	ChallengeMFA(ctx context.Context, id int) (*MFAChallenge, error)
+	VerifyMFA(ctx context.Context, token, code string) (*User, error)
+	EnrollMFAChallenge(ctx context.Context, req MFAEnrollChallengeRequest) (*MFAEnrollment, error)
+	EnrollMFA(ctx context.Context, id int) (*MFAEnrollment, error)
+	ConfirmMFA(ctx context.Context, id int, req MFACodeRequest) (*RecoveryCodesResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, id int, req MFACodeRequest) (*RecoveryCodesResponse, error)
+	DisableMFA(ctx context.Context, id int, req MFACodeRequest) error
+	ResetMFA(ctx context.Context, id int) error
+	BeginPasskeyRegistration(ctx context.Context, id int) (*PasskeyCreationOptions, error)
+	FinishPasskeyRegistration(ctx context.Context, id int, req PasskeyRegistrationRequest) (*Passkey, error)
+	ListPasskeys(ctx context.Context, id int) ([]*Passkey, error)
+	DeletePasskey(ctx context.Context, id, passkeyID int) error
+	BeginPasskeyLogin(ctx context.Context, req PasskeyLoginOptionsRequest) (*PasskeyRequestOptions, error)
+	AuthenticatePasskey(ctx context.Context, req PasskeyAuthRequest) (*AuthResponse, error)
+	RequestMagicLink(ctx context.Context, req MagicLinkRequest) (*MagicLinkSession, error)
+	AuthenticateMagicLink(ctx context.Context, req MagicLinkAuthRequest) (*AuthResponse, error)
+	Unlock(ctx context.Context, id int) error
+	ListLockouts(ctx context.Context, id int) ([]*Lockout, error)
 }
 
+var (
+	// ErrInvalidCredentials is returned when an email and password do not match an active user.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrPasswordExpired is returned when a password login succeeds but the
+	// password is older than the app's policy allows and must be changed.
+	ErrPasswordExpired = errors.New("password expired")
+	// ErrInvalidRefreshToken is returned when a refresh token is unknown, expired or revoked.
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrUserNotFound is returned when the requested user does not exist.
+	ErrUserNotFound = errors.New("user not found")
+	// ErrForbidden is returned when the caller may not make a change, such as
+	// moving a user to another app without being a platform admin.
+	ErrForbidden = errors.New("operation not permitted")
+	// ErrInvalidRole is returned when a role does not exist or belongs to another app.
+	ErrInvalidRole = errors.New("invalid role")
+	// ErrInvalidResetToken is returned when a password reset token is unknown,
+	// expired or already used.
+	ErrInvalidResetToken = errors.New("invalid or expired reset token")
+	// ErrInvalidVerificationToken is returned when an email verification token
+	// is unknown, expired, already used or issued for a previous email.
+	ErrInvalidVerificationToken = errors.New("invalid or expired verification token")
+	// ErrEmailNotVerified is returned when the user's app requires a verified
+	// email and the user has not verified theirs.
+	ErrEmailNotVerified = errors.New("email not verified")
+	// ErrInvalidMFAToken is returned when an MFA challenge token is unknown,
+	// expired, already used or has seen too many wrong codes.
+	ErrInvalidMFAToken = errors.New("invalid or expired mfa token")
+	// ErrInvalidMFACode is returned when a TOTP or recovery code is wrong or
+	// was already used.
+	ErrInvalidMFACode = errors.New("invalid mfa code")
+	// ErrMFAEnrollmentRequired is returned when a user must enrol in MFA
+	// before the requested step.
+	ErrMFAEnrollmentRequired = errors.New("mfa enrollment required")
+	// ErrMFAAlreadyEnabled is returned when enrolling a user who already has MFA.
+	ErrMFAAlreadyEnabled = errors.New("mfa already enabled")
+	// ErrMFANotEnabled is returned when an MFA change needs an enrolment the
+	// user does not have.
+	ErrMFANotEnabled = errors.New("mfa not enabled")
+	// ErrPasskeysNotConfigured is returned when the user's app has no WebAuthn
+	// relying party ID, or a passkey login names an unknown app.
+	ErrPasskeysNotConfigured = errors.New("passkeys not configured for app")
+	// ErrInvalidPasskey is returned when a passkey ceremony fails: its
+	// challenge is unknown, expired or used, or the credential does not verify.
+	ErrInvalidPasskey = errors.New("invalid passkey")
+	// ErrPasskeyAlreadyRegistered is returned when registering a credential
+	// that is already stored.
+	ErrPasskeyAlreadyRegistered = errors.New("passkey already registered")
+	// ErrPasskeyNotFound is returned when the user has no passkey with the given ID.
+	ErrPasskeyNotFound = errors.New("passkey not found")
+	// ErrMagicLinkDisabled is returned when a login link is requested for, or
+	// redeemed in, an app that does not allow them, or an unknown app.
+	ErrMagicLinkDisabled = errors.New("magic link login disabled for app")
+	// ErrInvalidMagicLink is returned when a login link is unknown, expired,
+	// already used or opened in another browser than the one that asked for it.
+	ErrInvalidMagicLink = errors.New("invalid or expired magic link")
+	// ErrInvalidSort is returned when users are listed by a field not in SortFields.
+	ErrInvalidSort = errors.New("invalid sort field")
+	// ErrInvalidCursor is returned when a list cursor is not one List
+	// returned for the same sort.
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidSearch is returned when a search query has no words to look for.
+	ErrInvalidSearch = errors.New("search query has no words")
+	// ErrSearchUnavailable is returned when the database has no search index,
+	// because the SQLite driver was built without FTS5.
+	ErrSearchUnavailable = errors.New("user search unavailable")
+)
+
+// DefaultListLimit is the page size of List when none is given, and
+// MaxListLimit the largest one.
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// DefaultSearchLimit is the number of results of Search when no limit is
+// given, and MaxSearchLimit the largest one.
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+// SortFields are the fields List can sort users by, with how to read them.
+var SortFields = map[string]func(*ent.User) any{
+	entuser.FieldID:        func(u *ent.User) any { return u.ID },
+	entuser.FieldFirstname: func(u *ent.User) any { return u.Firstname },
+	entuser.FieldLastname:  func(u *ent.User) any { return u.Lastname },
+	entuser.FieldEmail:     func(u *ent.User) any { return u.Email },
+	entuser.FieldStatus:    func(u *ent.User) any { return u.Status },
+	entuser.FieldCreatedAt: func(u *ent.User) any { return u.CreatedAt },
+	entuser.FieldUpdatedAt: func(u *ent.User) any { return u.UpdatedAt },
+}
+
+// DefaultRefreshExpiry is the refresh token lifetime used when none is configured.
+const DefaultRefreshExpiry = 30 * 24 * time.Hour
+
+// DefaultPasswordResetExpiry is the reset token lifetime used when none is configured.
+const DefaultPasswordResetExpiry = time.Hour
+
+// DefaultEmailVerificationExpiry is the verification token lifetime used when none is configured.
+const DefaultEmailVerificationExpiry = 48 * time.Hour
+
 type userService struct {
-	repo *UserRepository
-	jwt  *auth.JWTManager
+	repo          *UserRepository
+	jwt           *auth.JWTManager
+	notifier      Notifier
+	refreshExpiry time.Duration
+	resetExpiry   time.Duration
+	verifyExpiry  time.Duration
+	mfaExpiry     time.Duration
+	// webauthnExpiry is how long a passkey ceremony can take.
+	webauthnExpiry time.Duration
+	// magicLinkExpiry is how long a login link can be used; magicLinkURL is
+	// the endpoint links point to, without the token.
+	magicLinkExpiry time.Duration
+	magicLinkURL    string
+	// breached screens new passwords; nil when no corpus is configured.
+	breached BreachedPasswords
+	hasher   *password.Hasher
+	// dummyHash is the hash a login for an unknown email is verified
+	// against, so that it takes as long as one with a wrong password.
+	dummyHash func() (string, error)
+	// auditors are told of login attempts, such as the audit trail and
+	// webhooks.
+	auditors []Auditor
+}
+
+// Option configures optional behaviour of the user service.
+type Option func(*userService)
+
+// WithRefreshExpiry sets the lifetime of issued refresh tokens.
+func WithRefreshExpiry(d time.Duration) Option {
+	return func(s *userService) {
+		if d > 0 {
+			s.refreshExpiry = d
+		}
+	}
+}
+
+// WithPasswordResetExpiry sets the lifetime of password reset tokens.
+func WithPasswordResetExpiry(d time.Duration) Option {
+	return func(s *userService) {
+		if d > 0 {
+			s.resetExpiry = d
+		}
+	}
+}
+
+// WithEmailVerificationExpiry sets the lifetime of email verification tokens.
+func WithEmailVerificationExpiry(d time.Duration) Option {
+	return func(s *userService) {
+		if d > 0 {
+			s.verifyExpiry = d
+		}
+	}
+}
+
+// WithMFAChallengeExpiry sets the lifetime of MFA challenge tokens.
+func WithMFAChallengeExpiry(d time.Duration) Option {
+	return func(s *userService) {
+		if d > 0 {
+			s.mfaExpiry = d
+		}
+	}
+}
+
+// WithWebAuthnChallengeExpiry sets how long a passkey registration or login can take.
+func WithWebAuthnChallengeExpiry(d time.Duration) Option {
+	return func(s *userService) {
+		if d > 0 {
+			s.webauthnExpiry = d
+		}
+	}
+}
+
+// WithMagicLinkExpiry sets how long a login link can be used.
+func WithMagicLinkExpiry(d time.Duration) Option {
+	return func(s *userService) {
+		if d > 0 {
+			s.magicLinkExpiry = d
+		}
+	}
+}
+
+// WithMagicLinkURL sets the URL login links point to; the token is added as
+// the token query parameter.
+func WithMagicLinkURL(u string) Option {
+	return func(s *userService) {
+		if u != "" {
+			s.magicLinkURL = u
+		}
+	}
+}
+
+// WithPasswordHasher sets how new passwords are hashed. It defaults to
+// password.DefaultHasher; hashes made otherwise are replaced at login.
+func WithPasswordHasher(h *password.Hasher) Option {
+	return func(s *userService) {
+		if h != nil {
+			s.hasher = h
+		}
+	}
+}
+
+// WithBreachedPasswords rejects new passwords found in b.
+func WithBreachedPasswords(b BreachedPasswords) Option {
+	return func(s *userService) {
+		s.breached = b
+	}
+}
+
+// WithAuditor reports login attempts to a. It can be given more than once.
+func WithAuditor(a Auditor) Option {
+	return func(s *userService) {
+		if a != nil {
+			s.auditors = append(s.auditors, a)
+		}
+	}
+}
+
+// WithNotifier sets how account messages are delivered. It defaults to LogNotifier.
+func WithNotifier(n Notifier) Option {
+	return func(s *userService) {
+		if n != nil {
+			s.notifier = n
+		}
+	}
 }
 
 // NewUserService creates a new user service.
-func NewUserService(repo *UserRepository, jwt *auth.JWTManager) UserService {
-	return &userService{repo: repo, jwt: jwt}
+func NewUserService(repo *UserRepository, jwt *auth.JWTManager, opts ...Option) UserService {
+	s := &userService{
+		repo:            repo,
+		jwt:             jwt,
+		notifier:        LogNotifier{},
+		refreshExpiry:   DefaultRefreshExpiry,
+		resetExpiry:     DefaultPasswordResetExpiry,
+		verifyExpiry:    DefaultEmailVerificationExpiry,
+		mfaExpiry:       DefaultMFAChallengeExpiry,
+		webauthnExpiry:  DefaultWebAuthnChallengeExpiry,
+		magicLinkExpiry: DefaultMagicLinkExpiry,
+		magicLinkURL:    DefaultMagicLinkURL,
+		hasher:          password.DefaultHasher(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.dummyHash = sync.OnceValues(func() (string, error) { return s.hasher.Hash("") })
+	return s
 }
 
 func (s *userService) Create(ctx context.Context, req CreateUserRequest) (*User, error) {
 	slog.Info("creating user", "email", req.Email)
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	// An unknown app gets the default policy; creating the user fails anyway.
+	a, err := s.repo.GetApp(ctx, req.AppID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	owner := password.Owner{Email: req.Email, Firstname: req.Firstname, Lastname: req.Lastname}
+	hashedPassword, err := s.hashPassword(ctx, passwordPolicyOf(a), req.Password, owner, nil)
 	if err != nil {
-		slog.Error("failed to hash password", "error", err)
-		return nil, fmt.Errorf("hash password: %w", err)
+		return nil, err
 	}
 
+	now := time.Now()
 	u := &ent.User{
-		AppID:     req.AppID,
-		Firstname: req.Firstname,
-		Lastname:  req.Lastname,
-		Email:     req.Email,
-		Password:  string(hashedPassword),
-		Status:    1,
+		AppID:             req.AppID,
+		Firstname:         req.Firstname,
+		Lastname:          req.Lastname,
+		Email:             req.Email,
+		Password:          hashedPassword,
+		PasswordChangedAt: &now,
+		Status:            1,
 	}
 
 	created, err := s.repo.Create(ctx, u)
 	if err != nil {
+		if errors.Is(err, privacy.Deny) {
+			slog.Warn("user creation denied", "email", req.Email, "app_id", req.AppID, "error", err)
+			return nil, ErrForbidden
+		}
 		slog.Error("failed to create user in repository", "email", req.Email, "error", err)
 		return nil, fmt.Errorf("repository create: %w", err)
 	}
 
 	slog.Info("user created successfully", "id", created.ID, "email", created.Email)
+	s.sendVerification(ctx, created)
 	return s.toDomain(created), nil
 }
 
@@ -69,24 +363,149 @@ func (s *userService) GetByID(ctx context.Context, id int) (*User, error) {
 	return s.toDomain(u), nil
 }
 
-func (s *userService) List(ctx context.Context) ([]*User, error) {
-	users, err := s.repo.List(ctx)
+// List returns a page of the users of the caller's app, or of every app
+// for platform admins, that match req.
+func (s *userService) List(ctx context.Context, req ListUsersRequest) (*UserPage, error) {
+	sort := req.Sort
+	if sort == "" {
+		sort = entuser.FieldID
+	}
+	sortValue, ok := SortFields[sort]
+	if !ok {
+		return nil, ErrInvalidSort
+	}
+	var after *db.Cursor
+	offset := max(req.Offset, 0)
+	if req.Cursor != "" {
+		c, err := db.DecodeCursor(req.Cursor)
+		if err != nil || c.Sort != sort || c.Desc != req.Desc {
+			return nil, ErrInvalidCursor
+		}
+		after, offset = &c, 0
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	limit = min(limit, MaxListLimit)
+
+	var where []predicate.User
+	if req.AppID != nil {
+		where = append(where, entuser.AppIDEQ(*req.AppID))
+	}
+	if req.Status != nil {
+		where = append(where, entuser.StatusEQ(*req.Status))
+	}
+	if domain := strings.TrimPrefix(strings.TrimSpace(req.EmailDomain), "@"); domain != "" {
+		where = append(where, emailDomainIs(domain))
+	}
+	if req.CreatedAfter != nil {
+		where = append(where, entuser.CreatedAtGTE(req.CreatedAfter.UTC()))
+	}
+	if req.CreatedBefore != nil {
+		where = append(where, entuser.CreatedAtLT(req.CreatedBefore.UTC()))
+	}
+	if email := strings.TrimSpace(req.Email); email != "" {
+		where = append(where, entuser.EmailEqualFold(email))
+	}
+	if len(req.RoleIDs) > 0 {
+		where = append(where, entuser.HasRolesWith(entrole.IDIn(req.RoleIDs...)))
+	}
+	for _, word := range strings.Fields(req.Q) {
+		where = append(where, entuser.Or(
+			entuser.FirstnameContainsFold(word),
+			entuser.LastnameContainsFold(word),
+			entuser.EmailContainsFold(word),
+		))
+	}
+
+	// One user more than asked for tells whether there is a next page.
+	users, total, err := s.repo.List(ctx, where, sort, req.Desc, after, offset, limit+1)
 	if err != nil {
 		slog.Error("failed to list users", "error", err)
 		return nil, err
 	}
 
-	domainUsers := make([]*User, len(users))
+	page := &UserPage{Users: make([]*User, 0, min(len(users), limit)), Total: total}
 	for i, u := range users {
-		domainUsers[i] = s.toDomain(u)
+		if i == limit {
+			last := users[i-1]
+			page.NextCursor = db.NewCursor(sort, req.Desc, sortValue(last), last.ID).Encode()
+			break
+		}
+		page.Users = append(page.Users, s.toDomain(u))
+	}
+	return page, nil
+}
+
+// emailDomainIs matches the users whose email is at domain, in any case:
+// emails are stored as they were entered.
+func emailDomainIs(domain string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(entuser.FieldEmail), "@"+domain))
+	})
+}
+
+func (s *userService) Search(ctx context.Context, req SearchUsersRequest) (*UserSearchPage, error) {
+	match := db.MatchQuery(req.Q)
+	if match == "" {
+		return nil, ErrInvalidSearch
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
 	}
-	return domainUsers, nil
+	limit = min(limit, MaxSearchLimit)
+
+	// The index is read without ent, so the caller is confined to their
+	// app here, as the privacy rules do for queries.
+	var appIDs []int
+	if claims, ok := auth.GetClaimsFromContext(ctx); ok && !claims.PlatformAdmin {
+		appIDs = append(appIDs, claims.AppID)
+	}
+	if req.AppID != nil {
+		appIDs = append(appIDs, *req.AppID)
+	}
+
+	hits, total, err := s.repo.Search(ctx, match, appIDs, limit)
+	if err != nil {
+		if errors.Is(err, db.ErrSearchUnavailable) {
+			return nil, ErrSearchUnavailable
+		}
+		slog.Error("failed to search users", "error", err)
+		return nil, err
+	}
+
+	page := &UserSearchPage{Results: make([]*UserSearchResult, 0, len(hits)), Total: total}
+	for _, h := range hits {
+		page.Results = append(page.Results, &UserSearchResult{
+			User:  s.toDomain(h.User),
+			Score: h.Score,
+			Highlight: UserHighlight{
+				Firstname: highlight(h.Firstname),
+				Lastname:  highlight(h.Lastname),
+				Email:     highlight(h.Email),
+			},
+		})
+	}
+	return page, nil
+}
+
+// highlight escapes a field returned by the search index for HTML and turns
+// its highlight markers into <mark> tags.
+func highlight(s string) string {
+	s = html.EscapeString(s)
+	s = strings.ReplaceAll(s, db.HighlightStart, "<mark>")
+	return strings.ReplaceAll(s, db.HighlightEnd, "</mark>")
 }
 
 func (s *userService) Update(ctx context.Context, id int, req UpdateUserRequest) (*User, error) {
 	slog.Info("updating user", "id", id)
 	existing, err := s.repo.GetByID(ctx, id)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
 		slog.Error("failed to get user for update", "id", id, "error", err)
 		return nil, err
 	}
@@ -100,28 +519,54 @@ func (s *userService) Update(ctx context.Context, id int, req UpdateUserRequest)
 	if req.AppID != nil {
 		existing.AppID = *req.AppID
 	}
-	if req.Email != nil {
+	emailChanged := req.Email != nil && *req.Email != existing.Email
+	if emailChanged {
 		existing.Email = *req.Email
+		existing.EmailVerifiedAt = nil
+	}
+	if req.EmailVerified != nil {
+		switch {
+		case !*req.EmailVerified:
+			existing.EmailVerifiedAt = nil
+		case existing.EmailVerifiedAt == nil:
+			now := time.Now()
+			existing.EmailVerifiedAt = &now
+		}
 	}
+	oldPassword := existing.Password
 	if req.Password != nil {
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(*req.Password), bcrypt.DefaultCost)
+		hashedPassword, err := s.hashPassword(ctx, passwordPolicyOf(existing.Edges.App), *req.Password, passwordOwner(existing), existing)
 		if err != nil {
-			slog.Error("failed to hash new password", "id", id, "error", err)
-			return nil, fmt.Errorf("hash password: %w", err)
+			return nil, err
 		}
-		existing.Password = string(hashedPassword)
+		now := time.Now()
+		existing.Password = hashedPassword
+		existing.PasswordChangedAt = &now
 	}
 	if req.Status != nil {
 		existing.Status = *req.Status
 	}
+	if req.PlatformAdmin != nil {
+		existing.PlatformAdmin = *req.PlatformAdmin
+	}
 
 	updated, err := s.repo.Update(ctx, id, existing)
 	if err != nil {
+		if errors.Is(err, privacy.Deny) {
+			slog.Warn("user update denied", "id", id, "error", err)
+			return nil, ErrForbidden
+		}
 		slog.Error("failed to update user in repository", "id", id, "error", err)
 		return nil, err
 	}
 
 	slog.Info("user updated successfully", "id", id)
+	if req.Password != nil {
+		s.rememberPassword(ctx, id, oldPassword, passwordPolicyOf(existing.Edges.App))
+	}
+	if emailChanged && updated.EmailVerifiedAt == nil {
+		s.sendVerification(ctx, updated)
+	}
 	return s.toDomain(updated), nil
 }
 
@@ -129,6 +574,9 @@ func (s *userService) Delete(ctx context.Context, id int) error {
 	slog.Info("deleting user", "id", id)
 	err := s.repo.Delete(ctx, id)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrUserNotFound
+		}
 		slog.Error("failed to delete user", "id", id, "error", err)
 		return err
 	}
@@ -137,47 +585,533 @@ func (s *userService) Delete(ctx context.Context, id int) error {
 }
 
 func (s *userService) Authenticate(ctx context.Context, req AuthRequest) (*AuthResponse, error) {
-	slog.Info("authenticating user", "email", req.Email)
+	u, err := s.verifyCredentials(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPasswordAge(u); err != nil {
+		return nil, err
+	}
+	return s.completeLogin(ctx, u, LoginMethodPassword)
+}
+
+// completeLogin issues tokens to a user who passed the first factor of a
+// login with the given method, or starts the MFA challenge they have to pass
+// first.
+func (s *userService) completeLogin(ctx context.Context, u *ent.User, method string) (*AuthResponse, error) {
+	if mfaRequired(u) {
+		c, err := s.beginMFA(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		return &AuthResponse{MFA: c}, nil
+	}
+
+	res, err := s.issueTokens(ctx, u, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.auditLogin(ctx, u, u.Email, method, ""); err != nil {
+		return nil, err
+	}
+	slog.Info("user authenticated successfully", "id", u.ID, "email", u.Email)
+	return res, nil
+}
+
+func (s *userService) VerifyCredentials(ctx context.Context, email, password string) (*User, error) {
+	u, err := s.verifyCredentials(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPasswordAge(u); err != nil {
+		return nil, err
+	}
+	return s.toDomain(u), nil
+}
+
+func (s *userService) IssueTokens(ctx context.Context, id int, scope string) (*AuthResponse, error) {
+	u, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	res, err := s.issueTokens(ctx, u, "", scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.auditLogin(ctx, u, u.Email, LoginMethodOIDC, ""); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// verifyCredentials checks the password of an active user. Wrong passwords
+// are throttled per user and client IP; throttled attempts fail with the same
+// ErrInvalidCredentials without the password being checked.
+func (s *userService) verifyCredentials(ctx context.Context, email, password string) (*ent.User, error) {
+	slog.Info("authenticating user", "email", email)
+	ip := auth.GetClientIPFromContext(ctx)
+	u, err := s.repo.GetByEmail(ctx, email)
+	if err != nil {
+		slog.Warn("authentication failed: user not found", "email", email)
+		if hash, err := s.dummyHash(); err == nil {
+			s.hasher.Verify(hash, password)
+		}
+		p := loginPolicyOf(nil)
+		if err := s.checkLoginThrottle(ctx, nil, ip, p); err == nil {
+			s.recordLoginFailure(ctx, nil, ip, p)
+		}
+		if err := s.auditLogin(ctx, nil, email, LoginMethodPassword, LoginFailureUnknownUser); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCredentials
+	}
+
+	p := loginPolicyOf(u.Edges.App)
+	if err := s.checkLoginThrottle(ctx, u, ip, p); err != nil {
+		if err := s.auditLogin(ctx, u, email, LoginMethodPassword, LoginFailureThrottled); err != nil {
+			return nil, err
+		}
+		return nil, err
+	}
+
+	ok, err := s.hasher.Verify(u.Password, password)
+	if err != nil {
+		slog.Error("authentication failed: unreadable password hash", "id", u.ID, "error", err)
+	}
+	if !ok {
+		slog.Warn("authentication failed: invalid password", "email", email)
+		s.recordLoginFailure(ctx, u, ip, p)
+		if err := s.auditLogin(ctx, u, email, LoginMethodPassword, LoginFailureInvalidPassword); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCredentials
+	}
+	s.clearLoginFailures(ctx, u)
+	s.rehashPassword(ctx, u, password)
+
+	if u.Status != 1 {
+		slog.Warn("authentication failed: user inactive", "email", email)
+		if err := s.auditLogin(ctx, u, email, LoginMethodPassword, LoginFailureInactive); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCredentials
+	}
+
+	if u.EmailVerifiedAt == nil && u.Edges.App != nil && u.Edges.App.RequireVerifiedEmail {
+		slog.Warn("authentication failed: email not verified", "email", email)
+		if err := s.auditLogin(ctx, u, email, LoginMethodPassword, LoginFailureEmailNotVerified); err != nil {
+			return nil, err
+		}
+		return nil, ErrEmailNotVerified
+	}
+
+	return u, nil
+}
+
+func (s *userService) Refresh(ctx context.Context, req RefreshRequest) (*AuthResponse, error) {
+	rt, err := s.repo.GetRefreshTokenByHash(ctx, auth.HashToken(req.RefreshToken))
+	if err != nil {
+		slog.Warn("refresh failed: token not found")
+		return nil, ErrInvalidRefreshToken
+	}
+
+	u, err := s.repo.GetByID(ctx, rt.UserID)
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+	// Checked first, so that an app presenting another app's token can
+	// neither use it up nor set off the reuse detection of its owner.
+	if req.AppID != 0 && u.AppID != req.AppID {
+		slog.Warn("refresh failed: token was issued to another app", "user_id", u.ID, "app_id", req.AppID)
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if rt.UsedAt != nil || rt.RevokedAt != nil {
+		// A rotated or revoked token is being replayed: assume it was stolen
+		// and revoke every token issued from the same login.
+		slog.Warn("refresh token reuse detected, revoking family", "user_id", rt.UserID, "family_id", rt.FamilyID)
+		if err := s.repo.RevokeRefreshTokenFamily(ctx, rt.FamilyID); err != nil {
+			return nil, fmt.Errorf("revoke token family: %w", err)
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if time.Now().After(rt.ExpiresAt) {
+		slog.Warn("refresh failed: token expired", "user_id", rt.UserID)
+		return nil, ErrInvalidRefreshToken
+	}
+
+	ok, err := s.repo.MarkRefreshTokenUsed(ctx, rt.ID)
+	if err != nil {
+		return nil, fmt.Errorf("mark refresh token used: %w", err)
+	}
+	if !ok {
+		slog.Warn("refresh token reuse detected, revoking family", "user_id", rt.UserID, "family_id", rt.FamilyID)
+		if err := s.repo.RevokeRefreshTokenFamily(ctx, rt.FamilyID); err != nil {
+			return nil, fmt.Errorf("revoke token family: %w", err)
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if u.Status != 1 {
+		slog.Warn("refresh failed: user inactive", "id", u.ID)
+		return nil, ErrInvalidRefreshToken
+	}
+
+	res, err := s.issueTokens(ctx, u, rt.FamilyID, rt.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("refresh token rotated", "user_id", u.ID)
+	return res, nil
+}
+
+func (s *userService) Logout(ctx context.Context, claims *auth.UserClaims, req LogoutRequest) error {
+	slog.Info("logging out user", "id", claims.UserID)
+	if err := s.jwt.Revoke(ctx, claims); err != nil {
+		slog.Error("failed to revoke access token", "id", claims.UserID, "error", err)
+		return fmt.Errorf("revoke access token: %w", err)
+	}
+
+	if req.RefreshToken != "" {
+		rt, err := s.repo.GetRefreshTokenByHash(ctx, auth.HashToken(req.RefreshToken))
+		switch {
+		case err != nil:
+			// An unknown refresh token has nothing left to revoke.
+		case rt.UserID != claims.UserID:
+			slog.Warn("logout refresh token belongs to another user", "id", claims.UserID, "owner_id", rt.UserID)
+		default:
+			if err := s.repo.RevokeRefreshTokenFamily(ctx, rt.FamilyID); err != nil {
+				return fmt.Errorf("revoke token family: %w", err)
+			}
+		}
+	}
+
+	slog.Info("user logged out", "id", claims.UserID)
+	return nil
+}
+
+func (s *userService) RevokeSessions(ctx context.Context, id int) error {
+	slog.Info("revoking all sessions", "id", id)
+	if err := s.repo.RevokeSessions(ctx, id, revocationCutoff()); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	slog.Info("all sessions revoked", "id", id)
+	return nil
+}
+
+func (s *userService) SetRoles(ctx context.Context, id int, req SetRolesRequest) (*User, error) {
+	slog.Info("setting user roles", "id", id, "role_ids", req.RoleIDs)
+	u, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	roleIDs := slices.Compact(slices.Sorted(slices.Values(req.RoleIDs)))
+	if len(roleIDs) > 0 {
+		n, err := s.repo.CountAppRoles(ctx, u.AppID, roleIDs)
+		if err != nil {
+			return nil, err
+		}
+		if n != len(roleIDs) {
+			slog.Warn("role assignment rejected: unknown role or role of another app", "id", id, "app_id", u.AppID)
+			return nil, ErrInvalidRole
+		}
+	}
+
+	updated, err := s.repo.SetRoles(ctx, id, roleIDs)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	slog.Info("user roles set", "id", id)
+	return s.toDomain(updated), nil
+}
+
+// ForgotPassword sends a reset token to the user with the given email. It
+// reports success for unknown and inactive users alike, so the result does not
+// reveal which emails have accounts.
+func (s *userService) ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error {
+	slog.Info("password reset requested", "email", req.Email)
 	u, err := s.repo.GetByEmail(ctx, req.Email)
 	if err != nil {
-		slog.Warn("authentication failed: user not found", "email", req.Email)
-		return nil, errors.New("invalid credentials")
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if u.Status != 1 {
+		slog.Warn("password reset skipped: user inactive", "id", u.ID)
+		return nil
+	}
+
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return fmt.Errorf("generate reset token: %w", err)
+	}
+	expiresAt := time.Now().Add(s.resetExpiry)
+	if _, err := s.repo.CreatePasswordResetToken(ctx, u.ID, auth.HashToken(token), expiresAt); err != nil {
+		return fmt.Errorf("store reset token: %w", err)
+	}
+
+	// A delivery failure is only logged: failing the request would reveal
+	// that the account exists.
+	if err := s.notifier.PasswordReset(ctx, s.toDomain(u), token, expiresAt); err != nil {
+		slog.Error("failed to send password reset", "id", u.ID, "error", err)
+		return nil
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password))
+	slog.Info("password reset sent", "id", u.ID)
+	return nil
+}
+
+// ResetPassword sets a new password with a reset token. The token can only be
+// used once, and every session of the user is revoked. A password the app's
+// policy rejects leaves the token usable for another try.
+func (s *userService) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	prt, err := s.repo.GetPasswordResetTokenByHash(ctx, auth.HashToken(req.Token))
 	if err != nil {
-		slog.Warn("authentication failed: invalid password", "email", req.Email)
-		return nil, errors.New("invalid credentials")
+		if ent.IsNotFound(err) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+
+	if prt.UsedAt != nil || time.Now().After(prt.ExpiresAt) {
+		slog.Warn("password reset failed: token used or expired", "user_id", prt.UserID)
+		return ErrInvalidResetToken
 	}
 
-	token, err := s.jwt.Generate(u.AppID, u.ID)
+	u, err := s.repo.GetByID(ctx, prt.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+	if u.Status != 1 {
+		slog.Warn("password reset failed: user inactive", "id", u.ID)
+		return ErrInvalidResetToken
+	}
+
+	p := passwordPolicyOf(u.Edges.App)
+	hashedPassword, err := s.hashPassword(ctx, p, req.Password, passwordOwner(u), u)
+	if err != nil {
+		return err
+	}
+
+	ok, err := s.repo.MarkPasswordResetTokenUsed(ctx, prt.ID)
+	if err != nil {
+		return fmt.Errorf("mark reset token used: %w", err)
+	}
+	if !ok {
+		slog.Warn("password reset failed: token already used", "user_id", prt.UserID)
+		return ErrInvalidResetToken
+	}
+
+	if err := s.repo.SetPassword(ctx, u.ID, hashedPassword); err != nil {
+		return err
+	}
+	s.rememberPassword(ctx, u.ID, u.Password, p)
+
+	// Whoever knew the old password may still hold tokens.
+	if err := s.repo.RevokeSessions(ctx, u.ID, revocationCutoff()); err != nil {
+		return fmt.Errorf("revoke sessions: %w", err)
+	}
+
+	slog.Info("password reset successfully", "id", u.ID)
+	return nil
+}
+
+// VerifyEmail marks the user's email as verified with a verification token.
+func (s *userService) VerifyEmail(ctx context.Context, req VerifyEmailRequest) error {
+	evt, err := s.repo.GetEmailVerificationTokenByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrInvalidVerificationToken
+		}
+		return err
+	}
+
+	if evt.UsedAt != nil || time.Now().After(evt.ExpiresAt) {
+		slog.Warn("email verification failed: token used or expired", "user_id", evt.UserID)
+		return ErrInvalidVerificationToken
+	}
+
+	u, err := s.repo.GetByID(ctx, evt.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrInvalidVerificationToken
+		}
+		return err
+	}
+	if u.Email != evt.Email {
+		slog.Warn("email verification failed: email changed since the token was issued", "id", u.ID)
+		return ErrInvalidVerificationToken
+	}
+
+	ok, err := s.repo.MarkEmailVerificationTokenUsed(ctx, evt.ID)
+	if err != nil {
+		return fmt.Errorf("mark verification token used: %w", err)
+	}
+	if !ok {
+		slog.Warn("email verification failed: token already used", "id", u.ID)
+		return ErrInvalidVerificationToken
+	}
+
+	if err := s.repo.SetEmailVerified(ctx, u.ID, time.Now()); err != nil {
+		return err
+	}
+
+	slog.Info("email verified", "id", u.ID)
+	return nil
+}
+
+// ResendVerification sends a new verification token to the user with the
+// given email. Like ForgotPassword, it reports success whether or not the
+// email has an unverified account.
+func (s *userService) ResendVerification(ctx context.Context, req ResendVerificationRequest) error {
+	slog.Info("email verification requested", "email", req.Email)
+	u, err := s.repo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if u.EmailVerifiedAt != nil || u.Status != 1 {
+		return nil
+	}
+
+	s.sendVerification(ctx, u)
+	return nil
+}
+
+// sendVerification issues a verification token for the user's current email.
+// Failures are logged rather than returned so they never undo the change that
+// triggered the email; the user can ask for a new token.
+func (s *userService) sendVerification(ctx context.Context, u *ent.User) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		slog.Error("failed to generate verification token", "id", u.ID, "error", err)
+		return
+	}
+	expiresAt := time.Now().Add(s.verifyExpiry)
+	if _, err := s.repo.CreateEmailVerificationToken(ctx, u.ID, u.Email, auth.HashToken(token), expiresAt); err != nil {
+		slog.Error("failed to store verification token", "id", u.ID, "error", err)
+		return
+	}
+	if err := s.notifier.EmailVerification(ctx, s.toDomain(u), token, expiresAt); err != nil {
+		slog.Error("failed to send email verification", "id", u.ID, "error", err)
+		return
+	}
+	slog.Info("email verification sent", "id", u.ID)
+}
+
+// issueTokens signs an access token for the user and stores a new refresh token
+// carrying the same scope. An empty familyID starts a new token family.
+func (s *userService) issueTokens(ctx context.Context, u *ent.User, familyID, scope string) (*AuthResponse, error) {
+	claims := auth.UserClaims{AppID: u.AppID, UserID: u.ID, Scope: scope}
+	// Roles and platform administration are only carried by first-party
+	// sessions, never by tokens issued to an OAuth client on the user's behalf.
+	if scope == "" {
+		roles, err := s.repo.GetRoles(ctx, u.ID, u.AppID)
+		if err != nil {
+			return nil, fmt.Errorf("load roles: %w", err)
+		}
+		claims.PlatformAdmin = u.PlatformAdmin
+		claims.Roles, claims.Permissions = rolesAndPermissions(roles)
+	}
+
+	token, err := s.jwt.Issue(claims)
 	if err != nil {
 		slog.Error("failed to generate JWT token", "id", u.ID, "error", err)
 		return nil, fmt.Errorf("generate token: %w", err)
 	}
 
-	slog.Info("user authenticated successfully", "id", u.ID, "email", u.Email)
+	refreshToken, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		slog.Error("failed to generate refresh token", "id", u.ID, "error", err)
+		return nil, fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	if familyID == "" {
+		familyID, err = auth.GenerateOpaqueToken()
+		if err != nil {
+			return nil, fmt.Errorf("generate token family: %w", err)
+		}
+	}
+
+	if _, err := s.repo.CreateRefreshToken(ctx, u.ID, auth.HashToken(refreshToken), familyID, scope, time.Now().Add(s.refreshExpiry)); err != nil {
+		return nil, fmt.Errorf("store refresh token: %w", err)
+	}
+
 	return &AuthResponse{
-		Token: token,
-		User:  *s.toDomain(u),
+		Token:        token,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(s.jwt.TokenDuration().Seconds()),
+		Scope:        scope,
+		User:         s.toDomain(u),
 	}, nil
 }
 
+// rolesAndPermissions returns the sorted role names and the sorted union of
+// the permissions granted by roles.
+func rolesAndPermissions(roles []*ent.Role) ([]string, []string) {
+	var names, perms []string
+	for _, r := range roles {
+		names = append(names, r.Name)
+		for _, p := range r.Edges.Permissions {
+			perms = append(perms, p.Name)
+		}
+	}
+	slices.Sort(names)
+	slices.Sort(perms)
+	return names, slices.Compact(perms)
+}
+
 func (s *userService) toDomain(u *ent.User) *User {
 	domainUser := &User{
-		ID:        u.ID,
-		AppID:     u.AppID,
-		Firstname: u.Firstname,
-		Lastname:  u.Lastname,
-		Email:     u.Email,
-		Status:    u.Status,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:              u.ID,
+		AppID:           u.AppID,
+		Firstname:       u.Firstname,
+		Lastname:        u.Lastname,
+		Email:           u.Email,
+		Status:          u.Status,
+		PlatformAdmin:   u.PlatformAdmin,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		MFAEnabled:      u.TotpConfirmedAt != nil,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
+	}
+
+	if u.LockedUntil != nil && u.LockedUntil.After(time.Now()) {
+		domainUser.LockedUntil = u.LockedUntil
 	}
 
 	if u.Edges.App != nil {
 		domainUser.AppName = u.Edges.App.Name
 	}
 
+	domainUser.Roles = make([]string, len(u.Edges.Roles))
+	for i, r := range u.Edges.Roles {
+		domainUser.Roles[i] = r.Name
+	}
+	slices.Sort(domainUser.Roles)
+
 	return domainUser
 }
@@ -2,14 +2,34 @@ package user
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"keeper/ent/enttest"
+	entuser "keeper/ent/user"
+	"keeper/internal/db"
 	"keeper/pkg/auth"
+	"keeper/pkg/breach"
+	"keeper/pkg/password"
+	"keeper/pkg/webauthn"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestService_Create(t *testing.T) {
@@ -188,56 +208,1895 @@ func TestService_Delete(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func BenchmarkService_Create(b *testing.B) {
-	client := enttest.Open(b, "sqlite3", "file:ent_bench_create?mode=memory&cache=shared&_fk=1")
-	defer client.Close()
+func TestService_Refresh(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_refresh?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
 
 	repo := NewUserRepository(client)
 	jwtManager := auth.NewJWTManager("secret", time.Hour)
-	svc := NewUserService(repo, jwtManager)
+	svc := NewUserService(repo, jwtManager, WithRefreshExpiry(time.Hour))
 
 	ctx := context.Background()
-	app, _ := client.App.Create().SetName("Bench App").Save(ctx)
+	email := "refresh@example.com"
+	password := "password123"
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		email := "bench_" + string(rune(i)) + "@example.com"
-		_, _ = svc.Create(ctx, CreateUserRequest{
-			AppID:     app.ID,
-			Firstname: "Bench",
-			Lastname:  "User",
-			Email:     email,
-			Password:  "password123",
+	app, err := client.App.Create().SetName("Refresh App").Save(ctx)
+	assert.NoError(t, err)
+
+	_, err = svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "Refresh",
+		Lastname:  "User",
+		Email:     email,
+		Password:  password,
+	})
+	assert.NoError(t, err)
+
+	login, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, login.RefreshToken)
+
+	t.Run("Rotation", func(t *testing.T) {
+		res, err := svc.Refresh(ctx, RefreshRequest{RefreshToken: login.RefreshToken})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+		assert.NotEqual(t, login.RefreshToken, res.RefreshToken)
+
+		// The rotated token keeps working until it is used.
+		next, err := svc.Refresh(ctx, RefreshRequest{RefreshToken: res.RefreshToken})
+		assert.NoError(t, err)
+
+		t.Run("ReuseRevokesFamily", func(t *testing.T) {
+			_, err := svc.Refresh(ctx, RefreshRequest{RefreshToken: login.RefreshToken})
+			assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+			// The latest token of the family is revoked as well.
+			_, err = svc.Refresh(ctx, RefreshRequest{RefreshToken: next.RefreshToken})
+			assert.ErrorIs(t, err, ErrInvalidRefreshToken)
 		})
-	}
+	})
+
+	t.Run("UnknownToken", func(t *testing.T) {
+		_, err := svc.Refresh(ctx, RefreshRequest{RefreshToken: "does-not-exist"})
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	})
 }
 
-func BenchmarkService_Authenticate(b *testing.B) {
-	client := enttest.Open(b, "sqlite3", "file:ent_bench_auth?mode=memory&cache=shared&_fk=1")
-	defer client.Close()
+func TestService_Logout(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_logout?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
 
 	repo := NewUserRepository(client)
 	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	jwtManager.SetDenylist(auth.NewMemoryDenylist())
+	jwtManager.SetUserStatusChecker(NewStatusChecker(repo))
 	svc := NewUserService(repo, jwtManager)
 
 	ctx := context.Background()
-	email := "bench_auth@example.com"
+	email := "logout@example.com"
 	password := "password123"
 
-	app, _ := client.App.Create().SetName("Bench Auth App").Save(ctx)
-	_, _ = svc.Create(ctx, CreateUserRequest{
+	app, err := client.App.Create().SetName("Logout App").Save(ctx)
+	assert.NoError(t, err)
+
+	_, err = svc.Create(ctx, CreateUserRequest{
 		AppID:     app.ID,
-		Firstname: "Bench",
-		Lastname:  "Auth",
+		Firstname: "Logout",
+		Lastname:  "User",
 		Email:     email,
 		Password:  password,
 	})
+	assert.NoError(t, err)
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, _ = svc.Authenticate(ctx, AuthRequest{
-			Email:    email,
-			Password: password,
-		})
+	login, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.NoError(t, err)
+
+	claims, err := jwtManager.Validate(ctx, login.Token)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, claims.ID)
+
+	err = svc.Logout(ctx, claims, LogoutRequest{RefreshToken: login.RefreshToken})
+	assert.NoError(t, err)
+
+	_, err = jwtManager.Validate(ctx, login.Token)
+	assert.ErrorIs(t, err, auth.ErrTokenRevoked)
+
+	_, err = svc.Refresh(ctx, RefreshRequest{RefreshToken: login.RefreshToken})
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+}
+
+func TestService_RevokeSessions(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_revoke_sessions?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	jwtManager.SetUserStatusChecker(NewStatusChecker(repo))
+	svc := NewUserService(repo, jwtManager)
+
+	ctx := context.Background()
+	email := "sessions@example.com"
+	password := "password123"
+
+	app, err := client.App.Create().SetName("Sessions App").Save(ctx)
+	assert.NoError(t, err)
+
+	u, err := svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "Sessions",
+		Lastname:  "User",
+		Email:     email,
+		Password:  password,
+	})
+	assert.NoError(t, err)
+
+	login, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.NoError(t, err)
+
+	// Move the cut-off past the token's issue time instead of sleeping a second.
+	err = repo.RevokeSessions(ctx, u.ID, time.Now().Add(time.Second))
+	assert.NoError(t, err)
+
+	_, err = jwtManager.Validate(ctx, login.Token)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+
+	_, err = svc.Refresh(ctx, RefreshRequest{RefreshToken: login.RefreshToken})
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+	t.Run("SameSecond", func(t *testing.T) {
+		// Issue times are whole seconds, so a token issued just before the
+		// revocation can have the same one.
+		relogin, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+		assert.NoError(t, repo.RevokeSessions(ctx, u.ID, time.Now().Add(-time.Second)))
+		_, err = jwtManager.Validate(ctx, relogin.Token)
+		assert.NoError(t, err)
+
+		assert.NoError(t, svc.RevokeSessions(ctx, u.ID))
+		_, err = jwtManager.Validate(ctx, relogin.Token)
+		assert.ErrorIs(t, err, ErrSessionRevoked)
+	})
+
+	t.Run("MovedToOtherApp", func(t *testing.T) {
+		relogin, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+
+		otherApp, err := client.App.Create().SetName("Other Sessions App").Save(ctx)
+		assert.NoError(t, err)
+		_, err = svc.Update(ctx, u.ID, UpdateUserRequest{AppID: &otherApp.ID})
+		assert.NoError(t, err)
+
+		_, err = jwtManager.Validate(ctx, relogin.Token)
+		assert.ErrorIs(t, err, ErrSessionRevoked)
+
+		_, err = svc.Refresh(ctx, RefreshRequest{RefreshToken: relogin.RefreshToken})
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	})
+
+	t.Run("InactiveUser", func(t *testing.T) {
+		// Move the cut-off back before the next login.
+		assert.NoError(t, repo.RevokeSessions(ctx, u.ID, time.Now().Add(-time.Second)))
+		relogin, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+
+		_, err = jwtManager.Validate(ctx, relogin.Token)
+		assert.NoError(t, err)
+
+		status := int8(0)
+		_, err = svc.Update(ctx, u.ID, UpdateUserRequest{Status: &status})
+		assert.NoError(t, err)
+
+		_, err = jwtManager.Validate(ctx, relogin.Token)
+		assert.ErrorIs(t, err, ErrUserInactive)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		err := svc.RevokeSessions(ctx, 9999)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+}
+
+func TestService_List(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_user_list?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewUserService(NewUserRepository(client), auth.NewJWTManager("secret", time.Hour))
+	ctx := context.Background()
+
+	a, err := client.App.Create().SetName("List App").Save(ctx)
+	assert.NoError(t, err)
+
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, u := range []struct{ first, last, email string }{
+		{"Ada", "Lovelace", "ada@example.com"},
+		{"Alan", "Turing", "alan@Example.org"},
+		{"Grace", "Hopper", "grace@example.com"},
+		{"Edsger", "Dijkstra", "edsger@example.net"},
+		{"Barbara", "Liskov", "barbara@example.com"},
+	} {
+		// Ada and Alan share a creation time, so pages split between them
+		// must fall back to the ID.
+		at := created.Add(time.Duration(max(i-1, 0)) * 24 * time.Hour)
+		_, err := client.User.Create().SetAppID(a.ID).SetFirstname(u.first).SetLastname(u.last).
+			SetEmail(u.email).SetPassword("hash").SetCreatedAt(at).Save(ctx)
+		assert.NoError(t, err)
+	}
+	_, err = client.User.Update().Where(entuser.EmailEQ("edsger@example.net")).SetStatus(0).Save(ctx)
+	assert.NoError(t, err)
+
+	listAll := func(req ListUsersRequest) []string {
+		var emails []string
+		for {
+			page, err := svc.List(ctx, req)
+			if !assert.NoError(t, err) {
+				return emails
+			}
+			for _, u := range page.Users {
+				emails = append(emails, u.Email)
+			}
+			if page.NextCursor == "" {
+				return emails
+			}
+			req.Cursor = page.NextCursor
+		}
+	}
+
+	t.Run("Paginate", func(t *testing.T) {
+		page, err := svc.List(ctx, ListUsersRequest{Limit: 2})
+		assert.NoError(t, err)
+		assert.Len(t, page.Users, 2)
+		assert.Equal(t, 5, page.Total)
+		assert.NotEmpty(t, page.NextCursor)
+
+		assert.Equal(t, []string{"ada@example.com", "alan@Example.org", "grace@example.com", "edsger@example.net", "barbara@example.com"},
+			listAll(ListUsersRequest{Limit: 2}))
+	})
+
+	t.Run("Sort", func(t *testing.T) {
+		assert.Equal(t, []string{"barbara@example.com", "edsger@example.net", "grace@example.com", "alan@Example.org", "ada@example.com"},
+			listAll(ListUsersRequest{Sort: "created_at", Desc: true, Limit: 1}))
+		assert.Equal(t, []string{"edsger@example.net", "grace@example.com", "barbara@example.com", "ada@example.com", "alan@Example.org"},
+			listAll(ListUsersRequest{Sort: "lastname", Limit: 2}))
+	})
+
+	t.Run("Offset", func(t *testing.T) {
+		page, err := svc.List(ctx, ListUsersRequest{Offset: 3, Limit: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 5, page.Total)
+		assert.Len(t, page.Users, 1)
+		assert.Equal(t, "edsger@example.net", page.Users[0].Email)
+
+		// The next page continues after the offset page.
+		assert.Equal(t, []string{"edsger@example.net", "barbara@example.com"}, listAll(ListUsersRequest{Offset: 3, Limit: 1}))
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		assert.Equal(t, []string{"ada@example.com", "grace@example.com", "barbara@example.com"},
+			listAll(ListUsersRequest{EmailDomain: "@EXAMPLE.com"}))
+		// Emails are matched whatever the case they were entered in.
+		assert.Equal(t, []string{"alan@Example.org"}, listAll(ListUsersRequest{EmailDomain: "example.org"}))
+		assert.Equal(t, []string{"alan@Example.org"}, listAll(ListUsersRequest{EmailDomain: "EXAMPLE.Org"}))
+
+		inactive := int8(0)
+		assert.Equal(t, []string{"edsger@example.net"}, listAll(ListUsersRequest{Status: &inactive}))
+
+		after, before := created.Add(24*time.Hour), created.Add(3*24*time.Hour)
+		assert.Equal(t, []string{"grace@example.com", "edsger@example.net"},
+			listAll(ListUsersRequest{CreatedAfter: &after, CreatedBefore: &before}))
+
+		assert.Equal(t, []string{"grace@example.com"}, listAll(ListUsersRequest{Q: "grace HOP"}))
+		assert.Equal(t, []string{"ada@example.com", "alan@Example.org"}, listAll(ListUsersRequest{Q: "LA", Limit: 1}))
+
+		assert.Equal(t, []string{"alan@Example.org"}, listAll(ListUsersRequest{Email: "ALAN@example.org"}))
+		assert.Empty(t, listAll(ListUsersRequest{Email: "alan@example"}))
+
+		r, err := client.Role.Create().SetAppID(a.ID).SetName("admin").Save(ctx)
+		assert.NoError(t, err)
+		_, err = client.User.Update().Where(entuser.EmailIn("grace@example.com", "barbara@example.com")).AddRoles(r).Save(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"grace@example.com", "barbara@example.com"}, listAll(ListUsersRequest{RoleIDs: []int{r.ID}, Limit: 1}))
+
+		// Users with several of the roles are listed once.
+		r2, err := client.Role.Create().SetAppID(a.ID).SetName("editor").Save(ctx)
+		assert.NoError(t, err)
+		_, err = client.User.Update().Where(entuser.EmailIn("ada@example.com", "barbara@example.com")).AddRoles(r2).Save(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ada@example.com", "grace@example.com", "barbara@example.com"},
+			listAll(ListUsersRequest{RoleIDs: []int{r.ID, r2.ID}, Limit: 2}))
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := svc.List(ctx, ListUsersRequest{Sort: "password"})
+		assert.ErrorIs(t, err, ErrInvalidSort)
+
+		page, err := svc.List(ctx, ListUsersRequest{Sort: "email", Limit: 1})
+		assert.NoError(t, err)
+		_, err = svc.List(ctx, ListUsersRequest{Sort: "email", Desc: true, Cursor: page.NextCursor})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+		_, err = svc.List(ctx, ListUsersRequest{Cursor: "bm90IGEgY3Vyc29y"})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestService_Search(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_user_search?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	svc := NewUserService(NewUserRepository(client), auth.NewJWTManager("secret", time.Hour))
+	ctx := context.Background()
+
+	appA, err := client.App.Create().SetName("Search A").Save(ctx)
+	assert.NoError(t, err)
+	appB, err := client.App.Create().SetName("Search B").Save(ctx)
+	assert.NoError(t, err)
+
+	newUser := func(appID int, first, last, email string) int {
+		u, err := client.User.Create().SetAppID(appID).SetFirstname(first).SetLastname(last).
+			SetEmail(email).SetPassword("hash").Save(ctx)
+		assert.NoError(t, err)
+		return u.ID
+	}
+	// Ada exists before the index, which must pick her up when it is built.
+	newUser(appA.ID, "Ada", "Lovelace", "ada@example.com")
+	if err := db.EnsureUserSearch(ctx, client); errors.Is(err, db.ErrSearchUnavailable) {
+		t.Skip("sqlite3 driver built without FTS5, run with -tags sqlite_fts5")
+	} else {
+		assert.NoError(t, err)
 	}
+	newUser(appA.ID, "Adam", "Smith", "adam@example.com")
+	graceID := newUser(appA.ID, "Grace", "Hopper", "grace@lovelace.example")
+	newUser(appA.ID, "José", "Álvarez", "jose@example.com")
+	newUser(appA.ID, "<b>Eve", "Moneypenny", "eve@example.com")
+	newUser(appB.ID, "Adele", "Goldberg", "adele@example.com")
+
+	tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: appA.ID})
+	adminCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: appA.ID, PlatformAdmin: true})
+
+	search := func(ctx context.Context, req SearchUsersRequest) []string {
+		page, err := svc.Search(ctx, req)
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		assert.GreaterOrEqual(t, page.Total, len(page.Results))
+		var emails []string
+		for _, r := range page.Results {
+			emails = append(emails, r.User.Email)
+		}
+		return emails
+	}
+
+	t.Run("Prefix", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"ada@example.com", "adam@example.com"}, search(tenantCtx, SearchUsersRequest{Q: "ad"}))
+		assert.Equal(t, []string{"adam@example.com"}, search(tenantCtx, SearchUsersRequest{Q: "ad smi"}))
+		assert.Equal(t, []string{"ada@example.com"}, search(tenantCtx, SearchUsersRequest{Q: "ada@exa"}))
+	})
+
+	t.Run("Rank", func(t *testing.T) {
+		// A last name outweighs an email domain.
+		page, err := svc.Search(tenantCtx, SearchUsersRequest{Q: "lovelace"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, page.Total)
+		if assert.Len(t, page.Results, 2) {
+			assert.Equal(t, "ada@example.com", page.Results[0].User.Email)
+			assert.Greater(t, page.Results[0].Score, page.Results[1].Score)
+		}
+
+		page, err = svc.Search(tenantCtx, SearchUsersRequest{Q: "lovelace", Limit: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, page.Total)
+		assert.Len(t, page.Results, 1)
+	})
+
+	t.Run("Highlight", func(t *testing.T) {
+		page, err := svc.Search(tenantCtx, SearchUsersRequest{Q: "ada love"})
+		assert.NoError(t, err)
+		if assert.Len(t, page.Results, 1) {
+			assert.Equal(t, UserHighlight{
+				Firstname: "<mark>Ada</mark>",
+				Lastname:  "<mark>Lovelace</mark>",
+				Email:     "<mark>ada</mark>@example.com",
+			}, page.Results[0].Highlight)
+		}
+
+		page, err = svc.Search(tenantCtx, SearchUsersRequest{Q: "eve"})
+		assert.NoError(t, err)
+		if assert.Len(t, page.Results, 1) {
+			assert.Equal(t, "&lt;b&gt;<mark>Eve</mark>", page.Results[0].Highlight.Firstname)
+		}
+	})
+
+	t.Run("Diacritics", func(t *testing.T) {
+		page, err := svc.Search(tenantCtx, SearchUsersRequest{Q: "jose alvarez"})
+		assert.NoError(t, err)
+		if assert.Len(t, page.Results, 1) {
+			assert.Equal(t, "<mark>José</mark>", page.Results[0].Highlight.Firstname)
+		}
+	})
+
+	t.Run("ScopedToApp", func(t *testing.T) {
+		assert.Equal(t, []string{"ada@example.com", "adam@example.com"}, sorted(search(tenantCtx, SearchUsersRequest{Q: "ad"})))
+		// Tenants cannot search another app.
+		assert.Empty(t, search(tenantCtx, SearchUsersRequest{Q: "ad", AppID: &appB.ID}))
+		assert.Equal(t, []string{"ada@example.com", "adam@example.com", "adele@example.com"}, sorted(search(adminCtx, SearchUsersRequest{Q: "ad"})))
+		assert.Equal(t, []string{"adele@example.com"}, search(adminCtx, SearchUsersRequest{Q: "ad", AppID: &appB.ID}))
+	})
+
+	t.Run("FollowsChanges", func(t *testing.T) {
+		_, err := client.User.UpdateOneID(graceID).SetLastname("Brewster").Save(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, search(tenantCtx, SearchUsersRequest{Q: "hopper"}))
+		assert.Equal(t, []string{"grace@lovelace.example"}, search(tenantCtx, SearchUsersRequest{Q: "brew"}))
+
+		assert.NoError(t, client.User.DeleteOneID(graceID).Exec(ctx))
+		assert.Empty(t, search(tenantCtx, SearchUsersRequest{Q: "brew"}))
+	})
+
+	t.Run("Rebuild", func(t *testing.T) {
+		assert.NoError(t, client.Schema.Create(ctx))
+		assert.NoError(t, db.EnsureUserSearch(ctx, client))
+		assert.Equal(t, []string{"ada@example.com", "adam@example.com"}, sorted(search(tenantCtx, SearchUsersRequest{Q: "ad"})))
+	})
+
+	t.Run("NoWords", func(t *testing.T) {
+		_, err := svc.Search(tenantCtx, SearchUsersRequest{Q: `* "`})
+		assert.ErrorIs(t, err, ErrInvalidSearch)
+	})
+}
+
+func sorted(s []string) []string {
+	slices.Sort(s)
+	return s
+}
+
+func TestService_TenantIsolation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_tenant?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	jwtManager.SetUserStatusChecker(NewStatusChecker(repo))
+	svc := NewUserService(repo, jwtManager)
+
+	ctx := context.Background()
+
+	appA, err := client.App.Create().SetName("Tenant A").Save(ctx)
+	assert.NoError(t, err)
+	appB, err := client.App.Create().SetName("Tenant B").Save(ctx)
+	assert.NoError(t, err)
+
+	userA, err := svc.Create(ctx, CreateUserRequest{AppID: appA.ID, Firstname: "Alice", Lastname: "A", Email: "alice@a.example.com", Password: "password123"})
+	assert.NoError(t, err)
+	userB, err := svc.Create(ctx, CreateUserRequest{AppID: appB.ID, Firstname: "Bob", Lastname: "B", Email: "bob@b.example.com", Password: "password123"})
+	assert.NoError(t, err)
+
+	tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: appA.ID, UserID: userA.ID})
+	adminCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: appA.ID, UserID: userA.ID, PlatformAdmin: true})
+
+	t.Run("ListOwnAppOnly", func(t *testing.T) {
+		page, err := svc.List(tenantCtx, ListUsersRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Users, 1)
+		assert.Equal(t, 1, page.Total)
+		assert.Equal(t, userA.ID, page.Users[0].ID)
+
+		page, err = svc.List(tenantCtx, ListUsersRequest{AppID: &appB.ID})
+		assert.NoError(t, err)
+		assert.Empty(t, page.Users)
+	})
+
+	t.Run("OtherAppHidden", func(t *testing.T) {
+		_, err := svc.GetByID(tenantCtx, userB.ID)
+		assert.Error(t, err)
+
+		name := "Mallory"
+		_, err = svc.Update(tenantCtx, userB.ID, UpdateUserRequest{Firstname: &name})
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		err = svc.Delete(tenantCtx, userB.ID)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+
+		err = svc.RevokeSessions(tenantCtx, userB.ID)
+		assert.ErrorIs(t, err, ErrUserNotFound)
+	})
+
+	t.Run("CannotCreateInOtherApp", func(t *testing.T) {
+		_, err := svc.Create(tenantCtx, CreateUserRequest{AppID: appB.ID, Firstname: "Eve", Lastname: "B", Email: "eve@b.example.com", Password: "password123"})
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		u, err := svc.Create(tenantCtx, CreateUserRequest{AppID: appA.ID, Firstname: "Carol", Lastname: "A", Email: "carol@a.example.com", Password: "password123"})
+		assert.NoError(t, err)
+		assert.Equal(t, appA.ID, u.AppID)
+	})
+
+	t.Run("CannotMoveUserToOtherApp", func(t *testing.T) {
+		_, err := svc.Update(tenantCtx, userA.ID, UpdateUserRequest{AppID: &appB.ID})
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		u, err := svc.GetByID(ctx, userA.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, appA.ID, u.AppID)
+	})
+
+	t.Run("CannotGrantPlatformAdmin", func(t *testing.T) {
+		admin := true
+		_, err := svc.Update(tenantCtx, userA.ID, UpdateUserRequest{PlatformAdmin: &admin})
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		name := "Alicia"
+		u, err := svc.Update(tenantCtx, userA.ID, UpdateUserRequest{Firstname: &name})
+		assert.NoError(t, err)
+		assert.Equal(t, name, u.Firstname)
+		assert.False(t, u.PlatformAdmin)
+	})
+
+	t.Run("PlatformAdmin", func(t *testing.T) {
+		page, err := svc.List(adminCtx, ListUsersRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, page.Users, 3)
+
+		page, err = svc.List(adminCtx, ListUsersRequest{AppID: &appB.ID})
+		assert.NoError(t, err)
+		assert.Len(t, page.Users, 1)
+		assert.Equal(t, userB.ID, page.Users[0].ID)
+
+		admin := true
+		u, err := svc.Update(adminCtx, userB.ID, UpdateUserRequest{AppID: &appA.ID, PlatformAdmin: &admin})
+		assert.NoError(t, err)
+		assert.Equal(t, appA.ID, u.AppID)
+		assert.True(t, u.PlatformAdmin)
+
+		// The move revoked the user's sessions until the next second; move
+		// the cut-off back before the next login.
+		assert.NoError(t, repo.RevokeSessions(ctx, userB.ID, time.Now().Add(-time.Second)))
+		login, err := svc.Authenticate(ctx, AuthRequest{Email: "bob@b.example.com", Password: "password123"})
+		assert.NoError(t, err)
+		claims, err := jwtManager.Validate(ctx, login.Token)
+		assert.NoError(t, err)
+		assert.True(t, claims.PlatformAdmin)
+
+		// Tokens issued to OAuth clients never carry platform administration.
+		oauth, err := svc.IssueTokens(ctx, userB.ID, "openid")
+		assert.NoError(t, err)
+		claims, err = jwtManager.Validate(ctx, oauth.Token)
+		assert.NoError(t, err)
+		assert.False(t, claims.PlatformAdmin)
+
+		// Demoting the user invalidates tokens still claiming the role.
+		admin = false
+		_, err = svc.Update(adminCtx, userB.ID, UpdateUserRequest{PlatformAdmin: &admin})
+		assert.NoError(t, err)
+		_, err = jwtManager.Validate(ctx, login.Token)
+		assert.ErrorIs(t, err, ErrSessionRevoked)
+	})
+}
+
+func TestService_SetRoles(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_set_roles?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	svc := NewUserService(repo, jwtManager)
+
+	ctx := context.Background()
+	email := "roles@example.com"
+	password := "password123"
+
+	app, err := client.App.Create().SetName("Roles App").Save(ctx)
+	assert.NoError(t, err)
+	otherApp, err := client.App.Create().SetName("Other Roles App").Save(ctx)
+	assert.NoError(t, err)
+
+	read, err := client.Permission.Create().SetName(PermissionRead).Save(ctx)
+	assert.NoError(t, err)
+	write, err := client.Permission.Create().SetName(PermissionWrite).Save(ctx)
+	assert.NoError(t, err)
+
+	viewer, err := client.Role.Create().SetAppID(app.ID).SetName("viewer").AddPermissions(read).Save(ctx)
+	assert.NoError(t, err)
+	support, err := client.Role.Create().SetAppID(app.ID).SetName("support").AddPermissions(read, write).Save(ctx)
+	assert.NoError(t, err)
+	foreign, err := client.Role.Create().SetAppID(otherApp.ID).SetName("admin").AddPermissions(write).Save(ctx)
+	assert.NoError(t, err)
+
+	u, err := svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "Roles",
+		Lastname:  "User",
+		Email:     email,
+		Password:  password,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, u.Roles)
+
+	u, err = svc.SetRoles(ctx, u.ID, SetRolesRequest{RoleIDs: []int{viewer.ID, support.ID, viewer.ID}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"support", "viewer"}, u.Roles)
+
+	login, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.NoError(t, err)
+	claims, err := jwtManager.Validate(ctx, login.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"support", "viewer"}, claims.Roles)
+	assert.Equal(t, []string{PermissionRead, PermissionWrite}, claims.Permissions)
+	assert.True(t, claims.HasPermission(PermissionWrite))
+
+	t.Run("RoleOfOtherApp", func(t *testing.T) {
+		_, err := svc.SetRoles(ctx, u.ID, SetRolesRequest{RoleIDs: []int{viewer.ID, foreign.ID}})
+		assert.ErrorIs(t, err, ErrInvalidRole)
+	})
+
+	t.Run("UnknownRole", func(t *testing.T) {
+		_, err := svc.SetRoles(ctx, u.ID, SetRolesRequest{RoleIDs: []int{9999}})
+		assert.ErrorIs(t, err, ErrInvalidRole)
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		u, err := svc.SetRoles(ctx, u.ID, SetRolesRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, u.Roles)
+	})
+
+	t.Run("OAuthTokensCarryNoRoles", func(t *testing.T) {
+		_, err := svc.SetRoles(ctx, u.ID, SetRolesRequest{RoleIDs: []int{support.ID}})
+		assert.NoError(t, err)
+
+		res, err := svc.IssueTokens(ctx, u.ID, "openid")
+		assert.NoError(t, err)
+		claims, err := jwtManager.Validate(ctx, res.Token)
+		assert.NoError(t, err)
+		assert.Empty(t, claims.Roles)
+		assert.False(t, claims.HasPermission(PermissionRead))
+	})
+
+	t.Run("MovedToOtherApp", func(t *testing.T) {
+		_, err := svc.SetRoles(ctx, u.ID, SetRolesRequest{RoleIDs: []int{support.ID}})
+		assert.NoError(t, err)
+
+		moved, err := svc.Update(ctx, u.ID, UpdateUserRequest{AppID: &otherApp.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, otherApp.ID, moved.AppID)
+		assert.Empty(t, moved.Roles)
+
+		login, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+		claims, err := jwtManager.Validate(ctx, login.Token)
+		assert.NoError(t, err)
+		assert.Equal(t, otherApp.ID, claims.AppID)
+		assert.Empty(t, claims.Roles)
+		assert.False(t, claims.HasPermission(PermissionWrite))
+	})
+}
+
+func BenchmarkService_Create(b *testing.B) {
+	client := enttest.Open(b, "sqlite3", "file:ent_bench_create?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	svc := NewUserService(repo, jwtManager)
+
+	ctx := context.Background()
+	app, _ := client.App.Create().SetName("Bench App").Save(ctx)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		email := "bench_" + string(rune(i)) + "@example.com"
+		_, _ = svc.Create(ctx, CreateUserRequest{
+			AppID:     app.ID,
+			Firstname: "Bench",
+			Lastname:  "User",
+			Email:     email,
+			Password:  "password123",
+		})
+	}
+}
+
+func BenchmarkService_Authenticate(b *testing.B) {
+	client := enttest.Open(b, "sqlite3", "file:ent_bench_auth?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	svc := NewUserService(repo, jwtManager)
+
+	ctx := context.Background()
+	email := "bench_auth@example.com"
+	password := "password123"
+
+	app, _ := client.App.Create().SetName("Bench Auth App").Save(ctx)
+	_, _ = svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "Bench",
+		Lastname:  "Auth",
+		Email:     email,
+		Password:  password,
+	})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = svc.Authenticate(ctx, AuthRequest{
+			Email:    email,
+			Password: password,
+		})
+	}
+}
+
+// captureNotifier records the tokens it is asked to send.
+type captureNotifier struct {
+	tokens       []string
+	verifyTokens []string
+	links        []string
+}
+
+func (n *captureNotifier) PasswordReset(_ context.Context, _ *User, token string, _ time.Time) error {
+	n.tokens = append(n.tokens, token)
+	return nil
+}
+
+func (n *captureNotifier) EmailVerification(_ context.Context, _ *User, token string, _ time.Time) error {
+	n.verifyTokens = append(n.verifyTokens, token)
+	return nil
+}
+
+func (n *captureNotifier) MagicLink(_ context.Context, _ *User, link string, _ time.Time) error {
+	n.links = append(n.links, link)
+	return nil
+}
+
+func TestService_PasswordReset(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_password_reset?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	jwtManager.SetUserStatusChecker(NewStatusChecker(repo))
+	notifier := &captureNotifier{}
+	svc := NewUserService(repo, jwtManager, WithNotifier(notifier))
+
+	ctx := context.Background()
+	email := "reset@example.com"
+	password := "password123"
+	newPassword := "newpassword456"
+
+	app, err := client.App.Create().SetName("Reset App").Save(ctx)
+	assert.NoError(t, err)
+	_, err = svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "Reset",
+		Lastname:  "User",
+		Email:     email,
+		Password:  password,
+	})
+	assert.NoError(t, err)
+
+	login, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.NoError(t, err)
+
+	t.Run("UnknownEmail", func(t *testing.T) {
+		err := svc.ForgotPassword(ctx, ForgotPasswordRequest{Email: "nobody@example.com"})
+		assert.NoError(t, err)
+		assert.Empty(t, notifier.tokens)
+	})
+
+	// Only the latest token can be used.
+	assert.NoError(t, svc.ForgotPassword(ctx, ForgotPasswordRequest{Email: email}))
+	assert.NoError(t, svc.ForgotPassword(ctx, ForgotPasswordRequest{Email: email}))
+	assert.Len(t, notifier.tokens, 2)
+
+	err = svc.ResetPassword(ctx, ResetPasswordRequest{Token: notifier.tokens[0], Password: newPassword})
+	assert.ErrorIs(t, err, ErrInvalidResetToken)
+
+	err = svc.ResetPassword(ctx, ResetPasswordRequest{Token: notifier.tokens[1], Password: newPassword})
+	assert.NoError(t, err)
+
+	_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: newPassword})
+	assert.NoError(t, err)
+
+	// Sessions from before the reset are revoked, even when issued in the
+	// same second.
+	_, err = jwtManager.Validate(ctx, login.Token)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+	_, err = svc.Refresh(ctx, RefreshRequest{RefreshToken: login.RefreshToken})
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+
+	t.Run("SingleUse", func(t *testing.T) {
+		err := svc.ResetPassword(ctx, ResetPasswordRequest{Token: notifier.tokens[1], Password: "anotherpassword"})
+		assert.ErrorIs(t, err, ErrInvalidResetToken)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		expiring := NewUserService(repo, jwtManager, WithNotifier(notifier), WithPasswordResetExpiry(time.Nanosecond))
+		assert.NoError(t, expiring.ForgotPassword(ctx, ForgotPasswordRequest{Email: email}))
+		time.Sleep(time.Millisecond)
+
+		err := expiring.ResetPassword(ctx, ResetPasswordRequest{Token: notifier.tokens[len(notifier.tokens)-1], Password: "anotherpassword"})
+		assert.ErrorIs(t, err, ErrInvalidResetToken)
+	})
+}
+
+func TestService_EmailVerification(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_email_verification?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	notifier := &captureNotifier{}
+	svc := NewUserService(NewUserRepository(client), auth.NewJWTManager("secret", time.Hour), WithNotifier(notifier))
+
+	ctx := context.Background()
+	email := "verify@example.com"
+	password := "password123"
+
+	app, err := client.App.Create().SetName("Verify App").SetRequireVerifiedEmail(true).Save(ctx)
+	assert.NoError(t, err)
+
+	u, err := svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "Verify",
+		Lastname:  "User",
+		Email:     email,
+		Password:  password,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, u.EmailVerifiedAt)
+	assert.Len(t, notifier.verifyTokens, 1)
+
+	_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.ErrorIs(t, err, ErrEmailNotVerified)
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		_, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: "wrongpassword"})
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+
+	assert.NoError(t, svc.VerifyEmail(ctx, VerifyEmailRequest{Token: notifier.verifyTokens[0]}))
+
+	got, err := svc.GetByID(ctx, u.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, got.EmailVerifiedAt)
+
+	_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.NoError(t, err)
+
+	t.Run("SingleUse", func(t *testing.T) {
+		err := svc.VerifyEmail(ctx, VerifyEmailRequest{Token: notifier.verifyTokens[0]})
+		assert.ErrorIs(t, err, ErrInvalidVerificationToken)
+	})
+
+	t.Run("ResendForVerifiedEmail", func(t *testing.T) {
+		assert.NoError(t, svc.ResendVerification(ctx, ResendVerificationRequest{Email: email}))
+		assert.Len(t, notifier.verifyTokens, 1)
+	})
+
+	t.Run("EmailChange", func(t *testing.T) {
+		newEmail := "verify-new@example.com"
+		updated, err := svc.Update(ctx, u.ID, UpdateUserRequest{Email: &newEmail})
+		assert.NoError(t, err)
+		assert.Nil(t, updated.EmailVerifiedAt)
+		assert.Len(t, notifier.verifyTokens, 2)
+
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: newEmail, Password: password})
+		assert.ErrorIs(t, err, ErrEmailNotVerified)
+
+		// A token for the new address stops working if the email changes again.
+		otherEmail := "verify-other@example.com"
+		_, err = svc.Update(ctx, u.ID, UpdateUserRequest{Email: &otherEmail})
+		assert.NoError(t, err)
+		_, err = svc.Update(ctx, u.ID, UpdateUserRequest{Email: &newEmail})
+		assert.NoError(t, err)
+		err = svc.VerifyEmail(ctx, VerifyEmailRequest{Token: notifier.verifyTokens[1]})
+		assert.ErrorIs(t, err, ErrInvalidVerificationToken)
+
+		verified := true
+		updated, err = svc.Update(ctx, u.ID, UpdateUserRequest{EmailVerified: &verified})
+		assert.NoError(t, err)
+		assert.NotNil(t, updated.EmailVerifiedAt)
+	})
+}
+
+func TestService_MFA(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_mfa?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	svc := NewUserService(repo, auth.NewJWTManager("secret", time.Hour))
+
+	ctx := context.Background()
+	email := "mfa@example.com"
+	password := "password123"
+
+	app, err := client.App.Create().SetName("MFA App").Save(ctx)
+	assert.NoError(t, err)
+
+	u, err := svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "MFA",
+		Lastname:  "User",
+		Email:     email,
+		Password:  password,
+	})
+	assert.NoError(t, err)
+	assert.False(t, u.MFAEnabled)
+
+	code := func(secret string, offset int64) string {
+		c, err := auth.TOTPCode(secret, auth.TOTPStep(time.Now())+offset)
+		assert.NoError(t, err)
+		return c
+	}
+	// forgetLastStep pretends the last TOTP code was used long ago, so codes
+	// of the current steps are accepted again.
+	forgetLastStep := func() {
+		_, err := client.User.UpdateOneID(u.ID).SetTotpLastStep(0).Save(ctx)
+		assert.NoError(t, err)
+	}
+
+	enrollment, err := svc.EnrollMFA(ctx, u.ID)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, enrollment.Secret)
+	assert.Contains(t, enrollment.URI, "otpauth://totp/MFA%20App:mfa@example.com")
+
+	// An unconfirmed enrolment does not change the login.
+	res, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.Token)
+	assert.Nil(t, res.MFA)
+
+	_, err = svc.ConfirmMFA(ctx, u.ID, MFACodeRequest{Code: "000000"})
+	assert.ErrorIs(t, err, ErrInvalidMFACode)
+
+	recovery, err := svc.ConfirmMFA(ctx, u.ID, MFACodeRequest{Code: code(enrollment.Secret, 0)})
+	assert.NoError(t, err)
+	assert.Len(t, recovery.RecoveryCodes, recoveryCodeCount)
+
+	_, err = svc.EnrollMFA(ctx, u.ID)
+	assert.ErrorIs(t, err, ErrMFAAlreadyEnabled)
+
+	got, err := svc.GetByID(ctx, u.ID)
+	assert.NoError(t, err)
+	assert.True(t, got.MFAEnabled)
+
+	challenge := func(t *testing.T) string {
+		res, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Token)
+		assert.NotNil(t, res.MFA)
+		assert.False(t, res.MFA.EnrollmentRequired)
+		return res.MFA.Token
+	}
+
+	t.Run("TOTP", func(t *testing.T) {
+		token := challenge(t)
+
+		// The code was spent confirming the enrolment.
+		_, err := svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: token, Code: code(enrollment.Secret, 0)})
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+
+		res, err := svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: token, Code: code(enrollment.Secret, 1)})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+		assert.True(t, res.User.MFAEnabled)
+		assert.Empty(t, res.RecoveryCodes)
+
+		_, err = svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: token, Code: code(enrollment.Secret, 1)})
+		assert.ErrorIs(t, err, ErrInvalidMFAToken)
+	})
+
+	t.Run("RecoveryCode", func(t *testing.T) {
+		typed := strings.ToUpper(strings.ReplaceAll(recovery.RecoveryCodes[0], "-", ""))
+		_, err := svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: challenge(t), Code: typed})
+		assert.NoError(t, err)
+
+		_, err = svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: challenge(t), Code: recovery.RecoveryCodes[0]})
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+	})
+
+	t.Run("AttemptLimit", func(t *testing.T) {
+		forgetLastStep()
+		token := challenge(t)
+		for range maxMFAAttempts {
+			_, err := svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: token, Code: "000000"})
+			assert.ErrorIs(t, err, ErrInvalidMFACode)
+		}
+		_, err := svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: token, Code: code(enrollment.Secret, 0)})
+		assert.ErrorIs(t, err, ErrInvalidMFAToken)
+	})
+
+	t.Run("ExpiredChallenge", func(t *testing.T) {
+		expired := NewUserService(repo, auth.NewJWTManager("secret", time.Hour), WithMFAChallengeExpiry(time.Nanosecond))
+		res, err := expired.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+		_, err = svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: res.MFA.Token, Code: recovery.RecoveryCodes[1]})
+		assert.ErrorIs(t, err, ErrInvalidMFAToken)
+	})
+
+	t.Run("RegenerateRecoveryCodes", func(t *testing.T) {
+		_, err := svc.RegenerateRecoveryCodes(ctx, u.ID, MFACodeRequest{Code: recovery.RecoveryCodes[1]})
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+
+		forgetLastStep()
+		regenerated, err := svc.RegenerateRecoveryCodes(ctx, u.ID, MFACodeRequest{Code: code(enrollment.Secret, 0)})
+		assert.NoError(t, err)
+		assert.Len(t, regenerated.RecoveryCodes, recoveryCodeCount)
+
+		_, err = svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: challenge(t), Code: recovery.RecoveryCodes[1]})
+		assert.ErrorIs(t, err, ErrInvalidMFACode)
+		recovery = regenerated
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		assert.NoError(t, svc.DisableMFA(ctx, u.ID, MFACodeRequest{Code: recovery.RecoveryCodes[0]}))
+
+		res, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+		assert.False(t, res.User.MFAEnabled)
+
+		err = svc.DisableMFA(ctx, u.ID, MFACodeRequest{Code: recovery.RecoveryCodes[1]})
+		assert.ErrorIs(t, err, ErrMFANotEnabled)
+	})
+
+	t.Run("RequiredByApp", func(t *testing.T) {
+		_, err := client.App.UpdateOneID(app.ID).SetRequireMfa(true).Save(ctx)
+		assert.NoError(t, err)
+
+		res, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Token)
+		assert.True(t, res.MFA.EnrollmentRequired)
+
+		_, err = svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: res.MFA.Token, Code: "000000"})
+		assert.ErrorIs(t, err, ErrMFAEnrollmentRequired)
+
+		enrollment, err := svc.EnrollMFAChallenge(ctx, MFAEnrollChallengeRequest{MFAToken: res.MFA.Token})
+		assert.NoError(t, err)
+
+		forgetLastStep()
+		enrolled, err := svc.AuthenticateMFA(ctx, MFAAuthRequest{MFAToken: res.MFA.Token, Code: code(enrollment.Secret, 0)})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, enrolled.Token)
+		assert.True(t, enrolled.User.MFAEnabled)
+		assert.Len(t, enrolled.RecoveryCodes, recoveryCodeCount)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		assert.NoError(t, svc.ResetMFA(ctx, u.ID))
+
+		got, err := svc.GetByID(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.False(t, got.MFAEnabled)
+
+		assert.ErrorIs(t, svc.ResetMFA(ctx, 9999), ErrUserNotFound)
+	})
+}
+
+// softAuthenticator is an ES256 authenticator that creates and signs
+// WebAuthn credentials in memory.
+type softAuthenticator struct {
+	t         *testing.T
+	key       *ecdsa.PrivateKey
+	id        []byte
+	signCount uint32
+	rpID      string
+	origin    string
+}
+
+func newSoftAuthenticator(t *testing.T, rpID string) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	id := make([]byte, 16)
+	_, err = rand.Read(id)
+	assert.NoError(t, err)
+	return &softAuthenticator{t: t, key: key, id: id, rpID: rpID, origin: "https://" + rpID}
+}
+
+// cborItem encodes the unsigned integer or length arg with a CBOR major type.
+func cborItem(major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		return []byte{major<<5 | 24, byte(arg)}
+	default:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+	}
+}
+
+// cborPairs is a CBOR map with its keys in order.
+type cborPairs [][2]any
+
+func cborEncode(v any) []byte {
+	switch v := v.(type) {
+	case int:
+		if v < 0 {
+			return cborItem(1, uint64(-1-v))
+		}
+		return cborItem(0, uint64(v))
+	case []byte:
+		return append(cborItem(2, uint64(len(v))), v...)
+	case string:
+		return append(cborItem(3, uint64(len(v))), v...)
+	case cborPairs:
+		b := cborItem(5, uint64(len(v)))
+		for _, kv := range v {
+			b = append(b, cborEncode(kv[0])...)
+			b = append(b, cborEncode(kv[1])...)
+		}
+		return b
+	default:
+		panic(fmt.Sprintf("cbor: unsupported type %T", v))
+	}
+}
+
+func (a *softAuthenticator) clientData(typ string, challenge []byte) []byte {
+	b, err := json.Marshal(map[string]any{
+		"type":      typ,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    a.origin,
+	})
+	assert.NoError(a.t, err)
+	return b
+}
+
+func (a *softAuthenticator) authData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	b := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(b, a.signCount)
+}
+
+func (a *softAuthenticator) register(opts *PasskeyCreationOptions) webauthn.RegistrationCredential {
+	point, err := a.key.PublicKey.Bytes()
+	assert.NoError(a.t, err)
+	coseKey := cborEncode(cborPairs{{1, 2}, {3, -7}, {-1, 1}, {-2, point[1:33]}, {-3, point[33:]}})
+
+	authData := a.authData(0x45) // user present, user verified, attested data
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.id)))
+	authData = append(append(authData, a.id...), coseKey...)
+
+	return webauthn.RegistrationCredential{
+		ID:    base64.RawURLEncoding.EncodeToString(a.id),
+		RawID: a.id,
+		Type:  "public-key",
+		Response: webauthn.AttestationResponse{
+			ClientDataJSON:    a.clientData("webauthn.create", opts.PublicKey.Challenge),
+			AttestationObject: cborEncode(cborPairs{{"fmt", "none"}, {"attStmt", cborPairs{}}, {"authData", authData}}),
+			Transports:        []string{"internal"},
+		},
+	}
+}
+
+func (a *softAuthenticator) login(opts *PasskeyRequestOptions, userHandle []byte) webauthn.AssertionCredential {
+	a.signCount++
+	authData := a.authData(0x05) // user present, user verified
+	clientData := a.clientData("webauthn.get", opts.PublicKey.Challenge)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(slices.Clone(authData), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	assert.NoError(a.t, err)
+
+	return webauthn.AssertionCredential{
+		ID:    base64.RawURLEncoding.EncodeToString(a.id),
+		RawID: a.id,
+		Type:  "public-key",
+		Response: webauthn.AssertionResponse{
+			ClientDataJSON:    clientData,
+			AuthenticatorData: authData,
+			Signature:         sig,
+			UserHandle:        userHandle,
+		},
+	}
+}
+
+func TestService_Passkeys(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_passkeys?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	svc := NewUserService(repo, auth.NewJWTManager("secret", time.Hour))
+
+	ctx := context.Background()
+	email := "passkey@example.com"
+
+	app, err := client.App.Create().SetName("Passkey App").Save(ctx)
+	assert.NoError(t, err)
+
+	u, err := svc.Create(ctx, CreateUserRequest{
+		AppID:     app.ID,
+		Firstname: "Passkey",
+		Lastname:  "User",
+		Email:     email,
+		Password:  "password123",
+	})
+	assert.NoError(t, err)
+
+	_, err = svc.BeginPasskeyRegistration(ctx, u.ID)
+	assert.ErrorIs(t, err, ErrPasskeysNotConfigured)
+
+	_, err = client.App.UpdateOneID(app.ID).SetWebauthnRpID("example.com").Save(ctx)
+	assert.NoError(t, err)
+
+	authn := newSoftAuthenticator(t, "example.com")
+	creation, err := svc.BeginPasskeyRegistration(ctx, u.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", creation.PublicKey.RP.ID)
+	assert.Equal(t, "Passkey User", creation.PublicKey.User.DisplayName)
+	assert.Empty(t, creation.PublicKey.ExcludeCredentials)
+
+	registration := authn.register(creation)
+	passkey, err := svc.FinishPasskeyRegistration(ctx, u.ID, PasskeyRegistrationRequest{Name: "Laptop", Credential: registration})
+	assert.NoError(t, err)
+	assert.Equal(t, "Laptop", passkey.Name)
+	assert.Equal(t, []string{"internal"}, passkey.Transports)
+
+	t.Run("RegisterTwice", func(t *testing.T) {
+		_, err := svc.FinishPasskeyRegistration(ctx, u.ID, PasskeyRegistrationRequest{Credential: registration})
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+
+		creation, err := svc.BeginPasskeyRegistration(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Len(t, creation.PublicKey.ExcludeCredentials, 1)
+		_, err = svc.FinishPasskeyRegistration(ctx, u.ID, PasskeyRegistrationRequest{Credential: authn.register(creation)})
+		assert.ErrorIs(t, err, ErrPasskeyAlreadyRegistered)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		passkeys, err := svc.ListPasskeys(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Len(t, passkeys, 1)
+		assert.Nil(t, passkeys[0].LastUsedAt)
+	})
+
+	t.Run("Login", func(t *testing.T) {
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: app.ID, Email: email})
+		assert.NoError(t, err)
+		assert.Len(t, opts.PublicKey.AllowCredentials, 1)
+
+		assertion := authn.login(opts, userHandle(u.ID))
+		res, err := svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: assertion})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+		assert.NotEmpty(t, res.RefreshToken)
+		assert.Equal(t, u.ID, res.User.ID)
+
+		_, err = svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: assertion})
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+
+		passkeys, err := svc.ListPasskeys(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, passkeys[0].LastUsedAt)
+	})
+
+	t.Run("DiscoverableLogin", func(t *testing.T) {
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: app.ID})
+		assert.NoError(t, err)
+		assert.Empty(t, opts.PublicKey.AllowCredentials)
+
+		res, err := svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: authn.login(opts, userHandle(u.ID))})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+	})
+
+	t.Run("UnknownEmail", func(t *testing.T) {
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: app.ID, Email: "nobody@example.com"})
+		assert.NoError(t, err)
+		assert.Empty(t, opts.PublicKey.AllowCredentials)
+	})
+
+	t.Run("ClonedAuthenticator", func(t *testing.T) {
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: app.ID})
+		assert.NoError(t, err)
+		authn.signCount--
+		_, err = svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: authn.login(opts, nil)})
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+	})
+
+	t.Run("WrongOrigin", func(t *testing.T) {
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: app.ID})
+		assert.NoError(t, err)
+		authn.origin = "https://evil.example"
+		defer func() { authn.origin = "https://example.com" }()
+		_, err = svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: authn.login(opts, nil)})
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+	})
+
+	t.Run("OtherApp", func(t *testing.T) {
+		other, err := client.App.Create().SetName("Other App").SetWebauthnRpID("example.com").Save(ctx)
+		assert.NoError(t, err)
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: other.ID})
+		assert.NoError(t, err)
+		_, err = svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: authn.login(opts, nil)})
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+	})
+
+	t.Run("SkipsMFA", func(t *testing.T) {
+		_, err := client.App.UpdateOneID(app.ID).SetRequireMfa(true).Save(ctx)
+		assert.NoError(t, err)
+
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: app.ID})
+		assert.NoError(t, err)
+		res, err := svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: authn.login(opts, nil)})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+		assert.Nil(t, res.MFA)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		assert.ErrorIs(t, svc.DeletePasskey(ctx, u.ID+1, passkey.ID), ErrPasskeyNotFound)
+		assert.NoError(t, svc.DeletePasskey(ctx, u.ID, passkey.ID))
+		assert.ErrorIs(t, svc.DeletePasskey(ctx, u.ID, passkey.ID), ErrPasskeyNotFound)
+
+		opts, err := svc.BeginPasskeyLogin(ctx, PasskeyLoginOptionsRequest{AppID: app.ID})
+		assert.NoError(t, err)
+		_, err = svc.AuthenticatePasskey(ctx, PasskeyAuthRequest{Credential: authn.login(opts, nil)})
+		assert.ErrorIs(t, err, ErrInvalidPasskey)
+	})
+}
+
+func TestService_LoginThrottling(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_lockout?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	svc := NewUserService(repo, jwtManager)
+
+	ctx := context.Background()
+	password := "password123"
+
+	newUser := func(t *testing.T, app, email string, maxAttempts, delay, maxIPAttempts int) *User {
+		a, err := client.App.Create().
+			SetName(app).
+			SetMaxLoginAttempts(maxAttempts).
+			SetLoginDelay(delay).
+			SetMaxIPLoginAttempts(maxIPAttempts).
+			Save(ctx)
+		assert.NoError(t, err)
+		u, err := svc.Create(ctx, CreateUserRequest{AppID: a.ID, Firstname: "Lock", Lastname: "User", Email: email, Password: password})
+		assert.NoError(t, err)
+		return u
+	}
+	fail := func(t *testing.T, ctx context.Context, email string, n int) {
+		for range n {
+			_, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: "wrongpassword"})
+			assert.ErrorIs(t, err, ErrInvalidCredentials)
+		}
+	}
+
+	t.Run("ProgressiveDelay", func(t *testing.T) {
+		email := "delay@example.com"
+		u := newUser(t, "Delay App", email, 10, 1, 0)
+
+		fail(t, ctx, email, 2)
+		_, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err, "the first failures are not delayed")
+
+		fail(t, ctx, email, 3)
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.ErrorIs(t, err, ErrInvalidCredentials, "the right password is refused during the delay")
+
+		assert.NoError(t, client.User.UpdateOneID(u.ID).SetLastFailedLoginAt(time.Now().Add(-2*time.Second)).Exec(ctx))
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+
+		got, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Zero(t, got.FailedLogins)
+	})
+
+	t.Run("AccountLockout", func(t *testing.T) {
+		email := "locked@example.com"
+		u := newUser(t, "Lockout App", email, 5, 0, 0)
+		adminCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: u.AppID})
+
+		fail(t, ctx, email, 5)
+		_, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+		got, err := svc.GetByID(adminCtx, u.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, got.LockedUntil)
+
+		lockouts, err := svc.ListLockouts(adminCtx, u.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, lockouts, 1) {
+			assert.Equal(t, "account", lockouts[0].Kind)
+			assert.Equal(t, 5, lockouts[0].Failures)
+			assert.Nil(t, lockouts[0].UnlockedAt)
+		}
+
+		otherCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: u.AppID + 1000})
+		assert.ErrorIs(t, svc.Unlock(otherCtx, u.ID), ErrUserNotFound)
+
+		assert.NoError(t, svc.Unlock(adminCtx, u.ID))
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err)
+
+		lockouts, err = svc.ListLockouts(adminCtx, u.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, lockouts, 1) {
+			assert.NotNil(t, lockouts[0].UnlockedAt)
+		}
+	})
+
+	t.Run("ExpiredLockout", func(t *testing.T) {
+		email := "expired@example.com"
+		u := newUser(t, "Expired App", email, 5, 0, 0)
+
+		fail(t, ctx, email, 5)
+		past := time.Now().Add(-time.Second)
+		assert.NoError(t, client.User.UpdateOneID(u.ID).SetLockedUntil(past).SetLastFailedLoginAt(past.Add(-15*time.Minute)).Exec(ctx))
+
+		fail(t, ctx, email, 1)
+		got, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.FailedLogins, "failures before the lockout expired no longer count")
+	})
+
+	t.Run("IPLockout", func(t *testing.T) {
+		email := "ip@example.com"
+		u := newUser(t, "IP App", email, 0, 0, 3)
+		attackerCtx := auth.WithClientIP(ctx, "203.0.113.7")
+
+		fail(t, attackerCtx, email, 3)
+		_, err := svc.Authenticate(attackerCtx, AuthRequest{Email: email, Password: password})
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+		_, err = svc.Authenticate(auth.WithClientIP(ctx, "198.51.100.1"), AuthRequest{Email: email, Password: password})
+		assert.NoError(t, err, "other IPs can still log in")
+
+		lockouts, err := svc.ListLockouts(ctx, u.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, lockouts, 1) {
+			assert.Equal(t, "ip", lockouts[0].Kind)
+			assert.Equal(t, "203.0.113.7", lockouts[0].IP)
+		}
+	})
+}
+
+func TestService_PasswordPolicy(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_password_policy?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	notifier := &captureNotifier{}
+	svc := NewUserService(repo, jwtManager, WithNotifier(notifier))
+
+	ctx := context.Background()
+	email := "policy@example.com"
+
+	app, err := client.App.Create().
+		SetName("Policy App").
+		SetPasswordPolicy(&password.Policy{
+			MinLength:            10,
+			RequireUppercase:     true,
+			RequireDigit:         true,
+			RequireSymbol:        true,
+			DisallowPersonalInfo: true,
+			History:              3,
+			MaxAgeDays:           90,
+		}).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	violations := func(t *testing.T, err error) []string {
+		var perr *PasswordPolicyError
+		if !assert.ErrorAs(t, err, &perr) {
+			return nil
+		}
+		codes := make([]string, len(perr.Violations))
+		for i, v := range perr.Violations {
+			codes[i] = v.Code
+		}
+		return codes
+	}
+	create := func(pw string) (*User, error) {
+		return svc.Create(ctx, CreateUserRequest{AppID: app.ID, Firstname: "Policy", Lastname: "Tester", Email: email, Password: pw})
+	}
+
+	t.Run("Rules", func(t *testing.T) {
+		_, err := create("short")
+		assert.Equal(t, []string{password.TooShort, password.MissingUppercase, password.MissingDigit, password.MissingSymbol}, violations(t, err))
+
+		_, err = create(strings.Repeat("Aa1!", 19))
+		assert.Equal(t, []string{password.TooLong}, violations(t, err))
+
+		_, err = create("My-Tester-2024")
+		assert.Equal(t, []string{password.ContainsPersonal}, violations(t, err))
+		_, err = create("POLICY@example.com1")
+		assert.Equal(t, []string{password.ContainsPersonal}, violations(t, err))
+	})
+
+	u, err := create("Correct-Horse-42")
+	assert.NoError(t, err)
+
+	t.Run("History", func(t *testing.T) {
+		update := func(pw string) error {
+			_, err := svc.Update(ctx, u.ID, UpdateUserRequest{Password: &pw})
+			return err
+		}
+
+		assert.Equal(t, []string{password.RecentlyUsed}, violations(t, update("Correct-Horse-42")))
+		assert.NoError(t, update("Second-Horse-42"))
+		assert.Equal(t, []string{password.RecentlyUsed}, violations(t, update("Correct-Horse-42")))
+		assert.NoError(t, update("Third-Horse-42"))
+		assert.NoError(t, update("Fourth-Horse-42"))
+
+		// Only the last three passwords, the current one included, are kept.
+		n, err := client.PasswordHistory.Query().Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.NoError(t, update("Correct-Horse-42"))
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		assert.NoError(t, svc.ForgotPassword(ctx, ForgotPasswordRequest{Email: email}))
+		token := notifier.tokens[len(notifier.tokens)-1]
+
+		err := svc.ResetPassword(ctx, ResetPasswordRequest{Token: token, Password: "Fourth-Horse-42"})
+		assert.Equal(t, []string{password.RecentlyUsed}, violations(t, err))
+
+		// A rejected password leaves the token usable.
+		assert.NoError(t, svc.ResetPassword(ctx, ResetPasswordRequest{Token: token, Password: "Fifth-Horse-42"}))
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: "Fifth-Horse-42"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Expiry", func(t *testing.T) {
+		current := "Fifth-Horse-42"
+		assert.NoError(t, client.User.UpdateOneID(u.ID).SetPasswordChangedAt(time.Now().AddDate(0, 0, -91)).Exec(ctx))
+
+		_, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: current})
+		assert.ErrorIs(t, err, ErrPasswordExpired)
+		_, err = svc.VerifyCredentials(ctx, email, current)
+		assert.ErrorIs(t, err, ErrPasswordExpired)
+
+		_, err = svc.ChangePassword(ctx, ChangePasswordRequest{Email: email, Password: "Wrong-Horse-42", NewPassword: "Sixth-Horse-42"})
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+		_, err = svc.ChangePassword(ctx, ChangePasswordRequest{Email: email, Password: current, NewPassword: current})
+		assert.Equal(t, []string{password.RecentlyUsed}, violations(t, err))
+
+		res, err := svc.ChangePassword(ctx, ChangePasswordRequest{Email: email, Password: current, NewPassword: "Sixth-Horse-42"})
+		assert.NoError(t, err)
+		if assert.NotNil(t, res) {
+			assert.NotEmpty(t, res.Token)
+		}
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: "Sixth-Horse-42"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("DefaultPolicy", func(t *testing.T) {
+		other, err := client.App.Create().SetName("Default Policy App").Save(ctx)
+		assert.NoError(t, err)
+
+		_, err = svc.Create(ctx, CreateUserRequest{AppID: other.ID, Firstname: "Default", Lastname: "User", Email: "default@example.com", Password: "short"})
+		assert.Equal(t, []string{password.TooShort}, violations(t, err))
+		_, err = svc.Create(ctx, CreateUserRequest{AppID: other.ID, Firstname: "Default", Lastname: "User", Email: "default@example.com", Password: "password123"})
+		assert.NoError(t, err)
+
+		// Changing a password never keeps the current one.
+		_, err = svc.ChangePassword(ctx, ChangePasswordRequest{Email: "default@example.com", Password: "password123", NewPassword: "password123"})
+		assert.Equal(t, []string{password.RecentlyUsed}, violations(t, err))
+	})
+}
+
+// writeBreachIndex writes an index of the SHA-1 hashes of passwords to path,
+// replacing the file like cmd/breach does.
+func writeBreachIndex(t *testing.T, path string, passwords ...string) {
+	var lines []string
+	for _, pw := range passwords {
+		lines = append(lines, fmt.Sprintf("%X:1", breach.SHA1.Sum(pw)))
+	}
+	slices.Sort(lines)
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	assert.NoError(t, err)
+	_, err = breach.Build(f, breach.SHA1, breach.NewReader(strings.NewReader(strings.Join(lines, "\n")), breach.SHA1, ""))
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	assert.NoError(t, os.Rename(tmp, path))
+}
+
+func TestService_BreachedPasswords(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_breached?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	path := filepath.Join(t.TempDir(), "breached.idx")
+	writeBreachIndex(t, path, "password123", "letmein2024")
+	screener, err := breach.NewScreener(path)
+	assert.NoError(t, err)
+	defer screener.Close()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	notifier := &captureNotifier{}
+	svc := NewUserService(repo, jwtManager, WithNotifier(notifier), WithBreachedPasswords(screener))
+
+	ctx := context.Background()
+	email := "breached@example.com"
+	app, err := client.App.Create().SetName("Breached App").Save(ctx)
+	assert.NoError(t, err)
+
+	_, err = svc.Create(ctx, CreateUserRequest{AppID: app.ID, Firstname: "Breached", Lastname: "User", Email: email, Password: "password123"})
+	var perr *PasswordPolicyError
+	if assert.ErrorAs(t, err, &perr) {
+		assert.Equal(t, []password.Violation{password.BreachedViolation()}, perr.Violations)
+	}
+
+	u, err := svc.Create(ctx, CreateUserRequest{AppID: app.ID, Firstname: "Breached", Lastname: "User", Email: email, Password: "unbreached-42"})
+	assert.NoError(t, err)
+
+	t.Run("Update", func(t *testing.T) {
+		pw := "letmein2024"
+		_, err := svc.Update(ctx, u.ID, UpdateUserRequest{Password: &pw})
+		assert.ErrorAs(t, err, &perr)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		assert.NoError(t, svc.ForgotPassword(ctx, ForgotPasswordRequest{Email: email}))
+		err := svc.ResetPassword(ctx, ResetPasswordRequest{Token: notifier.tokens[len(notifier.tokens)-1], Password: "letmein2024"})
+		assert.ErrorAs(t, err, &perr)
+	})
+
+	t.Run("UpdatedCorpus", func(t *testing.T) {
+		writeBreachIndex(t, path, "password123", "letmein2024", "newly-leaked-99")
+		assert.NoError(t, screener.Reload())
+
+		pw := "newly-leaked-99"
+		_, err := svc.Update(ctx, u.ID, UpdateUserRequest{Password: &pw})
+		assert.ErrorAs(t, err, &perr)
+	})
+}
+
+func TestService_PasswordRehash(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_password_rehash?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	bcryptHasher, err := password.NewHasher(password.HashParams{Algorithm: password.Bcrypt, BcryptCost: bcrypt.MinCost})
+	assert.NoError(t, err)
+	legacy := NewUserService(repo, jwtManager, WithPasswordHasher(bcryptHasher))
+	svc := NewUserService(repo, jwtManager)
+
+	ctx := context.Background()
+	email := "rehash@example.com"
+	app, err := client.App.Create().SetName("Rehash App").Save(ctx)
+	assert.NoError(t, err)
+	u, err := legacy.Create(ctx, CreateUserRequest{AppID: app.ID, Firstname: "Rehash", Lastname: "User", Email: email, Password: "password123"})
+	assert.NoError(t, err)
+
+	before, err := client.User.Get(ctx, u.ID)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(before.Password, "$2a$"))
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		_, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: "wrong-password"})
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+		got, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, before.Password, got.Password)
+	})
+
+	t.Run("Rehashed", func(t *testing.T) {
+		_, err := svc.Authenticate(ctx, AuthRequest{Email: email, Password: "password123"})
+		assert.NoError(t, err)
+
+		got, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(got.Password, "$argon2id$"))
+		assert.Equal(t, before.PasswordChangedAt.Unix(), got.PasswordChangedAt.Unix())
+
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: email, Password: "password123"})
+		assert.NoError(t, err)
+		again, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, got.Password, again.Password)
+	})
+
+	t.Run("ConfiguredAlgorithmWins", func(t *testing.T) {
+		// Any supported hash verifies; it is replaced by one of the configured kind.
+		_, err := legacy.Authenticate(ctx, AuthRequest{Email: email, Password: "password123"})
+		assert.NoError(t, err)
+
+		got, err := client.User.Get(ctx, u.ID)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(got.Password, "$2a$"))
+	})
+}
+
+func TestService_MagicLink(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_magic_link?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewUserRepository(client)
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	notifier := &captureNotifier{}
+	svc := NewUserService(repo, jwtManager, WithNotifier(notifier), WithMagicLinkURL("https://id.example.com/users/auth/magic-link/verify"))
+
+	ctx := context.Background()
+	email := "magic@example.com"
+	app, err := client.App.Create().SetName("Magic App").Save(ctx)
+	assert.NoError(t, err)
+	u, err := svc.Create(ctx, CreateUserRequest{AppID: app.ID, Firstname: "Magic", Lastname: "User", Email: email, Password: "password123"})
+	assert.NoError(t, err)
+
+	linkToken := func(t *testing.T) string {
+		t.Helper()
+		link, err := url.Parse(notifier.links[len(notifier.links)-1])
+		assert.NoError(t, err)
+		assert.Equal(t, "id.example.com", link.Host)
+		return link.Query().Get("token")
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		_, err := svc.RequestMagicLink(ctx, MagicLinkRequest{AppID: app.ID, Email: email})
+		assert.ErrorIs(t, err, ErrMagicLinkDisabled)
+		assert.Empty(t, notifier.links)
+	})
+
+	_, err = client.App.UpdateOneID(app.ID).SetMagicLinkEnabled(true).Save(ctx)
+	assert.NoError(t, err)
+
+	t.Run("UnknownEmail", func(t *testing.T) {
+		session, err := svc.RequestMagicLink(ctx, MagicLinkRequest{AppID: app.ID, Email: "nobody@example.com"})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, session.Nonce)
+		assert.Empty(t, notifier.links)
+	})
+
+	t.Run("Redeem", func(t *testing.T) {
+		session, err := svc.RequestMagicLink(ctx, MagicLinkRequest{AppID: app.ID, Email: email})
+		assert.NoError(t, err)
+		token := linkToken(t)
+
+		_, err = svc.AuthenticateMagicLink(ctx, MagicLinkAuthRequest{Token: token, Nonce: "other-browser"})
+		assert.ErrorIs(t, err, ErrInvalidMagicLink)
+
+		res, err := svc.AuthenticateMagicLink(ctx, MagicLinkAuthRequest{Token: token, Nonce: session.Nonce})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Token)
+		assert.Equal(t, u.ID, res.User.ID)
+
+		_, err = svc.AuthenticateMagicLink(ctx, MagicLinkAuthRequest{Token: token, Nonce: session.Nonce})
+		assert.ErrorIs(t, err, ErrInvalidMagicLink)
+	})
+
+	t.Run("SupersededLink", func(t *testing.T) {
+		first, err := svc.RequestMagicLink(ctx, MagicLinkRequest{AppID: app.ID, Email: email})
+		assert.NoError(t, err)
+		token := linkToken(t)
+		_, err = svc.RequestMagicLink(ctx, MagicLinkRequest{AppID: app.ID, Email: email})
+		assert.NoError(t, err)
+
+		_, err = svc.AuthenticateMagicLink(ctx, MagicLinkAuthRequest{Token: token, Nonce: first.Nonce})
+		assert.ErrorIs(t, err, ErrInvalidMagicLink)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		expiring := NewUserService(repo, jwtManager, WithNotifier(notifier), WithMagicLinkURL("https://id.example.com/users/auth/magic-link/verify"), WithMagicLinkExpiry(time.Nanosecond))
+		session, err := expiring.RequestMagicLink(ctx, MagicLinkRequest{AppID: app.ID, Email: email})
+		assert.NoError(t, err)
+
+		_, err = expiring.AuthenticateMagicLink(ctx, MagicLinkAuthRequest{Token: linkToken(t), Nonce: session.Nonce})
+		assert.ErrorIs(t, err, ErrInvalidMagicLink)
+	})
+
+	t.Run("DisabledAfterRequest", func(t *testing.T) {
+		session, err := svc.RequestMagicLink(ctx, MagicLinkRequest{AppID: app.ID, Email: email})
+		assert.NoError(t, err)
+		token := linkToken(t)
+		_, err = client.App.UpdateOneID(app.ID).SetMagicLinkEnabled(false).Save(ctx)
+		assert.NoError(t, err)
+
+		_, err = svc.AuthenticateMagicLink(ctx, MagicLinkAuthRequest{Token: token, Nonce: session.Nonce})
+		assert.ErrorIs(t, err, ErrMagicLinkDisabled)
+	})
+}
+
+// fakeAuditor records the login attempts reported to it.
+type fakeAuditor struct {
+	events []LoginEvent
+	err    error
+}
+
+func (a *fakeAuditor) RecordLogin(_ context.Context, e LoginEvent) error {
+	a.events = append(a.events, e)
+	return a.err
+}
+
+func TestService_AuditLogins(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_audit_logins?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	auditor := &fakeAuditor{}
+	svc := NewUserService(NewUserRepository(client), auth.NewJWTManager("secret", time.Hour), WithAuditor(auditor))
+
+	ctx := context.Background()
+	app, err := client.App.Create().SetName("Audit App").Save(ctx)
+	assert.NoError(t, err)
+	u, err := svc.Create(ctx, CreateUserRequest{AppID: app.ID, Firstname: "Audit", Lastname: "User", Email: "audit@example.com", Password: "password123"})
+	assert.NoError(t, err)
+
+	_, err = svc.Authenticate(ctx, AuthRequest{Email: "audit@example.com", Password: "password123"})
+	assert.NoError(t, err)
+	_, err = svc.Authenticate(ctx, AuthRequest{Email: "audit@example.com", Password: "wrong-password"})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = svc.Authenticate(ctx, AuthRequest{Email: "nobody@example.com", Password: "password123"})
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = svc.IssueTokens(ctx, u.ID, "openid")
+	assert.NoError(t, err)
+
+	if assert.Len(t, auditor.events, 4) {
+		assert.Equal(t, LoginEvent{AppID: &app.ID, UserID: &u.ID, Email: u.Email, Method: LoginMethodPassword}, auditor.events[0])
+		assert.Equal(t, LoginFailureInvalidPassword, auditor.events[1].Failure)
+		assert.Equal(t, u.ID, *auditor.events[1].UserID)
+		assert.Equal(t, LoginEvent{Email: "nobody@example.com", Method: LoginMethodPassword, Failure: LoginFailureUnknownUser}, auditor.events[2])
+		assert.Equal(t, LoginMethodOIDC, auditor.events[3].Method)
+		assert.Empty(t, auditor.events[3].Failure)
+	}
+
+	t.Run("UnrecordedLoginFails", func(t *testing.T) {
+		auditor.err = errors.New("audit unavailable")
+		defer func() { auditor.err = nil }()
+
+		_, err := svc.Authenticate(ctx, AuthRequest{Email: "audit@example.com", Password: "password123"})
+		assert.ErrorContains(t, err, "audit unavailable")
+		_, err = svc.Authenticate(ctx, AuthRequest{Email: "audit@example.com", Password: "wrong-password"})
+		assert.ErrorContains(t, err, "audit unavailable")
+	})
 }
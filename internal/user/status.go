@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"keeper/ent"
+	"keeper/pkg/auth"
+)
+
+var (
+	// ErrUserInactive is returned when a token belongs to a deleted or deactivated user.
+	ErrUserInactive = errors.New("user is not active")
+	// ErrSessionRevoked is returned when a token was issued before the user's sessions were revoked.
+	ErrSessionRevoked = errors.New("session has been revoked")
+)
+
+// statusChecker rejects tokens whose user has been deleted, deactivated,
+// moved to another app, had all sessions revoked or lost platform
+// administration since the token was issued.
+type statusChecker struct {
+	repo *UserRepository
+}
+
+// NewStatusChecker creates an auth.UserStatusChecker backed by the user repository.
+func NewStatusChecker(repo *UserRepository) auth.UserStatusChecker {
+	return &statusChecker{repo: repo}
+}
+
+func (c *statusChecker) CheckUser(ctx context.Context, claims *auth.UserClaims) error {
+	u, err := c.repo.GetByID(ctx, claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrUserInactive
+		}
+		return err
+	}
+
+	if u.Status != 1 {
+		slog.Warn("token rejected: user inactive", "id", u.ID)
+		return ErrUserInactive
+	}
+
+	if claims.AppID != u.AppID {
+		slog.Warn("token rejected: user moved to another app", "id", u.ID)
+		return ErrSessionRevoked
+	}
+
+	if claims.PlatformAdmin && !u.PlatformAdmin {
+		slog.Warn("token rejected: platform admin revoked", "id", u.ID)
+		return ErrSessionRevoked
+	}
+
+	if u.TokensValidAfter != nil {
+		if claims.IssuedAt == nil || claims.IssuedAt.Before(*u.TokensValidAfter) {
+			slog.Warn("token rejected: issued before session revocation", "id", u.ID)
+			return ErrSessionRevoked
+		}
+	}
+
+	return nil
+}
+
+// revocationCutoff returns the time tokens have to be issued after to outlive
+// a revocation of sessions made now. Issue times have one second precision,
+// so it is the start of the next second: a token issued earlier in the
+// current one is revoked too.
+func revocationCutoff() time.Time {
+	return time.Now().Truncate(time.Second).Add(time.Second)
+}
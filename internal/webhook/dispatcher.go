@@ -0,0 +1,260 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"keeper/ent"
+	"keeper/internal/db"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+)
+
+const (
+	// defaultMaxAttempts is how many times a delivery is tried before it is
+	// marked dead. With db.RetryDelay between attempts that spans about
+	// four hours.
+	defaultMaxAttempts = 8
+	// defaultTimeout is how long a webhook has to respond.
+	defaultTimeout = 10 * time.Second
+	// deliverBatch is how many due deliveries one Deliver call sends at most.
+	deliverBatch = 50
+	// claimLease is how long beyond the webhook's timeout a claimed delivery
+	// is hidden from other workers while it is being sent.
+	claimLease = 5 * time.Minute
+)
+
+// Headers sent with every delivery.
+const (
+	// HeaderEvent is the event type.
+	HeaderEvent = "Keeper-Event"
+	// HeaderDelivery is the ID of the delivery in the delivery log.
+	HeaderDelivery = "Keeper-Delivery"
+	// HeaderSignature is "t=<unix time>,v1=<signature>", the signature being
+	// the hex encoded HMAC-SHA256, keyed with the webhook's secret, of the
+	// time, a dot and the body.
+	HeaderSignature = "Keeper-Signature"
+)
+
+// Dispatcher delivers queued events to webhooks in the background, so that
+// an unreachable endpoint neither loses events nor slows down the change
+// that caused them.
+type Dispatcher struct {
+	repo        *WebhookRepository
+	client      *http.Client
+	maxAttempts int
+}
+
+// DispatcherOption configures a Dispatcher.
+type DispatcherOption func(*Dispatcher)
+
+// WithMaxAttempts sets how many times a delivery is tried before it is marked dead.
+func WithMaxAttempts(n int) DispatcherOption {
+	return func(d *Dispatcher) {
+		if n > 0 {
+			d.maxAttempts = n
+		}
+	}
+}
+
+// WithAddressGuard sets the guard deciding which addresses deliveries may
+// connect to. By default they may not connect to any internal address.
+func WithAddressGuard(g *AddressGuard) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.client.Transport = g.Transport()
+	}
+}
+
+// WithTimeout sets how long a webhook has to respond.
+func WithTimeout(t time.Duration) DispatcherOption {
+	return func(d *Dispatcher) {
+		if t > 0 {
+			d.client.Timeout = t
+		}
+	}
+}
+
+var _ user.Auditor = (*Dispatcher)(nil)
+
+// NewDispatcher creates a dispatcher delivering the deliveries queued in repo.
+func NewDispatcher(repo *WebhookRepository, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		repo: repo,
+		client: &http.Client{
+			Transport: (&AddressGuard{}).Transport(),
+			Timeout:   defaultTimeout,
+			// A redirect is a failed delivery; the webhook's URL is where
+			// the app asked events to be sent.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		maxAttempts: defaultMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// RecordLogin queues a user.login event for successful logins. It implements
+// user.Auditor; an event it fails to queue fails the login.
+func (d *Dispatcher) RecordLogin(ctx context.Context, e user.LoginEvent) error {
+	if e.Failure != "" || e.UserID == nil {
+		return nil
+	}
+	u, err := d.repo.GetUser(ctx, *e.UserID)
+	if err != nil {
+		slog.Error("queueing webhook event failed", "event", EventUserLogin, "user_id", *e.UserID, "error", err)
+		return err
+	}
+	if err := enqueue(ctx, d.repo, u.AppID, EventUserLogin, EventData{User: userData(u), Method: e.Method}); err != nil {
+		slog.Error("queueing webhook event failed", "event", EventUserLogin, "user_id", u.ID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// enqueue queues an event for delivery to the webhooks of the app subscribed
+// to it. Bound to the client of a transaction, repo stores the deliveries
+// with the change they are about.
+func enqueue(ctx context.Context, repo *WebhookRepository, appID int, typ string, data EventData) error {
+	hooks, err := repo.ListSubscribers(ctx, appID, typ)
+	if err != nil || len(hooks) == 0 {
+		return err
+	}
+	id, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(Event{
+		ID:        id,
+		Type:      typ,
+		AppID:     appID,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return fmt.Errorf("encode webhook event: %w", err)
+	}
+	return repo.Enqueue(ctx, hooks, id, typ, string(payload))
+}
+
+// Deliver sends the deliveries that are due and reports how many succeeded.
+// Failed deliveries are retried with exponential backoff until they have
+// been tried maxAttempts times, when they are marked dead.
+func (d *Dispatcher) Deliver(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := d.repo.ListDue(ctx, now, deliverBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, del := range due {
+		// Sending the batch can take longer than a lease, so each delivery
+		// is claimed just before it is sent, for as long as sending it can
+		// take.
+		claimedAt := time.Now()
+		claimed, err := d.repo.Claim(ctx, del.ID, claimedAt, claimedAt.Add(d.client.Timeout+claimLease))
+		if err != nil {
+			return delivered, err
+		}
+		if !claimed {
+			continue
+		}
+
+		status, err := d.send(ctx, del)
+		if err == nil {
+			if err := d.repo.MarkDelivered(ctx, del.ID, status); err != nil {
+				return delivered, err
+			}
+			delivered++
+			continue
+		}
+
+		attempts := del.Attempts + 1
+		if attempts >= d.maxAttempts {
+			slog.Error("giving up on webhook delivery", "id", del.ID, "webhook_id", del.WebhookID, "event", del.Event, "attempts", attempts, "error", err)
+			if err := d.repo.MarkDead(ctx, del.ID, status, err.Error()); err != nil {
+				return delivered, err
+			}
+			continue
+		}
+		next := time.Now().Add(db.RetryDelay(attempts))
+		slog.Warn("webhook delivery failed, will retry", "id", del.ID, "webhook_id", del.WebhookID, "event", del.Event, "attempts", attempts, "next_attempt_at", next, "error", err)
+		if err := d.repo.MarkRetry(ctx, del.ID, status, next, err.Error()); err != nil {
+			return delivered, err
+		}
+	}
+	return delivered, nil
+}
+
+// send POSTs the payload of a delivery to its webhook and returns the status
+// of the response, zero when there was none. Only 2xx responses succeed.
+func (d *Dispatcher) send(ctx context.Context, del *ent.WebhookDelivery) (int, error) {
+	w := del.Edges.Webhook
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader([]byte(del.Payload)))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Keeper-Webhook/1.0")
+	req.Header.Set(HeaderEvent, del.Event)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(del.ID))
+	req.Header.Set(HeaderSignature, Sign(w.Secret, time.Now(), []byte(del.Payload)))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Read some of the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header of a payload sent at t.
+func Sign(secret string, t time.Time, payload []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Run delivers due events every interval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := d.Deliver(ctx)
+			if err != nil {
+				slog.Error("failed to deliver webhook events", "error", err)
+				continue
+			}
+			if n > 0 {
+				slog.Info("delivered webhook events", "count", n)
+			}
+		}
+	}
+}
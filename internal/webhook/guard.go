@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when a webhook URL points to, or a delivery
+// would connect to, an address inside the network Keeper runs in.
+var ErrForbiddenAddress = errors.New("webhook url must not point to a loopback, private, link-local or reserved address")
+
+// reservedNetworks are the ranges webhooks may not reach besides loopback,
+// private, link-local, multicast and unspecified addresses.
+var reservedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // this network
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),   // reserved and broadcast
+}
+
+// AddressGuard keeps webhooks from reaching the network Keeper runs in, such
+// as cloud metadata endpoints and internal services: anyone who may manage
+// the webhooks of an app could otherwise probe it through the delivery log.
+// Networks in its allowlist may be reached all the same.
+type AddressGuard struct {
+	allowed []netip.Prefix
+}
+
+// NewAddressGuard creates a guard that lets webhooks reach the given networks,
+// in CIDR notation or as single addresses, even when they are internal.
+func NewAddressGuard(allowed []string) (*AddressGuard, error) {
+	g := &AddressGuard{}
+	for _, s := range allowed {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(s)
+			if aerr != nil {
+				return nil, fmt.Errorf("invalid webhook allowed network %q: %w", s, err)
+			}
+			p = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		}
+		g.allowed = append(g.allowed, p.Masked())
+	}
+	return g, nil
+}
+
+// Allowed reports whether webhooks may connect to addr.
+func (g *AddressGuard) Allowed(addr netip.Addr) bool {
+	addr = addr.Unmap().WithZone("")
+	for _, p := range g.allowed {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() || addr.IsUnspecified() {
+		return false
+	}
+	for _, p := range reservedNetworks {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckURL returns ErrForbiddenAddress when the host of a webhook URL is, or
+// resolves to, an address webhooks may not reach. Hosts that do not resolve
+// yet are accepted: every delivery checks the address it connects to.
+func (g *AddressGuard) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !g.Allowed(addr) {
+			return ErrForbiddenAddress
+		}
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !g.Allowed(addr) {
+			return ErrForbiddenAddress
+		}
+	}
+	return nil
+}
+
+// Transport returns an HTTP transport that refuses to connect to addresses
+// webhooks may not reach. The address is checked once resolved, right
+// before connecting, so a host whose DNS answer changed since CheckURL
+// accepted it cannot get around the check.
+func (g *AddressGuard) Transport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would connect on the dispatcher's behalf, out of sight of the
+	// check.
+	t.Proxy = nil
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   g.control,
+	}
+	t.DialContext = dialer.DialContext
+	return t
+}
+
+// control checks the address a connection is about to be made to.
+func (g *AddressGuard) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !g.Allowed(addrPort.Addr()) {
+		return fmt.Errorf("connect to %s: %w", addrPort.Addr(), ErrForbiddenAddress)
+	}
+	return nil
+}
@@ -0,0 +1,351 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"keeper/pkg/auth"
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+)
+
+// WebhookHandler handles HTTP requests for webhooks.
+type WebhookHandler struct {
+	svc      WebhookService
+	validate *validator.Validate
+}
+
+// NewWebhookHandler creates a new webhook handler.
+func NewWebhookHandler(svc WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		svc:      svc,
+		validate: validator.New(),
+	}
+}
+
+// Permissions a token needs to call the webhook endpoints. App tokens are
+// granted them as scopes.
+const (
+	PermissionRead  = "webhooks:read"
+	PermissionWrite = "webhooks:write"
+)
+
+// Routes returns the chi router for webhook endpoints.
+func (h *WebhookHandler) Routes(jwtManager *auth.JWTManager) chi.Router {
+	r := chi.NewRouter()
+
+	// All routes are protected
+	r.Group(func(r chi.Router) {
+		r.Use(auth.Middleware(jwtManager))
+
+		r.With(auth.RequirePermission(PermissionWrite)).Post("/", h.CreateWebhook)
+		r.With(auth.RequirePermission(PermissionRead)).Get("/", h.ListWebhooks)
+
+		r.Route("/{id}", func(r chi.Router) {
+			r.With(auth.RequirePermission(PermissionRead)).Get("/", h.GetWebhookByID)
+			r.With(auth.RequirePermission(PermissionWrite)).Put("/", h.UpdateWebhook)
+			r.With(auth.RequirePermission(PermissionWrite)).Delete("/", h.DeleteWebhook)
+			r.With(auth.RequirePermission(PermissionWrite)).Post("/secret", h.RotateSecret)
+			r.With(auth.RequirePermission(PermissionRead)).Get("/deliveries", h.ListDeliveries)
+			r.With(auth.RequirePermission(PermissionWrite)).Post("/deliveries/{deliveryID}/redeliver", h.Redeliver)
+		})
+	})
+
+	return r
+}
+
+// CreateWebhook godoc
+// @Summary Create a webhook
+// @Description Subscribe a URL to events of an app: user.created, user.updated, user.deleted, user.locked, user.login and app.updated, or all of them when events is empty. Each event is POSTed as JSON with a Keeper-Signature header of the form t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the secret>. The secret is only returned once.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body CreateWebhookRequest true "Webhook details"
+// @Success 201 {object} render.Response{data=Webhook}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode create webhook request", "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid create webhook request", "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	wh, err := h.svc.Create(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusCreated, wh)
+}
+
+// ListWebhooks godoc
+// @Summary List webhooks
+// @Description Get the webhooks of the caller's app, or of every app for platform admins
+// @Tags webhooks
+// @Produce json
+// @Success 200 {object} render.Response{data=[]Webhook}
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /webhooks [get]
+func (h *WebhookHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	hooks, err := h.svc.List(r.Context())
+	if err != nil {
+		render.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.JSON(w, http.StatusOK, hooks)
+}
+
+// GetWebhookByID godoc
+// @Summary Get webhook by ID
+// @Description Get a single webhook by its unique ID
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} render.Response{data=Webhook}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Security Bearer
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhookByID(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookID(w, r)
+	if !ok {
+		return
+	}
+
+	wh, err := h.svc.GetByID(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, wh)
+}
+
+// UpdateWebhook godoc
+// @Summary Update webhook
+// @Description Update a webhook's URL, events, description or whether it is enabled. A disabled webhook gets no new events; those queued before wait until it is enabled again.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param webhook body UpdateWebhookRequest true "Updated webhook details"
+// @Success 200 {object} render.Response{data=Webhook}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Warn("failed to decode update webhook request", "id", id, "error", err)
+		render.Error(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		slog.Warn("invalid update webhook request", "id", id, "error", err)
+		render.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	wh, err := h.svc.Update(r.Context(), id, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, wh)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete webhook
+// @Description Remove a webhook and its delivery log
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.Delete(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusNoContent, nil)
+}
+
+// RotateSecret godoc
+// @Summary Rotate a webhook secret
+// @Description Generate a new signing secret for the webhook, replacing the previous one. The secret is only returned once.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} render.Response{data=Webhook}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Security Bearer
+// @Router /webhooks/{id}/secret [post]
+func (h *WebhookHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookID(w, r)
+	if !ok {
+		return
+	}
+
+	wh, err := h.svc.RotateSecret(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, wh)
+}
+
+// ListDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Get the delivery log of a webhook, newest first. Deliveries are pending until the webhook responds with a 2xx status; failed ones are retried with exponential backoff and marked dead once out of attempts.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param status query string false "Status: pending, delivered or dead"
+// @Param limit query int false "Number of deliveries, at most 200"
+// @Success 200 {object} render.Response{data=[]Delivery}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookID(w, r)
+	if !ok {
+		return
+	}
+
+	req := ListDeliveriesRequest{Status: r.URL.Query().Get("status")}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			slog.Warn("invalid limit in list webhook deliveries request", "limit", v)
+			render.Error(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		req.Limit = limit
+	}
+
+	deliveries, err := h.svc.ListDeliveries(r.Context(), id, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusOK, deliveries)
+}
+
+// Redeliver godoc
+// @Summary Redeliver a webhook event
+// @Description Queue the event of a delivery, such as a dead one, for delivery again. The new delivery carries the same event ID.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param deliveryID path int true "Delivery ID"
+// @Success 202 {object} render.Response{data=Delivery}
+// @Failure 400 {object} render.Response
+// @Failure 401 {object} render.Response
+// @Failure 403 {object} render.Response
+// @Failure 404 {object} render.Response
+// @Failure 500 {object} render.Response
+// @Security Bearer
+// @Router /webhooks/{id}/deliveries/{deliveryID}/redeliver [post]
+func (h *WebhookHandler) Redeliver(w http.ResponseWriter, r *http.Request) {
+	id, ok := webhookID(w, r)
+	if !ok {
+		return
+	}
+	deliveryStr := chi.URLParam(r, "deliveryID")
+	deliveryID, err := strconv.Atoi(deliveryStr)
+	if err != nil {
+		slog.Warn("invalid delivery id in redeliver request", "id", deliveryStr)
+		render.Error(w, http.StatusBadRequest, "invalid delivery id")
+		return
+	}
+
+	d, err := h.svc.Redeliver(r.Context(), id, deliveryID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	render.JSON(w, http.StatusAccepted, d)
+}
+
+// webhookID parses the webhook ID of the request path, rendering an error
+// when it is invalid.
+func webhookID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		slog.Warn("invalid webhook id in request", "id", idStr)
+		render.Error(w, http.StatusBadRequest, "invalid webhook id")
+		return 0, false
+	}
+	return id, true
+}
+
+// writeError renders a webhook service error with its HTTP status.
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrAppNotFound), errors.Is(err, ErrInvalidStatus), errors.Is(err, ErrForbiddenAddress):
+		render.Error(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrForbidden):
+		render.Error(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrWebhookNotFound), errors.Is(err, ErrDeliveryNotFound):
+		render.Error(w, http.StatusNotFound, err.Error())
+	default:
+		render.Error(w, http.StatusInternalServerError, err.Error())
+	}
+}
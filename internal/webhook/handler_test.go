@@ -0,0 +1,255 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"keeper/pkg/render"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockWebhookService struct {
+	mock.Mock
+}
+
+func (m *mockWebhookService) Create(ctx context.Context, req CreateWebhookRequest) (*Webhook, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Webhook), args.Error(1)
+}
+
+func (m *mockWebhookService) GetByID(ctx context.Context, id int) (*Webhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Webhook), args.Error(1)
+}
+
+func (m *mockWebhookService) List(ctx context.Context) ([]*Webhook, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Webhook), args.Error(1)
+}
+
+func (m *mockWebhookService) Update(ctx context.Context, id int, req UpdateWebhookRequest) (*Webhook, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Webhook), args.Error(1)
+}
+
+func (m *mockWebhookService) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockWebhookService) RotateSecret(ctx context.Context, id int) (*Webhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Webhook), args.Error(1)
+}
+
+func (m *mockWebhookService) ListDeliveries(ctx context.Context, webhookID int, req ListDeliveriesRequest) ([]*Delivery, error) {
+	args := m.Called(ctx, webhookID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Delivery), args.Error(1)
+}
+
+func (m *mockWebhookService) Redeliver(ctx context.Context, webhookID, deliveryID int) (*Delivery, error) {
+	args := m.Called(ctx, webhookID, deliveryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Delivery), args.Error(1)
+}
+
+// withURLParams adds chi URL parameters to req.
+func withURLParams(req *http.Request, params map[string]string) *http.Request {
+	rctx := chi.NewRouteContext()
+	for k, v := range params {
+		rctx.URLParams.Add(k, v)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestHandler_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(svc *mockWebhookService)
+		wantStatusCode int
+	}{
+		{
+			name: "Success",
+			body: `{"app_id":1,"url":"https://example.com/hook","events":["user.created","user.login"]}`,
+			mockSetup: func(svc *mockWebhookService) {
+				svc.On("Create", mock.Anything, CreateWebhookRequest{
+					AppID:  1,
+					URL:    "https://example.com/hook",
+					Events: []string{EventUserCreated, EventUserLogin},
+				}).Return(&Webhook{ID: 1, AppID: 1, Secret: "secret"}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "Unknown event",
+			body:           `{"app_id":1,"url":"https://example.com/hook","events":["user.renamed"]}`,
+			mockSetup:      func(svc *mockWebhookService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid URL",
+			body:           `{"app_id":1,"url":"ftp://example.com/hook"}`,
+			mockSetup:      func(svc *mockWebhookService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Other app",
+			body: `{"app_id":2,"url":"https://example.com/hook"}`,
+			mockSetup: func(svc *mockWebhookService) {
+				svc.On("Create", mock.Anything, mock.Anything).Return(nil, ErrForbidden)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockWebhookService)
+			tt.mockSetup(svc)
+			handler := NewWebhookHandler(svc)
+
+			req, _ := http.NewRequest("POST", "/webhooks", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			handler.CreateWebhook(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_ListDeliveries(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		query          string
+		mockSetup      func(svc *mockWebhookService)
+		wantStatusCode int
+	}{
+		{
+			name:  "Success",
+			id:    "1",
+			query: "?status=dead&limit=10",
+			mockSetup: func(svc *mockWebhookService) {
+				svc.On("ListDeliveries", mock.Anything, 1, ListDeliveriesRequest{Status: "dead", Limit: 10}).
+					Return([]*Delivery{{ID: 5, WebhookID: 1, Status: "dead", Payload: json.RawMessage(`{}`)}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:  "Unknown webhook",
+			id:    "2",
+			query: "",
+			mockSetup: func(svc *mockWebhookService) {
+				svc.On("ListDeliveries", mock.Anything, 2, ListDeliveriesRequest{}).Return(nil, ErrWebhookNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:  "Invalid status",
+			id:    "1",
+			query: "?status=lost",
+			mockSetup: func(svc *mockWebhookService) {
+				svc.On("ListDeliveries", mock.Anything, 1, ListDeliveriesRequest{Status: "lost"}).Return(nil, ErrInvalidStatus)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "Limit too large",
+			id:             "1",
+			query:          "?limit=1000",
+			mockSetup:      func(svc *mockWebhookService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid ID",
+			id:             "abc",
+			mockSetup:      func(svc *mockWebhookService) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := new(mockWebhookService)
+			tt.mockSetup(svc)
+			handler := NewWebhookHandler(svc)
+
+			req, _ := http.NewRequest("GET", "/webhooks/"+tt.id+"/deliveries"+tt.query, nil)
+			req = withURLParams(req, map[string]string{"id": tt.id})
+			rr := httptest.NewRecorder()
+
+			handler.ListDeliveries(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			if tt.wantStatusCode == http.StatusOK {
+				var resp struct {
+					render.Response
+					Data []Delivery `json:"data"`
+				}
+				err := json.Unmarshal(rr.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Len(t, resp.Data, 1)
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandler_Redeliver(t *testing.T) {
+	svc := new(mockWebhookService)
+	handler := NewWebhookHandler(svc)
+
+	svc.On("Redeliver", mock.Anything, 1, 5).Return(&Delivery{ID: 6, WebhookID: 1, Status: "pending", Payload: json.RawMessage(`{}`)}, nil)
+	svc.On("Redeliver", mock.Anything, 1, 7).Return(nil, ErrDeliveryNotFound)
+
+	tests := []struct {
+		deliveryID string
+		want       int
+	}{
+		{"5", http.StatusAccepted},
+		{"7", http.StatusNotFound},
+		{"abc", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.deliveryID, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", "/webhooks/1/deliveries/"+tt.deliveryID+"/redeliver", nil)
+			req = withURLParams(req, map[string]string{"id": "1", "deliveryID": tt.deliveryID})
+			rr := httptest.NewRecorder()
+
+			handler.Redeliver(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"context"
+
+	"keeper/ent"
+	entapp "keeper/ent/app"
+	"keeper/ent/hook"
+	entuser "keeper/ent/user"
+	"keeper/internal/db"
+)
+
+// userFields are the user fields whose changes are sent as user.updated.
+// Login bookkeeping, MFA state and password hashes are left out; a new
+// password shows as a change of password_changed_at.
+var userFields = map[string]bool{
+	entuser.FieldAppID:             true,
+	entuser.FieldFirstname:         true,
+	entuser.FieldLastname:          true,
+	entuser.FieldEmail:             true,
+	entuser.FieldStatus:            true,
+	entuser.FieldPlatformAdmin:     true,
+	entuser.FieldEmailVerifiedAt:   true,
+	entuser.FieldPasswordChangedAt: true,
+}
+
+// ignoredAppFields are the app fields whose changes are not sent as
+// app.updated.
+var ignoredAppFields = map[string]bool{
+	entapp.FieldUpdatedAt:        true,
+	entapp.FieldClientSecretHash: true,
+	entapp.FieldScimTokenHash:    true,
+}
+
+// Register queues events for the creation, update, deletion and locking of
+// single users and the update of apps made with client. New apps have no
+// webhooks yet, and the webhooks of a deleted app are deleted with it.
+func Register(client *ent.Client, d *Dispatcher) {
+	client.User.Use(hook.On(d.Hook(), ent.OpCreate|ent.OpUpdateOne|ent.OpDeleteOne))
+	client.App.Use(hook.On(d.Hook(), ent.OpUpdateOne))
+}
+
+// Hook returns an ent hook that queues the events of each mutation it runs
+// on through the mutation's client. Run in a transaction, the deliveries are
+// stored with the change or not at all; failing to queue them fails the
+// mutation.
+func (d *Dispatcher) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			client := db.MutationClient(m)
+			id, _ := db.MutationID(m)
+
+			// user.deleted carries the user as it was, and updates list the
+			// fields whose value they changed.
+			var (
+				deleted *ent.User
+				old     map[string]ent.Value
+				err     error
+			)
+			switch {
+			case m.Op().Is(ent.OpDeleteOne):
+				if deleted, err = client.User.Get(ctx, id); err != nil {
+					return nil, err
+				}
+			case m.Op().Is(ent.OpUpdateOne):
+				if old, err = db.OldFields(ctx, m, func(f string) bool { return sent(m, f) }); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			var changes []string
+			for _, c := range db.ChangedFields(m, old) {
+				changes = append(changes, c.Field)
+			}
+
+			repo := NewWebhookRepository(client)
+			switch v := v.(type) {
+			case *ent.User:
+				return v, queueUserEvents(ctx, repo, m.(*ent.UserMutation), v, changes)
+			case *ent.App:
+				if len(changes) == 0 {
+					return v, nil
+				}
+				return v, enqueue(ctx, repo, v.ID, EventAppUpdated, EventData{App: appData(v), Changes: changes})
+			}
+			if deleted != nil {
+				return v, enqueue(ctx, repo, deleted.AppID, EventUserDeleted, EventData{User: userData(deleted)})
+			}
+			return v, nil
+		})
+	}
+}
+
+// queueUserEvents queues the events of a user's creation or update.
+func queueUserEvents(ctx context.Context, repo *WebhookRepository, m *ent.UserMutation, u *ent.User, changes []string) error {
+	if m.Op().Is(ent.OpCreate) {
+		return enqueue(ctx, repo, u.AppID, EventUserCreated, EventData{User: userData(u)})
+	}
+	if len(changes) > 0 {
+		if err := enqueue(ctx, repo, u.AppID, EventUserUpdated, EventData{User: userData(u), Changes: changes}); err != nil {
+			return err
+		}
+	}
+	if _, locked := m.LockedUntil(); locked {
+		return enqueue(ctx, repo, u.AppID, EventUserLocked, EventData{User: userData(u)})
+	}
+	return nil
+}
+
+// sent reports whether changes to a field of the entity m is for are sent.
+func sent(m ent.Mutation, field string) bool {
+	switch m.(type) {
+	case *ent.UserMutation:
+		return userFields[field]
+	case *ent.AppMutation:
+		return !ignoredAppFields[field]
+	}
+	return false
+}
+
+func userData(u *ent.User) *UserData {
+	return &UserData{
+		ID:            u.ID,
+		AppID:         u.AppID,
+		Firstname:     u.Firstname,
+		Lastname:      u.Lastname,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerifiedAt != nil,
+		Status:        u.Status,
+		LockedUntil:   u.LockedUntil,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
+	}
+}
+
+func appData(a *ent.App) *AppData {
+	return &AppData{
+		ID:        a.ID,
+		Name:      a.Name,
+		ClientID:  a.ClientID,
+		Status:    a.Status,
+		UpdatedAt: a.UpdatedAt,
+	}
+}
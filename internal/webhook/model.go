@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event types webhooks can subscribe to.
+const (
+	EventUserCreated = "user.created"
+	EventUserUpdated = "user.updated"
+	EventUserDeleted = "user.deleted"
+	EventUserLocked  = "user.locked"
+	EventUserLogin   = "user.login"
+	EventAppUpdated  = "app.updated"
+)
+
+// EventTypes are all the event types, in the order they are documented.
+var EventTypes = []string{
+	EventUserCreated,
+	EventUserUpdated,
+	EventUserDeleted,
+	EventUserLocked,
+	EventUserLogin,
+	EventAppUpdated,
+}
+
+// Webhook represents the domain model for a webhook subscription.
+type Webhook struct {
+	ID    int    `json:"id"`
+	AppID int    `json:"app_id"`
+	URL   string `json:"url"`
+	// Events are the event types delivered; all of them when empty.
+	Events      []string `json:"events"`
+	Enabled     bool     `json:"enabled"`
+	Description string   `json:"description"`
+	// Secret signs the payloads. It is only returned when the webhook is
+	// created or its secret rotated.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateWebhookRequest defines the payload for subscribing a URL to the
+// events of an app.
+type CreateWebhookRequest struct {
+	AppID       int      `json:"app_id" validate:"required"`
+	URL         string   `json:"url" validate:"required,http_url"`
+	Events      []string `json:"events" validate:"omitempty,dive,oneof=user.created user.updated user.deleted user.locked user.login app.updated"`
+	Enabled     *bool    `json:"enabled"`
+	Description string   `json:"description"`
+}
+
+// UpdateWebhookRequest defines the payload for updating a webhook.
+type UpdateWebhookRequest struct {
+	URL *string `json:"url" validate:"omitempty,http_url"`
+	// Events replaces the event types when set; an empty list subscribes
+	// to all of them.
+	Events      []string `json:"events" validate:"omitempty,dive,oneof=user.created user.updated user.deleted user.locked user.login app.updated"`
+	Enabled     *bool    `json:"enabled"`
+	Description *string  `json:"description"`
+}
+
+// Delivery is an event queued for, or delivered to, a webhook.
+type Delivery struct {
+	ID        int    `json:"id"`
+	WebhookID int    `json:"webhook_id"`
+	EventID   string `json:"event_id"`
+	Event     string `json:"event"`
+	// Status is pending until the webhook accepts the event, and dead once
+	// it has been tried the maximum number of times.
+	Status        string    `json:"status"`
+	Attempts      int       `json:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	LastError     string    `json:"last_error,omitempty"`
+	// ResponseStatus is the HTTP status of the last attempt, zero when it
+	// got no response.
+	ResponseStatus int             `json:"response_status"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// ListDeliveriesRequest filters the deliveries returned by ListDeliveries.
+// Deliveries are returned newest first.
+type ListDeliveriesRequest struct {
+	Status string
+	Limit  int
+}
+
+// Event is the JSON body POSTed to webhooks.
+type Event struct {
+	// ID identifies the event. Redeliveries carry the ID of the event they
+	// repeat, so that receivers can ignore duplicates.
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	AppID     int       `json:"app_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      EventData `json:"data"`
+}
+
+// EventData is what an event is about.
+type EventData struct {
+	// User is the user the event is about, as it is after the change, or
+	// was before its deletion.
+	User *UserData `json:"user,omitempty"`
+	App  *AppData  `json:"app,omitempty"`
+	// Changes names the fields an update changed.
+	Changes []string `json:"changes,omitempty"`
+	// Method is how the user logged in.
+	Method string `json:"method,omitempty"`
+}
+
+// UserData is a user as sent to webhooks.
+type UserData struct {
+	ID            int        `json:"id"`
+	AppID         int        `json:"app_id"`
+	Firstname     string     `json:"firstname"`
+	Lastname      string     `json:"lastname"`
+	Email         string     `json:"email"`
+	EmailVerified bool       `json:"email_verified"`
+	Status        int8       `json:"status"`
+	LockedUntil   *time.Time `json:"locked_until,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// AppData is an app as sent to webhooks.
+type AppData struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	ClientID  string    `json:"client_id"`
+	Status    int8      `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,303 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/webhook"
+	"keeper/ent/webhookdelivery"
+)
+
+// WebhookRepository handles database operations for webhooks and their
+// deliveries.
+type WebhookRepository struct {
+	client *ent.Client
+}
+
+// NewWebhookRepository creates a new webhook repository.
+func NewWebhookRepository(client *ent.Client) *WebhookRepository {
+	return &WebhookRepository{client: client}
+}
+
+// Create creates a new webhook.
+func (r *WebhookRepository) Create(ctx context.Context, w *ent.Webhook) (*ent.Webhook, error) {
+	created, err := r.client.Webhook.
+		Create().
+		SetAppID(w.AppID).
+		SetURL(w.URL).
+		SetSecret(w.Secret).
+		SetEvents(w.Events).
+		SetEnabled(w.Enabled).
+		SetDescription(w.Description).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to create webhook", "app_id", w.AppID, "error", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetByID retrieves a webhook by its ID.
+func (r *WebhookRepository) GetByID(ctx context.Context, id int) (*ent.Webhook, error) {
+	w, err := r.client.Webhook.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("webhook not found in database", "id", id)
+			return nil, fmt.Errorf("webhook not found: %w", err)
+		}
+		slog.Error("database error: failed to get webhook", "id", id, "error", err)
+		return nil, err
+	}
+	return w, nil
+}
+
+// List retrieves all webhooks.
+func (r *WebhookRepository) List(ctx context.Context) ([]*ent.Webhook, error) {
+	hooks, err := r.client.Webhook.Query().
+		Order(ent.Asc(webhook.FieldID)).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list webhooks", "error", err)
+		return nil, err
+	}
+	return hooks, nil
+}
+
+// Update updates an existing webhook.
+func (r *WebhookRepository) Update(ctx context.Context, id int, w *ent.Webhook) (*ent.Webhook, error) {
+	updated, err := r.client.Webhook.UpdateOneID(id).
+		SetURL(w.URL).
+		SetEvents(w.Events).
+		SetEnabled(w.Enabled).
+		SetDescription(w.Description).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("webhook not found for update", "id", id)
+			return nil, fmt.Errorf("webhook not found: %w", err)
+		}
+		slog.Error("database error: failed to update webhook", "id", id, "error", err)
+		return nil, err
+	}
+	return updated, nil
+}
+
+// SetSecret replaces the signing secret of a webhook.
+func (r *WebhookRepository) SetSecret(ctx context.Context, id int, secret string) (*ent.Webhook, error) {
+	updated, err := r.client.Webhook.UpdateOneID(id).
+		SetSecret(secret).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("webhook not found for secret rotation", "id", id)
+			return nil, fmt.Errorf("webhook not found: %w", err)
+		}
+		slog.Error("database error: failed to set webhook secret", "id", id, "error", err)
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete deletes a webhook and its deliveries.
+func (r *WebhookRepository) Delete(ctx context.Context, id int) error {
+	err := r.client.Webhook.DeleteOneID(id).Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("webhook not found for deletion", "id", id)
+			return fmt.Errorf("webhook not found: %w", err)
+		}
+		slog.Error("database error: failed to delete webhook", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// ListSubscribers retrieves the enabled webhooks of an app subscribed to the
+// event type.
+func (r *WebhookRepository) ListSubscribers(ctx context.Context, appID int, event string) ([]*ent.Webhook, error) {
+	hooks, err := r.client.Webhook.Query().
+		Where(
+			webhook.AppIDEQ(appID),
+			webhook.Enabled(true),
+		).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list webhook subscribers", "app_id", appID, "event", event, "error", err)
+		return nil, err
+	}
+	return slices.DeleteFunc(hooks, func(w *ent.Webhook) bool {
+		return len(w.Events) > 0 && !slices.Contains(w.Events, event)
+	}), nil
+}
+
+// Enqueue queues the payload of an event for delivery to each of the webhooks.
+func (r *WebhookRepository) Enqueue(ctx context.Context, hooks []*ent.Webhook, eventID, event, payload string) error {
+	builders := make([]*ent.WebhookDeliveryCreate, len(hooks))
+	for i, w := range hooks {
+		builders[i] = r.client.WebhookDelivery.Create().
+			SetWebhookID(w.ID).
+			SetAppID(w.AppID).
+			SetEventID(eventID).
+			SetEvent(event).
+			SetPayload(payload)
+	}
+	if _, err := r.client.WebhookDelivery.CreateBulk(builders...).Save(ctx); err != nil {
+		slog.Error("database error: failed to enqueue webhook deliveries", "event", event, "error", err)
+		return err
+	}
+	return nil
+}
+
+// ListDeliveries retrieves up to limit deliveries of a webhook, newest first,
+// optionally only those with the given status.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, webhookID int, status string, limit int) ([]*ent.WebhookDelivery, error) {
+	q := r.client.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookIDEQ(webhookID))
+	if status != "" {
+		q = q.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(status)))
+	}
+	deliveries, err := q.
+		Order(ent.Desc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list webhook deliveries", "webhook_id", webhookID, "error", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// GetDelivery retrieves a delivery of a webhook.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, webhookID, id int) (*ent.WebhookDelivery, error) {
+	d, err := r.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.IDEQ(id),
+			webhookdelivery.WebhookIDEQ(webhookID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			slog.Warn("webhook delivery not found in database", "webhook_id", webhookID, "id", id)
+			return nil, fmt.Errorf("webhook delivery not found: %w", err)
+		}
+		slog.Error("database error: failed to get webhook delivery", "webhook_id", webhookID, "id", id, "error", err)
+		return nil, err
+	}
+	return d, nil
+}
+
+// Redeliver queues the event of a delivery again, as a new delivery that is
+// due now.
+func (r *WebhookRepository) Redeliver(ctx context.Context, d *ent.WebhookDelivery) (*ent.WebhookDelivery, error) {
+	created, err := r.client.WebhookDelivery.Create().
+		SetWebhookID(d.WebhookID).
+		SetAppID(d.AppID).
+		SetEventID(d.EventID).
+		SetEvent(d.Event).
+		SetPayload(d.Payload).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to redeliver webhook event", "id", d.ID, "error", err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// ListDue retrieves up to limit pending deliveries to enabled webhooks whose
+// next attempt is due, with their webhook.
+func (r *WebhookRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*ent.WebhookDelivery, error) {
+	deliveries, err := r.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+			webhookdelivery.HasWebhookWith(webhook.Enabled(true)),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		slog.Error("database error: failed to list due webhook deliveries", "error", err)
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// Claim postpones a due delivery to leaseUntil so that no other worker picks
+// it up while it is being sent. It reports false when another worker claimed
+// it first.
+func (r *WebhookRepository) Claim(ctx context.Context, id int, now, leaseUntil time.Time) (bool, error) {
+	n, err := r.client.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.IDEQ(id),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		SetNextAttemptAt(leaseUntil).
+		Save(ctx)
+	if err != nil {
+		slog.Error("database error: failed to claim webhook delivery", "id", id, "error", err)
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// MarkDelivered records a successful delivery.
+func (r *WebhookRepository) MarkDelivered(ctx context.Context, id, responseStatus int) error {
+	err := r.client.WebhookDelivery.UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusDelivered).
+		AddAttempts(1).
+		SetResponseStatus(responseStatus).
+		SetDeliveredAt(time.Now()).
+		SetLastError("").
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark webhook delivery delivered", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// MarkRetry records a failed attempt and schedules the next one.
+func (r *WebhookRepository) MarkRetry(ctx context.Context, id, responseStatus int, next time.Time, lastError string) error {
+	err := r.client.WebhookDelivery.UpdateOneID(id).
+		AddAttempts(1).
+		SetResponseStatus(responseStatus).
+		SetNextAttemptAt(next).
+		SetLastError(lastError).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to schedule webhook delivery retry", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// MarkDead gives up on a delivery. It stays in the log and can be redelivered.
+func (r *WebhookRepository) MarkDead(ctx context.Context, id, responseStatus int, lastError string) error {
+	err := r.client.WebhookDelivery.UpdateOneID(id).
+		SetStatus(webhookdelivery.StatusDead).
+		AddAttempts(1).
+		SetResponseStatus(responseStatus).
+		SetLastError(lastError).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("database error: failed to mark webhook delivery dead", "id", id, "error", err)
+		return err
+	}
+	return nil
+}
+
+// GetUser retrieves the user a login event is about.
+func (r *WebhookRepository) GetUser(ctx context.Context, id int) (*ent.User, error) {
+	u, err := r.client.User.Get(ctx, id)
+	if err != nil {
+		slog.Error("database error: failed to get user", "id", id, "error", err)
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,282 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"keeper/ent"
+	"keeper/ent/privacy"
+	"keeper/ent/webhookdelivery"
+	"keeper/pkg/auth"
+)
+
+// WebhookService defines the business logic for webhooks.
+type WebhookService interface {
+	Create(ctx context.Context, req CreateWebhookRequest) (*Webhook, error)
+	GetByID(ctx context.Context, id int) (*Webhook, error)
+	List(ctx context.Context) ([]*Webhook, error)
+	Update(ctx context.Context, id int, req UpdateWebhookRequest) (*Webhook, error)
+	Delete(ctx context.Context, id int) error
+	RotateSecret(ctx context.Context, id int) (*Webhook, error)
+	ListDeliveries(ctx context.Context, webhookID int, req ListDeliveriesRequest) ([]*Delivery, error)
+	Redeliver(ctx context.Context, webhookID, deliveryID int) (*Delivery, error)
+}
+
+// DefaultLimit and MaxLimit bound the number of deliveries ListDeliveries returns.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+var (
+	// ErrWebhookNotFound is returned when the requested webhook does not exist.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrDeliveryNotFound is returned when the requested delivery does not
+	// exist or is not of the given webhook.
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrAppNotFound is returned when a webhook is for an app that does not exist.
+	ErrAppNotFound = errors.New("app not found")
+	// ErrInvalidStatus is returned when deliveries are filtered by an unknown status.
+	ErrInvalidStatus = errors.New("invalid delivery status")
+	// ErrForbidden is returned when the caller may not make a change, such as
+	// adding a webhook to another app.
+	ErrForbidden = errors.New("operation not permitted")
+)
+
+type webhookService struct {
+	repo  *WebhookRepository
+	guard *AddressGuard
+}
+
+// NewWebhookService creates a new webhook service. Webhook URLs must point to
+// addresses guard lets deliveries reach.
+func NewWebhookService(repo *WebhookRepository, guard *AddressGuard) WebhookService {
+	return &webhookService{repo: repo, guard: guard}
+}
+
+func (s *webhookService) Create(ctx context.Context, req CreateWebhookRequest) (*Webhook, error) {
+	slog.Info("creating webhook", "app_id", req.AppID, "url", req.URL)
+	if err := s.guard.CheckURL(ctx, req.URL); err != nil {
+		slog.Warn("webhook url rejected", "app_id", req.AppID, "url", req.URL, "error", err)
+		return nil, err
+	}
+	secret, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate webhook secret: %w", err)
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	created, err := s.repo.Create(ctx, &ent.Webhook{
+		AppID:       req.AppID,
+		URL:         req.URL,
+		Secret:      secret,
+		Events:      req.Events,
+		Enabled:     enabled,
+		Description: req.Description,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, privacy.Deny):
+			slog.Warn("webhook creation denied", "app_id", req.AppID, "error", err)
+			return nil, ErrForbidden
+		case ent.IsConstraintError(err):
+			return nil, ErrAppNotFound
+		}
+		return nil, fmt.Errorf("repository create: %w", err)
+	}
+
+	slog.Info("webhook created successfully", "id", created.ID, "app_id", created.AppID)
+	w := s.toDomain(created)
+	w.Secret = secret
+	return w, nil
+}
+
+func (s *webhookService) GetByID(ctx context.Context, id int) (*Webhook, error) {
+	w, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return s.toDomain(w), nil
+}
+
+func (s *webhookService) List(ctx context.Context) ([]*Webhook, error) {
+	slog.Info("listing webhooks")
+	hooks, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domainHooks := make([]*Webhook, len(hooks))
+	for i, w := range hooks {
+		domainHooks[i] = s.toDomain(w)
+	}
+	return domainHooks, nil
+}
+
+func (s *webhookService) Update(ctx context.Context, id int, req UpdateWebhookRequest) (*Webhook, error) {
+	slog.Info("updating webhook", "id", id)
+	existing, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := s.guard.CheckURL(ctx, *req.URL); err != nil {
+			slog.Warn("webhook url rejected", "id", id, "url", *req.URL, "error", err)
+			return nil, err
+		}
+		existing.URL = *req.URL
+	}
+	if req.Events != nil {
+		existing.Events = req.Events
+	}
+	if req.Enabled != nil {
+		existing.Enabled = *req.Enabled
+	}
+	if req.Description != nil {
+		existing.Description = *req.Description
+	}
+
+	updated, err := s.repo.Update(ctx, id, existing)
+	if err != nil {
+		if errors.Is(err, privacy.Deny) {
+			slog.Warn("webhook update denied", "id", id, "error", err)
+			return nil, ErrForbidden
+		}
+		return nil, err
+	}
+
+	slog.Info("webhook updated successfully", "id", id)
+	return s.toDomain(updated), nil
+}
+
+func (s *webhookService) Delete(ctx context.Context, id int) error {
+	slog.Info("deleting webhook", "id", id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrWebhookNotFound
+		}
+		return err
+	}
+	slog.Info("webhook deleted successfully", "id", id)
+	return nil
+}
+
+func (s *webhookService) RotateSecret(ctx context.Context, id int) (*Webhook, error) {
+	slog.Info("rotating webhook secret", "id", id)
+	secret, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate webhook secret: %w", err)
+	}
+
+	updated, err := s.repo.SetSecret(ctx, id, secret)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	slog.Info("webhook secret rotated", "id", id)
+	w := s.toDomain(updated)
+	w.Secret = secret
+	return w, nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, webhookID int, req ListDeliveriesRequest) ([]*Delivery, error) {
+	if req.Status != "" {
+		if err := webhookdelivery.StatusValidator(webhookdelivery.Status(req.Status)); err != nil {
+			return nil, ErrInvalidStatus
+		}
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	// Check the webhook exists, and is visible to the caller, so that an
+	// unknown one is not reported as having no deliveries.
+	if _, err := s.GetByID(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.repo.ListDeliveries(ctx, webhookID, req.Status, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	domainDeliveries := make([]*Delivery, len(deliveries))
+	for i, d := range deliveries {
+		domainDeliveries[i] = s.deliveryToDomain(d)
+	}
+	return domainDeliveries, nil
+}
+
+func (s *webhookService) Redeliver(ctx context.Context, webhookID, deliveryID int) (*Delivery, error) {
+	slog.Info("redelivering webhook event", "webhook_id", webhookID, "delivery_id", deliveryID)
+	d, err := s.repo.GetDelivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	created, err := s.repo.Redeliver(ctx, d)
+	if err != nil {
+		if errors.Is(err, privacy.Deny) {
+			slog.Warn("webhook redelivery denied", "delivery_id", deliveryID, "error", err)
+			return nil, ErrForbidden
+		}
+		return nil, err
+	}
+
+	slog.Info("webhook event queued for redelivery", "delivery_id", deliveryID, "id", created.ID)
+	return s.deliveryToDomain(created), nil
+}
+
+func (s *webhookService) toDomain(w *ent.Webhook) *Webhook {
+	events := w.Events
+	if events == nil {
+		events = []string{}
+	}
+	return &Webhook{
+		ID:          w.ID,
+		AppID:       w.AppID,
+		URL:         w.URL,
+		Events:      events,
+		Enabled:     w.Enabled,
+		Description: w.Description,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+func (s *webhookService) deliveryToDomain(d *ent.WebhookDelivery) *Delivery {
+	return &Delivery{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventID:        d.EventID,
+		Event:          d.Event,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastError:      d.LastError,
+		ResponseStatus: d.ResponseStatus,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		Payload:        json.RawMessage(d.Payload),
+	}
+}
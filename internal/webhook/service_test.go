@@ -0,0 +1,488 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"keeper/ent"
+	"keeper/ent/enttest"
+	"keeper/ent/webhookdelivery"
+	"keeper/internal/db"
+	"keeper/internal/user"
+	"keeper/pkg/auth"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// receiver is a webhook endpoint that records the requests it gets and
+// fails while failures is positive.
+type receiver struct {
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	failures int
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	body, _ := io.ReadAll(r.Body)
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, body)
+	if rc.failures > 0 {
+		rc.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestService_Events(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_webhook_events?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	repo := NewWebhookRepository(client)
+	d := NewDispatcher(repo)
+	Register(client, d)
+	svc := NewWebhookService(repo, &AddressGuard{})
+	users := user.NewUserRepository(client)
+
+	ctx := context.Background()
+	a, err := client.App.Create().SetName("Webhook App").Save(ctx)
+	assert.NoError(t, err)
+	other, err := client.App.Create().SetName("Other App").Save(ctx)
+	assert.NoError(t, err)
+
+	all, err := svc.Create(ctx, CreateWebhookRequest{AppID: a.ID, URL: "https://example.com/all"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, all.Secret)
+	assert.Empty(t, all.Events)
+	deletions, err := svc.Create(ctx, CreateWebhookRequest{AppID: a.ID, URL: "https://example.com/deletions", Events: []string{EventUserDeleted}})
+	assert.NoError(t, err)
+	disabled := false
+	_, err = svc.Create(ctx, CreateWebhookRequest{AppID: a.ID, URL: "https://example.com/off", Enabled: &disabled})
+	assert.NoError(t, err)
+	_, err = svc.Create(ctx, CreateWebhookRequest{AppID: other.ID, URL: "https://example.com/other"})
+	assert.NoError(t, err)
+
+	u, err := users.Create(ctx, &ent.User{AppID: a.ID, Firstname: "Ada", Lastname: "Lovelace", Email: "ada@example.com", Password: "hash", Status: 1})
+	assert.NoError(t, err)
+	u.Firstname = "Augusta"
+	_, err = users.Update(ctx, u.ID, u)
+	assert.NoError(t, err)
+	// Bookkeeping is not sent.
+	err = users.UpdatePasswordHash(ctx, u.ID, "rehashed")
+	assert.NoError(t, err)
+	err = users.LockUser(ctx, u.ID, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.NoError(t, d.RecordLogin(ctx, user.LoginEvent{AppID: &a.ID, UserID: &u.ID, Email: u.Email, Method: user.LoginMethodPassword}))
+	assert.NoError(t, d.RecordLogin(ctx, user.LoginEvent{AppID: &a.ID, UserID: &u.ID, Email: u.Email, Method: user.LoginMethodPassword, Failure: user.LoginFailureInvalidPassword}))
+	a.Name = "Renamed App"
+	_, err = client.App.UpdateOneID(a.ID).SetName("Renamed App").Save(ctx)
+	assert.NoError(t, err)
+	err = users.Delete(ctx, u.ID)
+	assert.NoError(t, err)
+
+	t.Run("Queues subscribed events", func(t *testing.T) {
+		deliveries, err := svc.ListDeliveries(ctx, all.ID, ListDeliveriesRequest{})
+		assert.NoError(t, err)
+		types := make([]string, len(deliveries))
+		for i, del := range deliveries {
+			types[i] = del.Event
+			assert.Equal(t, "pending", del.Status)
+		}
+		assert.Equal(t, []string{EventUserDeleted, EventAppUpdated, EventUserLogin, EventUserLocked, EventUserUpdated, EventUserCreated}, types)
+
+		var updated Event
+		err = json.Unmarshal(deliveries[4].Payload, &updated)
+		assert.NoError(t, err)
+		assert.Equal(t, EventUserUpdated, updated.Type)
+		assert.Equal(t, a.ID, updated.AppID)
+		assert.Equal(t, []string{"firstname"}, updated.Data.Changes)
+		assert.Equal(t, "Augusta", updated.Data.User.Firstname)
+
+		var login Event
+		err = json.Unmarshal(deliveries[2].Payload, &login)
+		assert.NoError(t, err)
+		assert.Equal(t, user.LoginMethodPassword, login.Data.Method)
+		assert.NotNil(t, login.Data.User.LockedUntil)
+
+		var app Event
+		err = json.Unmarshal(deliveries[1].Payload, &app)
+		assert.NoError(t, err)
+		assert.Equal(t, "Renamed App", app.Data.App.Name)
+		assert.Equal(t, []string{"name"}, app.Data.Changes)
+
+		deliveries, err = svc.ListDeliveries(ctx, deletions.ID, ListDeliveriesRequest{})
+		assert.NoError(t, err)
+		if assert.Len(t, deliveries, 1) {
+			assert.Equal(t, EventUserDeleted, deliveries[0].Event)
+		}
+
+		n, err := client.WebhookDelivery.Query().Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 7, n)
+	})
+
+	t.Run("Events are stored with the change", func(t *testing.T) {
+		before, err := client.WebhookDelivery.Query().Count(ctx)
+		assert.NoError(t, err)
+
+		errRollback := errors.New("rollback")
+		err = db.WithTx(ctx, client, func(tx *ent.Client) error {
+			_, err := tx.User.Create().SetAppID(a.ID).SetFirstname("Grace").SetLastname("Hopper").SetEmail("grace@example.com").SetPassword("hash").Save(ctx)
+			assert.NoError(t, err)
+			return errRollback
+		})
+		assert.ErrorIs(t, err, errRollback)
+
+		after, err := client.WebhookDelivery.Query().Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, before, after)
+		n, err := client.User.Query().Count(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("Scopes webhooks to the caller's app", func(t *testing.T) {
+		tenantCtx := context.WithValue(ctx, auth.UserClaimsKey, &auth.UserClaims{AppID: other.ID, UserID: 1})
+		hooks, err := svc.List(tenantCtx)
+		assert.NoError(t, err)
+		if assert.Len(t, hooks, 1) {
+			assert.Equal(t, other.ID, hooks[0].AppID)
+			assert.Empty(t, hooks[0].Secret)
+		}
+
+		_, err = svc.GetByID(tenantCtx, all.ID)
+		assert.ErrorIs(t, err, ErrWebhookNotFound)
+		_, err = svc.ListDeliveries(tenantCtx, all.ID, ListDeliveriesRequest{})
+		assert.ErrorIs(t, err, ErrWebhookNotFound)
+		_, err = svc.Create(tenantCtx, CreateWebhookRequest{AppID: a.ID, URL: "https://example.com/steal"})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Updates and rotates", func(t *testing.T) {
+		events := []string{EventUserCreated}
+		updated, err := svc.Update(ctx, deletions.ID, UpdateWebhookRequest{Events: events})
+		assert.NoError(t, err)
+		assert.Equal(t, events, updated.Events)
+		assert.Equal(t, "https://example.com/deletions", updated.URL)
+
+		rotated, err := svc.RotateSecret(ctx, deletions.ID)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, rotated.Secret)
+		assert.NotEqual(t, deletions.Secret, rotated.Secret)
+
+		_, err = svc.Create(ctx, CreateWebhookRequest{AppID: 999999, URL: "https://example.com/none"})
+		assert.ErrorIs(t, err, ErrAppNotFound)
+		_, err = svc.ListDeliveries(ctx, all.ID, ListDeliveriesRequest{Status: "lost"})
+		assert.ErrorIs(t, err, ErrInvalidStatus)
+	})
+
+	t.Run("Deleting a webhook deletes its deliveries", func(t *testing.T) {
+		err := svc.Delete(ctx, deletions.ID)
+		assert.NoError(t, err)
+		n, err := client.WebhookDelivery.Query().Where(webhookdelivery.WebhookIDEQ(deletions.ID)).Count(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+
+		err = svc.Delete(ctx, deletions.ID)
+		assert.ErrorIs(t, err, ErrWebhookNotFound)
+	})
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_webhook_deliver?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	rc := &receiver{failures: 1}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+
+	// The test server listens on loopback, which webhooks may not reach
+	// unless allowed.
+	guard, err := NewAddressGuard([]string{"127.0.0.1"})
+	assert.NoError(t, err)
+	repo := NewWebhookRepository(client)
+	d := NewDispatcher(repo, WithMaxAttempts(3), WithAddressGuard(guard))
+	Register(client, d)
+	svc := NewWebhookService(repo, guard)
+
+	ctx := context.Background()
+	a, err := client.App.Create().SetName("Deliver App").Save(ctx)
+	assert.NoError(t, err)
+	wh, err := svc.Create(ctx, CreateWebhookRequest{AppID: a.ID, URL: srv.URL, Events: []string{EventUserCreated}})
+	assert.NoError(t, err)
+
+	_, err = user.NewUserRepository(client).Create(ctx, &ent.User{AppID: a.ID, Firstname: "Ada", Lastname: "Lovelace", Email: "ada@example.com", Password: "hash", Status: 1})
+	assert.NoError(t, err)
+
+	// due makes every pending delivery due now.
+	due := func() {
+		err := client.WebhookDelivery.Update().
+			Where(webhookdelivery.StatusEQ(webhookdelivery.StatusPending)).
+			SetNextAttemptAt(time.Now().Add(-time.Second)).
+			Exec(ctx)
+		assert.NoError(t, err)
+	}
+
+	t.Run("Retries failed deliveries", func(t *testing.T) {
+		n, err := d.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+
+		deliveries, err := svc.ListDeliveries(ctx, wh.ID, ListDeliveriesRequest{})
+		assert.NoError(t, err)
+		if assert.Len(t, deliveries, 1) {
+			del := deliveries[0]
+			assert.Equal(t, "pending", del.Status)
+			assert.Equal(t, 1, del.Attempts)
+			assert.Equal(t, http.StatusServiceUnavailable, del.ResponseStatus)
+			assert.Contains(t, del.LastError, "503")
+			assert.True(t, del.NextAttemptAt.After(time.Now()))
+		}
+
+		// Not due yet.
+		n, err = d.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("Delivers signed payloads", func(t *testing.T) {
+		due()
+		n, err := d.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		deliveries, err := svc.ListDeliveries(ctx, wh.ID, ListDeliveriesRequest{Status: "delivered"})
+		assert.NoError(t, err)
+		if !assert.Len(t, deliveries, 1) {
+			return
+		}
+		del := deliveries[0]
+		assert.Equal(t, 2, del.Attempts)
+		assert.NotNil(t, del.DeliveredAt)
+		assert.Empty(t, del.LastError)
+
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+		if !assert.Len(t, rc.requests, 2) {
+			return
+		}
+		req, body := rc.requests[1], rc.bodies[1]
+		assert.Equal(t, EventUserCreated, req.Header.Get(HeaderEvent))
+		assert.Equal(t, strconv.Itoa(del.ID), req.Header.Get(HeaderDelivery))
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+		sig := req.Header.Get(HeaderSignature)
+		ts, _, _ := strings.Cut(strings.TrimPrefix(sig, "t="), ",")
+		unix, err := strconv.ParseInt(ts, 10, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, Sign(wh.Secret, time.Unix(unix, 0), body), sig)
+		assert.NotEqual(t, Sign("wrong", time.Unix(unix, 0), body), sig)
+
+		var e Event
+		err = json.Unmarshal(body, &e)
+		assert.NoError(t, err)
+		assert.Equal(t, del.EventID, e.ID)
+		assert.Equal(t, "ada@example.com", e.Data.User.Email)
+	})
+
+	t.Run("Dead letters and redelivers", func(t *testing.T) {
+		rc.mu.Lock()
+		rc.failures = 3
+		rc.mu.Unlock()
+
+		_, err := user.NewUserRepository(client).Create(ctx, &ent.User{AppID: a.ID, Firstname: "Grace", Lastname: "Hopper", Email: "grace@example.com", Password: "hash", Status: 1})
+		assert.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			due()
+			_, err := d.Deliver(ctx)
+			assert.NoError(t, err)
+		}
+
+		dead, err := svc.ListDeliveries(ctx, wh.ID, ListDeliveriesRequest{Status: "dead"})
+		assert.NoError(t, err)
+		if !assert.Len(t, dead, 1) {
+			return
+		}
+		assert.Equal(t, 3, dead[0].Attempts)
+
+		// Dead deliveries are not tried again.
+		n, err := d.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+
+		redelivery, err := svc.Redeliver(ctx, wh.ID, dead[0].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", redelivery.Status)
+		assert.Equal(t, dead[0].EventID, redelivery.EventID)
+		assert.Zero(t, redelivery.Attempts)
+
+		n, err = d.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		_, err = svc.Redeliver(ctx, wh.ID+1000, dead[0].ID)
+		assert.ErrorIs(t, err, ErrDeliveryNotFound)
+	})
+
+	t.Run("Holds deliveries of disabled webhooks", func(t *testing.T) {
+		_, err := user.NewUserRepository(client).Create(ctx, &ent.User{AppID: a.ID, Firstname: "Alan", Lastname: "Turing", Email: "alan@example.com", Password: "hash", Status: 1})
+		assert.NoError(t, err)
+		disabled := false
+		_, err = svc.Update(ctx, wh.ID, UpdateWebhookRequest{Enabled: &disabled})
+		assert.NoError(t, err)
+		// Disabled webhooks get no new events.
+		_, err = user.NewUserRepository(client).Create(ctx, &ent.User{AppID: a.ID, Firstname: "Edsger", Lastname: "Dijkstra", Email: "edsger@example.com", Password: "hash", Status: 1})
+		assert.NoError(t, err)
+
+		n, err := d.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+
+		enabled := true
+		_, err = svc.Update(ctx, wh.ID, UpdateWebhookRequest{Enabled: &enabled})
+		assert.NoError(t, err)
+		n, err = d.Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("Refuses internal addresses", func(t *testing.T) {
+		strict := &AddressGuard{}
+		_, err := NewWebhookService(repo, strict).Create(ctx, CreateWebhookRequest{AppID: a.ID, URL: srv.URL})
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+		_, err = NewWebhookService(repo, strict).Update(ctx, wh.ID, UpdateWebhookRequest{URL: &srv.URL})
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+
+		// A webhook that was accepted, or whose host now resolves to an
+		// internal address, is refused when connecting.
+		rc.mu.Lock()
+		requests := len(rc.requests)
+		rc.mu.Unlock()
+		_, err = user.NewUserRepository(client).Create(ctx, &ent.User{AppID: a.ID, Firstname: "Barbara", Lastname: "Liskov", Email: "barbara@example.com", Password: "hash", Status: 1})
+		assert.NoError(t, err)
+
+		n, err := NewDispatcher(repo).Deliver(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		pending, err := svc.ListDeliveries(ctx, wh.ID, ListDeliveriesRequest{Status: "pending"})
+		assert.NoError(t, err)
+		if assert.Len(t, pending, 1) {
+			assert.Contains(t, pending[0].LastError, ErrForbiddenAddress.Error())
+		}
+		rc.mu.Lock()
+		assert.Len(t, rc.requests, requests)
+		rc.mu.Unlock()
+	})
+}
+
+func TestDispatcher_Claim(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent_webhook_claim?mode=memory&cache=shared&_fk=1")
+	defer func() {
+		err := client.Close()
+		assert.NoError(t, err)
+	}()
+
+	ctx := context.Background()
+	// leases holds how long each delivery is still claimed for when it is
+	// received, sent one after another by a slow endpoint.
+	var (
+		mu     sync.Mutex
+		leases []time.Duration
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, _ := strconv.Atoi(r.Header.Get(HeaderDelivery))
+		if del, err := client.WebhookDelivery.Get(ctx, id); assert.NoError(t, err) {
+			mu.Lock()
+			leases = append(leases, time.Until(del.NextAttemptAt))
+			mu.Unlock()
+		}
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	guard, err := NewAddressGuard([]string{"127.0.0.1"})
+	assert.NoError(t, err)
+	repo := NewWebhookRepository(client)
+	d := NewDispatcher(repo, WithAddressGuard(guard), WithTimeout(time.Minute))
+	Register(client, d)
+
+	a, err := client.App.Create().SetName("Claim App").Save(ctx)
+	assert.NoError(t, err)
+	_, err = NewWebhookService(repo, guard).Create(ctx, CreateWebhookRequest{AppID: a.ID, URL: srv.URL, Events: []string{EventUserCreated}})
+	assert.NoError(t, err)
+	for _, email := range []string{"ada@example.com", "grace@example.com", "alan@example.com"} {
+		_, err = user.NewUserRepository(client).Create(ctx, &ent.User{AppID: a.ID, Firstname: "Claim", Lastname: "User", Email: email, Password: "hash", Status: 1})
+		assert.NoError(t, err)
+	}
+
+	n, err := d.Deliver(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// Each delivery is claimed when it is sent, for longer than the
+	// timeout, however long the ones before it took.
+	mu.Lock()
+	defer mu.Unlock()
+	if assert.Len(t, leases, 3) {
+		for _, lease := range leases {
+			assert.Greater(t, lease, time.Minute+claimLease-100*time.Millisecond)
+		}
+	}
+}
+
+func TestAddressGuard(t *testing.T) {
+	guard, err := NewAddressGuard([]string{"10.1.0.0/16", "192.168.1.10"})
+	assert.NoError(t, err)
+
+	for addr, allowed := range map[string]bool{
+		"93.184.215.14":    true,
+		"2606:2800::1":     true,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"::ffff:127.0.0.1": false,
+		"10.0.0.1":         false,
+		"10.1.2.3":         true,
+		"172.16.5.4":       false,
+		"192.168.1.10":     true,
+		"192.168.1.11":     false,
+		"169.254.169.254":  false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"100.64.0.1":       false,
+		"0.0.0.0":          false,
+		"224.0.0.1":        false,
+	} {
+		assert.Equal(t, allowed, guard.Allowed(netip.MustParseAddr(addr)), addr)
+	}
+
+	ctx := context.Background()
+	assert.ErrorIs(t, guard.CheckURL(ctx, "http://169.254.169.254/latest/meta-data/"), ErrForbiddenAddress)
+	assert.ErrorIs(t, guard.CheckURL(ctx, "http://[::1]:8080/hook"), ErrForbiddenAddress)
+	assert.ErrorIs(t, guard.CheckURL(ctx, "http://localhost:8080/hook"), ErrForbiddenAddress)
+	assert.NoError(t, guard.CheckURL(ctx, "http://10.1.0.5/hook"))
+	assert.NoError(t, guard.CheckURL(ctx, "https://93.184.215.14/hook"))
+
+	_, err = NewAddressGuard([]string{"not-a-network"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"net/http"
+)
+
+const ClientIPKey contextKey = "client_ip"
+
+// ClientIP is a middleware that stores the IP a request came from in its
+// context, where login throttling reads it. The IP is taken from
+// r.RemoteAddr, so proxy headers are only honoured when a middleware such as
+// chi's RealIP ran before it.
+func ClientIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := r.RemoteAddr
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+		next.ServeHTTP(w, r.WithContext(WithClientIP(r.Context(), ip)))
+	})
+}
+
+// WithClientIP returns a copy of ctx carrying the client IP.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ClientIPKey, ip)
+}
+
+// GetClientIPFromContext retrieves the client IP from the context. It returns
+// an empty string for contexts that did not come from a request.
+func GetClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(ClientIPKey).(string)
+	return ip
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Denylist records the IDs (jti) of revoked tokens until they would have expired anyway.
+type Denylist interface {
+	// Revoke adds a token ID to the denylist until expiresAt.
+	Revoke(ctx context.Context, jti string, expiresAt time.Time) error
+	// IsRevoked reports whether a token ID has been revoked.
+	IsRevoked(ctx context.Context, jti string) (bool, error)
+	// PurgeExpired removes entries whose tokens have expired and returns how many were removed.
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+// MemoryDenylist is a Denylist kept in process memory. It is suitable for
+// single-instance deployments and tests; entries are lost on restart.
+type MemoryDenylist struct {
+	mu      sync.RWMutex
+	entries map[string]time.Time
+}
+
+// NewMemoryDenylist creates an empty in-memory denylist.
+func NewMemoryDenylist() *MemoryDenylist {
+	return &MemoryDenylist{entries: make(map[string]time.Time)}
+}
+
+// Revoke adds a token ID to the denylist until expiresAt.
+func (d *MemoryDenylist) Revoke(_ context.Context, jti string, expiresAt time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries[jti] = expiresAt
+	return nil
+}
+
+// IsRevoked reports whether a token ID has been revoked.
+func (d *MemoryDenylist) IsRevoked(_ context.Context, jti string) (bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	_, ok := d.entries[jti]
+	return ok, nil
+}
+
+// PurgeExpired removes entries whose tokens have expired.
+func (d *MemoryDenylist) PurgeExpired(_ context.Context) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	purged := 0
+	for jti, expiresAt := range d.entries {
+		if now.After(expiresAt) {
+			delete(d.entries, jti)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// RunPurger removes expired denylist entries every interval until ctx is cancelled.
+func RunPurger(ctx context.Context, d Denylist, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := d.PurgeExpired(ctx)
+			if err != nil {
+				slog.Error("failed to purge token denylist", "error", err)
+				continue
+			}
+			if n > 0 {
+				slog.Info("purged expired denylist entries", "count", n)
+			}
+		}
+	}
+}
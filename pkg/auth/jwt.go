@@ -1,21 +1,97 @@
 package auth
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// ErrTokenRevoked is returned when a token has been revoked before it expired.
+var ErrTokenRevoked = errors.New("token has been revoked")
+
+// UserStatusChecker reports whether the user a token was issued to may still use it,
+// for example because the user was deactivated, deleted or had all sessions revoked.
+type UserStatusChecker interface {
+	CheckUser(ctx context.Context, claims *UserClaims) error
+}
+
+// ClientStatusChecker reports whether the app a client credentials token was
+// issued to may still use it.
+type ClientStatusChecker interface {
+	CheckClient(ctx context.Context, claims *UserClaims) error
+}
+
 // JWTManager handles generation and validation of JWT tokens.
 type JWTManager struct {
-	secretKey     string
+	keyring       *Keyring
 	tokenDuration time.Duration
+	issuer        string
+	denylist      Denylist
+	users         UserStatusChecker
+	clients       ClientStatusChecker
 }
 
-// NewJWTManager creates a new JWT manager.
+// NewJWTManager creates a new JWT manager that signs with a shared HMAC secret.
 func NewJWTManager(secretKey string, tokenDuration time.Duration) *JWTManager {
-	return &JWTManager{secretKey, tokenDuration}
+	return NewJWTManagerWithSigner(NewHMACSigner("", secretKey), tokenDuration)
+}
+
+// NewJWTManagerWithSigner creates a new JWT manager that signs with the given key.
+func NewJWTManagerWithSigner(signer Signer, tokenDuration time.Duration) *JWTManager {
+	return NewJWTManagerWithKeyring(NewKeyring(tokenDuration, Key{Signer: signer}), tokenDuration)
+}
+
+// NewJWTManagerWithKeyring creates a new JWT manager that signs with the active
+// key of the keyring and verifies with any key the keyring still trusts.
+func NewJWTManagerWithKeyring(keyring *Keyring, tokenDuration time.Duration) *JWTManager {
+	return &JWTManager{keyring: keyring, tokenDuration: tokenDuration}
+}
+
+// SetIssuer sets the iss claim of issued tokens.
+func (manager *JWTManager) SetIssuer(issuer string) {
+	manager.issuer = issuer
+}
+
+// Issuer returns the iss claim of issued tokens.
+func (manager *JWTManager) Issuer() string {
+	return manager.issuer
+}
+
+// SetDenylist sets the store consulted by Validate and written by Revoke.
+func (manager *JWTManager) SetDenylist(d Denylist) {
+	manager.denylist = d
+}
+
+// SetUserStatusChecker sets the check Validate runs against the token's user.
+func (manager *JWTManager) SetUserStatusChecker(c UserStatusChecker) {
+	manager.users = c
+}
+
+// SetClientStatusChecker sets the check Validate runs against the app of a client token.
+func (manager *JWTManager) SetClientStatusChecker(c ClientStatusChecker) {
+	manager.clients = c
+}
+
+// Keyring returns the keyring holding the manager's signing keys.
+func (manager *JWTManager) Keyring() *Keyring {
+	return manager.keyring
+}
+
+// TokenDuration returns the lifetime of the access tokens issued by the manager.
+func (manager *JWTManager) TokenDuration() time.Duration {
+	return manager.tokenDuration
+}
+
+// JWKS returns the public keys that can be used to verify issued tokens.
+// Symmetric keys are never published.
+func (manager *JWTManager) JWKS() JWKSet {
+	return manager.keyring.JWKS(time.Now())
 }
 
 // UserClaims is a custom JWT claims that contains user's information.
@@ -23,20 +99,86 @@ type UserClaims struct {
 	jwt.RegisteredClaims
 	AppID  int `json:"app_id"`
 	UserID int `json:"user_id"`
+	// Scope is the space separated list of OAuth scopes granted to the token.
+	Scope string `json:"scope,omitempty"`
+	// ClientID is set on tokens issued to an app through the client
+	// credentials grant. Such tokens have no UserID.
+	ClientID string `json:"client_id,omitempty"`
+	// PlatformAdmin is set on tokens of users who may act on every app.
+	PlatformAdmin bool `json:"platform_admin,omitempty"`
+	// Roles are the names of the user's roles in their app and Permissions
+	// the union of the permissions those roles grant.
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// IsClientToken reports whether the token identifies an app rather than a user.
+func (c *UserClaims) IsClientToken() bool {
+	return c.UserID == 0 && c.ClientID != ""
+}
+
+// HasScope reports whether scope was granted to the token.
+func (c *UserClaims) HasScope(scope string) bool {
+	for _, s := range strings.Fields(c.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission reports whether the token may perform the action named by
+// permission. App tokens are granted permissions through their scopes and
+// platform admins hold every permission.
+func (c *UserClaims) HasPermission(permission string) bool {
+	switch {
+	case c.PlatformAdmin:
+		return true
+	case c.IsClientToken():
+		return c.HasScope(permission)
+	}
+	return slices.Contains(c.Permissions, permission)
 }
 
 // Generate generates and signs a new token for a user.
 func (manager *JWTManager) Generate(appID, userID int) (string, error) {
-	claims := UserClaims{
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(manager.tokenDuration)),
-		},
-		AppID:  appID,
-		UserID: userID,
+	return manager.Issue(UserClaims{AppID: appID, UserID: userID})
+}
+
+// Issue fills in the token ID, subject, issuer, issue and expiry times of the
+// claims and signs them.
+func (manager *JWTManager) Issue(claims UserClaims) (string, error) {
+	jti, err := GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims.ID = jti
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(manager.tokenDuration))
+	if claims.Issuer == "" {
+		claims.Issuer = manager.issuer
+	}
+	if claims.Subject == "" && claims.UserID != 0 {
+		claims.Subject = strconv.Itoa(claims.UserID)
+	}
+
+	return manager.Sign(claims)
+}
+
+// Sign signs arbitrary claims, such as OpenID Connect ID tokens, with the active key.
+func (manager *JWTManager) Sign(claims jwt.Claims) (string, error) {
+	signer, err := manager.keyring.Active(time.Now())
+	if err != nil {
+		return "", err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(manager.secretKey))
+	token := jwt.NewWithClaims(signer.Method(), claims)
+	if kid := signer.KeyID(); kid != "" {
+		token.Header["kid"] = kid
+	}
+	return token.SignedString(signer.SigningKey())
 }
 
 // Verify verifies the access token string and return a user claims if the token is valid.
@@ -45,12 +187,17 @@ func (manager *JWTManager) Verify(accessToken string) (*UserClaims, error) {
 		accessToken,
 		&UserClaims{},
 		func(token *jwt.Token) (interface{}, error) {
-			_, ok := token.Method.(*jwt.SigningMethodHMAC)
+			kid, _ := token.Header["kid"].(string)
+			signer, ok := manager.keyring.Verifier(kid, time.Now())
 			if !ok {
+				return nil, fmt.Errorf("unknown signing key %q", kid)
+			}
+
+			if token.Method.Alg() != signer.Method().Alg() {
 				return nil, fmt.Errorf("unexpected token signing method")
 			}
 
-			return []byte(manager.secretKey), nil
+			return signer.VerificationKey(), nil
 		},
 	)
 
@@ -65,3 +212,52 @@ func (manager *JWTManager) Verify(accessToken string) (*UserClaims, error) {
 
 	return claims, nil
 }
+
+// Validate verifies the token and checks that it has not been revoked and that
+// its user is still allowed to use it.
+func (manager *JWTManager) Validate(ctx context.Context, accessToken string) (*UserClaims, error) {
+	claims, err := manager.Verify(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.denylist != nil && claims.ID != "" {
+		revoked, err := manager.denylist.IsRevoked(ctx, claims.ID)
+		if err != nil {
+			return nil, fmt.Errorf("check denylist: %w", err)
+		}
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
+	}
+
+	if claims.IsClientToken() {
+		if manager.clients != nil {
+			if err := manager.clients.CheckClient(ctx, claims); err != nil {
+				return nil, err
+			}
+		}
+	} else if manager.users != nil {
+		if err := manager.users.CheckUser(ctx, claims); err != nil {
+			return nil, err
+		}
+	}
+
+	return claims, nil
+}
+
+// Revoke adds the token to the denylist so it is rejected until it expires.
+func (manager *JWTManager) Revoke(ctx context.Context, claims *UserClaims) error {
+	if manager.denylist == nil {
+		return errors.New("token revocation is not configured")
+	}
+	if claims.ID == "" {
+		return errors.New("token has no jti")
+	}
+
+	expiresAt := time.Now().Add(manager.tokenDuration)
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+	return manager.denylist.Revoke(ctx, claims.ID, expiresAt)
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrNoSigningKey is returned when the keyring holds no key that may sign tokens.
+var ErrNoSigningKey = errors.New("no active signing key")
+
+// Key status values reported by the keyring.
+const (
+	KeyStatusPending  = "pending"
+	KeyStatusActive   = "active"
+	KeyStatusRetiring = "retiring"
+	KeyStatusExpired  = "expired"
+)
+
+// Key is a signing key together with its rotation schedule.
+type Key struct {
+	Signer Signer
+	// ActivatesAt is when the key starts signing. The most recently activated
+	// key signs; older keys stop signing as soon as a newer one activates.
+	ActivatesAt time.Time
+	// RetiredAt, when set, is when the key was explicitly taken out of service.
+	RetiredAt time.Time
+}
+
+// KeyState describes a key of the keyring at a point in time.
+type KeyState struct {
+	Key
+	Status string
+	// VerifyUntil is when tokens signed by the key stop being accepted.
+	// It is zero while the key may still sign.
+	VerifyUntil time.Time
+}
+
+// Keyring holds every key that is pending, signing or still trusted for verification.
+// Keys keep verifying for maxTokenAge after they stop signing, so rotating keys
+// never invalidates tokens that have not expired yet.
+type Keyring struct {
+	mu          sync.RWMutex
+	keys        []Key
+	maxTokenAge time.Duration
+}
+
+// NewKeyring creates a keyring for tokens that live at most maxTokenAge.
+func NewKeyring(maxTokenAge time.Duration, keys ...Key) *Keyring {
+	kr := &Keyring{maxTokenAge: maxTokenAge}
+	kr.Replace(keys)
+	return kr
+}
+
+// Replace atomically swaps the keys held by the keyring.
+func (kr *Keyring) Replace(keys []Key) {
+	sorted := make([]Key, len(keys))
+	copy(sorted, keys)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ActivatesAt.Before(sorted[j].ActivatesAt)
+	})
+
+	kr.mu.Lock()
+	kr.keys = sorted
+	kr.mu.Unlock()
+}
+
+// States returns the state of every key at the given time, oldest first.
+func (kr *Keyring) States(now time.Time) []KeyState {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	states := make([]KeyState, len(kr.keys))
+	for i, k := range kr.keys {
+		states[i] = KeyState{Key: k}
+
+		if cancelled(k) && !k.RetiredAt.After(now) {
+			// Retired before it ever signed.
+			states[i].Status = KeyStatusExpired
+			continue
+		}
+
+		if k.ActivatesAt.After(now) {
+			states[i].Status = KeyStatusPending
+			continue
+		}
+
+		// A key stops signing when it is retired or superseded by a newer key.
+		var signingEnd time.Time
+		if !k.RetiredAt.IsZero() && !k.RetiredAt.After(now) {
+			signingEnd = k.RetiredAt
+		}
+		for _, next := range kr.keys[i+1:] {
+			if cancelled(next) {
+				continue
+			}
+			if !next.ActivatesAt.After(now) && (signingEnd.IsZero() || next.ActivatesAt.Before(signingEnd)) {
+				signingEnd = next.ActivatesAt
+			}
+			break
+		}
+
+		if signingEnd.IsZero() {
+			states[i].Status = KeyStatusActive
+			continue
+		}
+
+		states[i].VerifyUntil = signingEnd.Add(kr.maxTokenAge)
+		if now.Before(states[i].VerifyUntil) {
+			states[i].Status = KeyStatusRetiring
+		} else {
+			states[i].Status = KeyStatusExpired
+		}
+	}
+	return states
+}
+
+// cancelled reports whether a key was retired before it was due to activate.
+func cancelled(k Key) bool {
+	return !k.RetiredAt.IsZero() && !k.RetiredAt.After(k.ActivatesAt)
+}
+
+// Active returns the key that signs new tokens at the given time.
+func (kr *Keyring) Active(now time.Time) (Signer, error) {
+	for _, s := range kr.States(now) {
+		if s.Status == KeyStatusActive {
+			return s.Signer, nil
+		}
+	}
+	return nil, ErrNoSigningKey
+}
+
+// Verifier returns the key with the given kid if tokens it signed are still trusted.
+func (kr *Keyring) Verifier(kid string, now time.Time) (Signer, bool) {
+	for _, s := range kr.States(now) {
+		if s.Signer.KeyID() == kid && s.Status != KeyStatusExpired {
+			return s.Signer, true
+		}
+	}
+	return nil, false
+}
+
+// JWKS returns the public half of every key that is pending, signing or still
+// trusted, so verifiers can cache upcoming keys before they start signing.
+func (kr *Keyring) JWKS(now time.Time) JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	for _, s := range kr.States(now) {
+		if s.Status == KeyStatusExpired {
+			continue
+		}
+		if jwk, ok := s.Signer.PublicJWK(); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	return set
+}
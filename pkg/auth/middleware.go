@@ -38,7 +38,7 @@ func Middleware(manager *JWTManager) func(http.Handler) http.Handler {
 			}
 
 			token := parts[1]
-			claims, err := manager.Verify(token)
+			claims, err := manager.Validate(r.Context(), token)
 			if err != nil {
 				slog.Warn("invalid or expired token", "path", r.URL.Path, "remote_addr", r.RemoteAddr, "error", err)
 				render.Error(w, http.StatusUnauthorized, "invalid or expired token")
@@ -51,3 +51,53 @@ func Middleware(manager *JWTManager) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// RequirePermission returns a middleware that rejects tokens which do not
+// hold permission, either through the roles of their user or, for app tokens,
+// through their scopes. It must run after Middleware.
+func RequirePermission(permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := GetClaimsFromContext(r.Context())
+			if !ok {
+				render.Error(w, http.StatusUnauthorized, "missing token claims")
+				return
+			}
+
+			if !claims.HasPermission(permission) {
+				slog.Warn("token missing permission", "path", r.URL.Path, "user_id", claims.UserID, "client_id", claims.ClientID, "permission", permission)
+				render.Error(w, http.StatusForbidden, "insufficient permission")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireUser returns a middleware that rejects app tokens on routes that act
+// on behalf of a user. It must run after Middleware.
+func RequireUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := GetClaimsFromContext(r.Context())
+		if !ok || claims.IsClientToken() {
+			render.Error(w, http.StatusForbidden, "a user token is required")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RequirePlatformAdmin returns a middleware that only lets platform admins
+// through, for routes acting on resources shared by every app. It must run
+// after Middleware.
+func RequirePlatformAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := GetClaimsFromContext(r.Context())
+		if !ok || !claims.PlatformAdmin {
+			render.Error(w, http.StatusForbidden, "platform admin required")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signer holds a single key used to sign and verify tokens.
+type Signer interface {
+	// KeyID returns the identifier placed in the "kid" header of issued tokens.
+	KeyID() string
+	// Method returns the JWT signing method for the key.
+	Method() jwt.SigningMethod
+	// SigningKey returns the key passed to the signing method when signing.
+	SigningKey() interface{}
+	// VerificationKey returns the key passed to the signing method when verifying.
+	VerificationKey() interface{}
+	// PublicJWK returns the public half of the key; ok is false for symmetric keys.
+	PublicJWK() (jwk JWK, ok bool)
+}
+
+type hmacSigner struct {
+	kid    string
+	secret []byte
+}
+
+// NewHMACSigner creates an HS256 signer from a shared secret.
+func NewHMACSigner(kid, secret string) Signer {
+	return &hmacSigner{kid: kid, secret: []byte(secret)}
+}
+
+func (s *hmacSigner) KeyID() string                { return s.kid }
+func (s *hmacSigner) Method() jwt.SigningMethod    { return jwt.SigningMethodHS256 }
+func (s *hmacSigner) SigningKey() interface{}      { return s.secret }
+func (s *hmacSigner) VerificationKey() interface{} { return s.secret }
+func (s *hmacSigner) PublicJWK() (JWK, bool)       { return JWK{}, false }
+
+type asymmetricSigner struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	jwk     JWK
+}
+
+// NewSigner creates a signer from an RSA, ECDSA or Ed25519 private key.
+// When kid is empty the RFC 7638 thumbprint of the public key is used.
+func NewSigner(kid string, key crypto.Signer) (Signer, error) {
+	var method jwt.SigningMethod
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			method = jwt.SigningMethodES256
+		case elliptic.P384():
+			method = jwt.SigningMethodES384
+		case elliptic.P521():
+			method = jwt.SigningMethodES512
+		default:
+			return nil, fmt.Errorf("unsupported ecdsa curve %s", k.Curve.Params().Name)
+		}
+	case ed25519.PrivateKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	jwk, err := newPublicJWK(key.Public(), method.Alg())
+	if err != nil {
+		return nil, err
+	}
+	if kid == "" {
+		kid = jwk.Thumbprint()
+	}
+	jwk.Kid = kid
+
+	return &asymmetricSigner{kid: kid, method: method, private: key, jwk: jwk}, nil
+}
+
+// NewSignerFromPEM creates a signer from a PEM encoded PKCS#8, PKCS#1 or SEC 1 private key.
+func NewSignerFromPEM(kid string, data []byte) (Signer, error) {
+	key, err := ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewSigner(kid, key)
+}
+
+// LoadSignerFromFile reads a PEM encoded private key from disk and creates a signer.
+func LoadSignerFromFile(kid, path string) (Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read signing key: %w", err)
+	}
+	return NewSignerFromPEM(kid, data)
+}
+
+// ParsePrivateKeyPEM decodes a PEM encoded RSA, ECDSA or Ed25519 private key.
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found in signing key")
+	}
+
+	var (
+		key interface{}
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+func (s *asymmetricSigner) KeyID() string                { return s.kid }
+func (s *asymmetricSigner) Method() jwt.SigningMethod    { return s.method }
+func (s *asymmetricSigner) SigningKey() interface{}      { return s.private }
+func (s *asymmetricSigner) VerificationKey() interface{} { return s.private.Public() }
+func (s *asymmetricSigner) PublicJWK() (JWK, bool)       { return s.jwk, true }
+
+// GenerateKey creates a new private key for the given asymmetric JWS algorithm.
+func GenerateKey(alg string) (crypto.Signer, error) {
+	switch alg {
+	case jwt.SigningMethodRS256.Alg():
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case jwt.SigningMethodES256.Alg():
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case jwt.SigningMethodES384.Alg():
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case jwt.SigningMethodES512.Alg():
+		return ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case jwt.SigningMethodEdDSA.Alg():
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+}
+
+// MarshalPrivateKeyPEM encodes a private key as a PKCS#8 PEM block.
+func MarshalPrivateKeyPEM(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("marshal private key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
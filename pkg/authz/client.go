@@ -0,0 +1,282 @@
+// Package authz is a client for the keeper authorization decision API. Services
+// embed it to ask whether a user may perform an action, with decisions cached
+// in memory for a short time so hot paths do not call keeper on every request.
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is how long decisions are cached unless WithTTL is given.
+const DefaultTTL = 30 * time.Second
+
+// maxEntries bounds the cache; expired entries are dropped once it is reached.
+const maxEntries = 10000
+
+// Resource describes what an action is performed on.
+type Resource struct {
+	Type    string `json:"type,omitempty"`
+	ID      string `json:"id,omitempty"`
+	AppID   int    `json:"app_id,omitempty"`
+	OwnerID int    `json:"owner_id,omitempty"`
+}
+
+// Request asks whether a user may perform an action. UserID and AppID default
+// to the user and app of the client's token; app tokens must set UserID.
+type Request struct {
+	UserID   int       `json:"user_id,omitempty"`
+	AppID    int       `json:"app_id,omitempty"`
+	Action   string    `json:"action"`
+	Resource *Resource `json:"resource,omitempty"`
+}
+
+// Decision is the outcome of a check with the reason it was reached.
+type Decision struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+}
+
+// TokenSource returns the bearer token sent with each call. The token needs
+// the authz:check permission or scope.
+type TokenSource func(ctx context.Context) (string, error)
+
+// Client calls the keeper /authz endpoints.
+type Client struct {
+	baseURL string
+	http    *http.Client
+	token   TokenSource
+	ttl     time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	decision  Decision
+	expiresAt time.Time
+}
+
+// Option configures optional behaviour of the client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for calls.
+func WithHTTPClient(c *http.Client) Option {
+	return func(cl *Client) {
+		if c != nil {
+			cl.http = c
+		}
+	}
+}
+
+// WithTTL sets how long decisions are cached. Zero disables caching.
+func WithTTL(d time.Duration) Option {
+	return func(cl *Client) {
+		if d >= 0 {
+			cl.ttl = d
+		}
+	}
+}
+
+// WithToken sets the source of the bearer token sent with each call.
+func WithToken(ts TokenSource) Option {
+	return func(cl *Client) {
+		cl.token = ts
+	}
+}
+
+// New creates a client for the keeper instance at baseURL.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		http:    http.DefaultClient,
+		ttl:     DefaultTTL,
+		cache:   make(map[string]cacheEntry),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Check asks keeper for a single decision.
+func (c *Client) Check(ctx context.Context, req Request) (Decision, error) {
+	token, err := c.bearer(ctx)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	key := cacheKey(token, req)
+	if d, ok := c.lookup(key); ok {
+		return d, nil
+	}
+
+	var d Decision
+	if err := c.post(ctx, token, "/authz/check", req, &d); err != nil {
+		return Decision{}, err
+	}
+	c.store(key, d)
+	return d, nil
+}
+
+// CheckBatch asks keeper for several decisions, returned in request order.
+// Cached decisions are reused and only the rest are sent.
+func (c *Client) CheckBatch(ctx context.Context, reqs []Request) ([]Decision, error) {
+	token, err := c.bearer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]Decision, len(reqs))
+	keys := make([]string, len(reqs))
+	var missing []int
+	for i, req := range reqs {
+		keys[i] = cacheKey(token, req)
+		if d, ok := c.lookup(keys[i]); ok {
+			decisions[i] = d
+			continue
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) == 0 {
+		return decisions, nil
+	}
+
+	body := struct {
+		Checks []Request `json:"checks"`
+	}{Checks: make([]Request, len(missing))}
+	for j, i := range missing {
+		body.Checks[j] = reqs[i]
+	}
+
+	var res struct {
+		Decisions []Decision `json:"decisions"`
+	}
+	if err := c.post(ctx, token, "/authz/check/batch", body, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Decisions) != len(missing) {
+		return nil, fmt.Errorf("authz: got %d decisions for %d checks", len(res.Decisions), len(missing))
+	}
+
+	for j, i := range missing {
+		decisions[i] = res.Decisions[j]
+		c.store(keys[i], res.Decisions[j])
+	}
+	return decisions, nil
+}
+
+// Allowed is a shorthand for Check that reports only whether the action is allowed.
+func (c *Client) Allowed(ctx context.Context, req Request) (bool, error) {
+	d, err := c.Check(ctx, req)
+	return d.Allowed, err
+}
+
+// Flush drops every cached decision, e.g. after changing a user's roles.
+func (c *Client) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.cache)
+}
+
+func (c *Client) bearer(ctx context.Context) (string, error) {
+	if c.token == nil {
+		return "", nil
+	}
+	token, err := c.token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("authz: get token: %w", err)
+	}
+	return token, nil
+}
+
+func (c *Client) post(ctx context.Context, token, path string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("authz: encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("authz: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("authz: call %s: %w", path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Responses use the render.Response envelope.
+	var envelope struct {
+		Data  json.RawMessage `json:"data"`
+		Error string          `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("authz: decode response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("authz: %s returned %d: %s", path, resp.StatusCode, envelope.Error)
+	}
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return fmt.Errorf("authz: decode decision: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) lookup(key string) (Decision, bool) {
+	if c.ttl == 0 {
+		return Decision{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.cache[key]
+	if !ok {
+		return Decision{}, false
+	}
+	if time.Now().After(e.expiresAt) {
+		delete(c.cache, key)
+		return Decision{}, false
+	}
+	return e.decision, true
+}
+
+func (c *Client) store(key string, d Decision) {
+	if c.ttl == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if len(c.cache) >= maxEntries {
+		for k, e := range c.cache {
+			if now.After(e.expiresAt) {
+				delete(c.cache, k)
+			}
+		}
+		// Still full of live entries: start over rather than grow unbounded.
+		if len(c.cache) >= maxEntries {
+			clear(c.cache)
+		}
+	}
+	c.cache[key] = cacheEntry{decision: d, expiresAt: now.Add(c.ttl)}
+}
+
+// cacheKey identifies a decision. The token is part of the key because the
+// user and app of a request default to the caller's.
+func cacheKey(token string, req Request) string {
+	b, _ := json.Marshal(req)
+	return token + "\x00" + string(b)
+}
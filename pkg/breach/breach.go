@@ -0,0 +1,204 @@
+// Package breach screens passwords against a local corpus of breached
+// password hashes, such as the Pwned Passwords downloads, without calling
+// external services.
+//
+// A corpus is compiled into an index file: a 16 byte header followed by the
+// distinct hashes of the corpus, sorted, as raw bytes. Lookups binary search
+// the file, so the index is never loaded into memory.
+package breach
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/crypto/md4"
+)
+
+// Kind is the hash function of a corpus.
+type Kind byte
+
+const (
+	// SHA1 corpora hold SHA-1 hashes of the UTF-8 password.
+	SHA1 Kind = 1
+	// NTLM corpora hold MD4 hashes of the UTF-16LE password.
+	NTLM Kind = 2
+)
+
+// ParseKind returns the kind named "sha1" or "ntlm".
+func ParseKind(s string) (Kind, error) {
+	switch strings.ToLower(s) {
+	case "sha1":
+		return SHA1, nil
+	case "ntlm":
+		return NTLM, nil
+	}
+	return 0, fmt.Errorf("unknown hash kind %q", s)
+}
+
+func (k Kind) String() string {
+	switch k {
+	case SHA1:
+		return "sha1"
+	case NTLM:
+		return "ntlm"
+	}
+	return fmt.Sprintf("kind(%d)", byte(k))
+}
+
+// Size is the length in bytes of the hashes of kind k.
+func (k Kind) Size() int {
+	switch k {
+	case SHA1:
+		return sha1.Size
+	case NTLM:
+		return md4.Size
+	}
+	return 0
+}
+
+// Sum returns the hash of a password as stored in corpora of kind k.
+func (k Kind) Sum(password string) []byte {
+	switch k {
+	case SHA1:
+		sum := sha1.Sum([]byte(password))
+		return sum[:]
+	case NTLM:
+		h := md4.New()
+		for _, c := range utf16.Encode([]rune(password)) {
+			h.Write([]byte{byte(c), byte(c >> 8)})
+		}
+		return h.Sum(nil)
+	}
+	return nil
+}
+
+// magic starts every index file; the byte after it is the Kind.
+const magic = "KPRBRCH1"
+
+// headerSize is the length of the index header: magic, kind and padding.
+const headerSize = 16
+
+// ErrUnsorted is returned when a corpus is not sorted by hash.
+var ErrUnsorted = errors.New("corpus is not sorted by hash")
+
+// Source yields the hashes of a corpus in ascending order. Next returns
+// io.EOF after the last hash.
+type Source interface {
+	Next() ([]byte, error)
+}
+
+// Reader is a Source reading a corpus in the Pwned Passwords text format:
+// one hex hash per line, optionally followed by ":" and a count, sorted by
+// hash. The lines of a range file hold the hash without its prefix.
+type Reader struct {
+	s      *bufio.Scanner
+	kind   Kind
+	prefix string
+	line   int
+	last   []byte
+}
+
+// NewReader returns a Reader of the hashes of kind k in r. prefix is the hex
+// prefix of every hash of a range file, empty for full hashes.
+func NewReader(r io.Reader, k Kind, prefix string) *Reader {
+	return &Reader{s: bufio.NewScanner(r), kind: k, prefix: prefix}
+}
+
+// Next returns the next hash of the corpus.
+func (r *Reader) Next() ([]byte, error) {
+	for r.s.Scan() {
+		r.line++
+		line := strings.TrimSpace(r.s.Text())
+		if line == "" {
+			continue
+		}
+		hexHash, _, _ := strings.Cut(line, ":")
+		hash, err := hex.DecodeString(r.prefix + hexHash)
+		if err != nil || len(hash) != r.kind.Size() {
+			return nil, fmt.Errorf("line %d: not a %s hash", r.line, r.kind)
+		}
+		if r.last != nil && bytes.Compare(hash, r.last) < 0 {
+			return nil, fmt.Errorf("line %d: %w", r.line, ErrUnsorted)
+		}
+		r.last = hash
+		return hash, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// Build writes the index of the hashes of kind k in sources to w and returns
+// how many distinct hashes it holds. Every source must be sorted; they are
+// merged, so they may overlap.
+func Build(w io.Writer, k Kind, sources ...Source) (int64, error) {
+	if k.Size() == 0 {
+		return 0, fmt.Errorf("unknown hash kind %d", k)
+	}
+	bw := bufio.NewWriter(w)
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	header[len(magic)] = byte(k)
+	if _, err := bw.Write(header); err != nil {
+		return 0, err
+	}
+
+	heads := make([][]byte, len(sources))
+	for i, s := range sources {
+		h, err := next(s, k)
+		if err != nil {
+			return 0, err
+		}
+		heads[i] = h
+	}
+
+	var n int64
+	var last []byte
+	for {
+		lowest := -1
+		for i, h := range heads {
+			if h != nil && (lowest < 0 || bytes.Compare(h, heads[lowest]) < 0) {
+				lowest = i
+			}
+		}
+		if lowest < 0 {
+			break
+		}
+		if last == nil || !bytes.Equal(heads[lowest], last) {
+			if _, err := bw.Write(heads[lowest]); err != nil {
+				return 0, err
+			}
+			last = heads[lowest]
+			n++
+		}
+		h, err := next(sources[lowest], k)
+		if err != nil {
+			return 0, err
+		}
+		heads[lowest] = h
+	}
+	return n, bw.Flush()
+}
+
+// next returns the next hash of s, or nil once s is exhausted.
+func next(s Source, k Kind) ([]byte, error) {
+	h, err := s.Next()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(h) != k.Size() {
+		return nil, fmt.Errorf("hash of %d bytes in a %s corpus", len(h), k)
+	}
+	return h, nil
+}
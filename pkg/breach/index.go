@@ -0,0 +1,167 @@
+package breach
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrInvalidIndex is returned when opening a file that is not an index.
+var ErrInvalidIndex = errors.New("not a breached password index")
+
+// Index is an open index file.
+type Index struct {
+	f    *os.File
+	kind Kind
+	n    int64
+}
+
+// Open opens the index file at path.
+func Open(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := newIndex(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return idx, nil
+}
+
+func newIndex(f *os.File) (*Index, error) {
+	header := make([]byte, headerSize)
+	if _, err := f.ReadAt(header, 0); err != nil || string(header[:len(magic)]) != magic {
+		return nil, ErrInvalidIndex
+	}
+	kind := Kind(header[len(magic)])
+	if kind.Size() == 0 {
+		return nil, ErrInvalidIndex
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	body := info.Size() - headerSize
+	if body%int64(kind.Size()) != 0 {
+		return nil, ErrInvalidIndex
+	}
+	return &Index{f: f, kind: kind, n: body / int64(kind.Size())}, nil
+}
+
+// Kind returns the hash function of the index.
+func (i *Index) Kind() Kind {
+	return i.kind
+}
+
+// Len returns the number of hashes in the index.
+func (i *Index) Len() int64 {
+	return i.n
+}
+
+// Contains reports whether the password is in the corpus of the index.
+func (i *Index) Contains(password string) (bool, error) {
+	return i.ContainsHash(i.kind.Sum(password))
+}
+
+// ContainsHash reports whether the hash is in the index.
+func (i *Index) ContainsHash(hash []byte) (bool, error) {
+	size := int64(i.kind.Size())
+	if int64(len(hash)) != size {
+		return false, fmt.Errorf("hash of %d bytes in a %s index", len(hash), i.kind)
+	}
+	buf := make([]byte, size)
+	lo, hi := int64(0), i.n
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if _, err := i.f.ReadAt(buf, headerSize+mid*size); err != nil {
+			return false, err
+		}
+		switch c := bytes.Compare(buf, hash); {
+		case c == 0:
+			return true, nil
+		case c < 0:
+			lo = mid + 1
+		default:
+			hi = mid
+		}
+	}
+	return false, nil
+}
+
+// Close closes the index file.
+func (i *Index) Close() error {
+	return i.f.Close()
+}
+
+// Screener checks passwords against the index file at a path and picks up a
+// replaced file on Reload, so the corpus can be updated without a restart.
+// Indexes should be replaced by renaming a complete file over the old one.
+type Screener struct {
+	path string
+
+	mu      sync.RWMutex
+	idx     *Index
+	modTime time.Time
+	size    int64
+}
+
+// NewScreener opens the index file at path.
+func NewScreener(path string) (*Screener, error) {
+	s := &Screener{path: path}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Contains reports whether the password is in the current corpus.
+func (s *Screener) Contains(password string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.idx.Contains(password)
+}
+
+// Reload reopens the index file when it has changed since it was opened.
+// The previous index stays in use when the new file cannot be opened.
+func (s *Screener) Reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	s.mu.RLock()
+	unchanged := s.idx != nil && info.ModTime().Equal(s.modTime) && info.Size() == s.size
+	s.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	idx, err := Open(s.path)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	old := s.idx
+	s.idx, s.modTime, s.size = idx, info.ModTime(), info.Size()
+	s.mu.Unlock()
+
+	if old != nil {
+		if err := old.Close(); err != nil {
+			slog.Warn("closing breached password index failed", "path", s.path, "error", err)
+		}
+	}
+	slog.Info("breached password index loaded", "path", s.path, "kind", idx.Kind().String(), "hashes", idx.Len())
+	return nil
+}
+
+// Close closes the current index file.
+func (s *Screener) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.idx.Close()
+}
@@ -16,6 +16,43 @@ type Config struct {
 	Log         LogConfig
 	Auth        AuthConfig
 	CORS        CORSConfig
+	Mail        MailConfig
+	Webhook     WebhookConfig
+}
+
+// MailConfig holds the outbound email configuration.
+type MailConfig struct {
+	// Driver selects how email is delivered: "smtp", "file" (one .eml file per
+	// message in Dir) or "log" (written to the log, for development).
+	Driver string `mapstructure:"DRIVER"`
+	// From is the sender address, e.g. "Keeper <no-reply@example.com>".
+	From string `mapstructure:"FROM"`
+	Dir  string `mapstructure:"DIR"`
+	// SMTPImplicitTLS connects with TLS from the start (usually port 465)
+	// instead of upgrading with STARTTLS.
+	SMTPHost        string `mapstructure:"SMTP_HOST"`
+	SMTPPort        int    `mapstructure:"SMTP_PORT"`
+	SMTPUsername    string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword    string `mapstructure:"SMTP_PASSWORD"`
+	SMTPImplicitTLS bool   `mapstructure:"SMTP_IMPLICIT_TLS"`
+	// OutboxInterval controls how often queued email is delivered.
+	OutboxInterval time.Duration `mapstructure:"OUTBOX_INTERVAL"`
+	// MaxAttempts is how many times a message is tried before it is given up on.
+	MaxAttempts int `mapstructure:"MAX_ATTEMPTS"`
+}
+
+// WebhookConfig holds the outbound webhook configuration.
+type WebhookConfig struct {
+	// DeliveryInterval controls how often queued events are delivered.
+	DeliveryInterval time.Duration `mapstructure:"DELIVERY_INTERVAL"`
+	// MaxAttempts is how many times a delivery is tried before it is marked dead.
+	MaxAttempts int `mapstructure:"MAX_ATTEMPTS"`
+	// Timeout is how long a webhook has to respond.
+	Timeout time.Duration `mapstructure:"TIMEOUT"`
+	// AllowedNetworks are the internal networks, in CIDR notation, webhooks
+	// may be sent to. Loopback, private, link-local and reserved addresses
+	// are refused otherwise.
+	AllowedNetworks []string `mapstructure:"ALLOWED_NETWORKS"`
 }
 
 // CORSConfig holds the CORS-specific configuration.
@@ -30,6 +67,9 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration `mapstructure:"READ_TIMEOUT"`
 	WriteTimeout time.Duration `mapstructure:"WRITE_TIMEOUT"`
 	IdleTimeout  time.Duration `mapstructure:"IDLE_TIMEOUT"`
+	// TrustProxy takes client IPs from the X-Forwarded-For and X-Real-IP
+	// headers. Only enable it behind a proxy that sets them.
+	TrustProxy bool `mapstructure:"TRUST_PROXY"`
 }
 
 // DatabaseConfig holds the database-specific configuration.
@@ -44,8 +84,50 @@ type LogConfig struct {
 
 // AuthConfig holds the authentication-specific configuration.
 type AuthConfig struct {
-	JWTSecret string        `mapstructure:"JWT_SECRET"`
-	JWTExpiry time.Duration `mapstructure:"JWT_EXPIRY"`
+	// Issuer is the public base URL of the service, used as the iss claim
+	// and to build the OpenID Connect endpoint URLs.
+	Issuer        string        `mapstructure:"ISSUER"`
+	JWTSecret     string        `mapstructure:"JWT_SECRET"`
+	JWTExpiry     time.Duration `mapstructure:"JWT_EXPIRY"`
+	RefreshExpiry time.Duration `mapstructure:"REFRESH_EXPIRY"`
+	// PasswordResetExpiry is how long a password reset token can be used.
+	PasswordResetExpiry time.Duration `mapstructure:"PASSWORD_RESET_EXPIRY"`
+	// EmailVerificationExpiry is how long an email verification token can be used.
+	EmailVerificationExpiry time.Duration `mapstructure:"EMAIL_VERIFICATION_EXPIRY"`
+	// MFAChallengeExpiry is how long a login can wait for its second factor.
+	MFAChallengeExpiry time.Duration `mapstructure:"MFA_CHALLENGE_EXPIRY"`
+	// WebAuthnChallengeExpiry is how long a passkey registration or login can take.
+	WebAuthnChallengeExpiry time.Duration `mapstructure:"WEBAUTHN_CHALLENGE_EXPIRY"`
+	// MagicLinkExpiry is how long an emailed login link can be used.
+	MagicLinkExpiry time.Duration `mapstructure:"MAGIC_LINK_EXPIRY"`
+	// SigningKeyFile is a PEM encoded RSA, ECDSA or Ed25519 private key.
+	// When empty, tokens are signed with JWTSecret using HS256.
+	SigningKeyFile string `mapstructure:"SIGNING_KEY_FILE"`
+	SigningKeyID   string `mapstructure:"SIGNING_KEY_ID"`
+	// KeyReloadInterval controls how often rotated keys are re-read from the database.
+	KeyReloadInterval time.Duration `mapstructure:"KEY_RELOAD_INTERVAL"`
+	// DenylistStore selects where revoked token IDs are kept: "database" or "memory".
+	DenylistStore         string        `mapstructure:"DENYLIST_STORE"`
+	DenylistPurgeInterval time.Duration `mapstructure:"DENYLIST_PURGE_INTERVAL"`
+	// BreachedPasswordsFile is an index built with cmd/breach; new passwords
+	// found in it are rejected. Screening is off when empty.
+	BreachedPasswordsFile string `mapstructure:"BREACHED_PASSWORDS_FILE"`
+	// BreachedPasswordsReloadInterval controls how often the index is
+	// reopened after being replaced.
+	BreachedPasswordsReloadInterval time.Duration `mapstructure:"BREACHED_PASSWORDS_RELOAD_INTERVAL"`
+	// PasswordHashAlgorithm selects how new passwords are hashed: "argon2id",
+	// "scrypt" or "bcrypt". Hashes made with another algorithm or cost are
+	// replaced when their user next logs in.
+	PasswordHashAlgorithm string `mapstructure:"PASSWORD_HASH_ALGORITHM"`
+	// Argon2Memory is the memory argon2id uses per hash, in KiB.
+	Argon2Memory  uint32 `mapstructure:"ARGON2_MEMORY"`
+	Argon2Time    uint32 `mapstructure:"ARGON2_TIME"`
+	Argon2Threads uint8  `mapstructure:"ARGON2_THREADS"`
+	// ScryptLogN is the base 2 logarithm of the scrypt cost N.
+	ScryptLogN uint8 `mapstructure:"SCRYPT_LOG_N"`
+	ScryptR    int   `mapstructure:"SCRYPT_R"`
+	ScryptP    int   `mapstructure:"SCRYPT_P"`
+	BcryptCost int   `mapstructure:"BCRYPT_COST"`
 }
 
 // Load loads the configuration from files and environment variables.
@@ -59,11 +141,48 @@ func Load() (*Config, error) {
 	v.SetDefault("SERVER.READ_TIMEOUT", 5*time.Second)
 	v.SetDefault("SERVER.WRITE_TIMEOUT", 10*time.Second)
 	v.SetDefault("SERVER.IDLE_TIMEOUT", 120*time.Second)
+	v.SetDefault("SERVER.TRUST_PROXY", false)
 	v.SetDefault("DB.PATH", "keeper.db")
 	v.SetDefault("LOG.DIR", "log")
+	v.SetDefault("AUTH.ISSUER", "http://localhost:8080")
 	v.SetDefault("AUTH.JWT_SECRET", "a-very-secure-and-shared-secret-key")
-	v.SetDefault("AUTH.JWT_EXPIRY", 24*time.Hour)
+	v.SetDefault("AUTH.JWT_EXPIRY", 15*time.Minute)
+	v.SetDefault("AUTH.REFRESH_EXPIRY", 30*24*time.Hour)
+	v.SetDefault("AUTH.PASSWORD_RESET_EXPIRY", time.Hour)
+	v.SetDefault("AUTH.EMAIL_VERIFICATION_EXPIRY", 48*time.Hour)
+	v.SetDefault("AUTH.MFA_CHALLENGE_EXPIRY", 5*time.Minute)
+	v.SetDefault("AUTH.WEBAUTHN_CHALLENGE_EXPIRY", 5*time.Minute)
+	v.SetDefault("AUTH.MAGIC_LINK_EXPIRY", 15*time.Minute)
+	v.SetDefault("AUTH.SIGNING_KEY_FILE", "")
+	v.SetDefault("AUTH.SIGNING_KEY_ID", "")
+	v.SetDefault("AUTH.KEY_RELOAD_INTERVAL", time.Minute)
+	v.SetDefault("AUTH.DENYLIST_STORE", "database")
+	v.SetDefault("AUTH.DENYLIST_PURGE_INTERVAL", 10*time.Minute)
+	v.SetDefault("AUTH.BREACHED_PASSWORDS_FILE", "")
+	v.SetDefault("AUTH.BREACHED_PASSWORDS_RELOAD_INTERVAL", time.Minute)
+	v.SetDefault("AUTH.PASSWORD_HASH_ALGORITHM", "argon2id")
+	v.SetDefault("AUTH.ARGON2_MEMORY", 19*1024)
+	v.SetDefault("AUTH.ARGON2_TIME", 2)
+	v.SetDefault("AUTH.ARGON2_THREADS", 1)
+	v.SetDefault("AUTH.SCRYPT_LOG_N", 17)
+	v.SetDefault("AUTH.SCRYPT_R", 8)
+	v.SetDefault("AUTH.SCRYPT_P", 1)
+	v.SetDefault("AUTH.BCRYPT_COST", 10)
 	v.SetDefault("CORS.ALLOWED_ORIGINS", []string{"*"})
+	v.SetDefault("MAIL.DRIVER", "log")
+	v.SetDefault("MAIL.FROM", "Keeper <no-reply@localhost>")
+	v.SetDefault("MAIL.DIR", "mail")
+	v.SetDefault("MAIL.SMTP_HOST", "localhost")
+	v.SetDefault("MAIL.SMTP_PORT", 587)
+	v.SetDefault("MAIL.SMTP_USERNAME", "")
+	v.SetDefault("MAIL.SMTP_PASSWORD", "")
+	v.SetDefault("MAIL.SMTP_IMPLICIT_TLS", false)
+	v.SetDefault("MAIL.OUTBOX_INTERVAL", 10*time.Second)
+	v.SetDefault("MAIL.MAX_ATTEMPTS", 8)
+	v.SetDefault("WEBHOOK.DELIVERY_INTERVAL", 5*time.Second)
+	v.SetDefault("WEBHOOK.MAX_ATTEMPTS", 8)
+	v.SetDefault("WEBHOOK.TIMEOUT", 10*time.Second)
+	v.SetDefault("WEBHOOK.ALLOWED_NETWORKS", []string{})
 
 	// Environment variables
 	v.SetEnvPrefix("KEEPER")
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LogMailer writes messages to the log instead of sending them. It is meant
+// for development: the log then holds whatever tokens the messages carry.
+type LogMailer struct{}
+
+// Send logs msg.
+func (LogMailer) Send(_ context.Context, msg Message) error {
+	body := msg.Text
+	if body == "" {
+		body = msg.HTML
+	}
+	slog.Info("email", "from", msg.From, "to", strings.Join(msg.To, ", "), "subject", msg.Subject, "body", body)
+	return nil
+}
+
+// FileMailer writes each message as an .eml file to a directory, where it can
+// be opened with a mail client or inspected by tests.
+type FileMailer struct {
+	Dir string
+}
+
+// Send writes msg to a new file in the mailer's directory.
+func (m FileMailer) Send(_ context.Context, msg Message) error {
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.Dir, 0o750); err != nil {
+		return fmt.Errorf("mailer: create mail directory: %w", err)
+	}
+
+	boundary, err := newBoundary()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), boundary[:8])
+	if err := os.WriteFile(filepath.Join(m.Dir, name), body, 0o640); err != nil {
+		return fmt.Errorf("mailer: write message: %w", err)
+	}
+	return nil
+}
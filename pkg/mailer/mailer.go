@@ -0,0 +1,116 @@
+// Package mailer sends email. It defines the Mailer interface with SMTP, log
+// and file implementations, and renders messages from html/template templates.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Message is an email ready to be sent. At least one of HTML and Text is set.
+type Message struct {
+	From    string
+	To      []string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Mailer delivers messages.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Bytes encodes the message as RFC 5322 text, using multipart/alternative
+// when it has both an HTML and a text body.
+func (m Message) Bytes() ([]byte, error) {
+	if len(m.To) == 0 {
+		return nil, fmt.Errorf("mailer: message has no recipient")
+	}
+	if _, err := mail.ParseAddress(m.From); err != nil {
+		return nil, fmt.Errorf("mailer: invalid from address: %w", err)
+	}
+	for _, to := range m.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return nil, fmt.Errorf("mailer: invalid recipient %q: %w", to, err)
+		}
+	}
+
+	var b bytes.Buffer
+	header := func(k, v string) { fmt.Fprintf(&b, "%s: %s\r\n", k, v) }
+	header("From", m.From)
+	header("To", strings.Join(m.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	switch {
+	case m.HTML != "" && m.Text != "":
+		boundary, err := newBoundary()
+		if err != nil {
+			return nil, err
+		}
+		header("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", boundary))
+		b.WriteString("\r\n")
+		for _, part := range []struct{ contentType, body string }{
+			{"text/plain", m.Text},
+			{"text/html", m.HTML},
+		} {
+			fmt.Fprintf(&b, "--%s\r\n", boundary)
+			if err := writeBody(&b, part.contentType, part.body); err != nil {
+				return nil, err
+			}
+		}
+		fmt.Fprintf(&b, "--%s--\r\n", boundary)
+	case m.HTML != "":
+		if err := writeBody(&b, "text/html", m.HTML); err != nil {
+			return nil, err
+		}
+	default:
+		if err := writeBody(&b, "text/plain", m.Text); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// writeBody writes the headers and quoted-printable body of a single part.
+func writeBody(b *bytes.Buffer, contentType, body string) error {
+	fmt.Fprintf(b, "Content-Type: %s; charset=utf-8\r\n", contentType)
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	w := quotedprintable.NewWriter(b)
+	if _, err := w.Write([]byte(body)); err != nil {
+		return fmt.Errorf("mailer: encode body: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("mailer: encode body: %w", err)
+	}
+	b.WriteString("\r\n")
+	return nil
+}
+
+func newBoundary() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("mailer: generate boundary: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// envelopeAddress returns the bare address of a possibly named address such
+// as "Keeper <no-reply@example.com>".
+func envelopeAddress(addr string) string {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return addr
+	}
+	return a.Address
+}
@@ -0,0 +1,113 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Template is the source of an email: a text/template subject, an
+// html/template HTML body and an optional text/template text body.
+type Template struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Validate reports whether the template parses.
+func (t Template) Validate() error {
+	_, _, _, err := t.parse()
+	return err
+}
+
+// Render executes the template with data and returns a message without sender
+// or recipients.
+func (t Template) Render(data any) (Message, error) {
+	subject, html, text, err := t.parse()
+	if err != nil {
+		return Message{}, err
+	}
+
+	var msg Message
+	var b bytes.Buffer
+	if err := subject.Execute(&b, data); err != nil {
+		return Message{}, fmt.Errorf("mailer: render subject: %w", err)
+	}
+	// Header values cannot span lines.
+	msg.Subject = strings.Join(strings.Fields(b.String()), " ")
+
+	b.Reset()
+	if err := html.Execute(&b, data); err != nil {
+		return Message{}, fmt.Errorf("mailer: render html: %w", err)
+	}
+	msg.HTML = b.String()
+
+	if text != nil {
+		b.Reset()
+		if err := text.Execute(&b, data); err != nil {
+			return Message{}, fmt.Errorf("mailer: render text: %w", err)
+		}
+		msg.Text = b.String()
+	}
+	return msg, nil
+}
+
+func (t Template) parse() (*texttemplate.Template, *htmltemplate.Template, *texttemplate.Template, error) {
+	subject, err := texttemplate.New("subject").Option("missingkey=error").Parse(t.Subject)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("mailer: parse subject: %w", err)
+	}
+	html, err := htmltemplate.New("html").Option("missingkey=error").Parse(t.HTML)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("mailer: parse html: %w", err)
+	}
+	var text *texttemplate.Template
+	if t.Text != "" {
+		text, err = texttemplate.New("text").Option("missingkey=error").Parse(t.Text)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("mailer: parse text: %w", err)
+		}
+	}
+	return subject, html, text, nil
+}
+
+// Templates holds the built-in templates by name.
+type Templates struct {
+	defaults map[string]Template
+}
+
+// NewTemplates creates a template set from the built-in templates.
+func NewTemplates(defaults map[string]Template) *Templates {
+	return &Templates{defaults: defaults}
+}
+
+// Names returns the names of the built-in templates in order.
+func (t *Templates) Names() []string {
+	names := make([]string, 0, len(t.defaults))
+	for name := range t.defaults {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Get returns the built-in template with the given name.
+func (t *Templates) Get(name string) (Template, bool) {
+	tmpl, ok := t.defaults[name]
+	return tmpl, ok
+}
+
+// Render renders the named template, or override instead when it is not nil.
+func (t *Templates) Render(name string, override *Template, data any) (Message, error) {
+	if override != nil {
+		return override.Render(data)
+	}
+	tmpl, ok := t.defaults[name]
+	if !ok {
+		return Message{}, fmt.Errorf("mailer: unknown template %q", name)
+	}
+	return tmpl.Render(data)
+}
@@ -0,0 +1,241 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Hash algorithms.
+const (
+	Argon2id = "argon2id"
+	Scrypt   = "scrypt"
+	Bcrypt   = "bcrypt"
+)
+
+const (
+	saltLength = 16
+	keyLength  = 32
+)
+
+// ErrUnknownHash is returned when verifying a hash in no supported format.
+var ErrUnknownHash = errors.New("unknown password hash format")
+
+// HashParams selects the algorithm new passwords are hashed with and its
+// cost. Only the parameters of the selected algorithm are used.
+type HashParams struct {
+	// Algorithm is Argon2id, Scrypt or Bcrypt.
+	Algorithm string
+	// Argon2Memory is the memory used by argon2id, in KiB.
+	Argon2Memory  uint32
+	Argon2Time    uint32
+	Argon2Threads uint8
+	// ScryptLogN is the base 2 logarithm of the scrypt CPU/memory cost N.
+	ScryptLogN uint8
+	ScryptR    int
+	ScryptP    int
+	BcryptCost int
+}
+
+// DefaultHashParams hashes with argon2id at the cost OWASP recommends:
+// 19 MiB of memory, 2 iterations and 1 thread.
+func DefaultHashParams() HashParams {
+	return HashParams{
+		Algorithm:     Argon2id,
+		Argon2Memory:  19 * 1024,
+		Argon2Time:    2,
+		Argon2Threads: 1,
+		ScryptLogN:    17,
+		ScryptR:       8,
+		ScryptP:       1,
+		BcryptCost:    bcrypt.DefaultCost,
+	}
+}
+
+// Hasher hashes passwords in the PHC string format and verifies hashes of
+// every supported algorithm, whatever it hashes new passwords with. Bcrypt
+// hashes keep their own $2a$ format, which predates PHC.
+type Hasher struct {
+	params HashParams
+}
+
+// NewHasher returns a Hasher for params.
+func NewHasher(params HashParams) (*Hasher, error) {
+	switch params.Algorithm {
+	case Argon2id:
+		if params.Argon2Memory < 8*uint32(params.Argon2Threads) || params.Argon2Time < 1 || params.Argon2Threads < 1 {
+			return nil, fmt.Errorf("invalid argon2id parameters m=%d,t=%d,p=%d", params.Argon2Memory, params.Argon2Time, params.Argon2Threads)
+		}
+	case Scrypt:
+		if params.ScryptLogN < 1 || params.ScryptLogN > 31 || params.ScryptR < 1 || params.ScryptP < 1 {
+			return nil, fmt.Errorf("invalid scrypt parameters ln=%d,r=%d,p=%d", params.ScryptLogN, params.ScryptR, params.ScryptP)
+		}
+	case Bcrypt:
+		if params.BcryptCost < bcrypt.MinCost || params.BcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("invalid bcrypt cost %d", params.BcryptCost)
+		}
+	default:
+		return nil, fmt.Errorf("unknown password hash algorithm %q", params.Algorithm)
+	}
+	return &Hasher{params: params}, nil
+}
+
+// DefaultHasher returns a Hasher for DefaultHashParams.
+func DefaultHasher() *Hasher {
+	return &Hasher{params: DefaultHashParams()}
+}
+
+// Hash returns the hash of a password with a random salt.
+func (h *Hasher) Hash(password string) (string, error) {
+	p := h.params
+	if p.Algorithm == Bcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), p.BcryptCost)
+		return string(hash), err
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	switch p.Algorithm {
+	case Argon2id:
+		key := argon2.IDKey([]byte(password), salt, p.Argon2Time, p.Argon2Memory, p.Argon2Threads, keyLength)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, p.Argon2Memory, p.Argon2Time, p.Argon2Threads, encode(salt), encode(key)), nil
+	case Scrypt:
+		key, err := scrypt.Key([]byte(password), salt, 1<<p.ScryptLogN, p.ScryptR, p.ScryptP, keyLength)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s", p.ScryptLogN, p.ScryptR, p.ScryptP, encode(salt), encode(key)), nil
+	}
+	return "", fmt.Errorf("unknown password hash algorithm %q", p.Algorithm)
+}
+
+// Verify reports whether password matches hash. It fails with ErrUnknownHash
+// for hashes it cannot parse.
+func (h *Hasher) Verify(hash, password string) (bool, error) {
+	if isBcrypt(hash) {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
+	ph, err := parse(hash)
+	if err != nil {
+		return false, err
+	}
+	var key []byte
+	switch ph.params.Algorithm {
+	case Argon2id:
+		key = argon2.IDKey([]byte(password), ph.salt, ph.params.Argon2Time, ph.params.Argon2Memory, ph.params.Argon2Threads, uint32(len(ph.key)))
+	case Scrypt:
+		key, err = scrypt.Key([]byte(password), ph.salt, 1<<ph.params.ScryptLogN, ph.params.ScryptR, ph.params.ScryptP, len(ph.key))
+		if err != nil {
+			return false, err
+		}
+	}
+	return subtle.ConstantTimeCompare(key, ph.key) == 1, nil
+}
+
+// NeedsRehash reports whether hash was made with another algorithm or cost
+// than h hashes with, so the password should be hashed again the next time
+// it is known.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	p := h.params
+	if isBcrypt(hash) {
+		if p.Algorithm != Bcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != p.BcryptCost
+	}
+
+	ph, err := parse(hash)
+	if err != nil || ph.params.Algorithm != p.Algorithm || len(ph.key) != keyLength {
+		return true
+	}
+	switch p.Algorithm {
+	case Argon2id:
+		return ph.params.Argon2Memory != p.Argon2Memory || ph.params.Argon2Time != p.Argon2Time || ph.params.Argon2Threads != p.Argon2Threads
+	case Scrypt:
+		return ph.params.ScryptLogN != p.ScryptLogN || ph.params.ScryptR != p.ScryptR || ph.params.ScryptP != p.ScryptP
+	}
+	return true
+}
+
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+// phcHash is a parsed PHC string.
+type phcHash struct {
+	params HashParams
+	salt   []byte
+	key    []byte
+}
+
+// parse parses the argon2id and scrypt PHC strings Hash writes.
+func parse(hash string) (*phcHash, error) {
+	fields := strings.Split(hash, "$")
+	if len(fields) < 5 || fields[0] != "" {
+		return nil, ErrUnknownHash
+	}
+	ph := &phcHash{params: HashParams{Algorithm: fields[1]}}
+	p := &ph.params
+	var err error
+	switch fields[1] {
+	case Argon2id:
+		var version int
+		if len(fields) != 6 {
+			return nil, ErrUnknownHash
+		}
+		if _, err := fmt.Sscanf(fields[2], "v=%d", &version); err != nil || version != argon2.Version {
+			return nil, ErrUnknownHash
+		}
+		if _, err := fmt.Sscanf(fields[3], "m=%d,t=%d,p=%d", &p.Argon2Memory, &p.Argon2Time, &p.Argon2Threads); err != nil {
+			return nil, ErrUnknownHash
+		}
+		if p.Argon2Time < 1 || p.Argon2Threads < 1 {
+			return nil, ErrUnknownHash
+		}
+		fields = fields[4:]
+	case Scrypt:
+		if len(fields) != 5 {
+			return nil, ErrUnknownHash
+		}
+		if _, err := fmt.Sscanf(fields[2], "ln=%d,r=%d,p=%d", &p.ScryptLogN, &p.ScryptR, &p.ScryptP); err != nil {
+			return nil, ErrUnknownHash
+		}
+		if p.ScryptLogN < 1 || p.ScryptLogN > 31 || p.ScryptR < 1 || p.ScryptP < 1 {
+			return nil, ErrUnknownHash
+		}
+		fields = fields[3:]
+	default:
+		return nil, ErrUnknownHash
+	}
+	if ph.salt, err = decode(fields[0]); err != nil {
+		return nil, ErrUnknownHash
+	}
+	if ph.key, err = decode(fields[1]); err != nil || len(ph.key) == 0 {
+		return nil, ErrUnknownHash
+	}
+	return ph, nil
+}
+
+// encode and decode use the unpadded base64 of PHC strings.
+func encode(b []byte) string {
+	return base64.RawStdEncoding.EncodeToString(b)
+}
+
+func decode(s string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(s)
+}
@@ -0,0 +1,151 @@
+// Package password checks new passwords against a password policy.
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxBytes is the longest password bcrypt hashes in full. It ignores the
+// bytes after it, so longer passwords would be accepted with any suffix.
+const MaxBytes = 72
+
+// Policy is the password policy of an app.
+type Policy struct {
+	// MinLength is the minimum number of characters.
+	MinLength int `json:"min_length" validate:"min=1,max=72"`
+	// MaxLength is the maximum length in UTF-8 bytes; 0 means MaxBytes.
+	MaxLength        int  `json:"max_length" validate:"omitempty,gtefield=MinLength,max=72"`
+	RequireUppercase bool `json:"require_uppercase"`
+	RequireLowercase bool `json:"require_lowercase"`
+	RequireDigit     bool `json:"require_digit"`
+	RequireSymbol    bool `json:"require_symbol"`
+	// DisallowPersonalInfo rejects passwords containing the user's email,
+	// the part of it before the @, or their first or last name.
+	DisallowPersonalInfo bool `json:"disallow_personal_info"`
+	// History is how many previous passwords cannot be used again.
+	History int `json:"history" validate:"min=0,max=24"`
+	// MaxAgeDays makes users change their password at login once it is
+	// older; 0 means passwords do not expire.
+	MaxAgeDays int `json:"max_age_days" validate:"min=0"`
+}
+
+// DefaultPolicy is the policy of apps that have not set one.
+func DefaultPolicy() Policy {
+	return Policy{MinLength: 8, MaxLength: MaxBytes}
+}
+
+// PolicyOrDefault returns *p, or DefaultPolicy when p is nil.
+func PolicyOrDefault(p *Policy) Policy {
+	if p == nil {
+		return DefaultPolicy()
+	}
+	return *p
+}
+
+// Violation codes.
+const (
+	TooShort          = "too_short"
+	TooLong           = "too_long"
+	MissingUppercase  = "missing_uppercase"
+	MissingLowercase  = "missing_lowercase"
+	MissingDigit      = "missing_digit"
+	MissingSymbol     = "missing_symbol"
+	ContainsPersonal  = "contains_personal_info"
+	RecentlyUsed      = "recently_used"
+	Breached          = "breached"
+	minPersonalLength = 3
+)
+
+// Violation describes a rule of the policy a password breaks.
+type Violation struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Owner is the personal information a password must not contain.
+type Owner struct {
+	Email     string
+	Firstname string
+	Lastname  string
+}
+
+// Check returns the rules of the policy the password breaks. Reuse of
+// previous passwords is not checked, as it needs their hashes.
+func (p Policy) Check(password string, owner Owner) []Violation {
+	var violations []Violation
+	add := func(code, format string, args ...any) {
+		violations = append(violations, Violation{Code: code, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if n := utf8.RuneCountInString(password); n < p.MinLength {
+		add(TooShort, "must be at least %d characters long", p.MinLength)
+	}
+	if max := p.maxLength(); len(password) > max {
+		add(TooLong, "must be at most %d bytes long", max)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if p.RequireUppercase && !upper {
+		add(MissingUppercase, "must contain an uppercase letter")
+	}
+	if p.RequireLowercase && !lower {
+		add(MissingLowercase, "must contain a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		add(MissingDigit, "must contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		add(MissingSymbol, "must contain a symbol")
+	}
+
+	if p.DisallowPersonalInfo && containsPersonalInfo(password, owner) {
+		add(ContainsPersonal, "must not contain your email or name")
+	}
+	return violations
+}
+
+// ReuseViolation is the violation of a password used too recently.
+func (p Policy) ReuseViolation() Violation {
+	return Violation{Code: RecentlyUsed, Message: fmt.Sprintf("must not be one of your last %d passwords", max(p.History, 1))}
+}
+
+// BreachedViolation is the violation of a password known from data breaches.
+func BreachedViolation() Violation {
+	return Violation{Code: Breached, Message: "must not be a password exposed in a data breach"}
+}
+
+func (p Policy) maxLength() int {
+	if p.MaxLength <= 0 || p.MaxLength > MaxBytes {
+		return MaxBytes
+	}
+	return p.MaxLength
+}
+
+// containsPersonalInfo reports whether the password contains, ignoring case,
+// any part of the owner's email or name long enough to be meaningful.
+func containsPersonalInfo(password string, owner Owner) bool {
+	password = strings.ToLower(password)
+	local, _, _ := strings.Cut(owner.Email, "@")
+	for _, s := range []string{owner.Email, local, owner.Firstname, owner.Lastname} {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if utf8.RuneCountInString(s) >= minPersonalLength && strings.Contains(password, s) {
+			return true
+		}
+	}
+	return false
+}
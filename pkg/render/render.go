@@ -5,13 +5,23 @@ import (
 	"net/http"
 )
 
-// Response is the standard API response format.
+// Response is the standard API response format. Meta is set on the pages
+// of paginated lists.
 type Response struct {
 	Data   interface{} `json:"data,omitempty"`
+	Meta   *Meta       `json:"meta,omitempty"`
 	Error  string      `json:"error,omitempty"`
 	Status int         `json:"status"`
 }
 
+// Meta describes a page of a paginated list.
+type Meta struct {
+	// Total is the number of items matching the filters, across all pages.
+	Total int `json:"total"`
+	// NextCursor fetches the next page. It is empty on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
 // JSON sends a JSON response.
 func JSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -22,6 +32,17 @@ func JSON(w http.ResponseWriter, status int, data interface{}) {
 	})
 }
 
+// Page sends a JSON response with a page of a list and its metadata.
+func Page(w http.ResponseWriter, status int, data interface{}, meta Meta) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Response{
+		Data:   data,
+		Meta:   &meta,
+		Status: status,
+	})
+}
+
 // Error sends a JSON error response.
 func Error(w http.ResponseWriter, status int, message string) {
 	w.Header().Set("Content-Type", "application/json")
@@ -31,3 +52,14 @@ func Error(w http.ResponseWriter, status int, message string) {
 		Status: status,
 	})
 }
+
+// ErrorWithData sends a JSON error response with data detailing the error.
+func ErrorWithData(w http.ResponseWriter, status int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(Response{
+		Data:   data,
+		Error:  message,
+		Status: status,
+	})
+}
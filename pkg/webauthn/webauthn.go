@@ -0,0 +1,481 @@
+// Package webauthn implements the relying party side of the WebAuthn
+// registration and authentication ceremonies used by passkeys and security
+// keys. It builds the options passed to navigator.credentials.create and
+// navigator.credentials.get and verifies the credentials they return.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrInvalidCredential is wrapped by every verification failure.
+var ErrInvalidCredential = errors.New("webauthn: invalid credential")
+
+// Authenticator data flags.
+const (
+	flagUserPresent    = 0x01
+	flagUserVerified   = 0x04
+	flagBackupEligible = 0x08
+	flagBackedUp       = 0x10
+	flagAttestedData   = 0x40
+	flagExtensionData  = 0x80
+)
+
+// RelyingParty identifies the site credentials are bound to.
+type RelyingParty struct {
+	// ID is the domain credentials are scoped to, such as example.com.
+	ID   string
+	Name string
+	// Origins are the origins allowed to run ceremonies, such as
+	// https://login.example.com. When empty only https://<ID> is allowed.
+	Origins []string
+}
+
+func (rp RelyingParty) allowsOrigin(origin string) bool {
+	if len(rp.Origins) == 0 {
+		return origin == "https://"+rp.ID
+	}
+	return slices.Contains(rp.Origins, origin)
+}
+
+// Bytes is binary data encoded as unpadded base64url in JSON, as in the JSON
+// forms of WebAuthn options and credentials.
+type Bytes []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Padding is tolerated.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return fmt.Errorf("decode base64url: %w", err)
+	}
+	*b = decoded
+	return nil
+}
+
+// NewChallenge returns a random 32 byte ceremony challenge.
+func NewChallenge() ([]byte, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("read random bytes: %w", err)
+	}
+	return b, nil
+}
+
+// CreationOptions are the publicKey options of navigator.credentials.create.
+type CreationOptions struct {
+	Challenge              Bytes                  `json:"challenge" swaggertype:"string"`
+	RP                     RPEntity               `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout,omitempty"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials,omitempty"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions are the publicKey options of navigator.credentials.get.
+type RequestOptions struct {
+	Challenge        Bytes                  `json:"challenge" swaggertype:"string"`
+	Timeout          int64                  `json:"timeout,omitempty"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials,omitempty"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// RPEntity describes the relying party to the authenticator.
+type RPEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserEntity describes the account a credential is created for. ID is the
+// user handle returned by discoverable credentials; it must not contain
+// personal information.
+type UserEntity struct {
+	ID          Bytes  `json:"id" swaggertype:"string"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// CredentialParameter names an accepted credential algorithm.
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// CredentialDescriptor identifies an existing credential.
+type CredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         Bytes    `json:"id" swaggertype:"string"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+// AuthenticatorSelection states the authenticator features a registration needs.
+type AuthenticatorSelection struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
+
+// NewCredentialDescriptor returns the descriptor of a stored credential.
+func NewCredentialDescriptor(id []byte, transports []string) CredentialDescriptor {
+	return CredentialDescriptor{Type: "public-key", ID: id, Transports: transports}
+}
+
+// CreationOptions returns the options for registering a discoverable
+// credential, a passkey, for user. Credentials the user already has are
+// excluded so an authenticator is not registered twice. User verification is
+// required so a passkey alone can sign the user in.
+func (rp RelyingParty) CreationOptions(challenge []byte, user UserEntity, exclude []CredentialDescriptor, timeout time.Duration) CreationOptions {
+	params := make([]CredentialParameter, len(SupportedAlgorithms))
+	for i, alg := range SupportedAlgorithms {
+		params[i] = CredentialParameter{Type: "public-key", Alg: alg}
+	}
+	return CreationOptions{
+		Challenge:          challenge,
+		RP:                 RPEntity{ID: rp.ID, Name: rp.Name},
+		User:               user,
+		PubKeyCredParams:   params,
+		Timeout:            timeout.Milliseconds(),
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:        "required",
+			RequireResidentKey: true,
+			UserVerification:   "required",
+		},
+		Attestation: "none",
+	}
+}
+
+// RequestOptions returns the options for signing in. With no allowed
+// credentials the browser offers every passkey it has for the relying party.
+func (rp RelyingParty) RequestOptions(challenge []byte, allow []CredentialDescriptor, timeout time.Duration) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		Timeout:          timeout.Milliseconds(),
+		RPID:             rp.ID,
+		AllowCredentials: allow,
+		UserVerification: "required",
+	}
+}
+
+// RegistrationCredential is the JSON form of the PublicKeyCredential returned
+// by navigator.credentials.create.
+type RegistrationCredential struct {
+	ID       string              `json:"id"`
+	RawID    Bytes               `json:"rawId" swaggertype:"string"`
+	Type     string              `json:"type"`
+	Response AttestationResponse `json:"response"`
+}
+
+// AttestationResponse is the response of a registration.
+type AttestationResponse struct {
+	ClientDataJSON    Bytes    `json:"clientDataJSON" swaggertype:"string"`
+	AttestationObject Bytes    `json:"attestationObject" swaggertype:"string"`
+	Transports        []string `json:"transports,omitempty"`
+}
+
+// AssertionCredential is the JSON form of the PublicKeyCredential returned by
+// navigator.credentials.get.
+type AssertionCredential struct {
+	ID       string            `json:"id"`
+	RawID    Bytes             `json:"rawId" swaggertype:"string"`
+	Type     string            `json:"type"`
+	Response AssertionResponse `json:"response"`
+}
+
+// AssertionResponse is the response of an authentication.
+type AssertionResponse struct {
+	ClientDataJSON    Bytes `json:"clientDataJSON" swaggertype:"string"`
+	AuthenticatorData Bytes `json:"authenticatorData" swaggertype:"string"`
+	Signature         Bytes `json:"signature" swaggertype:"string"`
+	UserHandle        Bytes `json:"userHandle,omitempty" swaggertype:"string"`
+}
+
+// Credential is a verified new credential to store for the user.
+type Credential struct {
+	ID []byte
+	// PublicKey is the COSE_Key, passed back to VerifyAssertion.
+	PublicKey      []byte
+	Algorithm      int64
+	SignCount      uint32
+	AAGUID         []byte
+	Transports     []string
+	BackupEligible bool
+	BackedUp       bool
+}
+
+// clientData is the collected client data signed by the authenticator.
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// Challenge returns the challenge clientDataJSON was created for, so the
+// caller can find the ceremony it belongs to. The data is not verified.
+func Challenge(clientDataJSON []byte) ([]byte, error) {
+	var cd clientData
+	if err := json.Unmarshal(clientDataJSON, &cd); err != nil {
+		return nil, fmt.Errorf("%w: decode client data: %v", ErrInvalidCredential, err)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(cd.Challenge, "="))
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode challenge: %v", ErrInvalidCredential, err)
+	}
+	return challenge, nil
+}
+
+// VerifyRegistration checks a registration made with CreationOptions and
+// challenge and returns the new credential. Attestation statements are
+// checked for consistency but not against trusted roots, as "none"
+// attestation is requested.
+func (rp RelyingParty) VerifyRegistration(challenge []byte, cred RegistrationCredential) (*Credential, error) {
+	if cred.Type != "public-key" {
+		return nil, fmt.Errorf("%w: unexpected type %q", ErrInvalidCredential, cred.Type)
+	}
+	if err := rp.verifyClientData(cred.Response.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	v, rest, err := decodeCBOR(cred.Response.AttestationObject)
+	if err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: malformed attestation object", ErrInvalidCredential)
+	}
+	obj, _ := v.(map[any]any)
+	format, _ := obj["fmt"].(string)
+	stmt, _ := obj["attStmt"].(map[any]any)
+	rawAuthData, _ := obj["authData"].([]byte)
+	if format == "" || stmt == nil || rawAuthData == nil {
+		return nil, fmt.Errorf("%w: malformed attestation object", ErrInvalidCredential)
+	}
+
+	ad, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(ad); err != nil {
+		return nil, err
+	}
+	if ad.flags&flagAttestedData == 0 {
+		return nil, fmt.Errorf("%w: no attested credential data", ErrInvalidCredential)
+	}
+	if !bytes.Equal(ad.credentialID, cred.RawID) {
+		return nil, fmt.Errorf("%w: credential id mismatch", ErrInvalidCredential)
+	}
+	pub, err := ParsePublicKey(ad.publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+	}
+
+	clientDataHash := sha256.Sum256(cred.Response.ClientDataJSON)
+	signed := append(slices.Clip(rawAuthData), clientDataHash[:]...)
+	if err := verifyAttestation(format, stmt, signed, pub); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:             ad.credentialID,
+		PublicKey:      ad.publicKey,
+		Algorithm:      pub.Algorithm,
+		SignCount:      ad.signCount,
+		AAGUID:         ad.aaguid,
+		Transports:     cred.Response.Transports,
+		BackupEligible: ad.flags&flagBackupEligible != 0,
+		BackedUp:       ad.flags&flagBackedUp != 0,
+	}, nil
+}
+
+// VerifyAssertion checks a sign-in made with RequestOptions and challenge
+// against the stored public key and sign count of the credential, and returns
+// the new sign count to store.
+func (rp RelyingParty) VerifyAssertion(challenge []byte, cred AssertionCredential, publicKey []byte, signCount uint32) (uint32, error) {
+	if cred.Type != "public-key" {
+		return 0, fmt.Errorf("%w: unexpected type %q", ErrInvalidCredential, cred.Type)
+	}
+	if err := rp.verifyClientData(cred.Response.ClientDataJSON, "webauthn.get", challenge); err != nil {
+		return 0, err
+	}
+
+	ad, err := parseAuthenticatorData(cred.Response.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+	if err := rp.verifyAuthenticatorData(ad); err != nil {
+		return 0, err
+	}
+
+	pub, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+	}
+	clientDataHash := sha256.Sum256(cred.Response.ClientDataJSON)
+	signed := append(slices.Clip([]byte(cred.Response.AuthenticatorData)), clientDataHash[:]...)
+	if err := pub.Verify(signed, cred.Response.Signature); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+	}
+
+	// Authenticators that keep a counter must increase it on every use; a
+	// counter that does not grow means the credential may have been cloned.
+	// Synced passkeys always report zero.
+	if (ad.signCount != 0 || signCount != 0) && ad.signCount <= signCount {
+		return 0, fmt.Errorf("%w: sign count did not increase", ErrInvalidCredential)
+	}
+	return ad.signCount, nil
+}
+
+func (rp RelyingParty) verifyClientData(raw []byte, typ string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return fmt.Errorf("%w: decode client data: %v", ErrInvalidCredential, err)
+	}
+	if cd.Type != typ {
+		return fmt.Errorf("%w: unexpected client data type %q", ErrInvalidCredential, cd.Type)
+	}
+	got, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(cd.Challenge, "="))
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return fmt.Errorf("%w: challenge mismatch", ErrInvalidCredential)
+	}
+	if !rp.allowsOrigin(cd.Origin) {
+		return fmt.Errorf("%w: origin %q not allowed", ErrInvalidCredential, cd.Origin)
+	}
+	if cd.CrossOrigin {
+		return fmt.Errorf("%w: cross-origin ceremony", ErrInvalidCredential)
+	}
+	return nil
+}
+
+func (rp RelyingParty) verifyAuthenticatorData(ad *authenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(ad.rpIDHash, rpIDHash[:]) != 1 {
+		return fmt.Errorf("%w: relying party id mismatch", ErrInvalidCredential)
+	}
+	if ad.flags&flagUserPresent == 0 {
+		return fmt.Errorf("%w: user not present", ErrInvalidCredential)
+	}
+	if ad.flags&flagUserVerified == 0 {
+		return fmt.Errorf("%w: user not verified", ErrInvalidCredential)
+	}
+	return nil
+}
+
+// authenticatorData is the parsed authenticator data of a ceremony.
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseAuthenticatorData(b []byte) (*authenticatorData, error) {
+	malformed := fmt.Errorf("%w: malformed authenticator data", ErrInvalidCredential)
+	if len(b) < 37 {
+		return nil, malformed
+	}
+	ad := &authenticatorData{
+		rpIDHash:  b[:32],
+		flags:     b[32],
+		signCount: binary.BigEndian.Uint32(b[33:37]),
+	}
+	rest := b[37:]
+
+	if ad.flags&flagAttestedData != 0 {
+		if len(rest) < 18 {
+			return nil, malformed
+		}
+		ad.aaguid = rest[:16]
+		n := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if n == 0 || n > 1023 || len(rest) < n {
+			return nil, malformed
+		}
+		ad.credentialID = rest[:n]
+		rest = rest[n:]
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, malformed
+		}
+		ad.publicKey = rest[:len(rest)-len(after)]
+		rest = after
+	}
+	if ad.flags&flagExtensionData != 0 {
+		var err error
+		if _, rest, err = decodeCBOR(rest); err != nil {
+			return nil, malformed
+		}
+	}
+	if len(rest) != 0 {
+		return nil, malformed
+	}
+	return ad, nil
+}
+
+// verifyAttestation checks the attestation statement of a registration.
+// signed is the authenticator data followed by the client data hash.
+func verifyAttestation(format string, stmt map[any]any, signed []byte, pub *PublicKey) error {
+	switch format {
+	case "none":
+		if len(stmt) != 0 {
+			return fmt.Errorf("%w: none attestation with a statement", ErrInvalidCredential)
+		}
+		return nil
+	case "packed":
+		alg, _ := stmt["alg"].(int64)
+		sig, _ := stmt["sig"].([]byte)
+		if sig == nil {
+			return fmt.Errorf("%w: packed attestation without a signature", ErrInvalidCredential)
+		}
+		x5c, hasCert := stmt["x5c"].([]any)
+		if !hasCert {
+			// Self attestation, signed by the credential key itself.
+			if alg != pub.Algorithm {
+				return fmt.Errorf("%w: attestation algorithm mismatch", ErrInvalidCredential)
+			}
+			if err := pub.Verify(signed, sig); err != nil {
+				return fmt.Errorf("%w: attestation %v", ErrInvalidCredential, err)
+			}
+			return nil
+		}
+		if len(x5c) == 0 {
+			return fmt.Errorf("%w: empty attestation certificate chain", ErrInvalidCredential)
+		}
+		der, _ := x5c[0].([]byte)
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("%w: attestation certificate: %v", ErrInvalidCredential, err)
+		}
+		if cert.IsCA {
+			return fmt.Errorf("%w: attestation certificate is a CA", ErrInvalidCredential)
+		}
+		if err := verifySignature(alg, cert.PublicKey, signed, sig); err != nil {
+			return fmt.Errorf("%w: attestation %v", ErrInvalidCredential, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported attestation format %q", ErrInvalidCredential, format)
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"os"
 
-	"golang.org/x/crypto/bcrypt"
+	"keeper/pkg/password"
 )
 
 func main() {
@@ -14,11 +14,11 @@ func main() {
 		fmt.Println("Usage: go run generate_hash.go <password>")
 		return
 	}
-	password := os.Args[1]
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	pw := os.Args[1]
+	hash, err := password.DefaultHasher().Hash(pw)
 	if err != nil {
 		fmt.Println("Error generating hash:", err)
 		return
 	}
-	fmt.Println("Hash:", string(hash))
+	fmt.Println("Hash:", hash)
 }
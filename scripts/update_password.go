@@ -8,7 +8,7 @@ import (
 	"log"
 	"os"
 
-	"golang.org/x/crypto/bcrypt"
+	"keeper/pkg/password"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -27,18 +27,18 @@ func main() {
 	}
 	defer db.Close()
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	hash, err := password.DefaultHasher().Hash(newPassword)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	stmt, err := db.Prepare("UPDATE users SET password = ? WHERE email = ?")
+	stmt, err := db.Prepare("UPDATE kpr_user SET password = ?, updated_at = CURRENT_TIMESTAMP WHERE email = ?")
 	if err != nil {
 		log.Fatal(err)
 	}
 	defer stmt.Close()
 
-	res, err := stmt.Exec(string(hash), email)
+	res, err := stmt.Exec(hash, email)
 	if err != nil {
 		log.Fatal(err)
 	}
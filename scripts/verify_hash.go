@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"os"
 
-	"golang.org/x/crypto/bcrypt"
+	"keeper/pkg/password"
 )
 
 func main() {
@@ -14,10 +14,14 @@ func main() {
 		fmt.Println("Usage: go run verify_hash.go <password> <hash>")
 		return
 	}
-	password := os.Args[1]
+	pw := os.Args[1]
 	hash := os.Args[2]
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	ok, err := password.DefaultHasher().Verify(hash, pw)
 	if err != nil {
+		fmt.Println("Error verifying hash:", err)
+		return
+	}
+	if !ok {
 		fmt.Println("Password does not match hash.")
 		return
 	}